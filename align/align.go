@@ -0,0 +1,117 @@
+// Package align finds the transform that best lines one set of 3D points up
+// with another, for registering scans, calibrating sensors against each
+// other, and tracking rigid bodies from markers. Rigid and Similarity solve
+// the problem when it's known which source point goes with which target
+// point, while ICP also discovers the correspondence.
+package align
+
+import (
+	"math"
+
+	"github.com/EliCDavis/vector/internal/eigen"
+	"github.com/EliCDavis/vector/quaternion"
+	"github.com/EliCDavis/vector/vector3"
+)
+
+// Transform is a similarity transform, applied to a point by scaling it,
+// then rotating it, then translating it
+type Transform struct {
+	Rotation    quaternion.Float64
+	Translation vector3.Float64
+	Scale       float64
+}
+
+// Identity returns the transform that leaves points unchanged
+func Identity() Transform {
+	return Transform{Rotation: quaternion.Identity[float64](), Scale: 1}
+}
+
+// Apply transforms the point
+func (t Transform) Apply(p vector3.Float64) vector3.Float64 {
+	return t.Rotation.Rotate(p.Scale(t.Scale)).Add(t.Translation)
+}
+
+// RMSError returns the root mean square distance between each transformed
+// source point and its target
+func (t Transform) RMSError(source, target []vector3.Float64) float64 {
+	if len(source) == 0 {
+		return 0
+	}
+	var sum float64
+	for i, p := range source {
+		sum += t.Apply(p).DistanceSquared(target[i])
+	}
+	return math.Sqrt(sum / float64(len(source)))
+}
+
+// Rigid returns the rotation and translation that best map each source point
+// onto the target point at the same index, minimizing the summed squared
+// distance between them, as solved by the Kabsch algorithm. It returns false
+// when the sets are empty or differ in length
+func Rigid(source, target []vector3.Float64) (Transform, bool) {
+	return fit(source, target, false)
+}
+
+// Similarity is Rigid but also solves for a uniform scale, as in Umeyama's
+// method, for aligning sets measured in different units
+func Similarity(source, target []vector3.Float64) (Transform, bool) {
+	return fit(source, target, true)
+}
+
+func centroid(points []vector3.Float64) vector3.Float64 {
+	var sum vector3.Float64
+	for _, p := range points {
+		sum = sum.Add(p)
+	}
+	return sum.DivByConstant(float64(len(points)))
+}
+
+func fit(source, target []vector3.Float64, scaled bool) (Transform, bool) {
+	if len(source) == 0 || len(source) != len(target) {
+		return Transform{}, false
+	}
+
+	sourceCenter, targetCenter := centroid(source), centroid(target)
+
+	// Cross covariance of the centered point sets
+	var s [3][3]float64
+	var sourceSpread float64
+	for i := range source {
+		p, q := source[i].Sub(sourceCenter), target[i].Sub(targetCenter)
+		pc, qc := [3]float64{p.X(), p.Y(), p.Z()}, [3]float64{q.X(), q.Y(), q.Z()}
+		for r := 0; r < 3; r++ {
+			for c := 0; c < 3; c++ {
+				s[r][c] += pc[r] * qc[c]
+			}
+		}
+		sourceSpread += p.LengthSquared()
+	}
+
+	// Rather than taking the SVD of the covariance, use Horn's equivalent
+	// formulation: the optimal rotation is the unit quaternion that's the
+	// major eigenvector of this symmetric matrix. This also can't produce a
+	// reflection, which plain SVD solutions need correcting for
+	n := [][]float64{
+		{s[0][0] + s[1][1] + s[2][2], s[1][2] - s[2][1], s[2][0] - s[0][2], s[0][1] - s[1][0]},
+		{s[1][2] - s[2][1], s[0][0] - s[1][1] - s[2][2], s[0][1] + s[1][0], s[2][0] + s[0][2]},
+		{s[2][0] - s[0][2], s[0][1] + s[1][0], -s[0][0] + s[1][1] - s[2][2], s[1][2] + s[2][1]},
+		{s[0][1] - s[1][0], s[2][0] + s[0][2], s[1][2] + s[2][1], -s[0][0] - s[1][1] + s[2][2]},
+	}
+	q := eigen.Major(n)
+	rotation := quaternion.New(q[1], q[2], q[3], q[0]).Normalized()
+
+	scale := 1.
+	if scaled && sourceSpread > 0 {
+		var agreement float64
+		for i := range source {
+			agreement += target[i].Sub(targetCenter).Dot(rotation.Rotate(source[i].Sub(sourceCenter)))
+		}
+		scale = agreement / sourceSpread
+	}
+
+	return Transform{
+		Rotation:    rotation,
+		Translation: targetCenter.Sub(rotation.Rotate(sourceCenter.Scale(scale))),
+		Scale:       scale,
+	}, true
+}
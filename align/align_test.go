@@ -0,0 +1,187 @@
+package align_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/EliCDavis/vector/align"
+	"github.com/EliCDavis/vector/quaternion"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func randomPoints(r *rand.Rand, n int) []vector3.Float64 {
+	points := make([]vector3.Float64, n)
+	for i := range points {
+		points[i] = vector3.New(r.Float64(), r.Float64(), r.Float64()).Scale(10)
+	}
+	return points
+}
+
+func transformAll(t align.Transform, points []vector3.Float64) []vector3.Float64 {
+	out := make([]vector3.Float64, len(points))
+	for i, p := range points {
+		out[i] = t.Apply(p)
+	}
+	return out
+}
+
+func assertSameRotation(t *testing.T, expected, actual quaternion.Float64) {
+	t.Helper()
+	// q and -q are the same rotation
+	assert.InDelta(t, 1., math.Abs(expected.Dot(actual)), 1e-9)
+}
+
+func TestRigid(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	source := randomPoints(r, 20)
+	expected := align.Transform{
+		Rotation:    quaternion.FromAxisAngle(vector3.New(1., 2., 3.), 2.5),
+		Translation: vector3.New(4., -5., 6.),
+		Scale:       1,
+	}
+	target := transformAll(expected, source)
+
+	actual, ok := align.Rigid(source, target)
+	require.True(t, ok)
+	assertSameRotation(t, expected.Rotation, actual.Rotation)
+	assert.InDelta(t, 0., actual.Translation.Distance(expected.Translation), 1e-9)
+	assert.Equal(t, 1., actual.Scale)
+	assert.InDelta(t, 0., actual.RMSError(source, target), 1e-9)
+}
+
+func TestRigidTinyScale(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+	rotation := quaternion.FromAxisAngle(vector3.New(1., 2., 3.), 2.5)
+
+	// The fit shouldn't depend on the units the points are measured in
+	for _, unit := range []float64{1e-9, 1e-7, 1, 1e7} {
+		source := randomPoints(r, 20)
+		for i := range source {
+			source[i] = source[i].Scale(unit)
+		}
+		target := transformAll(align.Transform{Rotation: rotation, Scale: 1}, source)
+
+		actual, ok := align.Rigid(source, target)
+		require.True(t, ok)
+		assertSameRotation(t, rotation, actual.Rotation)
+		assert.Less(t, actual.RMSError(source, target)/unit, 1e-12, "unit %g", unit)
+	}
+}
+
+func TestSimilarity(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	source := randomPoints(r, 20)
+	expected := align.Transform{
+		Rotation:    quaternion.FromAxisAngle(vector3.New(0., 1., 0.), -1.),
+		Translation: vector3.New(1., 2., 3.),
+		Scale:       2.5,
+	}
+	target := transformAll(expected, source)
+
+	actual, ok := align.Similarity(source, target)
+	require.True(t, ok)
+	assertSameRotation(t, expected.Rotation, actual.Rotation)
+	assert.InDelta(t, 2.5, actual.Scale, 1e-9)
+	assert.InDelta(t, 0., actual.Translation.Distance(expected.Translation), 1e-9)
+
+	// Rigid can't account for the scale
+	rigid, ok := align.Rigid(source, target)
+	require.True(t, ok)
+	assertSameRotation(t, expected.Rotation, rigid.Rotation)
+	assert.Greater(t, rigid.RMSError(source, target), 1.)
+}
+
+func TestRigidNoisy(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	source := randomPoints(r, 200)
+	expected := align.Transform{
+		Rotation:    quaternion.FromAxisAngle(vector3.New(-1., 1., 0.), 0.7),
+		Translation: vector3.New(0., 3., 0.),
+		Scale:       1,
+	}
+	target := transformAll(expected, source)
+	for i := range target {
+		target[i] = target[i].Add(vector3.New(r.NormFloat64(), r.NormFloat64(), r.NormFloat64()).Scale(0.01))
+	}
+
+	actual, ok := align.Rigid(source, target)
+	require.True(t, ok)
+	assert.InDelta(t, 1., math.Abs(expected.Rotation.Dot(actual.Rotation)), 1e-4)
+	assert.InDelta(t, 0., actual.Translation.Distance(expected.Translation), 0.01)
+}
+
+func TestRigidInvalid(t *testing.T) {
+	_, ok := align.Rigid(nil, nil)
+	assert.False(t, ok)
+
+	_, ok = align.Rigid(make([]vector3.Float64, 2), make([]vector3.Float64, 3))
+	assert.False(t, ok)
+
+	single, ok := align.Rigid([]vector3.Float64{vector3.New(1., 1., 1.)}, []vector3.Float64{vector3.New(2., 3., 4.)})
+	require.True(t, ok)
+	assert.InDelta(t, 0., single.Apply(vector3.New(1., 1., 1.)).Distance(vector3.New(2., 3., 4.)), 1e-12)
+}
+
+func TestICP(t *testing.T) {
+	r := rand.New(rand.NewSource(4))
+	target := randomPoints(r, 500)
+	expected := align.Transform{
+		Rotation:    quaternion.FromAxisAngle(vector3.New(0., 0., 1.), 0.1),
+		Translation: vector3.New(0.2, -0.1, 0.3),
+		Scale:       1,
+	}
+
+	// The source is a shuffled subset of the target, moved out of place
+	inverse := align.Transform{
+		Rotation:    expected.Rotation.Inverse(),
+		Translation: expected.Rotation.Inverse().Rotate(expected.Translation).Scale(-1),
+		Scale:       1,
+	}
+	perm := r.Perm(len(target))[:300]
+	source := make([]vector3.Float64, len(perm))
+	for i, p := range perm {
+		source[i] = inverse.Apply(target[p])
+	}
+
+	result, ok := align.ICP(source, target, align.ICPOptions{Tolerance: 1e-12, Iterations: 100})
+	require.True(t, ok)
+	assert.True(t, result.Converged)
+	assert.Equal(t, 300, result.Matched)
+	assert.InDelta(t, 0., result.RMSError, 1e-6)
+	assertSameRotation(t, expected.Rotation, result.Transform.Rotation)
+	assert.InDelta(t, 0., result.Transform.Translation.Distance(expected.Translation), 1e-6)
+}
+
+func TestICPMaxDistance(t *testing.T) {
+	r := rand.New(rand.NewSource(5))
+	target := randomPoints(r, 300)
+	offset := vector3.New(0.05, 0., 0.)
+
+	// Source points from the target, plus some far away points that have no
+	// counterpart
+	var source []vector3.Float64
+	for _, p := range target[:200] {
+		source = append(source, p.Sub(offset))
+	}
+	for i := 0; i < 20; i++ {
+		source = append(source, vector3.New(r.Float64(), r.Float64(), r.Float64()).Scale(5).Add(vector3.Fill(30.)))
+	}
+
+	result, ok := align.ICP(source, target, align.ICPOptions{MaxDistance: 1, Tolerance: 1e-12})
+	require.True(t, ok)
+	assert.Equal(t, 200, result.Matched)
+	assert.InDelta(t, 0., result.Transform.Translation.Distance(offset), 1e-6)
+}
+
+func TestICPInvalid(t *testing.T) {
+	_, ok := align.ICP(make([]vector3.Float64, 2), make([]vector3.Float64, 10), align.ICPOptions{})
+	assert.False(t, ok)
+
+	// Nothing within range
+	source := []vector3.Float64{vector3.New(100., 0., 0.), vector3.New(101., 0., 0.), vector3.New(100., 1., 0.)}
+	_, ok = align.ICP(source, make([]vector3.Float64, 3), align.ICPOptions{MaxDistance: 1})
+	assert.False(t, ok)
+}
@@ -0,0 +1,113 @@
+package align
+
+import (
+	"math"
+
+	"github.com/EliCDavis/vector/vector3"
+)
+
+// DefaultICPIterations is the number of iterations ICP runs when
+// ICPOptions.Iterations isn't set
+const DefaultICPIterations = 50
+
+// ICPOptions controls an ICP alignment
+type ICPOptions struct {
+	// Initial is the starting guess of the transform. ICP only finds the
+	// nearest good alignment, so a rough guess is needed when the point
+	// sets start far apart. The zero value starts from the identity
+	Initial Transform
+
+	// Iterations is the maximum number of iterations to run. It defaults to
+	// DefaultICPIterations when zero
+	Iterations int
+
+	// Tolerance stops iterating once the RMS error improves by less than it
+	Tolerance float64
+
+	// MaxDistance, when positive, ignores source points further than it from
+	// their closest target point, so parts of the source missing from the
+	// target don't pull the alignment off
+	MaxDistance float64
+
+	// Scale also solves for a uniform scale, see Similarity
+	Scale bool
+}
+
+// ICPResult is the outcome of an ICP alignment
+type ICPResult struct {
+	Transform Transform
+
+	// RMSError is the root mean square distance between the transformed
+	// source points that were matched and their closest target points
+	RMSError float64
+
+	// Matched is the number of source points within MaxDistance of a
+	// target point in the final iteration
+	Matched int
+
+	// Iterations is the number of iterations run
+	Iterations int
+
+	// Converged is true when iterating stopped because the error settled
+	// within Tolerance, rather than running out of iterations
+	Converged bool
+}
+
+// ICP aligns the source points with the target points using iterative
+// closest point: each iteration pairs every transformed source point with its
+// closest target point, then solves for the transform that best maps the
+// source onto those pairs. The point sets don't need to be the same size or
+// correspond to each other. It returns false when fewer than three source
+// points can be matched
+func ICP(source, target []vector3.Float64, opts ICPOptions) (ICPResult, bool) {
+	if len(source) < 3 || len(target) == 0 {
+		return ICPResult{}, false
+	}
+
+	transform := opts.Initial
+	if transform == (Transform{}) {
+		transform = Identity()
+	}
+
+	iterations := opts.Iterations
+	if iterations <= 0 {
+		iterations = DefaultICPIterations
+	}
+
+	tree := newKDTree(target)
+	maxDistanceSquared := math.Inf(1)
+	if opts.MaxDistance > 0 {
+		maxDistanceSquared = opts.MaxDistance * opts.MaxDistance
+	}
+
+	matchedSource := make([]vector3.Float64, 0, len(source))
+	matchedTarget := make([]vector3.Float64, 0, len(source))
+	result := ICPResult{Transform: transform, RMSError: math.Inf(1)}
+	for result.Iterations < iterations {
+		matchedSource, matchedTarget = matchedSource[:0], matchedTarget[:0]
+		for _, p := range source {
+			closest, d := tree.nearest(transform.Apply(p))
+			if d <= maxDistanceSquared {
+				matchedSource = append(matchedSource, p)
+				matchedTarget = append(matchedTarget, target[closest])
+			}
+		}
+		if len(matchedSource) < 3 {
+			break
+		}
+
+		next, _ := fit(matchedSource, matchedTarget, opts.Scale)
+		rms := next.RMSError(matchedSource, matchedTarget)
+		result.Iterations++
+
+		improvement := result.RMSError - rms
+		transform = next
+		result.Transform, result.RMSError, result.Matched = next, rms, len(matchedSource)
+		if improvement <= opts.Tolerance {
+			result.Converged = true
+			break
+		}
+	}
+
+	return result, result.Iterations > 0
+}
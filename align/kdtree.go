@@ -0,0 +1,79 @@
+package align
+
+import (
+	"sort"
+
+	"github.com/EliCDavis/vector/vector3"
+)
+
+// kdTree answers nearest neighbor queries against a fixed set of points. The
+// tree is stored implicitly: each subslice of nodes has its splitting point
+// at the middle, with the points below it on the left
+type kdTree struct {
+	points []vector3.Float64
+	nodes  []int
+}
+
+func component(p vector3.Float64, axis int) float64 {
+	switch axis {
+	case 0:
+		return p.X()
+	case 1:
+		return p.Y()
+	}
+	return p.Z()
+}
+
+func newKDTree(points []vector3.Float64) *kdTree {
+	t := &kdTree{points: points, nodes: make([]int, len(points))}
+	for i := range t.nodes {
+		t.nodes[i] = i
+	}
+	t.build(t.nodes, 0)
+	return t
+}
+
+func (t *kdTree) build(nodes []int, depth int) {
+	if len(nodes) <= 1 {
+		return
+	}
+	axis := depth % 3
+	sort.Slice(nodes, func(i, j int) bool {
+		return component(t.points[nodes[i]], axis) < component(t.points[nodes[j]], axis)
+	})
+	mid := len(nodes) / 2
+	t.build(nodes[:mid], depth+1)
+	t.build(nodes[mid+1:], depth+1)
+}
+
+// nearest returns the index of the point closest to p, along with its
+// squared distance
+func (t *kdTree) nearest(p vector3.Float64) (int, float64) {
+	best, bestDistance := -1, 0.
+	t.search(t.nodes, 0, p, &best, &bestDistance)
+	return best, bestDistance
+}
+
+func (t *kdTree) search(nodes []int, depth int, p vector3.Float64, best *int, bestDistance *float64) {
+	if len(nodes) == 0 {
+		return
+	}
+
+	mid := len(nodes) / 2
+	index := nodes[mid]
+	if d := t.points[index].DistanceSquared(p); *best < 0 || d < *bestDistance {
+		*best, *bestDistance = index, d
+	}
+
+	axis := depth % 3
+	delta := component(p, axis) - component(t.points[index], axis)
+	near, far := nodes[:mid], nodes[mid+1:]
+	if delta > 0 {
+		near, far = far, near
+	}
+
+	t.search(near, depth+1, p, best, bestDistance)
+	if delta*delta < *bestDistance {
+		t.search(far, depth+1, p, best, bestDistance)
+	}
+}
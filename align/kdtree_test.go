@@ -0,0 +1,33 @@
+package align
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKDTreeNearest(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	points := make([]vector3.Float64, 1000)
+	for i := range points {
+		points[i] = vector3.New(r.Float64(), r.Float64(), r.Float64())
+	}
+	tree := newKDTree(points)
+
+	for i := 0; i < 200; i++ {
+		p := vector3.New(r.Float64(), r.Float64(), r.Float64()).Scale(1.2)
+
+		expected := 0
+		for j := range points {
+			if points[j].DistanceSquared(p) < points[expected].DistanceSquared(p) {
+				expected = j
+			}
+		}
+
+		actual, d := tree.nearest(p)
+		assert.Equal(t, expected, actual)
+		assert.Equal(t, points[expected].DistanceSquared(p), d)
+	}
+}
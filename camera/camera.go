@@ -0,0 +1,176 @@
+// Package camera implements common camera controllers as plain math. Each
+// controller is a small state struct updated from input deltas, such as
+// mouse movement or key presses scaled by frame time, and produces the eye,
+// target and up vectors or view matrix to render with. Input handling is
+// left entirely to the caller.
+//
+// Both controllers assume a Y up world, and share the same meaning of yaw
+// and pitch: a yaw and pitch of zero looks down -Z, positive yaw turns
+// left, and positive pitch looks up.
+package camera
+
+import (
+	"math"
+
+	"github.com/EliCDavis/vector/mathex"
+	"github.com/EliCDavis/vector/matrix4"
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vector3"
+)
+
+// DefaultPitchLimit keeps the camera from looking straight up or down, where
+// the view would flip over
+const DefaultPitchLimit = math.Pi/2 - 0.01
+
+// WorldUp is the up direction both controllers orient themselves to
+var WorldUp = vector3.New(0., 1., 0.)
+
+// direction returns the unit vector a camera with the given yaw and pitch
+// looks along
+func direction(yaw, pitch float64) vector3.Float64 {
+	sinYaw, cosYaw := math.Sincos(yaw)
+	sinPitch, cosPitch := math.Sincos(pitch)
+	return vector3.New(-sinYaw*cosPitch, sinPitch, -cosYaw*cosPitch)
+}
+
+// right returns the horizontal unit vector to the right of a camera with the
+// given yaw
+func right(yaw float64) vector3.Float64 {
+	sin, cos := math.Sincos(yaw)
+	return vector3.New(cos, 0, -sin)
+}
+
+// Orbit is a camera circling around a target point, as used by model viewers
+// and editors
+type Orbit struct {
+	Target   vector3.Float64
+	Distance float64
+	Yaw      float64
+	Pitch    float64
+
+	MinDistance, MaxDistance float64
+	MinPitch, MaxPitch       float64
+}
+
+// NewOrbit creates a camera looking at target from distance away along +Z,
+// with the pitch limited by DefaultPitchLimit and no limit on distance
+func NewOrbit(target vector3.Float64, distance float64) *Orbit {
+	return &Orbit{
+		Target:      target,
+		Distance:    distance,
+		MaxDistance: math.Inf(1),
+		MinPitch:    -DefaultPitchLimit,
+		MaxPitch:    DefaultPitchLimit,
+	}
+}
+
+// Rotate orbits the camera around the target, given a screen space delta in
+// radians. Positive X turns the view right and positive Y turns it down
+func (o *Orbit) Rotate(delta vector2.Float64) {
+	o.Yaw -= delta.X()
+	o.Pitch = mathex.Clamp(o.Pitch-delta.Y(), o.MinPitch, o.MaxPitch)
+}
+
+// Zoom multiplies the distance to the target by factor, within the distance
+// limits. Factors below 1 move the camera closer
+func (o *Orbit) Zoom(factor float64) {
+	o.Distance = mathex.Clamp(o.Distance*factor, o.MinDistance, o.MaxDistance)
+}
+
+// Pan slides the target, and the camera with it, along the view's right and
+// up axes. The delta is scaled by the distance to the target so panning
+// feels the same at every zoom level
+func (o *Orbit) Pan(delta vector2.Float64) {
+	forward := direction(o.Yaw, o.Pitch)
+	r := right(o.Yaw)
+	up := r.Cross(forward)
+	o.Target = o.Target.
+		Add(r.Scale(delta.X() * o.Distance)).
+		Add(up.Scale(delta.Y() * o.Distance))
+}
+
+// Forward returns the unit vector the camera looks along
+func (o Orbit) Forward() vector3.Float64 {
+	return direction(o.Yaw, o.Pitch)
+}
+
+// Eye returns the position of the camera
+func (o Orbit) Eye() vector3.Float64 {
+	return o.Target.Sub(o.Forward().Scale(o.Distance))
+}
+
+// Up returns the up vector to build the view from
+func (o Orbit) Up() vector3.Float64 {
+	return WorldUp
+}
+
+// View returns the view matrix of the camera
+func (o Orbit) View(handedness matrix4.Handedness) matrix4.Float64 {
+	return matrix4.LookAt(o.Eye(), o.Target, o.Up(), handedness)
+}
+
+// Fly is a first person camera free to move and look around
+type Fly struct {
+	Position vector3.Float64
+	Yaw      float64
+	Pitch    float64
+
+	MinPitch, MaxPitch float64
+}
+
+// NewFly creates a camera at position looking down -Z, with the pitch
+// limited by DefaultPitchLimit
+func NewFly(position vector3.Float64) *Fly {
+	return &Fly{
+		Position: position,
+		MinPitch: -DefaultPitchLimit,
+		MaxPitch: DefaultPitchLimit,
+	}
+}
+
+// Look turns the camera, given a screen space delta in radians. Positive X
+// turns the view right and positive Y turns it down
+func (f *Fly) Look(delta vector2.Float64) {
+	f.Yaw -= delta.X()
+	f.Pitch = mathex.Clamp(f.Pitch-delta.Y(), f.MinPitch, f.MaxPitch)
+}
+
+// Move translates the camera relative to where it's looking. X moves right,
+// Y moves up along WorldUp, and Z moves forward in the direction the camera
+// faces
+func (f *Fly) Move(delta vector3.Float64) {
+	f.Position = f.Position.
+		Add(f.Right().Scale(delta.X())).
+		Add(WorldUp.Scale(delta.Y())).
+		Add(f.Forward().Scale(delta.Z()))
+}
+
+// Forward returns the unit vector the camera looks along
+func (f Fly) Forward() vector3.Float64 {
+	return direction(f.Yaw, f.Pitch)
+}
+
+// Right returns the horizontal unit vector to the right of the camera
+func (f Fly) Right() vector3.Float64 {
+	return right(f.Yaw)
+}
+
+// Eye returns the position of the camera
+func (f Fly) Eye() vector3.Float64 {
+	return f.Position
+}
+
+// Target returns a point one unit in front of the camera
+func (f Fly) Target() vector3.Float64 {
+	return f.Position.Add(f.Forward())
+}
+
+// Up returns the up vector to build the view from
+func (f Fly) Up() vector3.Float64 {
+	return WorldUp
+}
+
+// View returns the view matrix of the camera
+func (f Fly) View(handedness matrix4.Handedness) matrix4.Float64 {
+	return matrix4.LookAt(f.Eye(), f.Target(), f.Up(), handedness)
+}
@@ -0,0 +1,62 @@
+package camera_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/EliCDavis/vector/camera"
+	"github.com/EliCDavis/vector/matrix4"
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/EliCDavis/vector/vectortest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrbit(t *testing.T) {
+	o := camera.NewOrbit(vector3.New(1., 2., 3.), 5)
+	vectortest.AssertVector3InDelta(t, vector3.New(1., 2., 8.), o.Eye(), 1e-12)
+
+	// Turning the view right swings the eye around to the left of the target
+	o.Rotate(vector2.New(math.Pi/2, 0.))
+	vectortest.AssertVector3InDelta(t, vector3.New(-4., 2., 3.), o.Eye(), 1e-12)
+	vectortest.AssertVector3InDelta(t, vector3.New(1., 0., 0.), o.Forward(), 1e-12)
+
+	// Looking down raises the eye above the target
+	o.Rotate(vector2.New(0., math.Pi/2))
+	assert.InDelta(t, -camera.DefaultPitchLimit, o.Pitch, 1e-12)
+	assert.Greater(t, o.Eye().Y(), 6.9)
+	assert.InDelta(t, 5., o.Eye().Distance(o.Target), 1e-12)
+
+	o.MinDistance = 2
+	o.Zoom(0.1)
+	assert.Equal(t, 2., o.Distance)
+
+	view := o.View(matrix4.RightHanded)
+	vectortest.AssertVector3InDelta(t, vector3.New(0., 0., -2.), view.MulPosition(o.Target), 1e-9)
+}
+
+func TestOrbitPan(t *testing.T) {
+	o := camera.NewOrbit(vector3.Zero[float64](), 10)
+	o.Pan(vector2.New(0.1, 0.2))
+	vectortest.AssertVector3InDelta(t, vector3.New(1., 2., 0.), o.Target, 1e-12)
+	vectortest.AssertVector3InDelta(t, vector3.New(1., 2., 10.), o.Eye(), 1e-12)
+}
+
+func TestFly(t *testing.T) {
+	f := camera.NewFly(vector3.Zero[float64]())
+	vectortest.AssertVector3InDelta(t, vector3.New(0., 0., -1.), f.Forward(), 1e-12)
+	vectortest.AssertVector3InDelta(t, vector3.New(1., 0., 0.), f.Right(), 1e-12)
+
+	f.Move(vector3.New(1., 2., 3.))
+	vectortest.AssertVector3InDelta(t, vector3.New(1., 2., -3.), f.Position, 1e-12)
+
+	f.Look(vector2.New(math.Pi/2, 0.))
+	vectortest.AssertVector3InDelta(t, vector3.New(1., 0., 0.), f.Forward(), 1e-12)
+	vectortest.AssertVector3InDelta(t, vector3.New(0., 0., 1.), f.Right(), 1e-12)
+
+	f.Look(vector2.New(0., -math.Pi/4))
+	vectortest.AssertVector3InDelta(t, vector3.New(1., 1., 0.).Normalized(), f.Forward(), 1e-12)
+
+	view := f.View(matrix4.RightHanded)
+	vectortest.AssertVector3InDelta(t, vector3.New(0., 0., -1.), view.MulPosition(f.Target()), 1e-12)
+}
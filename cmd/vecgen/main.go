@@ -0,0 +1,78 @@
+// Command vecgen generates vector-like types with custom component names,
+// such as colors, texture coordinates or ranges, that share the API of the
+// vector2, vector3 and vector4 packages: component accessors and setters,
+// swizzles, arithmetic, lengths, and conversions between numeric types.
+//
+// It's meant to be run through go generate, which supplies the package name:
+//
+//	//go:generate go run github.com/EliCDavis/vector/cmd/vecgen -type RGBA -components r,g,b,a
+//
+// This writes rgba_gen.go containing a generic RGBA[T] type with the
+// components r, g, b and a, a NewRGBA constructor, and methods such as R,
+// SetR, BGRA and Add.
+//
+// Flags:
+//
+//	-type        name of the type to generate
+//	-components  comma separated, lowercase component names
+//	-package     package name, defaulting to $GOPACKAGE
+//	-out         file to write, defaulting to <type>_gen.go
+//	-swizzle     whether to generate swizzles, true by default
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/EliCDavis/vector/internal/gen"
+)
+
+func main() {
+	typeName := flag.String("type", "", "name of the type to generate")
+	components := flag.String("components", "", "comma separated, lowercase component names")
+	pkg := flag.String("package", os.Getenv("GOPACKAGE"), "package name, defaulting to $GOPACKAGE")
+	out := flag.String("out", "", "file to write, defaulting to <type>_gen.go")
+	swizzle := flag.Bool("swizzle", true, "generate swizzles of the components")
+	flag.Parse()
+
+	if err := run(*typeName, *components, *pkg, *out, *swizzle); err != nil {
+		fmt.Fprintln(os.Stderr, "vecgen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(typeName, components, pkg, out string, swizzle bool) error {
+	if typeName == "" || components == "" {
+		return fmt.Errorf("-type and -components are required")
+	}
+
+	spec := gen.Spec{
+		Package:    pkg,
+		Type:       typeName,
+		Components: strings.Split(components, ","),
+		Swizzles:   map[int]gen.Swizzle{},
+		Generator:  "vecgen",
+		Declare:    true,
+	}
+	for i, c := range spec.Components {
+		spec.Components[i] = strings.TrimSpace(c)
+	}
+
+	// Swizzles only produce the type being generated, so they're limited to
+	// its own component count
+	if n := len(spec.Components); swizzle && n >= 2 && n <= 4 {
+		spec.Swizzles[n] = gen.Swizzle{}
+	}
+
+	src, err := gen.Generate(spec)
+	if err != nil {
+		return err
+	}
+
+	if out == "" {
+		out = strings.ToLower(typeName) + "_gen.go"
+	}
+	return os.WriteFile(out, src, 0o644)
+}
@@ -0,0 +1,220 @@
+// Package collide2 implements overlap tests between convex 2D shapes using
+// the separating axis theorem, reporting the minimum translation needed to
+// push overlapping shapes apart. It's intended for lightweight 2D physics
+// and game collision where pulling in a physics engine would be overkill.
+package collide2
+
+import (
+	"math"
+
+	"github.com/EliCDavis/vector/rect2"
+	"github.com/EliCDavis/vector/vector2"
+)
+
+// Circle is the solid disc of points within Radius of Center
+type Circle struct {
+	Center vector2.Float64
+	Radius float64
+}
+
+// Contact describes how two overlapping shapes, a and b, collide
+type Contact struct {
+	// Normal is the unit direction pointing from a towards b along which
+	// the shapes overlap the least
+	Normal vector2.Float64
+
+	// Depth is how far the shapes overlap along Normal
+	Depth float64
+}
+
+// MTV returns the minimum translation vector, the smallest movement of b
+// that separates the shapes. Moving a by the negated MTV works equally well
+func (c Contact) MTV() vector2.Float64 {
+	return c.Normal.Scale(c.Depth)
+}
+
+// RectCorners returns the corners of the rectangle as a convex polygon,
+// wound counter-clockwise in a Y up coordinate system
+func RectCorners(r rect2.Float64) []vector2.Float64 {
+	a, b := r.A(), r.B()
+	return []vector2.Float64{
+		a,
+		vector2.New(b.X(), a.Y()),
+		b,
+		vector2.New(a.X(), b.Y()),
+	}
+}
+
+// projection is the interval a shape covers when projected onto an axis
+type projection struct {
+	min, max float64
+}
+
+func projectPolygon(polygon []vector2.Float64, axis vector2.Float64) projection {
+	p := projection{min: math.Inf(1), max: math.Inf(-1)}
+	for _, v := range polygon {
+		d := v.Dot(axis)
+		p.min = math.Min(p.min, d)
+		p.max = math.Max(p.max, d)
+	}
+	return p
+}
+
+func projectCircle(c Circle, axis vector2.Float64) projection {
+	d := c.Center.Dot(axis)
+	return projection{min: d - c.Radius, max: d + c.Radius}
+}
+
+// separation tracks the axis of least overlap found so far while testing a
+// pair of shapes
+type separation struct {
+	contact Contact
+
+	// tied is set when b could be pushed out either way along the contact
+	// normal by the same distance, leaving its direction to be decided by
+	// the shapes' centers
+	tied bool
+}
+
+// test checks a single axis, returning false if it separates the shapes.
+// The depth is the shorter of the two pushes that separate b from a along
+// the axis, which is larger than the width of the overlap when one
+// projection contains the other
+func (s *separation) test(axis vector2.Float64, a, b projection) bool {
+	forward := a.max - b.min
+	backward := b.max - a.min
+	depth := math.Min(forward, backward)
+	if depth <= 0 {
+		return false
+	}
+	if depth < s.contact.Depth {
+		normal := axis
+		if backward < forward {
+			normal = axis.Scale(-1)
+		}
+		s.contact = Contact{Normal: normal, Depth: depth}
+		s.tied = forward == backward
+	}
+	return true
+}
+
+// oriented settles the direction of a tied contact normal so that it points
+// from a towards b
+func (s *separation) oriented(from, to vector2.Float64) Contact {
+	if s.tied && to.Sub(from).Dot(s.contact.Normal) < 0 {
+		s.contact.Normal = s.contact.Normal.Scale(-1)
+	}
+	return s.contact
+}
+
+func newSeparation() separation {
+	return separation{contact: Contact{Depth: math.Inf(1)}}
+}
+
+// edgeNormals returns the unit normal of every edge of the polygon,
+// skipping degenerate edges
+func edgeNormals(polygon []vector2.Float64) []vector2.Float64 {
+	normals := make([]vector2.Float64, 0, len(polygon))
+	for i, v := range polygon {
+		edge := polygon[(i+1)%len(polygon)].Sub(v)
+		if edge.LengthSquared() == 0 {
+			continue
+		}
+		normals = append(normals, vector2.New(-edge.Y(), edge.X()).Normalized())
+	}
+	return normals
+}
+
+func centroid(polygon []vector2.Float64) vector2.Float64 {
+	var sum vector2.Float64
+	for _, v := range polygon {
+		sum = sum.Add(v)
+	}
+	return sum.DivByConstant(float64(len(polygon)))
+}
+
+// PolygonPolygon tests whether two convex polygons overlap. Polygons may be
+// wound in either direction. Shapes that merely touch are not considered to
+// overlap
+func PolygonPolygon(a, b []vector2.Float64) (Contact, bool) {
+	if len(a) == 0 || len(b) == 0 {
+		return Contact{}, false
+	}
+
+	s := newSeparation()
+	for _, polygon := range [2][]vector2.Float64{a, b} {
+		for _, axis := range edgeNormals(polygon) {
+			if !s.test(axis, projectPolygon(a, axis), projectPolygon(b, axis)) {
+				return Contact{}, false
+			}
+		}
+	}
+	if math.IsInf(s.contact.Depth, 1) {
+		return Contact{}, false
+	}
+	return s.oriented(centroid(a), centroid(b)), true
+}
+
+// PolygonCircle tests whether a convex polygon and a circle overlap
+func PolygonCircle(polygon []vector2.Float64, c Circle) (Contact, bool) {
+	if len(polygon) == 0 {
+		return Contact{}, false
+	}
+
+	axes := edgeNormals(polygon)
+
+	// The axis from the closest vertex to the circle's center catches the
+	// circle overlapping a corner
+	closest := polygon[0]
+	for _, v := range polygon[1:] {
+		if v.DistanceSquared(c.Center) < closest.DistanceSquared(c.Center) {
+			closest = v
+		}
+	}
+	if toCenter := c.Center.Sub(closest); toCenter.LengthSquared() > 0 {
+		axes = append(axes, toCenter.Normalized())
+	}
+
+	s := newSeparation()
+	for _, axis := range axes {
+		if !s.test(axis, projectPolygon(polygon, axis), projectCircle(c, axis)) {
+			return Contact{}, false
+		}
+	}
+	if math.IsInf(s.contact.Depth, 1) {
+		return Contact{}, false
+	}
+	return s.oriented(centroid(polygon), c.Center), true
+}
+
+// CircleCircle tests whether two circles overlap. Circles sharing a center
+// are pushed apart along the X axis
+func CircleCircle(a, b Circle) (Contact, bool) {
+	delta := b.Center.Sub(a.Center)
+	radii := a.Radius + b.Radius
+	distanceSquared := delta.LengthSquared()
+	if distanceSquared >= radii*radii {
+		return Contact{}, false
+	}
+
+	if distanceSquared == 0 {
+		return Contact{Normal: vector2.Right[float64](), Depth: radii}, true
+	}
+	distance := math.Sqrt(distanceSquared)
+	return Contact{Normal: delta.DivByConstant(distance), Depth: radii - distance}, true
+}
+
+// RectRect tests whether two rectangles overlap
+func RectRect(a, b rect2.Float64) (Contact, bool) {
+	return PolygonPolygon(RectCorners(a), RectCorners(b))
+}
+
+// RectCircle tests whether a rectangle and a circle overlap
+func RectCircle(r rect2.Float64, c Circle) (Contact, bool) {
+	return PolygonCircle(RectCorners(r), c)
+}
+
+// RectPolygon tests whether a rectangle and a convex polygon overlap
+func RectPolygon(r rect2.Float64, polygon []vector2.Float64) (Contact, bool) {
+	return PolygonPolygon(RectCorners(r), polygon)
+}
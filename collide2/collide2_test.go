@@ -0,0 +1,127 @@
+package collide2_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/EliCDavis/vector/collide2"
+	"github.com/EliCDavis/vector/rect2"
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vectortest"
+	"github.com/stretchr/testify/assert"
+)
+
+func square(x, y, size float64) []vector2.Float64 {
+	return collide2.RectCorners(rect2.New(vector2.New(x, y), vector2.New(size, size)))
+}
+
+func TestPolygonPolygon(t *testing.T) {
+	contact, ok := collide2.PolygonPolygon(square(0, 0, 2), square(1.5, 0.5, 2))
+	assert.True(t, ok)
+	assert.Equal(t, vector2.New(1., 0.), contact.Normal)
+	assert.Equal(t, 0.5, contact.Depth)
+	assert.Equal(t, vector2.New(0.5, 0.), contact.MTV())
+
+	// The normal always points from a to b
+	contact, ok = collide2.PolygonPolygon(square(1.5, 0.5, 2), square(0, 0, 2))
+	assert.True(t, ok)
+	assert.Equal(t, vector2.New(-1., 0.), contact.Normal)
+
+	// Clockwise winding works too
+	triangle := []vector2.Float64{vector2.New(1., 3.), vector2.New(2., 1.9), vector2.New(0., 1.9)}
+	contact, ok = collide2.PolygonPolygon(square(0, 0, 2), triangle)
+	assert.True(t, ok)
+	vectortest.AssertVector2InDelta(t, vector2.New(0., 1.), contact.Normal, 1e-12)
+	assert.InDelta(t, 0.1, contact.Depth, 1e-12)
+
+	_, ok = collide2.PolygonPolygon(square(0, 0, 1), square(1, 0, 1))
+	assert.False(t, ok, "touching shapes don't overlap")
+	_, ok = collide2.PolygonPolygon(square(0, 0, 1), square(3, 3, 1))
+	assert.False(t, ok)
+
+	// A diamond whose bounding box overlaps the square while the shapes
+	// themselves don't
+	diamond := []vector2.Float64{vector2.New(3.5, 2.5), vector2.New(4.5, 3.5), vector2.New(3.5, 4.5), vector2.New(2.5, 3.5)}
+	_, ok = collide2.PolygonPolygon(square(0, 0, 2.9), diamond)
+	assert.False(t, ok)
+}
+
+func TestPolygonCircle(t *testing.T) {
+	contact, ok := collide2.PolygonCircle(square(0, 0, 2), collide2.Circle{Center: vector2.New(1., 2.5), Radius: 1})
+	assert.True(t, ok)
+	assert.Equal(t, vector2.New(0., 1.), contact.Normal)
+	assert.Equal(t, 0.5, contact.Depth)
+
+	// Near a corner, the circle is pushed out diagonally
+	contact, ok = collide2.PolygonCircle(square(0, 0, 2), collide2.Circle{Center: vector2.New(2.5, 2.5), Radius: 1})
+	assert.True(t, ok)
+	vectortest.AssertVector2InDelta(t, vector2.New(1., 1.).Normalized(), contact.Normal, 1e-12)
+	assert.InDelta(t, 1-math.Sqrt(0.5), contact.Depth, 1e-12)
+
+	// Within both slabs of a corner but outside the circle's reach
+	_, ok = collide2.PolygonCircle(square(0, 0, 2), collide2.Circle{Center: vector2.New(2.8, 2.8), Radius: 1})
+	assert.False(t, ok)
+}
+
+func TestCircleCircle(t *testing.T) {
+	contact, ok := collide2.CircleCircle(
+		collide2.Circle{Center: vector2.New(0., 0.), Radius: 1},
+		collide2.Circle{Center: vector2.New(0., 1.5), Radius: 1},
+	)
+	assert.True(t, ok)
+	assert.Equal(t, vector2.New(0., 1.), contact.Normal)
+	assert.Equal(t, 0.5, contact.Depth)
+
+	contact, ok = collide2.CircleCircle(collide2.Circle{Radius: 1}, collide2.Circle{Radius: 2})
+	assert.True(t, ok)
+	assert.Equal(t, 3., contact.Depth)
+
+	_, ok = collide2.CircleCircle(collide2.Circle{Radius: 1}, collide2.Circle{Center: vector2.New(2., 0.), Radius: 1})
+	assert.False(t, ok)
+}
+
+func TestRects(t *testing.T) {
+	a := rect2.New(vector2.New(0., 0.), vector2.New(4., 2.))
+
+	contact, ok := collide2.RectRect(a, rect2.New(vector2.New(3., -1.), vector2.New(4., 2.)))
+	assert.True(t, ok)
+	assert.Equal(t, vector2.New(0., -1.), contact.Normal)
+	assert.Equal(t, 1., contact.Depth)
+
+	_, ok = collide2.RectCircle(a, collide2.Circle{Center: vector2.New(5., 1.), Radius: 0.5})
+	assert.False(t, ok)
+
+	_, ok = collide2.RectPolygon(a, square(3.5, 1.5, 1))
+	assert.True(t, ok)
+}
+
+func TestContainment(t *testing.T) {
+	outer := rect2.New(vector2.New(0., 0.), vector2.New(10., 10.))
+	inner := rect2.New(vector2.New(1., 1.), vector2.New(1., 1.))
+
+	// The depth is how far the inner shape has to travel to get out, not
+	// the width of the overlap
+	contact, ok := collide2.RectRect(outer, inner)
+	assert.True(t, ok)
+	assert.Equal(t, 2., contact.Depth)
+	assert.Equal(t, vector2.New(0., -1.), contact.Normal)
+
+	moved := rect2.New(inner.A().Add(contact.MTV()), vector2.New(1., 1.))
+	_, ok = collide2.RectRect(outer, moved)
+	assert.False(t, ok, "applying the MTV separates the shapes")
+
+	contact, ok = collide2.RectRect(inner, outer)
+	assert.True(t, ok)
+	assert.Equal(t, 2., contact.Depth)
+	assert.Equal(t, vector2.New(0., 1.), contact.Normal)
+
+	circle := collide2.Circle{Center: vector2.New(2., 5.), Radius: 1}
+	contact, ok = collide2.RectCircle(outer, circle)
+	assert.True(t, ok)
+	assert.Equal(t, 3., contact.Depth)
+	assert.Equal(t, vector2.New(-1., 0.), contact.Normal)
+
+	circle.Center = circle.Center.Add(contact.MTV())
+	_, ok = collide2.RectCircle(outer, circle)
+	assert.False(t, ok, "applying the MTV separates the shapes")
+}
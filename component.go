@@ -0,0 +1,78 @@
+package vector
+
+import "fmt"
+
+// ComponentType identifies the numeric type backing the components of a
+// vector. It's used to tag binary encodings so that data written with one
+// component type isn't silently decoded as another.
+type ComponentType byte
+
+const (
+	UnknownComponent ComponentType = iota
+	Int8Component
+	Int16Component
+	Int32Component
+	Int64Component
+	IntComponent
+	Float32Component
+	Float64Component
+)
+
+// ComponentTypeOf returns the ComponentType that corresponds to T
+func ComponentTypeOf[T Number]() ComponentType {
+	var v T
+	switch any(v).(type) {
+	case int8:
+		return Int8Component
+	case int16:
+		return Int16Component
+	case int32:
+		return Int32Component
+	case int64:
+		return Int64Component
+	case int:
+		return IntComponent
+	case float32:
+		return Float32Component
+	case float64:
+		return Float64Component
+	}
+	return UnknownComponent
+}
+
+// Size returns the number of bytes a single component occupies in binary
+// encodings. int components are always encoded using 8 bytes regardless of
+// platform.
+func (c ComponentType) Size() int {
+	switch c {
+	case Int8Component:
+		return 1
+	case Int16Component:
+		return 2
+	case Int32Component, Float32Component:
+		return 4
+	case Int64Component, IntComponent, Float64Component:
+		return 8
+	}
+	return 0
+}
+
+func (c ComponentType) String() string {
+	switch c {
+	case Int8Component:
+		return "int8"
+	case Int16Component:
+		return "int16"
+	case Int32Component:
+		return "int32"
+	case Int64Component:
+		return "int64"
+	case IntComponent:
+		return "int"
+	case Float32Component:
+		return "float32"
+	case Float64Component:
+		return "float64"
+	}
+	return fmt.Sprintf("ComponentType(%d)", byte(c))
+}
@@ -0,0 +1,31 @@
+package vector_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComponentTypeOf(t *testing.T) {
+	assert.Equal(t, vector.Int8Component, vector.ComponentTypeOf[int8]())
+	assert.Equal(t, vector.Int16Component, vector.ComponentTypeOf[int16]())
+	assert.Equal(t, vector.Int32Component, vector.ComponentTypeOf[int32]())
+	assert.Equal(t, vector.Int64Component, vector.ComponentTypeOf[int64]())
+	assert.Equal(t, vector.IntComponent, vector.ComponentTypeOf[int]())
+	assert.Equal(t, vector.Float32Component, vector.ComponentTypeOf[float32]())
+	assert.Equal(t, vector.Float64Component, vector.ComponentTypeOf[float64]())
+}
+
+func TestComponentTypeSize(t *testing.T) {
+	assert.Equal(t, 1, vector.Int8Component.Size())
+	assert.Equal(t, 2, vector.Int16Component.Size())
+	assert.Equal(t, 4, vector.Float32Component.Size())
+	assert.Equal(t, 8, vector.IntComponent.Size())
+	assert.Equal(t, 0, vector.UnknownComponent.Size())
+}
+
+func TestComponentTypeString(t *testing.T) {
+	assert.Equal(t, "float64", vector.Float64Component.String())
+	assert.Equal(t, "ComponentType(42)", vector.ComponentType(42).String())
+}
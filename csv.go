@@ -0,0 +1,18 @@
+package vector
+
+// CSVOptions controls how vectors are read from CSV data
+type CSVOptions struct {
+	// NoHeader indicates the first record holds data rather than column
+	// names
+	NoHeader bool
+
+	// Columns names the column holding each component, in component order.
+	// Requires a header. Defaults to the component names ("x", "y", ...),
+	// matched case-insensitively, falling back to the leading columns when
+	// the header doesn't contain them
+	Columns []string
+
+	// ColumnIndices selects the zero based column holding each component, in
+	// component order. Takes precedence over Columns
+	ColumnIndices []int
+}
@@ -0,0 +1,198 @@
+// Package euler represents rotations as three angles about the X, Y and Z
+// axes along with the order they're applied in, and converts them to and
+// from quaternions and direction vectors.
+//
+// Angles assume a Y up, right handed world. Pitch rotates about X, yaw about
+// Y and roll about Z, each counter-clockwise following the right hand rule,
+// and all in radians. Different engines and file formats apply the three
+// rotations in different orders, and mixing them up is a common source of
+// bugs, so every Angles value carries its Order explicitly.
+package euler
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/mathex"
+	"github.com/EliCDavis/vector/quaternion"
+	"github.com/EliCDavis/vector/vector3"
+)
+
+// Order is the sequence rotations are applied in, about the fixed world
+// axes. XYZ rotates about X first, then Y, then Z, which is the same as
+// rotating about the object's own axes in the order Z, Y, X. When comparing
+// against other libraries, check whether they name orders by the world
+// (extrinsic) or local (intrinsic) axes
+type Order int
+
+const (
+	XYZ Order = iota
+	XZY
+	YXZ
+	YZX
+	ZXY
+	ZYX
+)
+
+// axes returns the indices of the axes in the order they're applied
+func (o Order) axes() (i, j, k int) {
+	switch o {
+	case XZY:
+		return 0, 2, 1
+	case YXZ:
+		return 1, 0, 2
+	case YZX:
+		return 1, 2, 0
+	case ZXY:
+		return 2, 0, 1
+	case ZYX:
+		return 2, 1, 0
+	}
+	return 0, 1, 2
+}
+
+func (o Order) String() string {
+	switch o {
+	case XYZ:
+		return "XYZ"
+	case XZY:
+		return "XZY"
+	case YXZ:
+		return "YXZ"
+	case YZX:
+		return "YZX"
+	case ZXY:
+		return "ZXY"
+	case ZYX:
+		return "ZYX"
+	}
+	return fmt.Sprintf("Order(%d)", int(o))
+}
+
+// Angles is a rotation made up of three angles in radians applied in the
+// given Order
+type Angles[T vector.Float] struct {
+	Pitch T // Rotation about the X axis
+	Yaw   T // Rotation about the Y axis
+	Roll  T // Rotation about the Z axis
+	Order Order
+}
+
+// New creates angles applied in the given order
+func New[T vector.Float](pitch, yaw, roll T, order Order) Angles[T] {
+	return Angles[T]{Pitch: pitch, Yaw: yaw, Roll: roll, Order: order}
+}
+
+func (a Angles[T]) axis(i int) T {
+	switch i {
+	case 0:
+		return a.Pitch
+	case 1:
+		return a.Yaw
+	}
+	return a.Roll
+}
+
+func (a *Angles[T]) setAxis(i int, v T) {
+	switch i {
+	case 0:
+		a.Pitch = v
+	case 1:
+		a.Yaw = v
+	default:
+		a.Roll = v
+	}
+}
+
+func unitAxis[T vector.Float](i int) vector3.Vector[T] {
+	var c [3]T
+	c[i] = 1
+	return vector3.New(c[0], c[1], c[2])
+}
+
+// Quaternion returns the quaternion applying the same rotation
+func (a Angles[T]) Quaternion() quaternion.Quaternion[T] {
+	i, j, k := a.Order.axes()
+	qi := quaternion.FromAxisAngle(unitAxis[T](i), float64(a.axis(i)))
+	qj := quaternion.FromAxisAngle(unitAxis[T](j), float64(a.axis(j)))
+	qk := quaternion.FromAxisAngle(unitAxis[T](k), float64(a.axis(k)))
+	return qk.Multiply(qj).Multiply(qi)
+}
+
+// Rotate applies the rotation to v
+func (a Angles[T]) Rotate(v vector3.Vector[T]) vector3.Vector[T] {
+	return a.Quaternion().Rotate(v)
+}
+
+// Direction returns the direction the rotation turns -Z towards, the
+// forward direction of cameras and lights in glTF and OpenGL
+func (a Angles[T]) Direction() vector3.Vector[T] {
+	return a.Rotate(vector3.New[T](0, 0, -1))
+}
+
+// Wrapped returns the same rotation with every angle wrapped into [-π, π)
+func (a Angles[T]) Wrapped() Angles[T] {
+	a.Pitch = mathex.Wrap(a.Pitch, -math.Pi, math.Pi)
+	a.Yaw = mathex.Wrap(a.Yaw, -math.Pi, math.Pi)
+	a.Roll = mathex.Wrap(a.Roll, -math.Pi, math.Pi)
+	return a
+}
+
+// WithOrder converts the angles into ones that apply the same rotation in a
+// different order
+func (a Angles[T]) WithOrder(order Order) Angles[T] {
+	if order == a.Order {
+		return a
+	}
+	return FromQuaternion(a.Quaternion(), order)
+}
+
+// FromQuaternion decomposes the rotation of a unit quaternion into angles
+// applied in the given order. The middle angle falls within [-π/2, π/2] and
+// the others within [-π, π]. When the middle angle is ±π/2 the first and
+// last rotations act about the same axis, and the last angle is set to 0
+func FromQuaternion[T vector.Float](q quaternion.Quaternion[T], order Order) Angles[T] {
+	m := q.Matrix()
+	r := func(row, col int) float64 {
+		return float64(m.At(row, col))
+	}
+
+	i, j, k := order.axes()
+
+	// Orders that cycle through the axes (XYZ, YZX, ZXY) and those that
+	// don't produce matrices whose off-diagonal terms differ only in sign
+	s := -1.
+	if (j-i+3)%3 == 1 {
+		s = 1
+	}
+
+	var first, middle, last float64
+	sinMiddle := mathex.Clamp(-s*r(k, i), -1, 1)
+	middle = math.Asin(sinMiddle)
+	if !mathex.ApproximatelyWithin(math.Abs(sinMiddle), 1, 1e-12) {
+		first = math.Atan2(s*r(k, j), r(k, k))
+		last = math.Atan2(s*r(j, i), r(i, i))
+	} else {
+		first = math.Atan2(-s*r(j, k), r(j, j))
+	}
+
+	out := Angles[T]{Order: order}
+	out.setAxis(i, T(first))
+	out.setAxis(j, T(middle))
+	out.setAxis(k, T(last))
+	return out
+}
+
+// FromDirection returns angles in the given order that turn -Z to face dir,
+// without rolling about it. This matches the yaw and pitch of a camera
+// looking along dir while staying level with the horizon
+func FromDirection[T vector.Float](dir vector3.Vector[T], order Order) Angles[T] {
+	d := dir.ToFloat64()
+	yaw := math.Atan2(-d.X(), -d.Z())
+	pitch := math.Atan2(d.Y(), math.Hypot(d.X(), d.Z()))
+
+	q := quaternion.FromAxisAngle(vector3.New[T](0, 1, 0), yaw).
+		Multiply(quaternion.FromAxisAngle(vector3.New[T](1, 0, 0), pitch))
+	return FromQuaternion(q, order)
+}
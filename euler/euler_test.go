@@ -0,0 +1,104 @@
+package euler_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/EliCDavis/vector/euler"
+	"github.com/EliCDavis/vector/quaternion"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/EliCDavis/vector/vectortest"
+	"github.com/stretchr/testify/assert"
+)
+
+var orders = []euler.Order{euler.XYZ, euler.XZY, euler.YXZ, euler.YZX, euler.ZXY, euler.ZYX}
+
+func TestOrderApplication(t *testing.T) {
+	a := euler.New(math.Pi/2, math.Pi/2, 0., euler.XYZ)
+	// X first takes +Y to +Z, then Y takes +Z to +X
+	vectortest.AssertVector3InDelta(t, vector3.New(1., 0., 0.), a.Rotate(vector3.New(0., 1., 0.)), 1e-12)
+
+	a.Order = euler.YXZ
+	// Y first leaves +Y alone, then X takes it to +Z
+	vectortest.AssertVector3InDelta(t, vector3.New(0., 0., 1.), a.Rotate(vector3.New(0., 1., 0.)), 1e-12)
+
+	assert.Equal(t, "ZXY", euler.ZXY.String())
+	assert.Equal(t, "Order(9)", euler.Order(9).String())
+}
+
+func TestQuaternionRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	v := vector3.New(0.3, -1.2, 2.)
+	for _, order := range orders {
+		t.Run(order.String(), func(t *testing.T) {
+			for i := 0; i < 100; i++ {
+				a := euler.New(
+					(r.Float64()*2-1)*math.Pi,
+					(r.Float64()*2-1)*math.Pi,
+					(r.Float64()*2-1)*math.Pi,
+					order,
+				)
+				back := euler.FromQuaternion(a.Quaternion(), order)
+				vectortest.AssertVector3InDelta(t, a.Rotate(v), back.Rotate(v), 1e-9)
+
+				for _, other := range orders {
+					vectortest.AssertVector3InDelta(t, a.Rotate(v), a.WithOrder(other).Rotate(v), 1e-9)
+				}
+			}
+		})
+	}
+}
+
+func TestFromQuaternionRecoversAngles(t *testing.T) {
+	for _, order := range orders {
+		a := euler.New(0.3, -0.4, 1.1, order)
+		back := euler.FromQuaternion(a.Quaternion(), order)
+		assert.InDelta(t, a.Pitch, back.Pitch, 1e-12, order.String())
+		assert.InDelta(t, a.Yaw, back.Yaw, 1e-12, order.String())
+		assert.InDelta(t, a.Roll, back.Roll, 1e-12, order.String())
+	}
+}
+
+func TestGimbalLock(t *testing.T) {
+	v := vector3.New(0.3, -1.2, 2.)
+	for _, order := range orders {
+		a := euler.New(math.Pi/2, math.Pi/2, math.Pi/2, order)
+		back := euler.FromQuaternion(a.Quaternion(), order)
+		vectortest.AssertVector3InDelta(t, a.Rotate(v), back.Rotate(v), 1e-6, order.String())
+	}
+}
+
+func TestDirection(t *testing.T) {
+	vectortest.AssertVector3InDelta(t, vector3.New(0., 0., -1.), euler.New(0., 0., 0., euler.XYZ).Direction(), 0)
+	vectortest.AssertVector3InDelta(t, vector3.New(-1., 0., 0.), euler.New(0., math.Pi/2, 0., euler.XYZ).Direction(), 1e-12)
+
+	dir := vector3.New(1., 2., -3.).Normalized()
+	for _, order := range orders {
+		a := euler.FromDirection(vector3.New(1., 2., -3.), order)
+		vectortest.AssertVector3InDelta(t, dir, a.Direction(), 1e-12, order.String())
+
+		// Stays level, with the rotated +X remaining horizontal
+		assert.InDelta(t, 0., a.Rotate(vector3.New(1., 0., 0.)).Y(), 1e-12, order.String())
+	}
+
+	// Rolling first, then pitching, then yawing is how a camera is usually
+	// oriented, so the direction is fully described by pitch and yaw
+	zxy := euler.FromDirection(vector3.New(1., 2., -3.), euler.ZXY)
+	assert.InDelta(t, 0., zxy.Roll, 1e-12)
+}
+
+func TestWrapped(t *testing.T) {
+	a := euler.New(3*math.Pi, -math.Pi/2-4*math.Pi, 0.5, euler.ZYX).Wrapped()
+	assert.InDelta(t, -math.Pi, a.Pitch, 1e-9)
+	assert.InDelta(t, -math.Pi/2, a.Yaw, 1e-9)
+	assert.InDelta(t, 0.5, a.Roll, 1e-12)
+	assert.Equal(t, euler.ZYX, a.Order)
+}
+
+func TestFloat32(t *testing.T) {
+	a := euler.New[float32](0.1, 0.2, 0.3, euler.XYZ)
+	q := a.Quaternion()
+	assert.InDelta(t, 1., q.Length(), 1e-6)
+	assert.IsType(t, quaternion.Float32{}, q)
+}
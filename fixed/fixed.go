@@ -0,0 +1,266 @@
+// Package fixed provides deterministic fixed-point numbers and vectors for
+// lockstep simulations, where every machine has to compute bit-identical
+// results. Floating point math gives no such guarantee in Go: the compiler
+// may fuse multiplies and adds into FMA instructions on some architectures
+// but not others, and functions in the math package have per-architecture
+// implementations. Everything here is built on integer arithmetic, which
+// behaves identically everywhere.
+//
+// Building with the vector_deterministic tag makes the core floating point
+// operations of vector2, vector3, and vector4 bit-identical across
+// architectures too, but functions from the math package such as math.Exp
+// can still vary.
+//
+// Simulations needing more than the core operations opt in by storing their
+// state in this package's types instead, converting to floating point vectors
+// only for rendering.
+package fixed
+
+import (
+	"math"
+	"math/bits"
+	"strconv"
+)
+
+// Fixed is a signed Q32.32 fixed-point number, with 32 integer bits and 32
+// fractional bits, covering roughly ±2.1 billion in steps of about 2.3e-10.
+//
+// Conversions, Mul, Div, and the vector products and lengths built on them
+// panic when their result doesn't fit, the same way on every machine, rather
+// than silently producing a wrong value.
+// Addition and subtraction use the regular + and - operators, which wrap
+// around on overflow like any other integer
+type Fixed int64
+
+const fracBits = 32
+
+const (
+	// One is the fixed-point number 1
+	One Fixed = 1 << fracBits
+
+	// Half is the fixed-point number 0.5
+	Half Fixed = One / 2
+
+	// Epsilon is the smallest positive fixed-point number
+	Epsilon Fixed = 1
+
+	// Max and Min are the largest and smallest fixed-point numbers
+	Max Fixed = math.MaxInt64
+	Min Fixed = math.MinInt64
+)
+
+// FromInt converts an integer to fixed-point. It panics if i is outside the
+// range of Fixed
+func FromInt(i int) Fixed {
+	if int64(i) < math.MinInt32 || int64(i) > math.MaxInt32 {
+		panic("fixed: integer out of range")
+	}
+	return Fixed(int64(i) << fracBits)
+}
+
+// FromFloat converts a floating point number to the nearest fixed-point
+// number, rounding halfway cases away from zero. The result is the same on
+// every machine, so it's safe to use for loading level data or tuning values.
+// It panics if f is NaN or outside the range of Fixed, since converting such
+// values to an integer gives different results on different architectures
+func FromFloat(f float64) Fixed {
+	scaled := math.Round(f * (1 << fracBits))
+	// -2^63 converts exactly, while 2^63 is the first value too large
+	if !(scaled >= math.MinInt64 && scaled < -math.MinInt64) {
+		panic("fixed: float out of range")
+	}
+	return Fixed(scaled)
+}
+
+// FromRatio returns the fixed-point number closest to num / den, truncated
+// towards zero
+func FromRatio(num, den int) Fixed {
+	return FromInt(num).Div(FromInt(den))
+}
+
+// Float64 converts the number to floating point, for rendering and debugging
+func (f Fixed) Float64() float64 {
+	return float64(f) / (1 << fracBits)
+}
+
+// Int returns the integer part of the number, rounding towards negative
+// infinity
+func (f Fixed) Int() int {
+	return int(f >> fracBits)
+}
+
+func (f Fixed) String() string {
+	return strconv.FormatFloat(f.Float64(), 'f', -1, 64)
+}
+
+// Abs returns the absolute value of the number
+func (f Fixed) Abs() Fixed {
+	if f < 0 {
+		return -f
+	}
+	return f
+}
+
+// Mul returns f × o, rounded towards negative infinity. It panics if the
+// product doesn't fit
+func (f Fixed) Mul(o Fixed) Fixed {
+	hi, lo := mul128(f, o)
+	return narrow(hi, lo, "fixed: multiplication overflow")
+}
+
+// mul128 returns the exact product of f and o as a signed 128 bit number
+func mul128(f, o Fixed) (hi, lo uint64) {
+	hi, lo = bits.Mul64(uint64(f), uint64(o))
+
+	// Correct the unsigned product for negative operands
+	if f < 0 {
+		hi -= uint64(o)
+	}
+	if o < 0 {
+		hi -= uint64(f)
+	}
+	return hi, lo
+}
+
+// narrow shifts the signed 128 bit number hi:lo down by fracBits, rounding
+// towards negative infinity, and panics with msg if the result doesn't fit
+func narrow(hi, lo uint64, msg string) Fixed {
+	// The number shifted down by fracBits only fits in 64 bits when the bits
+	// shifted out of the top all match the sign bit
+	if top := int64(hi) >> (fracBits - 1); top != 0 && top != -1 {
+		panic(msg)
+	}
+	return Fixed(hi<<fracBits | lo>>fracBits)
+}
+
+// dot returns the sum of a[i] × b[i]. The products are summed exactly before
+// rounding once towards negative infinity, so intermediate sums can't wrap
+// around, and like Mul it panics if the result doesn't fit. Each product is
+// under 2^126 in magnitude, so up to four of them can't overflow the 128 bit
+// total
+func dot(a, b [4]Fixed) Fixed {
+	var hi, lo uint64
+	for i := range a {
+		phi, plo := mul128(a[i], b[i])
+		var carry uint64
+		lo, carry = bits.Add64(lo, plo, 0)
+		hi, _ = bits.Add64(hi, phi, carry)
+	}
+	return narrow(hi, lo, "fixed: dot product overflow")
+}
+
+// diffOfProducts returns a × b - c × d, computed exactly before rounding
+// once towards negative infinity. It panics if the result doesn't fit
+func diffOfProducts(a, b, c, d Fixed) Fixed {
+	hi, lo := mul128(a, b)
+	chi, clo := mul128(c, d)
+	var borrow uint64
+	lo, borrow = bits.Sub64(lo, clo, 0)
+	hi, _ = bits.Sub64(hi, chi, borrow)
+	return narrow(hi, lo, "fixed: cross product overflow")
+}
+
+// length returns the square root of the summed squares of the components,
+// which are summed exactly in 128 bits so that vectors with a representable
+// length never overflow on the way there. It panics if the length itself
+// doesn't fit
+func length(components [3]Fixed) Fixed {
+	var hi, lo uint64
+	for _, c := range components {
+		// Negating Min overflows back to Min, which still converts to the
+		// correct unsigned magnitude of 2^63
+		m := uint64(c.Abs())
+		phi, plo := bits.Mul64(m, m)
+		var carry uint64
+		lo, carry = bits.Add64(lo, plo, 0)
+		hi, _ = bits.Add64(hi, phi, carry)
+	}
+
+	// sqrt(sum / 2^64) * 2^32 = sqrt(sum)
+	r := sqrt128(hi, lo)
+	if r > math.MaxInt64 {
+		panic("fixed: length overflow")
+	}
+	return Fixed(r)
+}
+
+// Div returns f ÷ o, truncated towards zero. It panics if o is zero or the
+// quotient doesn't fit
+func (f Fixed) Div(o Fixed) Fixed {
+	if o == 0 {
+		panic("fixed: division by zero")
+	}
+
+	// Negating Min overflows back to Min, which still converts to the
+	// correct unsigned magnitude of 2^63
+	neg := (f < 0) != (o < 0)
+	n, d := uint64(f.Abs()), uint64(o.Abs())
+
+	hi, lo := n>>(64-fracBits), n<<fracBits
+	if hi >= d {
+		panic("fixed: division overflow")
+	}
+	q, _ := bits.Div64(hi, lo, d)
+	if q > math.MaxInt64 && !(neg && q == 1<<63) {
+		panic("fixed: division overflow")
+	}
+	if neg {
+		return -Fixed(q)
+	}
+	return Fixed(q)
+}
+
+// Sqrt returns the square root of f, rounded towards zero. Negative numbers
+// panic
+func (f Fixed) Sqrt() Fixed {
+	if f < 0 {
+		panic("fixed: square root of negative number")
+	}
+	// sqrt(f / 2^32) * 2^32 = sqrt(f * 2^32)
+	return Fixed(sqrt128(uint64(f)>>(64-fracBits), uint64(f)<<fracBits))
+}
+
+// Lerp linearly interpolates between a and b by t
+func Lerp(a, b, t Fixed) Fixed {
+	return a + (b - a).Mul(t)
+}
+
+// Clamp limits f to within [vmin, vmax]
+func Clamp(f, vmin, vmax Fixed) Fixed {
+	return min(max(f, vmin), vmax)
+}
+
+// sqrt128 returns the integer square root of the 128 bit number hi:lo,
+// computed one bit at a time
+func sqrt128(hi, lo uint64) uint64 {
+	var resHi, resLo uint64
+	bitHi, bitLo := uint64(1)<<62, uint64(0)
+
+	// Start from the highest power of four no larger than the number
+	for less128(hi, lo, bitHi, bitLo) {
+		bitHi, bitLo = shr128(bitHi, bitLo, 2)
+	}
+
+	for bitHi != 0 || bitLo != 0 {
+		sumLo, carry := bits.Add64(resLo, bitLo, 0)
+		sumHi, _ := bits.Add64(resHi, bitHi, carry)
+		resHi, resLo = shr128(resHi, resLo, 1)
+		if !less128(hi, lo, sumHi, sumLo) {
+			var borrow uint64
+			lo, borrow = bits.Sub64(lo, sumLo, 0)
+			hi, _ = bits.Sub64(hi, sumHi, borrow)
+			resLo, carry = bits.Add64(resLo, bitLo, 0)
+			resHi, _ = bits.Add64(resHi, bitHi, carry)
+		}
+		bitHi, bitLo = shr128(bitHi, bitLo, 2)
+	}
+	return resLo
+}
+
+func less128(aHi, aLo, bHi, bLo uint64) bool {
+	return aHi < bHi || (aHi == bHi && aLo < bLo)
+}
+
+func shr128(hi, lo uint64, n uint) (uint64, uint64) {
+	return hi >> n, lo>>n | hi<<(64-n)
+}
@@ -0,0 +1,104 @@
+package fixed_test
+
+import (
+	"math"
+	"math/rand"
+	"strconv"
+	"testing"
+
+	"github.com/EliCDavis/vector/fixed"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConversions(t *testing.T) {
+	assert.Equal(t, fixed.One, fixed.FromInt(1))
+	assert.Equal(t, fixed.Half, fixed.FromFloat(0.5))
+	assert.Equal(t, -3, fixed.FromFloat(-2.5).Int())
+	assert.Equal(t, 2, fixed.FromFloat(2.99).Int())
+	assert.Equal(t, -1.25, fixed.FromFloat(-1.25).Float64())
+	assert.Equal(t, "-1.25", fixed.FromFloat(-1.25).String())
+	assert.InDelta(t, 1./3, fixed.FromRatio(1, 3).Float64(), 1e-9)
+}
+
+func TestMulDiv(t *testing.T) {
+	tests := map[string]struct{ a, b float64 }{
+		"positive":      {a: 3.5, b: 2.25},
+		"negative":      {a: -3.5, b: 2.25},
+		"both negative": {a: -3.5, b: -0.125},
+		"fractions":     {a: 0.001, b: 0.002},
+		"large":         {a: 40000, b: -50000.5},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			a, b := fixed.FromFloat(tc.a), fixed.FromFloat(tc.b)
+			assert.InDelta(t, a.Float64()*b.Float64(), a.Mul(b).Float64(), 1e-9)
+			assert.InDelta(t, a.Float64()/b.Float64(), a.Div(b).Float64(), 1e-9)
+		})
+	}
+
+	assert.Equal(t, fixed.FromInt(-6), fixed.FromInt(2).Mul(fixed.FromInt(-3)))
+	assert.Equal(t, fixed.FromInt(-4), fixed.FromInt(-12).Div(fixed.FromInt(3)))
+	assert.Equal(t, fixed.Min, fixed.Min.Div(fixed.One))
+
+	assert.PanicsWithValue(t, "fixed: division by zero", func() { fixed.One.Div(0) })
+	assert.PanicsWithValue(t, "fixed: division overflow", func() { fixed.Max.Div(fixed.Half) })
+}
+
+func TestMulOverflow(t *testing.T) {
+	// The extremes of the range still multiply by one
+	assert.Equal(t, fixed.Max, fixed.Max.Mul(fixed.One))
+	assert.Equal(t, fixed.Min, fixed.Min.Mul(fixed.One))
+	assert.Equal(t, fixed.Min, fixed.FromInt(-65536).Mul(fixed.FromInt(32768)))
+
+	assert.PanicsWithValue(t, "fixed: multiplication overflow", func() { fixed.Max.Mul(fixed.FromInt(2)) })
+	assert.PanicsWithValue(t, "fixed: multiplication overflow", func() { fixed.Min.Mul(-fixed.One) })
+	assert.PanicsWithValue(t, "fixed: multiplication overflow", func() {
+		fixed.FromInt(65536).Mul(fixed.FromInt(32768))
+	})
+	assert.PanicsWithValue(t, "fixed: multiplication overflow", func() {
+		fixed.FromInt(-65536).Mul(fixed.FromInt(-65536))
+	})
+}
+
+func TestConversionRange(t *testing.T) {
+	assert.Equal(t, fixed.Min, fixed.FromFloat(-2147483648))
+	assert.Equal(t, fixed.FromInt(math.MaxInt32), fixed.FromFloat(math.MaxInt32))
+
+	for _, f := range []float64{math.NaN(), math.Inf(1), math.Inf(-1), 2147483648, -2147483649, 1e300} {
+		assert.PanicsWithValue(t, "fixed: float out of range", func() { fixed.FromFloat(f) }, "%g", f)
+	}
+
+	assert.Equal(t, fixed.Min, fixed.FromInt(math.MinInt32))
+
+	// A 32 bit int can't hold anything out of range
+	if strconv.IntSize == 64 {
+		tooBig, tooSmall := int64(math.MaxInt32)+1, int64(math.MinInt32)-1
+		assert.PanicsWithValue(t, "fixed: integer out of range", func() { fixed.FromInt(int(tooBig)) })
+		assert.PanicsWithValue(t, "fixed: integer out of range", func() { fixed.FromInt(int(tooSmall)) })
+	}
+}
+
+func TestSqrt(t *testing.T) {
+	assert.Equal(t, fixed.FromInt(3), fixed.FromInt(9).Sqrt())
+	assert.Equal(t, fixed.Half, fixed.FromFloat(0.25).Sqrt())
+	assert.Equal(t, fixed.Fixed(0), fixed.Fixed(0).Sqrt())
+	assert.Equal(t, fixed.Fixed(65536), fixed.Epsilon.Sqrt())
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		v := fixed.Fixed(r.Int63())
+		s := v.Sqrt()
+		assert.InDelta(t, math.Sqrt(v.Float64()), s.Float64(), 1e-9)
+
+		// The result is the floor of the exact root
+		assert.LessOrEqual(t, s.Mul(s), v)
+	}
+
+	assert.Panics(t, func() { fixed.FromInt(-1).Sqrt() })
+}
+
+func TestLerpClamp(t *testing.T) {
+	assert.Equal(t, fixed.FromInt(5), fixed.Lerp(fixed.FromInt(0), fixed.FromInt(10), fixed.Half))
+	assert.Equal(t, fixed.One, fixed.Clamp(fixed.FromInt(3), -fixed.One, fixed.One))
+}
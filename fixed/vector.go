@@ -0,0 +1,182 @@
+package fixed
+
+import (
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vector3"
+)
+
+// Vector2 is a deterministic 2D vector of fixed-point components
+type Vector2 struct {
+	x, y Fixed
+}
+
+// NewVector2 creates a vector from its components
+func NewVector2(x, y Fixed) Vector2 {
+	return Vector2{x: x, y: y}
+}
+
+// Vector2FromFloat64 converts a floating point vector to the nearest
+// fixed-point one
+func Vector2FromFloat64(v vector2.Float64) Vector2 {
+	return Vector2{x: FromFloat(v.X()), y: FromFloat(v.Y())}
+}
+
+func (v Vector2) X() Fixed {
+	return v.x
+}
+
+func (v Vector2) Y() Fixed {
+	return v.y
+}
+
+// Float64 converts the vector to floating point, for rendering
+func (v Vector2) Float64() vector2.Float64 {
+	return vector2.New(v.x.Float64(), v.y.Float64())
+}
+
+func (v Vector2) Add(o Vector2) Vector2 {
+	return Vector2{x: v.x + o.x, y: v.y + o.y}
+}
+
+func (v Vector2) Sub(o Vector2) Vector2 {
+	return Vector2{x: v.x - o.x, y: v.y - o.y}
+}
+
+func (v Vector2) Scale(t Fixed) Vector2 {
+	return Vector2{x: v.x.Mul(t), y: v.y.Mul(t)}
+}
+
+// Dot returns the dot product of the vectors, rounded towards negative
+// infinity. It panics if the result doesn't fit
+func (v Vector2) Dot(o Vector2) Fixed {
+	return dot([4]Fixed{v.x, v.y}, [4]Fixed{o.x, o.y})
+}
+
+// LengthSquared returns the squared length of the vector. It panics if the
+// result doesn't fit, which happens for lengths above about 46340
+func (v Vector2) LengthSquared() Fixed {
+	return v.Dot(v)
+}
+
+// Length returns the length of the vector, rounded towards zero. Unlike
+// LengthSquared it only panics when the length itself doesn't fit
+func (v Vector2) Length() Fixed {
+	return length([3]Fixed{v.x, v.y})
+}
+
+// Distance returns the distance between the vectors. The difference is taken
+// with Sub, so it wraps around for points further apart than the range of
+// Fixed
+func (v Vector2) Distance(o Vector2) Fixed {
+	return v.Sub(o).Length()
+}
+
+// Normalized scales the vector to a length of 1. Zero length vectors are
+// returned unchanged
+func (v Vector2) Normalized() Vector2 {
+	l := v.Length()
+	if l == 0 {
+		return v
+	}
+	return Vector2{x: v.x.Div(l), y: v.y.Div(l)}
+}
+
+// LerpVector2 linearly interpolates between a and b by t
+func LerpVector2(a, b Vector2, t Fixed) Vector2 {
+	return Vector2{x: Lerp(a.x, b.x, t), y: Lerp(a.y, b.y, t)}
+}
+
+// Vector3 is a deterministic 3D vector of fixed-point components
+type Vector3 struct {
+	x, y, z Fixed
+}
+
+// NewVector3 creates a vector from its components
+func NewVector3(x, y, z Fixed) Vector3 {
+	return Vector3{x: x, y: y, z: z}
+}
+
+// Vector3FromFloat64 converts a floating point vector to the nearest
+// fixed-point one
+func Vector3FromFloat64(v vector3.Float64) Vector3 {
+	return Vector3{x: FromFloat(v.X()), y: FromFloat(v.Y()), z: FromFloat(v.Z())}
+}
+
+func (v Vector3) X() Fixed {
+	return v.x
+}
+
+func (v Vector3) Y() Fixed {
+	return v.y
+}
+
+func (v Vector3) Z() Fixed {
+	return v.z
+}
+
+// Float64 converts the vector to floating point, for rendering
+func (v Vector3) Float64() vector3.Float64 {
+	return vector3.New(v.x.Float64(), v.y.Float64(), v.z.Float64())
+}
+
+func (v Vector3) Add(o Vector3) Vector3 {
+	return Vector3{x: v.x + o.x, y: v.y + o.y, z: v.z + o.z}
+}
+
+func (v Vector3) Sub(o Vector3) Vector3 {
+	return Vector3{x: v.x - o.x, y: v.y - o.y, z: v.z - o.z}
+}
+
+func (v Vector3) Scale(t Fixed) Vector3 {
+	return Vector3{x: v.x.Mul(t), y: v.y.Mul(t), z: v.z.Mul(t)}
+}
+
+// Dot returns the dot product of the vectors, rounded towards negative
+// infinity. It panics if the result doesn't fit
+func (v Vector3) Dot(o Vector3) Fixed {
+	return dot([4]Fixed{v.x, v.y, v.z}, [4]Fixed{o.x, o.y, o.z})
+}
+
+// Cross returns the cross product of the vectors. Each component is computed
+// exactly before rounding, and it panics if one doesn't fit
+func (v Vector3) Cross(o Vector3) Vector3 {
+	return Vector3{
+		x: diffOfProducts(v.y, o.z, v.z, o.y),
+		y: diffOfProducts(v.z, o.x, v.x, o.z),
+		z: diffOfProducts(v.x, o.y, v.y, o.x),
+	}
+}
+
+// LengthSquared returns the squared length of the vector. It panics if the
+// result doesn't fit, which happens for lengths above about 46340
+func (v Vector3) LengthSquared() Fixed {
+	return v.Dot(v)
+}
+
+// Length returns the length of the vector, rounded towards zero. Unlike
+// LengthSquared it only panics when the length itself doesn't fit
+func (v Vector3) Length() Fixed {
+	return length([3]Fixed{v.x, v.y, v.z})
+}
+
+// Distance returns the distance between the vectors. The difference is taken
+// with Sub, so it wraps around for points further apart than the range of
+// Fixed
+func (v Vector3) Distance(o Vector3) Fixed {
+	return v.Sub(o).Length()
+}
+
+// Normalized scales the vector to a length of 1. Zero length vectors are
+// returned unchanged
+func (v Vector3) Normalized() Vector3 {
+	l := v.Length()
+	if l == 0 {
+		return v
+	}
+	return Vector3{x: v.x.Div(l), y: v.y.Div(l), z: v.z.Div(l)}
+}
+
+// LerpVector3 linearly interpolates between a and b by t
+func LerpVector3(a, b Vector3, t Fixed) Vector3 {
+	return Vector3{x: Lerp(a.x, b.x, t), y: Lerp(a.y, b.y, t), z: Lerp(a.z, b.z, t)}
+}
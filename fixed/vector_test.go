@@ -0,0 +1,79 @@
+package fixed_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector/fixed"
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/EliCDavis/vector/vectortest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVector2(t *testing.T) {
+	a := fixed.Vector2FromFloat64(vector2.New(3., 4.))
+	b := fixed.NewVector2(fixed.One, fixed.Half)
+
+	assert.Equal(t, fixed.FromInt(5), a.Length())
+	assert.Equal(t, vector2.New(4., 4.5), a.Add(b).Float64())
+	assert.Equal(t, vector2.New(2., 3.5), a.Sub(b).Float64())
+	assert.Equal(t, fixed.FromInt(5), a.Dot(b))
+	assert.Equal(t, vector2.New(1.5, 2.), a.Scale(fixed.Half).Float64())
+	assert.Equal(t, vector2.New(2., 2.25), fixed.LerpVector2(a, b, fixed.Half).Float64())
+	vectortest.AssertVector2InDelta(t, vector2.New(0.6, 0.8), a.Normalized().Float64(), 1e-9)
+	assert.Equal(t, fixed.Vector2{}, fixed.Vector2{}.Normalized())
+}
+
+func TestVector3(t *testing.T) {
+	x := fixed.NewVector3(fixed.One, 0, 0)
+	y := fixed.NewVector3(0, fixed.One, 0)
+	assert.Equal(t, fixed.NewVector3(0, 0, fixed.One), x.Cross(y))
+
+	a := fixed.Vector3FromFloat64(vector3.New(2., 3., 6.))
+	assert.Equal(t, fixed.FromInt(7), a.Length())
+	assert.Equal(t, fixed.FromInt(7), a.Distance(fixed.Vector3{}))
+	vectortest.AssertVector3InDelta(t, vector3.New(2./7, 3./7, 6./7), a.Normalized().Float64(), 1e-9)
+	assert.Equal(t, vector3.New(1., 1.5, 3.), fixed.LerpVector3(fixed.Vector3{}, a, fixed.Half).Float64())
+}
+
+func TestVectorLargeComponents(t *testing.T) {
+	// Squaring these overflows Fixed, but their lengths fit comfortably
+	assert.Equal(t, fixed.FromInt(50000), fixed.NewVector2(fixed.FromInt(50000), 0).Length())
+	assert.Equal(t, fixed.FromInt(50000), fixed.NewVector3(0, fixed.FromInt(-50000), 0).Length())
+	assert.Equal(t, fixed.FromInt(1500000000), fixed.NewVector3(fixed.FromInt(900000000), fixed.FromInt(1200000000), 0).Length())
+
+	diagonal := fixed.NewVector2(fixed.FromInt(40000), fixed.FromInt(40000))
+	assert.InDelta(t, 40000*1.4142135623730951, diagonal.Length().Float64(), 1e-6)
+	vectortest.AssertVector2InDelta(t, vector2.New(0.70710678, 0.70710678), diagonal.Normalized().Float64(), 1e-8)
+	assert.InDelta(t, 50000., fixed.NewVector2(fixed.FromInt(30000), 0).Distance(fixed.NewVector2(0, fixed.FromInt(40000))).Float64(), 1e-9)
+
+	huge := fixed.NewVector3(fixed.Max, fixed.Max, fixed.Max)
+	assert.PanicsWithValue(t, "fixed: length overflow", func() { huge.Length() })
+
+	// Sums that don't fit panic like Mul rather than silently wrapping
+	assert.PanicsWithValue(t, "fixed: dot product overflow", func() { diagonal.LengthSquared() })
+	assert.PanicsWithValue(t, "fixed: dot product overflow", func() { diagonal.Dot(diagonal) })
+	big := fixed.FromInt(40000)
+	assert.PanicsWithValue(t, "fixed: cross product overflow", func() {
+		fixed.NewVector3(big, big, 0).Cross(fixed.NewVector3(-big, big, 0))
+	})
+
+	// Products that cancel out are fine even when each one alone wouldn't fit
+	assert.Equal(t, fixed.Fixed(0), fixed.NewVector2(big, big).Dot(fixed.NewVector2(big, -big)))
+	assert.Equal(t, fixed.NewVector3(0, 0, 0), fixed.NewVector3(big, big, 0).Cross(fixed.NewVector3(big, big, 0)))
+}
+
+// The same simulation always lands on exactly the same state
+func TestDeterministic(t *testing.T) {
+	run := func() fixed.Vector3 {
+		p := fixed.Vector3FromFloat64(vector3.New(0.1, 0.2, 0.3))
+		v := fixed.Vector3FromFloat64(vector3.New(1.7, -0.3, 0.01))
+		dt := fixed.FromRatio(1, 60)
+		for i := 0; i < 1000; i++ {
+			v = v.Add(p.Scale(-dt)).Normalized().Scale(fixed.FromInt(2))
+			p = p.Add(v.Scale(dt))
+		}
+		return p
+	}
+	assert.Equal(t, run(), run())
+}
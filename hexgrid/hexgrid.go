@@ -0,0 +1,102 @@
+// Package hexgrid implements hexagonal grids addressed by axial coordinates,
+// following the conventions popularized by Red Blob Games. Axial coordinates
+// (q, r) are stored in a vector2.Int, and their equivalent cube coordinates
+// (q, r, s), where q + r + s = 0, in a vector3.Int.
+package hexgrid
+
+import (
+	"math"
+
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vector3"
+)
+
+// directions are the axial offsets to each of a hex's six neighbors, starting
+// with the east (+q) neighbor and proceeding counter-clockwise
+var directions = [6]vector2.Int{
+	vector2.New(1, 0),
+	vector2.New(1, -1),
+	vector2.New(0, -1),
+	vector2.New(-1, 0),
+	vector2.New(-1, 1),
+	vector2.New(0, 1),
+}
+
+// AxialToCube converts axial coordinates into cube coordinates
+func AxialToCube(a vector2.Int) vector3.Int {
+	return vector3.New(a.X(), a.Y(), -a.X()-a.Y())
+}
+
+// CubeToAxial converts cube coordinates into axial coordinates
+func CubeToAxial(c vector3.Int) vector2.Int {
+	return vector2.New(c.X(), c.Y())
+}
+
+// Round converts fractional axial coordinates to the coordinates of the hex
+// containing them, rounding in cube space so that the q + r + s = 0
+// constraint is kept
+func Round(frac vector2.Float64) vector2.Int {
+	q, r := frac.X(), frac.Y()
+	s := -q - r
+
+	rq, rr, rs := math.Round(q), math.Round(r), math.Round(s)
+	dq, dr, ds := math.Abs(rq-q), math.Abs(rr-r), math.Abs(rs-s)
+
+	switch {
+	case dq > dr && dq > ds:
+		rq = -rr - rs
+	case dr > ds:
+		rr = -rq - rs
+	}
+	return vector2.New(int(rq), int(rr))
+}
+
+// Neighbor returns the hex adjacent to a in the given direction, where 0 is
+// east (+q) and directions increase counter-clockwise. Directions outside
+// [0, 6) wrap around
+func Neighbor(a vector2.Int, direction int) vector2.Int {
+	return a.Add(directions[((direction%6)+6)%6])
+}
+
+// Neighbors returns the six hexes adjacent to a, in the order of the
+// directions accepted by Neighbor
+func Neighbors(a vector2.Int) (out [6]vector2.Int) {
+	for i, d := range directions {
+		out[i] = a.Add(d)
+	}
+	return
+}
+
+// Distance returns the number of steps needed to move between two hexes
+func Distance(a, b vector2.Int) int {
+	d := AxialToCube(a.Sub(b)).Abs()
+	return d.MaxComponent()
+}
+
+// Ring returns the hexes exactly radius steps away from center, walking
+// counter-clockwise around it. A radius of 0 results in only the center
+func Ring(center vector2.Int, radius int) []vector2.Int {
+	if radius <= 0 {
+		return []vector2.Int{center}
+	}
+
+	out := make([]vector2.Int, 0, 6*radius)
+	hex := center.Add(directions[4].Scale(float64(radius)))
+	for side := 0; side < 6; side++ {
+		for step := 0; step < radius; step++ {
+			out = append(out, hex)
+			hex = hex.Add(directions[side])
+		}
+	}
+	return out
+}
+
+// Spiral returns every hex within radius steps of center, starting with the
+// center and proceeding outwards one ring at a time
+func Spiral(center vector2.Int, radius int) []vector2.Int {
+	out := make([]vector2.Int, 0, 1+3*radius*(radius+1))
+	for r := 0; r <= radius; r++ {
+		out = append(out, Ring(center, r)...)
+	}
+	return out
+}
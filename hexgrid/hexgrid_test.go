@@ -0,0 +1,87 @@
+package hexgrid_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector/hexgrid"
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCubeConversion(t *testing.T) {
+	a := vector2.New(2, -5)
+	c := hexgrid.AxialToCube(a)
+	assert.Equal(t, vector3.New(2, -5, 3), c)
+	assert.Equal(t, a, hexgrid.CubeToAxial(c))
+}
+
+func TestDistanceAndNeighbors(t *testing.T) {
+	center := vector2.New(3, -2)
+	for i, n := range hexgrid.Neighbors(center) {
+		assert.Equal(t, 1, hexgrid.Distance(center, n))
+		assert.Equal(t, n, hexgrid.Neighbor(center, i))
+	}
+	assert.Equal(t, hexgrid.Neighbor(center, 5), hexgrid.Neighbor(center, -1))
+
+	assert.Equal(t, 0, hexgrid.Distance(center, center))
+	assert.Equal(t, 5, hexgrid.Distance(vector2.New(0, 0), vector2.New(3, 2)))
+	assert.Equal(t, 3, hexgrid.Distance(vector2.New(0, 0), vector2.New(3, -3)))
+}
+
+func TestRingAndSpiral(t *testing.T) {
+	center := vector2.New(1, 1)
+	assert.Equal(t, []vector2.Int{center}, hexgrid.Ring(center, 0))
+
+	for radius := 1; radius <= 4; radius++ {
+		ring := hexgrid.Ring(center, radius)
+		assert.Len(t, ring, 6*radius)
+		for _, h := range ring {
+			assert.Equal(t, radius, hexgrid.Distance(center, h))
+		}
+	}
+
+	spiral := hexgrid.Spiral(center, 3)
+	assert.Len(t, spiral, 37)
+	assert.Equal(t, center, spiral[0])
+
+	seen := map[vector2.Int]bool{}
+	for _, h := range spiral {
+		seen[h] = true
+	}
+	assert.Len(t, seen, 37)
+}
+
+func TestRound(t *testing.T) {
+	assert.Equal(t, vector2.New(1, 0), hexgrid.Round(vector2.New(0.9, 0.05)))
+	assert.Equal(t, vector2.New(0, 0), hexgrid.Round(vector2.New(0.3, 0.3)))
+	assert.Equal(t, vector2.New(-2, 1), hexgrid.Round(vector2.New(-1.6, 0.8)))
+}
+
+func TestLayout(t *testing.T) {
+	for name, orientation := range map[string]hexgrid.Orientation{
+		"pointy": hexgrid.PointyTop,
+		"flat":   hexgrid.FlatTop,
+	} {
+		t.Run(name, func(t *testing.T) {
+			layout := hexgrid.Layout{
+				Orientation: orientation,
+				Size:        vector2.New(10., 12.),
+				Origin:      vector2.New(100., -50.),
+			}
+
+			assert.Equal(t, layout.Origin, layout.ToWorld(vector2.Zero[int]()))
+
+			for _, h := range hexgrid.Spiral(vector2.New(2, -1), 3) {
+				center := layout.ToWorld(h)
+				assert.Equal(t, h, layout.FromWorld(center))
+
+				// Points just inside each corner still belong to the hex
+				for _, corner := range layout.Corners(h) {
+					inside := vector2.Lerp(center, corner, 0.95)
+					assert.Equal(t, h, layout.FromWorld(inside))
+				}
+			}
+		})
+	}
+}
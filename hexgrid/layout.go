@@ -0,0 +1,84 @@
+package hexgrid
+
+import (
+	"math"
+
+	"github.com/EliCDavis/vector/vector2"
+)
+
+// Orientation describes how hexes are rotated within a grid, as the forward
+// and inverse matrices mapping between axial coordinates and world space,
+// along with the angle of the first corner in multiples of 60°
+type Orientation struct {
+	f0, f1, f2, f3 float64
+	b0, b1, b2, b3 float64
+	startAngle     float64
+}
+
+var (
+	// PointyTop orients hexes with a corner pointing up, arranging them in
+	// horizontal rows
+	PointyTop = Orientation{
+		f0: math.Sqrt(3), f1: math.Sqrt(3) / 2, f2: 0, f3: 3. / 2,
+		b0: math.Sqrt(3) / 3, b1: -1. / 3, b2: 0, b3: 2. / 3,
+		startAngle: 0.5,
+	}
+
+	// FlatTop orients hexes with an edge facing up, arranging them in
+	// vertical columns
+	FlatTop = Orientation{
+		f0: 3. / 2, f1: 0, f2: math.Sqrt(3) / 2, f3: math.Sqrt(3),
+		b0: 2. / 3, b1: 0, b2: -1. / 3, b3: math.Sqrt(3) / 3,
+		startAngle: 0,
+	}
+)
+
+// Layout places a hex grid in world space
+type Layout struct {
+	Orientation Orientation
+
+	// Size is the distance from the center of a hex to its corners along
+	// each axis. Differing values stretch the hexes
+	Size vector2.Float64
+
+	// Origin is the world space position of the center of hex (0, 0)
+	Origin vector2.Float64
+}
+
+// ToWorld returns the world space position of the center of the hex
+func (l Layout) ToWorld(a vector2.Int) vector2.Float64 {
+	o := l.Orientation
+	q, r := float64(a.X()), float64(a.Y())
+	return vector2.New(
+		(o.f0*q+o.f1*r)*l.Size.X()+l.Origin.X(),
+		(o.f2*q+o.f3*r)*l.Size.Y()+l.Origin.Y(),
+	)
+}
+
+// FractionalFromWorld converts a world space position into fractional axial
+// coordinates
+func (l Layout) FractionalFromWorld(p vector2.Float64) vector2.Float64 {
+	o := l.Orientation
+	x := (p.X() - l.Origin.X()) / l.Size.X()
+	y := (p.Y() - l.Origin.Y()) / l.Size.Y()
+	return vector2.New(o.b0*x+o.b1*y, o.b2*x+o.b3*y)
+}
+
+// FromWorld returns the hex containing the world space position
+func (l Layout) FromWorld(p vector2.Float64) vector2.Int {
+	return Round(l.FractionalFromWorld(p))
+}
+
+// Corners returns the world space positions of the hex's six corners,
+// winding counter-clockwise
+func (l Layout) Corners(a vector2.Int) (out [6]vector2.Float64) {
+	center := l.ToWorld(a)
+	for i := range out {
+		angle := 2 * math.Pi * (l.Orientation.startAngle + float64(i)) / 6
+		out[i] = vector2.New(
+			center.X()+l.Size.X()*math.Cos(angle),
+			center.Y()+l.Size.Y()*math.Sin(angle),
+		)
+	}
+	return
+}
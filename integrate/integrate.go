@@ -0,0 +1,101 @@
+// Package integrate advances the position and velocity of bodies through
+// time using common numerical integration schemes, for vector2.Float64,
+// vector3.Float64, or any other type implementing Vector.
+//
+// The schemes trade accuracy for cost. Euler is the cheapest but gains
+// energy over time, SemiImplicitEuler is just as cheap and stable for most
+// game physics, Verlet conserves energy well for position based simulations,
+// and RK4 is the most accurate at the cost of evaluating the acceleration
+// four times per step.
+package integrate
+
+// Vector is satisfied by the floating point vector types that can be
+// integrated, such as vector2.Float64 and vector3.Float64
+type Vector[V any] interface {
+	Add(V) V
+	Scale(float64) V
+}
+
+// State is the position and velocity of a body
+type State[V Vector[V]] struct {
+	Position V
+	Velocity V
+}
+
+// Acceleration computes the acceleration of a body given its state, such as
+// gravity combined with a drag that depends on velocity
+type Acceleration[V Vector[V]] func(position, velocity V) V
+
+func checkSliceLengths(lengths ...int) {
+	for _, l := range lengths[1:] {
+		if l != lengths[0] {
+			panic("integrate: slices must have the same length")
+		}
+	}
+}
+
+// Euler advances the state by dt using the explicit Euler method, moving the
+// position by the velocity from the start of the step
+func Euler[V Vector[V]](state State[V], acceleration V, dt float64) State[V] {
+	return State[V]{
+		Position: state.Position.Add(state.Velocity.Scale(dt)),
+		Velocity: state.Velocity.Add(acceleration.Scale(dt)),
+	}
+}
+
+// SemiImplicitEuler advances the state by dt using the semi-implicit, or
+// symplectic, Euler method, moving the position by the velocity from the end
+// of the step
+func SemiImplicitEuler[V Vector[V]](state State[V], acceleration V, dt float64) State[V] {
+	velocity := state.Velocity.Add(acceleration.Scale(dt))
+	return State[V]{
+		Position: state.Position.Add(velocity.Scale(dt)),
+		Velocity: velocity,
+	}
+}
+
+// Verlet advances a position by dt using position Verlet integration, where
+// velocity is implied by the position from the previous step. It returns the
+// next position, after which position becomes the previous one. dt must stay
+// constant between steps
+func Verlet[V Vector[V]](position, previous, acceleration V, dt float64) V {
+	return position.Scale(2).Add(previous.Scale(-1)).Add(acceleration.Scale(dt * dt))
+}
+
+// VelocityVerlet advances the state by dt using velocity Verlet integration,
+// evaluating the acceleration at the start and end of the step
+func VelocityVerlet[V Vector[V]](state State[V], acceleration Acceleration[V], dt float64) State[V] {
+	a0 := acceleration(state.Position, state.Velocity)
+	position := state.Position.Add(state.Velocity.Scale(dt)).Add(a0.Scale(dt * dt / 2))
+
+	// The velocity at the end of the step isn't known yet, so the
+	// acceleration is evaluated with a full step Euler prediction of it
+	estimate := state.Velocity.Add(a0.Scale(dt))
+	a1 := acceleration(position, estimate)
+	return State[V]{
+		Position: position,
+		Velocity: state.Velocity.Add(a0.Add(a1).Scale(dt / 2)),
+	}
+}
+
+// RK4 advances the state by dt using the classic fourth order Runge-Kutta
+// method
+func RK4[V Vector[V]](state State[V], acceleration Acceleration[V], dt float64) State[V] {
+	p, v := state.Position, state.Velocity
+
+	k1p, k1v := v, acceleration(p, v)
+
+	p2, v2 := p.Add(k1p.Scale(dt/2)), v.Add(k1v.Scale(dt/2))
+	k2p, k2v := v2, acceleration(p2, v2)
+
+	p3, v3 := p.Add(k2p.Scale(dt/2)), v.Add(k2v.Scale(dt/2))
+	k3p, k3v := v3, acceleration(p3, v3)
+
+	p4, v4 := p.Add(k3p.Scale(dt)), v.Add(k3v.Scale(dt))
+	k4p, k4v := v4, acceleration(p4, v4)
+
+	return State[V]{
+		Position: p.Add(k1p.Add(k2p.Scale(2)).Add(k3p.Scale(2)).Add(k4p).Scale(dt / 6)),
+		Velocity: v.Add(k1v.Add(k2v.Scale(2)).Add(k3v.Scale(2)).Add(k4v).Scale(dt / 6)),
+	}
+}
@@ -0,0 +1,95 @@
+package integrate_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/EliCDavis/vector/integrate"
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/EliCDavis/vector/vectortest"
+	"github.com/stretchr/testify/assert"
+)
+
+var gravity = vector3.New(0., -10., 0.)
+
+func TestEuler(t *testing.T) {
+	s := integrate.State[vector3.Float64]{Velocity: vector3.New(1., 0., 0.)}
+
+	explicit := integrate.Euler(s, gravity, 0.5)
+	vectortest.AssertVector3InDelta(t, vector3.New(0.5, 0., 0.), explicit.Position, 1e-12)
+	vectortest.AssertVector3InDelta(t, vector3.New(1., -5., 0.), explicit.Velocity, 1e-12)
+
+	semi := integrate.SemiImplicitEuler(s, gravity, 0.5)
+	vectortest.AssertVector3InDelta(t, vector3.New(0.5, -2.5, 0.), semi.Position, 1e-12)
+	vectortest.AssertVector3InDelta(t, vector3.New(1., -5., 0.), semi.Velocity, 1e-12)
+}
+
+func TestVerletMatchesConstantAcceleration(t *testing.T) {
+	dt := 0.1
+	// Start at rest, with the previous position chosen to match
+	prev := gravity.Scale(dt * dt / 2)
+	cur := vector3.Zero[float64]()
+	for i := 0; i < 10; i++ {
+		cur, prev = integrate.Verlet(cur, prev, gravity, dt), cur
+	}
+	vectortest.AssertVector3InDelta(t, gravity.Scale(0.5), cur, 1e-9)
+}
+
+func spring(position, velocity vector2.Float64) vector2.Float64 {
+	return position.Scale(-1)
+}
+
+// A unit spring oscillates around the origin as cos(t)
+func TestHigherOrderAccuracy(t *testing.T) {
+	dt := 0.01
+	steps := 100
+	want := vector2.New(math.Cos(1), 0.)
+
+	rk4 := integrate.State[vector2.Float64]{Position: vector2.New(1., 0.)}
+	verlet := rk4
+	euler := rk4
+	for i := 0; i < steps; i++ {
+		rk4 = integrate.RK4(rk4, spring, dt)
+		verlet = integrate.VelocityVerlet(verlet, spring, dt)
+		euler = integrate.Euler(euler, spring(euler.Position, euler.Velocity), dt)
+	}
+
+	vectortest.AssertVector2InDelta(t, want, rk4.Position, 1e-10)
+	vectortest.AssertVector2InDelta(t, vector2.New(-math.Sin(1), 0.), rk4.Velocity, 1e-10)
+	vectortest.AssertVector2InDelta(t, want, verlet.Position, 1e-4)
+	assert.Greater(t, euler.Position.Distance(want), verlet.Position.Distance(want))
+}
+
+func TestSlices(t *testing.T) {
+	positions := []vector3.Float64{vector3.Zero[float64](), vector3.New(1., 1., 1.)}
+	velocities := []vector3.Float64{vector3.New(1., 0., 0.), vector3.Zero[float64]()}
+	accelerations := []vector3.Float64{gravity, gravity}
+
+	p, v := append([]vector3.Float64{}, positions...), append([]vector3.Float64{}, velocities...)
+	integrate.SemiImplicitEulerSlices(p, v, accelerations, 0.5)
+	for i := range positions {
+		s := integrate.SemiImplicitEuler(integrate.State[vector3.Float64]{positions[i], velocities[i]}, accelerations[i], 0.5)
+		assert.Equal(t, s.Position, p[i])
+		assert.Equal(t, s.Velocity, v[i])
+	}
+
+	p, v = append([]vector3.Float64{}, positions...), append([]vector3.Float64{}, velocities...)
+	integrate.EulerSlices(p, v, accelerations, 0.5)
+	vectortest.AssertVector3InDelta(t, vector3.New(0.5, 0., 0.), p[0], 1e-12)
+
+	p, prev := append([]vector3.Float64{}, positions...), append([]vector3.Float64{}, positions...)
+	integrate.VerletSlices(p, prev, accelerations, 0.5)
+	assert.Equal(t, positions, prev)
+	vectortest.AssertVector3InDelta(t, vector3.New(1., -1.5, 1.), p[1], 1e-12)
+
+	p, v = append([]vector3.Float64{}, positions...), append([]vector3.Float64{}, velocities...)
+	integrate.RK4Slices(p, v, func(i int, position, velocity vector3.Float64) vector3.Float64 {
+		return accelerations[i]
+	}, 0.5)
+	vectortest.AssertVector3InDelta(t, vector3.New(0.5, -1.25, 0.), p[0], 1e-12)
+
+	assert.PanicsWithValue(t, "integrate: slices must have the same length", func() {
+		integrate.EulerSlices(p, v[:1], accelerations, 0.5)
+	})
+}
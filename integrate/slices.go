@@ -0,0 +1,46 @@
+package integrate
+
+// The slice forms below step many bodies at once, updating positions and
+// velocities in place. Every slice passed must share a length.
+
+// EulerSlices advances every body by dt using the explicit Euler method
+func EulerSlices[V Vector[V]](positions, velocities, accelerations []V, dt float64) {
+	checkSliceLengths(len(positions), len(velocities), len(accelerations))
+	for i := range positions {
+		s := Euler(State[V]{positions[i], velocities[i]}, accelerations[i], dt)
+		positions[i], velocities[i] = s.Position, s.Velocity
+	}
+}
+
+// SemiImplicitEulerSlices advances every body by dt using the semi-implicit
+// Euler method
+func SemiImplicitEulerSlices[V Vector[V]](positions, velocities, accelerations []V, dt float64) {
+	checkSliceLengths(len(positions), len(velocities), len(accelerations))
+	for i := range positions {
+		s := SemiImplicitEuler(State[V]{positions[i], velocities[i]}, accelerations[i], dt)
+		positions[i], velocities[i] = s.Position, s.Velocity
+	}
+}
+
+// VerletSlices advances every position by dt using position Verlet
+// integration. Afterwards positions holds the next positions and previous
+// holds the positions that were passed in
+func VerletSlices[V Vector[V]](positions, previous, accelerations []V, dt float64) {
+	checkSliceLengths(len(positions), len(previous), len(accelerations))
+	for i := range positions {
+		positions[i], previous[i] = Verlet(positions[i], previous[i], accelerations[i], dt), positions[i]
+	}
+}
+
+// RK4Slices advances every body by dt using the fourth order Runge-Kutta
+// method. acceleration is evaluated independently for each body, identified
+// by its index
+func RK4Slices[V Vector[V]](positions, velocities []V, acceleration func(i int, position, velocity V) V, dt float64) {
+	checkSliceLengths(len(positions), len(velocities))
+	for i := range positions {
+		s := RK4(State[V]{positions[i], velocities[i]}, func(p, v V) V {
+			return acceleration(i, p, v)
+		}, dt)
+		positions[i], velocities[i] = s.Position, s.Velocity
+	}
+}
@@ -0,0 +1,34 @@
+// Command swizzlegen writes the generated component accessors and swizzles
+// of a vector package. It's run through go generate from within the
+// vector2, vector3 and vector4 packages:
+//
+//	//go:generate go run ../internal/cmd/swizzlegen -dim 3
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+
+	"github.com/EliCDavis/vector/internal/gen"
+)
+
+func main() {
+	dim := flag.Int("dim", 0, "dimension of the vector package being generated")
+	out := flag.String("out", gen.VectorFile, "file to write")
+	flag.Parse()
+
+	spec, err := gen.VectorSpec(*dim)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	src, err := gen.Generate(spec)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
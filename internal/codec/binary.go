@@ -0,0 +1,97 @@
+// Package codec contains the encoding primitives shared between the vector
+// packages.
+package codec
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/EliCDavis/vector"
+)
+
+// AppendBinary appends the little-endian encoding of each component to dst
+func AppendBinary[T vector.Number](dst []byte, components ...T) []byte {
+	return AppendBinaryOrder(dst, binary.LittleEndian, components...)
+}
+
+// AppendBinaryOrder appends the encoding of each component to dst using the
+// provided byte order
+func AppendBinaryOrder[T vector.Number](dst []byte, order binary.AppendByteOrder, components ...T) []byte {
+	for _, c := range components {
+		switch cv := any(c).(type) {
+		case int8:
+			dst = append(dst, byte(cv))
+		case int16:
+			dst = order.AppendUint16(dst, uint16(cv))
+		case int32:
+			dst = order.AppendUint32(dst, uint32(cv))
+		case int64:
+			dst = order.AppendUint64(dst, uint64(cv))
+		case int:
+			dst = order.AppendUint64(dst, uint64(cv))
+		case float32:
+			dst = order.AppendUint32(dst, math.Float32bits(cv))
+		case float64:
+			dst = order.AppendUint64(dst, math.Float64bits(cv))
+		}
+	}
+	return dst
+}
+
+// AppendTaggedBinary appends the component type tag followed by the
+// little-endian encoding of each component to dst
+func AppendTaggedBinary[T vector.Number](dst []byte, components ...T) []byte {
+	dst = append(dst, byte(vector.ComponentTypeOf[T]()))
+	return AppendBinary(dst, components...)
+}
+
+// DecodeBinary decodes len(out) little-endian components from data
+func DecodeBinary[T vector.Number](data []byte, out []T) {
+	DecodeBinaryOrder(data, binary.LittleEndian, out)
+}
+
+// DecodeBinaryOrder decodes len(out) components from data using the provided
+// byte order
+func DecodeBinaryOrder[T vector.Number](data []byte, order binary.ByteOrder, out []T) {
+	size := vector.ComponentTypeOf[T]().Size()
+	for i := range out {
+		b := data[i*size:]
+		switch p := any(&out[i]).(type) {
+		case *int8:
+			*p = int8(b[0])
+		case *int16:
+			*p = int16(order.Uint16(b))
+		case *int32:
+			*p = int32(order.Uint32(b))
+		case *int64:
+			*p = int64(order.Uint64(b))
+		case *int:
+			*p = int(order.Uint64(b))
+		case *float32:
+			*p = math.Float32frombits(order.Uint32(b))
+		case *float64:
+			*p = math.Float64frombits(order.Uint64(b))
+		}
+	}
+}
+
+// DecodeTaggedBinary validates the component type tag and length of data
+// before decoding len(out) components from it
+func DecodeTaggedBinary[T vector.Number](data []byte, out []T) error {
+	want := vector.ComponentTypeOf[T]()
+	if len(data) == 0 {
+		return fmt.Errorf("unable to decode binary vector: no data")
+	}
+
+	if got := vector.ComponentType(data[0]); got != want {
+		return fmt.Errorf("unable to decode binary vector: component type %s does not match %s", got, want)
+	}
+
+	if expected := 1 + want.Size()*len(out); len(data) != expected {
+		return fmt.Errorf("unable to decode binary vector: expected %d bytes, got %d", expected, len(data))
+	}
+
+	DecodeBinary(data[1:], out)
+	return nil
+}
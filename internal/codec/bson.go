@@ -0,0 +1,149 @@
+package codec
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/EliCDavis/vector"
+)
+
+// BSON element types used by the vector encodings
+const (
+	BSONDouble   byte = 0x01
+	BSONDocument byte = 0x03
+	BSONArray    byte = 0x04
+	BSONInt32    byte = 0x10
+	BSONInt64    byte = 0x12
+)
+
+// AppendBSONDocument appends the components to dst as a BSON document, using
+// keys as the name of each component. Floating point components are stored as
+// doubles, and integer components as int32 or int64 depending on their type.
+func AppendBSONDocument[T vector.Number](dst []byte, keys []string, components ...T) []byte {
+	start := len(dst)
+	dst = append(dst, 0, 0, 0, 0)
+
+	for i, c := range components {
+		var elementType byte
+		switch any(c).(type) {
+		case float32, float64:
+			elementType = BSONDouble
+		case int64, int:
+			elementType = BSONInt64
+		default:
+			elementType = BSONInt32
+		}
+
+		dst = append(dst, elementType)
+		dst = append(dst, keys[i]...)
+		dst = append(dst, 0)
+
+		switch elementType {
+		case BSONDouble:
+			dst = binary.LittleEndian.AppendUint64(dst, math.Float64bits(float64(c)))
+		case BSONInt64:
+			dst = binary.LittleEndian.AppendUint64(dst, uint64(int64(c)))
+		default:
+			dst = binary.LittleEndian.AppendUint32(dst, uint32(int32(c)))
+		}
+	}
+
+	dst = append(dst, 0)
+	binary.LittleEndian.PutUint32(dst[start:], uint32(len(dst)-start))
+	return dst
+}
+
+// DecodeBSONDocument decodes a BSON document into out, reading each component
+// from the element named by the corresponding entry in keys. When the document
+// is a BSON array the elements are read positionally instead. Missing
+// components decode as zero.
+func DecodeBSONDocument[T vector.Number](data []byte, keys []string, isArray bool, out []T) error {
+	if len(data) < 5 {
+		return fmt.Errorf("unable to decode bson vector: document too short")
+	}
+
+	length := int(binary.LittleEndian.Uint32(data))
+	if length != len(data) || data[length-1] != 0 {
+		return fmt.Errorf("unable to decode bson vector: invalid document length")
+	}
+
+	elements := data[4 : length-1]
+	for len(elements) > 0 {
+		elementType := elements[0]
+		elements = elements[1:]
+
+		end := 0
+		for end < len(elements) && elements[end] != 0 {
+			end++
+		}
+		if end == len(elements) {
+			return fmt.Errorf("unable to decode bson vector: unterminated element name")
+		}
+		name := string(elements[:end])
+		elements = elements[end+1:]
+
+		var (
+			size int
+			i    int64
+			f    float64
+		)
+		switch elementType {
+		case BSONDouble, BSONInt64:
+			size = 8
+		case BSONInt32:
+			size = 4
+		default:
+			return fmt.Errorf("unable to decode bson vector component %q: unsupported element type 0x%02x", name, elementType)
+		}
+
+		if len(elements) < size {
+			return fmt.Errorf("unable to decode bson vector component %q: unexpected end of data", name)
+		}
+
+		switch elementType {
+		case BSONDouble:
+			f = math.Float64frombits(binary.LittleEndian.Uint64(elements))
+		case BSONInt64:
+			i = int64(binary.LittleEndian.Uint64(elements))
+			f = float64(i)
+		case BSONInt32:
+			i = int64(int32(binary.LittleEndian.Uint32(elements)))
+			f = float64(i)
+		}
+		elements = elements[size:]
+
+		index := -1
+		for k, key := range keys {
+			if (isArray && name == strconv.Itoa(k)) || (!isArray && name == key) {
+				index = k
+				break
+			}
+		}
+		if index == -1 {
+			continue
+		}
+
+		switch any(out[index]).(type) {
+		case float32, float64:
+			out[index] = T(f)
+			continue
+		}
+
+		if elementType == BSONDouble {
+			if f != math.Trunc(f) || float64(T(f)) != f {
+				return fmt.Errorf("unable to decode bson vector component %q: %v is not representable as %s", name, f, vector.ComponentTypeOf[T]())
+			}
+			out[index] = T(f)
+			continue
+		}
+
+		if int64(T(i)) != i {
+			return fmt.Errorf("unable to decode bson vector component %q: value %d overflows %s", name, i, vector.ComponentTypeOf[T]())
+		}
+		out[index] = T(i)
+	}
+
+	return nil
+}
@@ -0,0 +1,26 @@
+package codec
+
+import "fmt"
+
+// BufferStride validates the layout of count elements of size bytes, the
+// first starting at offset and each following stride bytes after the last,
+// within a buffer of length bufLen. A stride of 0 means the elements are
+// tightly packed. The effective stride is returned.
+func BufferStride(bufLen, count, size, offset, stride int) (int, error) {
+	if stride == 0 {
+		stride = size
+	}
+	if stride < size {
+		return 0, fmt.Errorf("stride %d is smaller than element size %d", stride, size)
+	}
+	if offset < 0 {
+		return 0, fmt.Errorf("invalid offset %d", offset)
+	}
+	if count < 0 {
+		return 0, fmt.Errorf("invalid count %d", count)
+	}
+	if count > 0 && offset+(count-1)*stride+size > bufLen {
+		return 0, fmt.Errorf("buffer of %d bytes too small for %d elements with offset %d and stride %d", bufLen, count, offset, stride)
+	}
+	return stride, nil
+}
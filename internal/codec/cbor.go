@@ -0,0 +1,222 @@
+package codec
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/EliCDavis/vector"
+)
+
+// CBOR major types and simple values used by the vector encodings
+const (
+	cborUnsigned = 0 << 5
+	cborNegative = 1 << 5
+	cborArray    = 4 << 5
+	cborFloat16  = 7<<5 | 25
+	cborFloat32  = 7<<5 | 26
+	cborFloat64  = 7<<5 | 27
+
+	// cborIndefiniteArray starts an array whose items run until cborBreak
+	cborIndefiniteArray = cborArray | 31
+	cborBreak           = 0xff
+)
+
+// AppendCBOR appends the components to dst as a definite length CBOR array.
+// Floating point components keep their precision, while integer components
+// use the smallest CBOR integer encoding that holds their value.
+func AppendCBOR[T vector.Number](dst []byte, components ...T) []byte {
+	dst = appendCBORHead(dst, cborArray, uint64(len(components)))
+	for _, c := range components {
+		switch cv := any(c).(type) {
+		case float32:
+			dst = append(dst, cborFloat32)
+			dst = binary.BigEndian.AppendUint32(dst, math.Float32bits(cv))
+		case float64:
+			dst = append(dst, cborFloat64)
+			dst = binary.BigEndian.AppendUint64(dst, math.Float64bits(cv))
+		default:
+			if i := int64(c); i < 0 {
+				dst = appendCBORHead(dst, cborNegative, uint64(-1-i))
+			} else {
+				dst = appendCBORHead(dst, cborUnsigned, uint64(i))
+			}
+		}
+	}
+	return dst
+}
+
+func appendCBORHead(dst []byte, major byte, n uint64) []byte {
+	switch {
+	case n < 24:
+		return append(dst, major|byte(n))
+	case n <= math.MaxUint8:
+		return append(dst, major|24, byte(n))
+	case n <= math.MaxUint16:
+		return binary.BigEndian.AppendUint16(append(dst, major|25), uint16(n))
+	case n <= math.MaxUint32:
+		return binary.BigEndian.AppendUint32(append(dst, major|26), uint32(n))
+	}
+	return binary.BigEndian.AppendUint64(append(dst, major|27), n)
+}
+
+// readCBORHead reads the major type and argument of the next CBOR data item
+func readCBORHead(data []byte) (major byte, n uint64, rest []byte, err error) {
+	if len(data) == 0 {
+		return 0, 0, data, fmt.Errorf("unexpected end of data")
+	}
+
+	major = data[0] & 0xe0
+	info := data[0] & 0x1f
+	data = data[1:]
+
+	size := 0
+	switch {
+	case info < 24:
+		return major, uint64(info), data, nil
+	case info == 24:
+		size = 1
+	case info == 25:
+		size = 2
+	case info == 26:
+		size = 4
+	case info == 27:
+		size = 8
+	default:
+		return major, 0, data, fmt.Errorf("unsupported additional information %d", info)
+	}
+
+	if len(data) < size {
+		return major, 0, data, fmt.Errorf("unexpected end of data")
+	}
+
+	switch size {
+	case 1:
+		n = uint64(data[0])
+	case 2:
+		n = uint64(binary.BigEndian.Uint16(data))
+	case 4:
+		n = uint64(binary.BigEndian.Uint32(data))
+	case 8:
+		n = binary.BigEndian.Uint64(data)
+	}
+	return major, n, data[size:], nil
+}
+
+// DecodeCBOR decodes a CBOR array of exactly len(out) numbers into out. Both
+// definite and indefinite length arrays are accepted. Any trailing data after
+// the array is treated as an error.
+func DecodeCBOR[T vector.Number](data []byte, out []T) error {
+	indefinite := len(data) > 0 && data[0] == cborIndefiniteArray
+	if indefinite {
+		data = data[1:]
+	} else {
+		major, count, rest, err := readCBORHead(data)
+		if err != nil {
+			return fmt.Errorf("unable to decode cbor vector: %w", err)
+		}
+
+		if major != cborArray {
+			return fmt.Errorf("unable to decode cbor vector: expected array, got major type %d", major>>5)
+		}
+
+		if count != uint64(len(out)) {
+			return fmt.Errorf("unable to decode cbor vector: expected %d components, got %d", len(out), count)
+		}
+		data = rest
+	}
+
+	for i := range out {
+		if indefinite && len(data) > 0 && data[0] == cborBreak {
+			return fmt.Errorf("unable to decode cbor vector: expected %d components, got %d", len(out), i)
+		}
+
+		var err error
+		out[i], data, err = readCBORComponent[T](data)
+		if err != nil {
+			return fmt.Errorf("unable to decode cbor vector component %d: %w", i, err)
+		}
+	}
+
+	if indefinite {
+		if len(data) == 0 {
+			return fmt.Errorf("unable to decode cbor vector: unexpected end of data")
+		}
+		if data[0] != cborBreak {
+			return fmt.Errorf("unable to decode cbor vector: expected %d components, got more", len(out))
+		}
+		data = data[1:]
+	}
+
+	if len(data) != 0 {
+		return fmt.Errorf("unable to decode cbor vector: %d bytes of trailing data", len(data))
+	}
+	return nil
+}
+
+func readCBORComponent[T vector.Number](data []byte) (T, []byte, error) {
+	if len(data) > 0 {
+		var f float64
+		switch data[0] {
+		case cborFloat16:
+			if len(data) < 3 {
+				return 0, data, fmt.Errorf("unexpected end of data")
+			}
+			f = float64(HalfToFloat32(binary.BigEndian.Uint16(data[1:])))
+			data = data[3:]
+		case cborFloat32:
+			if len(data) < 5 {
+				return 0, data, fmt.Errorf("unexpected end of data")
+			}
+			f = float64(math.Float32frombits(binary.BigEndian.Uint32(data[1:])))
+			data = data[5:]
+		case cborFloat64:
+			if len(data) < 9 {
+				return 0, data, fmt.Errorf("unexpected end of data")
+			}
+			f = math.Float64frombits(binary.BigEndian.Uint64(data[1:]))
+			data = data[9:]
+		default:
+			return readCBORInt[T](data)
+		}
+
+		var out T
+		switch any(out).(type) {
+		case float32, float64:
+			return T(f), data, nil
+		}
+		return 0, data, fmt.Errorf("can not decode float into %s", vector.ComponentTypeOf[T]())
+	}
+	return readCBORInt[T](data)
+}
+
+func readCBORInt[T vector.Number](data []byte) (T, []byte, error) {
+	major, n, rest, err := readCBORHead(data)
+	if err != nil {
+		return 0, rest, err
+	}
+
+	if major != cborUnsigned && major != cborNegative {
+		return 0, rest, fmt.Errorf("expected number, got major type %d", major>>5)
+	}
+
+	if n > math.MaxInt64 {
+		return 0, rest, fmt.Errorf("value overflows %s", vector.ComponentTypeOf[T]())
+	}
+
+	i := int64(n)
+	if major == cborNegative {
+		i = -1 - i
+	}
+
+	var out T
+	switch any(out).(type) {
+	case float32, float64:
+		return T(i), rest, nil
+	}
+
+	if int64(T(i)) != i {
+		return 0, rest, fmt.Errorf("value %d overflows %s", i, vector.ComponentTypeOf[T]())
+	}
+	return T(i), rest, nil
+}
@@ -0,0 +1,121 @@
+package codec_test
+
+import (
+	"encoding/hex"
+	"math"
+	"testing"
+
+	"github.com/EliCDavis/vector/internal/codec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustHex(t *testing.T, s string) []byte {
+	t.Helper()
+	data, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return data
+}
+
+// Encodings of single values, taken from RFC 8949 Appendix A
+var rfc8949Floats = map[string]float64{
+	"f90000":             0,
+	"f98000":             math.Copysign(0, -1),
+	"f93c00":             1,
+	"f93e00":             1.5,
+	"f97bff":             65504,
+	"f90001":             5.960464477539063e-8,
+	"f90400":             0.00006103515625,
+	"f9c400":             -4,
+	"f97c00":             math.Inf(1),
+	"f9fc00":             math.Inf(-1),
+	"fa47c35000":         100000,
+	"fa7f7fffff":         3.4028234663852886e+38,
+	"fa7f800000":         math.Inf(1),
+	"fb3ff199999999999a": 1.1,
+	"fb7e37e43c8800759c": 1.0e+300,
+	"fbc010666666666666": -4.1,
+	"1903e8":             1000,
+	"3903e7":             -1000,
+}
+
+func TestDecodeCBORFloats(t *testing.T) {
+	for encoded, want := range rfc8949Floats {
+		t.Run(encoded, func(t *testing.T) {
+			// Wrap the value in a one element array
+			var out [1]float64
+			require.NoError(t, codec.DecodeCBOR(append([]byte{0x81}, mustHex(t, encoded)...), out[:]))
+			assert.Equal(t, want, out[0])
+			assert.Equal(t, math.Signbit(want), math.Signbit(out[0]))
+		})
+	}
+
+	var out [1]float32
+	require.NoError(t, codec.DecodeCBOR(mustHex(t, "81f97e00"), out[:]))
+	assert.True(t, math.IsNaN(float64(out[0])))
+}
+
+func TestDecodeCBORArrays(t *testing.T) {
+	tests := map[string]string{
+		"definite":            "83010203",
+		"indefinite":          "9f010203ff",
+		"mixed floats":        "83f93c00fa40000000fb4008000000000000",
+		"indefinite floats":   "9ff93c00fa40000000fb4008000000000000ff",
+		"one byte arguments":  "831801180218" + "03",
+		"two byte arguments":  "83190001190002190003",
+		"four byte arguments": "831a000000011a000000021a00000003",
+	}
+
+	for name, encoded := range tests {
+		t.Run(name, func(t *testing.T) {
+			var out [3]float64
+			require.NoError(t, codec.DecodeCBOR(mustHex(t, encoded), out[:]))
+			assert.Equal(t, [3]float64{1, 2, 3}, out)
+		})
+	}
+
+	var ints [3]int8
+	require.NoError(t, codec.DecodeCBOR(mustHex(t, "833820387f20"), ints[:]))
+	assert.Equal(t, [3]int8{-33, -128, -1}, ints)
+}
+
+func TestDecodeCBORInvalid(t *testing.T) {
+	tests := map[string]string{
+		"empty":                  "",
+		"not an array":           "a0",
+		"too few":                "820102",
+		"too many":               "8401020304",
+		"indefinite too few":     "9f0102ff",
+		"indefinite too many":    "9f01020304ff",
+		"missing break":          "9f010203",
+		"truncated float":        "83f93c",
+		"truncated double":       "8301fb3ff1999999",
+		"truncated argument":     "8301021900",
+		"trailing data":          "8301020300",
+		"string component":       "83010261",
+		"unsupported info":       "8301021c",
+		"nested array":           "8301028101",
+		"uint64 overflow":        "830102" + "1bffffffffffffffff",
+		"definite missing items": "83",
+	}
+
+	for name, encoded := range tests {
+		t.Run(name, func(t *testing.T) {
+			var out [3]float64
+			assert.Error(t, codec.DecodeCBOR(mustHex(t, encoded), out[:]))
+		})
+	}
+
+	// Integer vectors reject floats and values that don't fit
+	var ints [1]int8
+	assert.Error(t, codec.DecodeCBOR(mustHex(t, "81f93c00"), ints[:]))
+	assert.Error(t, codec.DecodeCBOR(mustHex(t, "811880"), ints[:]))
+	assert.Error(t, codec.DecodeCBOR(mustHex(t, "813880"), ints[:]))
+}
+
+func TestAppendCBOR(t *testing.T) {
+	assert.Equal(t, "83010203", hex.EncodeToString(codec.AppendCBOR(nil, 1, 2, 3)))
+	assert.Equal(t, "821903e83903e7", hex.EncodeToString(codec.AppendCBOR(nil, 1000, -1000)))
+	assert.Equal(t, "82fa3fc00000fa7f800000", hex.EncodeToString(codec.AppendCBOR(nil, float32(1.5), float32(math.Inf(1)))))
+	assert.Equal(t, "81fb3ff199999999999a", hex.EncodeToString(codec.AppendCBOR(nil, 1.1)))
+}
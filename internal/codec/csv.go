@@ -0,0 +1,148 @@
+package codec
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/EliCDavis/vector"
+)
+
+// CSVWriter writes vector components as CSV records, reusing its buffers
+// between records
+type CSVWriter[T vector.Number] struct {
+	w      *csv.Writer
+	record []string
+	buf    []byte
+}
+
+// NewCSVWriter creates a CSVWriter and writes the header row of keys
+func NewCSVWriter[T vector.Number](w io.Writer, keys []string) (*CSVWriter[T], error) {
+	c := &CSVWriter[T]{
+		w:      csv.NewWriter(w),
+		record: make([]string, len(keys)),
+	}
+	return c, c.w.Write(keys)
+}
+
+// Write writes a single record
+func (c *CSVWriter[T]) Write(components ...T) error {
+	for i, component := range components {
+		c.buf = AppendComponent(c.buf[:0], component)
+		c.record[i] = string(c.buf)
+	}
+	return c.w.Write(c.record)
+}
+
+// Flush writes any buffered data to the underlying writer
+func (c *CSVWriter[T]) Flush() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// CSVReader reads vector components from CSV records
+type CSVReader[T vector.Number] struct {
+	r       *csv.Reader
+	columns []int
+	header  []string
+}
+
+// NewCSVReader creates a CSVReader, consuming the header row unless
+// opts.NoHeader is set and resolving which columns hold each of the keys
+func NewCSVReader[T vector.Number](r io.Reader, keys []string, opts vector.CSVOptions) (*CSVReader[T], error) {
+	c := &CSVReader[T]{r: csv.NewReader(r)}
+	c.r.FieldsPerRecord = -1
+	c.r.TrimLeadingSpace = true
+	c.r.ReuseRecord = true
+
+	if !opts.NoHeader {
+		header, err := c.r.Read()
+		if err != nil {
+			return nil, err
+		}
+		c.header = append([]string(nil), header...)
+	}
+
+	columns, err := csvColumns(c.header, keys, opts)
+	if err != nil {
+		return nil, err
+	}
+	c.columns = columns
+	return c, nil
+}
+
+func csvColumns(header, keys []string, opts vector.CSVOptions) ([]int, error) {
+	if opts.ColumnIndices != nil {
+		if len(opts.ColumnIndices) != len(keys) {
+			return nil, fmt.Errorf("expected %d column indices, got %d", len(keys), len(opts.ColumnIndices))
+		}
+		for _, index := range opts.ColumnIndices {
+			if index < 0 {
+				return nil, fmt.Errorf("invalid column index %d", index)
+			}
+		}
+		return opts.ColumnIndices, nil
+	}
+
+	columns := make([]int, len(keys))
+	if opts.Columns != nil {
+		if len(opts.Columns) != len(keys) {
+			return nil, fmt.Errorf("expected %d columns, got %d", len(keys), len(opts.Columns))
+		}
+		if opts.NoHeader {
+			return nil, fmt.Errorf("selecting columns by name requires a header")
+		}
+		for i, name := range opts.Columns {
+			columns[i] = csvHeaderIndex(header, name)
+			if columns[i] == -1 {
+				return nil, fmt.Errorf("column %q not found in header", name)
+			}
+		}
+		return columns, nil
+	}
+
+	for i, key := range keys {
+		columns[i] = csvHeaderIndex(header, key)
+		if columns[i] == -1 {
+			// Header doesn't name the components, use the leading columns
+			for j := range columns {
+				columns[j] = j
+			}
+			break
+		}
+	}
+	return columns, nil
+}
+
+func csvHeaderIndex(header []string, name string) int {
+	for i, h := range header {
+		if strings.EqualFold(strings.TrimSpace(h), name) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Read reads the next record into out, returning io.EOF once no records
+// remain
+func (c *CSVReader[T]) Read(out []T) error {
+	record, err := c.r.Read()
+	if err != nil {
+		return err
+	}
+
+	for i, column := range c.columns {
+		if column >= len(record) {
+			line, _ := c.r.FieldPos(0)
+			return fmt.Errorf("line %d: missing column %d", line, column)
+		}
+		v, err := ParseComponent[T](strings.TrimSpace(record[column]))
+		if err != nil {
+			line, _ := c.r.FieldPos(column)
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+		out[i] = v
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package codec
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/EliCDavis/vector"
+)
+
+// AppendGeoJSONPoint appends the components to dst as a GeoJSON Point
+// geometry, {"type":"Point","coordinates":[x,y]}
+func AppendGeoJSONPoint[T vector.Number](dst []byte, components ...T) ([]byte, error) {
+	dst = append(dst, `{"type":"Point","coordinates":`...)
+	dst, err := AppendJSONArray(dst, components...)
+	if err != nil {
+		return dst, err
+	}
+	return append(dst, '}'), nil
+}
+
+// UnmarshalGeoJSONPoint decodes a GeoJSON Point geometry into out. The
+// position must hold at least len(out) coordinates, any additional ones such
+// as altitude are ignored
+func UnmarshalGeoJSONPoint[T vector.Number](data []byte, out []T) error {
+	var geometry struct {
+		Type        string        `json:"type"`
+		Coordinates []json.Number `json:"coordinates"`
+	}
+	if err := json.Unmarshal(data, &geometry); err != nil {
+		return err
+	}
+
+	if geometry.Type != "Point" {
+		return fmt.Errorf("unable to decode GeoJSON point: unexpected geometry type %q", geometry.Type)
+	}
+
+	if len(geometry.Coordinates) < len(out) {
+		return fmt.Errorf("unable to decode GeoJSON point: expected %d coordinates, got %d", len(out), len(geometry.Coordinates))
+	}
+
+	for i := range out {
+		c, err := ParseJSONNumber[T](geometry.Coordinates[i])
+		if err != nil {
+			return fmt.Errorf("unable to decode GeoJSON point coordinate %d: %w", i, err)
+		}
+		out[i] = c
+	}
+	return nil
+}
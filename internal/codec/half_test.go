@@ -0,0 +1,62 @@
+package codec_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/EliCDavis/vector/internal/codec"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHalfToFloat32(t *testing.T) {
+	tests := map[uint16]float32{
+		0x0000: 0,
+		0x3c00: 1,
+		0x3e00: 1.5,
+		0x7bff: 65504,
+		0x0001: 5.960464477539063e-8,
+		0x03ff: 6.097555160522461e-5,
+		0x0400: 0.00006103515625,
+		0xc400: -4,
+		0x7c00: float32(math.Inf(1)),
+		0xfc00: float32(math.Inf(-1)),
+	}
+	for h, want := range tests {
+		assert.Equal(t, want, codec.HalfToFloat32(h), "0x%04x", h)
+	}
+
+	assert.True(t, math.Signbit(float64(codec.HalfToFloat32(0x8000))))
+	assert.True(t, math.IsNaN(float64(codec.HalfToFloat32(0x7e00))))
+}
+
+func TestFloat32ToHalf(t *testing.T) {
+	tests := map[float32]uint16{
+		0:                    0x0000,
+		1:                    0x3c00,
+		-4:                   0xc400,
+		65504:                0x7bff,
+		65520:                0x7c00, // rounds up to Inf
+		1e10:                 0x7c00,
+		5.960464477539063e-8: 0x0001,
+		1e-10:                0x0000,
+		1 + 1.0/2048:         0x3c00, // halfway, rounds to even
+		1 + 3.0/2048:         0x3c02, // halfway, rounds to even
+	}
+	for f, want := range tests {
+		assert.Equal(t, want, codec.Float32ToHalf(f), "%g", f)
+	}
+
+	assert.Equal(t, uint16(0x7c00), codec.Float32ToHalf(float32(math.Inf(1))))
+	assert.Equal(t, uint16(0x7c00), codec.Float32ToHalf(float32(math.NaN()))&0x7c00)
+	assert.NotZero(t, codec.Float32ToHalf(float32(math.NaN()))&0x3ff)
+}
+
+func TestHalfRoundTrip(t *testing.T) {
+	// Every finite half converts to float32 and back unchanged
+	for h := 0; h < 0x10000; h++ {
+		if h&0x7c00 == 0x7c00 {
+			continue
+		}
+		assert.Equal(t, uint16(h), codec.Float32ToHalf(codec.HalfToFloat32(uint16(h))))
+	}
+}
@@ -0,0 +1,201 @@
+package codec
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/EliCDavis/vector"
+)
+
+// IsJSONArray returns true if data holds a JSON array
+func IsJSONArray(data []byte) bool {
+	trimmed := bytes.TrimLeft(data, " \t\r\n")
+	return len(trimmed) > 0 && trimmed[0] == '['
+}
+
+// AppendJSONComponent appends c to dst as a JSON number. Integer components
+// are written as JSON integers so that no precision is lost, while floating
+// point components are formatted the same way encoding/json formats float64
+// values.
+func AppendJSONComponent[T vector.Number](dst []byte, c T) ([]byte, error) {
+	switch any(c).(type) {
+	case float32, float64:
+		f := float64(c)
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return dst, &json.UnsupportedValueError{
+				Value: reflect.ValueOf(f),
+				Str:   strconv.FormatFloat(f, 'g', -1, 64),
+			}
+		}
+
+		format := byte('f')
+		if abs := math.Abs(f); abs != 0 && (abs < 1e-6 || abs >= 1e21) {
+			format = 'e'
+		}
+		dst = strconv.AppendFloat(dst, f, format, -1, 64)
+		if format == 'e' {
+			// clean up e-09 to e-9
+			n := len(dst)
+			if n >= 4 && dst[n-4] == 'e' && dst[n-3] == '-' && dst[n-2] == '0' {
+				dst[n-2] = dst[n-1]
+				dst = dst[:n-1]
+			}
+		}
+		return dst, nil
+	}
+	return strconv.AppendInt(dst, int64(c), 10), nil
+}
+
+// AppendJSONObject appends the components to dst as a JSON object, using
+// keys as the name of each component
+func AppendJSONObject[T vector.Number](dst []byte, keys []string, components ...T) ([]byte, error) {
+	var err error
+	dst = append(dst, '{')
+	for i, c := range components {
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+		dst = append(dst, '"')
+		dst = append(dst, keys[i]...)
+		dst = append(dst, '"', ':')
+		if dst, err = AppendJSONComponent(dst, c); err != nil {
+			return dst, err
+		}
+	}
+	return append(dst, '}'), nil
+}
+
+// AppendJSONArray appends the components to dst as a JSON array
+func AppendJSONArray[T vector.Number](dst []byte, components ...T) ([]byte, error) {
+	var err error
+	dst = append(dst, '[')
+	for i, c := range components {
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+		if dst, err = AppendJSONComponent(dst, c); err != nil {
+			return dst, err
+		}
+	}
+	return append(dst, ']'), nil
+}
+
+// ParseJSONNumber converts n to T without passing integers through a float64
+// intermediate. Integer components given a fractional or exponent form are
+// truncated, and an empty number decodes as zero.
+func ParseJSONNumber[T vector.Number](n json.Number) (T, error) {
+	if n == "" {
+		return 0, nil
+	}
+
+	c, err := ParseComponent[T](string(n))
+	if err == nil {
+		return c, nil
+	}
+
+	switch any(c).(type) {
+	case float32, float64:
+		return c, err
+	}
+
+	// Fall back to truncating values such as 1.5 or 1e3, as long as they're
+	// representable by T
+	f, ferr := strconv.ParseFloat(string(n), 64)
+	if ferr != nil || float64(T(f)) != math.Trunc(f) {
+		return c, err
+	}
+	return T(f), nil
+}
+
+// UnmarshalJSONObject decodes a JSON object into out, reading each component
+// from the member named by the corresponding entry in keys. Missing members
+// decode as zero.
+func UnmarshalJSONObject[T vector.Number](data []byte, keys []string, out []T) error {
+	var members map[string]json.Number
+	if err := json.Unmarshal(data, &members); err != nil {
+		return err
+	}
+
+	for i, key := range keys {
+		n, ok := members[key]
+		if !ok {
+			n = lookupFold(members, key)
+		}
+
+		c, err := ParseJSONNumber[T](n)
+		if err != nil {
+			return fmt.Errorf("unable to decode vector component %q: %w", key, err)
+		}
+		out[i] = c
+	}
+	return nil
+}
+
+// UnmarshalJSONArray decodes a JSON array of exactly len(out) numbers into out
+func UnmarshalJSONArray[T vector.Number](data []byte, out []T) error {
+	var values []json.Number
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+
+	if len(values) != len(out) {
+		return fmt.Errorf("unable to decode vector: expected %d components, got %d", len(out), len(values))
+	}
+
+	for i, v := range values {
+		c, err := ParseJSONNumber[T](v)
+		if err != nil {
+			return fmt.Errorf("unable to decode vector component %d: %w", i, err)
+		}
+		out[i] = c
+	}
+	return nil
+}
+
+// lookupFold mirrors encoding/json's case-insensitive matching of object keys
+func lookupFold(members map[string]json.Number, key string) json.Number {
+	for k, v := range members {
+		if strings.EqualFold(k, key) {
+			return v
+		}
+	}
+	return ""
+}
+
+// jsonChunkSize is roughly how many bytes WriteJSONArray buffers before
+// handing them to the underlying writer
+const jsonChunkSize = 32 * 1024
+
+// WriteJSONArray streams n elements to w as a JSON array. Each element is
+// appended by appendElem into a buffer that is reused and flushed whenever it
+// grows past a small chunk size, so memory use doesn't grow with n
+func WriteJSONArray(w io.Writer, n int, appendElem func(dst []byte, i int) ([]byte, error)) error {
+	buf := make([]byte, 0, jsonChunkSize+256)
+	buf = append(buf, '[')
+
+	var err error
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			buf = append(buf, ',')
+		}
+		if buf, err = appendElem(buf, i); err != nil {
+			return err
+		}
+		if len(buf) >= jsonChunkSize {
+			if _, err := w.Write(buf); err != nil {
+				return err
+			}
+			buf = buf[:0]
+		}
+	}
+
+	buf = append(buf, ']')
+	_, err = w.Write(buf)
+	return err
+}
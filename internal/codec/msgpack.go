@@ -0,0 +1,185 @@
+package codec
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/EliCDavis/vector"
+)
+
+// MessagePack format markers used by the vector encodings
+const (
+	msgpFixArray  = 0x90
+	msgpArray16   = 0xdc
+	msgpArray32   = 0xdd
+	msgpFloat32   = 0xca
+	msgpFloat64   = 0xcb
+	msgpUint8     = 0xcc
+	msgpUint16    = 0xcd
+	msgpUint32    = 0xce
+	msgpUint64    = 0xcf
+	msgpInt8      = 0xd0
+	msgpInt16     = 0xd1
+	msgpInt32     = 0xd2
+	msgpInt64     = 0xd3
+	msgpNegFixInt = 0xe0
+)
+
+// AppendMsgpack appends the components to dst as a MessagePack array.
+// Floating point components keep their precision, while integer components
+// use the smallest integer representation that holds their value.
+func AppendMsgpack[T vector.Number](dst []byte, components ...T) []byte {
+	dst = append(dst, msgpFixArray|byte(len(components)))
+	for _, c := range components {
+		switch cv := any(c).(type) {
+		case float32:
+			dst = append(dst, msgpFloat32)
+			dst = binary.BigEndian.AppendUint32(dst, math.Float32bits(cv))
+		case float64:
+			dst = append(dst, msgpFloat64)
+			dst = binary.BigEndian.AppendUint64(dst, math.Float64bits(cv))
+		default:
+			dst = appendMsgpackInt(dst, int64(c))
+		}
+	}
+	return dst
+}
+
+func appendMsgpackInt(dst []byte, i int64) []byte {
+	switch {
+	case i >= 0 && i <= math.MaxInt8:
+		return append(dst, byte(i))
+	case i < 0 && i >= -32:
+		return append(dst, byte(i))
+	case i >= math.MinInt8 && i <= math.MaxInt8:
+		return append(dst, msgpInt8, byte(i))
+	case i >= math.MinInt16 && i <= math.MaxInt16:
+		return binary.BigEndian.AppendUint16(append(dst, msgpInt16), uint16(i))
+	case i >= math.MinInt32 && i <= math.MaxInt32:
+		return binary.BigEndian.AppendUint32(append(dst, msgpInt32), uint32(i))
+	}
+	return binary.BigEndian.AppendUint64(append(dst, msgpInt64), uint64(i))
+}
+
+// MsgpackSize returns an upper bound on the number of bytes AppendMsgpack
+// requires to encode count components of type T
+func MsgpackSize[T vector.Number](count int) int {
+	return 1 + count*(1+vector.ComponentTypeOf[T]().Size())
+}
+
+// ReadMsgpack decodes a MessagePack array of exactly len(out) numbers from
+// data into out, returning the remaining bytes
+func ReadMsgpack[T vector.Number](data []byte, out []T) ([]byte, error) {
+	if len(data) == 0 {
+		return data, fmt.Errorf("unable to decode msgpack vector: no data")
+	}
+
+	var count int
+	switch b := data[0]; {
+	case b&0xf0 == msgpFixArray:
+		count = int(b & 0x0f)
+		data = data[1:]
+	case b == msgpArray16 && len(data) >= 3:
+		count = int(binary.BigEndian.Uint16(data[1:]))
+		data = data[3:]
+	case b == msgpArray32 && len(data) >= 5:
+		count = int(binary.BigEndian.Uint32(data[1:]))
+		data = data[5:]
+	default:
+		return data, fmt.Errorf("unable to decode msgpack vector: expected array, got 0x%02x", b)
+	}
+
+	if count != len(out) {
+		return data, fmt.Errorf("unable to decode msgpack vector: expected %d components, got %d", len(out), count)
+	}
+
+	for i := range out {
+		var err error
+		out[i], data, err = readMsgpackComponent[T](data)
+		if err != nil {
+			return data, fmt.Errorf("unable to decode msgpack vector component %d: %w", i, err)
+		}
+	}
+	return data, nil
+}
+
+func readMsgpackComponent[T vector.Number](data []byte) (T, []byte, error) {
+	if len(data) == 0 {
+		return 0, data, fmt.Errorf("unexpected end of data")
+	}
+
+	marker := data[0]
+	size := 0
+	switch marker {
+	case msgpUint8, msgpInt8:
+		size = 1
+	case msgpUint16, msgpInt16:
+		size = 2
+	case msgpUint32, msgpInt32, msgpFloat32:
+		size = 4
+	case msgpUint64, msgpInt64, msgpFloat64:
+		size = 8
+	}
+
+	if len(data) < 1+size {
+		return 0, data, fmt.Errorf("unexpected end of data")
+	}
+	b := data[1:]
+	rest := data[1+size:]
+
+	var (
+		i       int64
+		f       float64
+		isFloat bool
+	)
+	switch {
+	case marker <= math.MaxInt8:
+		i = int64(marker)
+	case marker >= msgpNegFixInt:
+		i = int64(int8(marker))
+	case marker == msgpUint8:
+		i = int64(b[0])
+	case marker == msgpUint16:
+		i = int64(binary.BigEndian.Uint16(b))
+	case marker == msgpUint32:
+		i = int64(binary.BigEndian.Uint32(b))
+	case marker == msgpUint64:
+		u := binary.BigEndian.Uint64(b)
+		if u > math.MaxInt64 {
+			return 0, rest, fmt.Errorf("value %d overflows %s", u, vector.ComponentTypeOf[T]())
+		}
+		i = int64(u)
+	case marker == msgpInt8:
+		i = int64(int8(b[0]))
+	case marker == msgpInt16:
+		i = int64(int16(binary.BigEndian.Uint16(b)))
+	case marker == msgpInt32:
+		i = int64(int32(binary.BigEndian.Uint32(b)))
+	case marker == msgpInt64:
+		i = int64(binary.BigEndian.Uint64(b))
+	case marker == msgpFloat32:
+		f, isFloat = float64(math.Float32frombits(binary.BigEndian.Uint32(b))), true
+	case marker == msgpFloat64:
+		f, isFloat = math.Float64frombits(binary.BigEndian.Uint64(b)), true
+	default:
+		return 0, rest, fmt.Errorf("expected number, got 0x%02x", marker)
+	}
+
+	var out T
+	switch any(out).(type) {
+	case float32, float64:
+		if isFloat {
+			return T(f), rest, nil
+		}
+		return T(i), rest, nil
+	}
+
+	if isFloat {
+		return 0, rest, fmt.Errorf("can not decode float into %s", vector.ComponentTypeOf[T]())
+	}
+	if int64(T(i)) != i {
+		return 0, rest, fmt.Errorf("value %d overflows %s", i, vector.ComponentTypeOf[T]())
+	}
+	return T(i), rest, nil
+}
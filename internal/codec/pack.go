@@ -0,0 +1,35 @@
+package codec
+
+import "math"
+
+// PackUnorm quantizes f, clamped to [0, 1], into an unsigned normalized
+// integer of the given number of bits
+func PackUnorm(f float64, bits uint) uint32 {
+	scale := float64(uint32(1)<<bits - 1)
+	return uint32(math.Round(math.Max(0, math.Min(1, f)) * scale))
+}
+
+// UnpackUnorm converts the low bits of p from an unsigned normalized integer
+// back into [0, 1]
+func UnpackUnorm(p uint32, bits uint) float64 {
+	mask := uint32(1)<<bits - 1
+	return float64(p&mask) / float64(mask)
+}
+
+// PackSnorm quantizes f, clamped to [-1, 1], into a two's complement signed
+// normalized integer held in the low bits of the result
+func PackSnorm(f float64, bits uint) uint32 {
+	scale := float64(uint32(1)<<(bits-1) - 1)
+	i := int32(math.Round(math.Max(-1, math.Min(1, f)) * scale))
+	return uint32(i) & (uint32(1)<<bits - 1)
+}
+
+// UnpackSnorm converts the low bits of p from a two's complement signed
+// normalized integer back into [-1, 1]
+func UnpackSnorm(p uint32, bits uint) float64 {
+	// sign extend
+	shift := 32 - bits
+	i := int32(p<<shift) >> shift
+	scale := float64(uint32(1)<<(bits-1) - 1)
+	return math.Max(-1, float64(i)/scale)
+}
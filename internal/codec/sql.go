@@ -0,0 +1,38 @@
+package codec
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/EliCDavis/vector"
+)
+
+// ScanSQL decodes a database value into out. Strings and byte slices holding
+// the "x,y,z" text form are accepted, optionally wrapped in parentheses,
+// braces, or brackets as produced by PostgreSQL's point and array types. Byte
+// slices produced by MarshalBinary are also accepted.
+func ScanSQL[T vector.Number](src any, out []T) error {
+	var text string
+	switch s := src.(type) {
+	case string:
+		text = s
+	case []byte:
+		if len(s) > 0 && s[0] > byte(vector.UnknownComponent) && s[0] <= byte(vector.Float64Component) {
+			return DecodeTaggedBinary(s, out)
+		}
+		text = string(s)
+	case nil:
+		return fmt.Errorf("unable to scan NULL into vector")
+	default:
+		return fmt.Errorf("unable to scan %T into vector", src)
+	}
+
+	text = strings.TrimSpace(text)
+	if len(text) >= 2 {
+		switch text[0] {
+		case '(', '{', '[':
+			text = text[1 : len(text)-1]
+		}
+	}
+	return ParseText([]byte(text), out)
+}
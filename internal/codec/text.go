@@ -0,0 +1,85 @@
+package codec
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/EliCDavis/vector"
+)
+
+// AppendText appends the components to dst as comma separated values, using
+// the shortest representation that round-trips each component exactly
+func AppendText[T vector.Number](dst []byte, components ...T) []byte {
+	for i, c := range components {
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+		dst = AppendComponent(dst, c)
+	}
+	return dst
+}
+
+// AppendComponent appends the shortest textual representation of c that
+// round-trips exactly
+func AppendComponent[T vector.Number](dst []byte, c T) []byte {
+	switch cv := any(c).(type) {
+	case float32:
+		return strconv.AppendFloat(dst, float64(cv), 'g', -1, 32)
+	case float64:
+		return strconv.AppendFloat(dst, cv, 'g', -1, 64)
+	}
+	return strconv.AppendInt(dst, int64(c), 10)
+}
+
+// ParseComponent parses s as a single component of type T
+func ParseComponent[T vector.Number](s string) (T, error) {
+	var out T
+	switch p := any(&out).(type) {
+	case *float32:
+		f, err := strconv.ParseFloat(s, 32)
+		*p = float32(f)
+		return out, err
+	case *float64:
+		f, err := strconv.ParseFloat(s, 64)
+		*p = f
+		return out, err
+	}
+
+	bitSize := vector.ComponentTypeOf[T]().Size() * 8
+	if _, ok := any(out).(int); ok {
+		bitSize = strconv.IntSize
+	}
+
+	i, err := strconv.ParseInt(s, 10, bitSize)
+	return T(i), err
+}
+
+// ParseText parses comma separated components from text into out. Whitespace
+// surrounding each component is ignored, and the number of components must
+// match len(out) exactly
+func ParseText[T vector.Number](text []byte, out []T) error {
+	s := string(text)
+	for i := range out {
+		end := strings.IndexByte(s, ',')
+		if i == len(out)-1 {
+			if end != -1 {
+				return fmt.Errorf("unable to parse vector %q: expected %d components", text, len(out))
+			}
+			end = len(s)
+		} else if end == -1 {
+			return fmt.Errorf("unable to parse vector %q: expected %d components", text, len(out))
+		}
+
+		c, err := ParseComponent[T](strings.TrimSpace(s[:end]))
+		if err != nil {
+			return fmt.Errorf("unable to parse vector %q: %w", text, err)
+		}
+		out[i] = c
+
+		if end < len(s) {
+			s = s[end+1:]
+		}
+	}
+	return nil
+}
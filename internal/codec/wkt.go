@@ -0,0 +1,194 @@
+package codec
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/EliCDavis/vector"
+)
+
+// AppendWKTPoint appends the components to dst as a WKT point, such as
+// "POINT(1 2)" or "POINT(1 2 3)"
+func AppendWKTPoint[T vector.Number](dst []byte, components ...T) []byte {
+	dst = append(dst, "POINT("...)
+	for i, c := range components {
+		if i > 0 {
+			dst = append(dst, ' ')
+		}
+		dst = AppendComponent(dst, c)
+	}
+	return append(dst, ')')
+}
+
+// ParseWKTPoint parses a WKT or EWKT point such as "POINT(1 2)",
+// "POINT Z (1 2 3)", or "SRID=4326;POINT(1 2)" into out. An out of length 3
+// requires a Z coordinate, and any M (measure) coordinate is skipped rather
+// than read as a spatial one.
+func ParseWKTPoint[T vector.Number](text string, out []T) error {
+	s := strings.TrimSpace(text)
+	if strings.HasPrefix(strings.ToUpper(s), "SRID=") {
+		semi := strings.IndexByte(s, ';')
+		if semi == -1 {
+			return fmt.Errorf("unable to parse WKT point %q: missing ';' after SRID", text)
+		}
+		s = strings.TrimSpace(s[semi+1:])
+	}
+
+	if !strings.HasPrefix(strings.ToUpper(s), "POINT") {
+		return fmt.Errorf("unable to parse WKT point %q: not a point", text)
+	}
+	s = s[len("POINT"):]
+
+	open := strings.IndexByte(s, '(')
+	if open == -1 || !strings.HasSuffix(s, ")") {
+		return fmt.Errorf("unable to parse WKT point %q: missing parentheses", text)
+	}
+
+	// Untagged points carry no measure, so their ordinates are read as they
+	// come
+	hasZ, hasM := len(out) == 3, false
+	switch dim := strings.ToUpper(strings.TrimSpace(s[:open])); dim {
+	case "":
+	case "Z":
+		hasZ = true
+	case "M":
+		hasZ, hasM = false, true
+	case "ZM":
+		hasZ, hasM = true, true
+	default:
+		return fmt.Errorf("unable to parse WKT point %q: unknown dimension %q", text, dim)
+	}
+
+	expected := 2
+	if hasZ {
+		expected++
+	}
+	if hasM {
+		expected++
+	}
+
+	fields := strings.Fields(s[open+1 : len(s)-1])
+	if len(fields) != expected {
+		return fmt.Errorf("unable to parse WKT point %q: expected %d coordinates, got %d", text, expected, len(fields))
+	}
+
+	switch {
+	case len(out) == 3 && !hasZ:
+		return fmt.Errorf("unable to parse WKT point %q: point has no Z coordinate", text)
+	case len(out) == 2 && hasZ:
+		return fmt.Errorf("unable to parse WKT point %q: unexpected Z coordinate", text)
+	}
+
+	// M always trails the spatial ordinates, so it falls outside out
+	for i, field := range fields[:len(out)] {
+		c, err := ParseComponent[T](field)
+		if err != nil {
+			return fmt.Errorf("unable to parse WKT point %q: %w", text, err)
+		}
+		out[i] = c
+	}
+	return nil
+}
+
+// DecodeWKBPoint decodes a WKB or PostGIS EWKB point into out. An out of
+// length 3 requires a Z coordinate, any other coordinates such as M are
+// ignored.
+func DecodeWKBPoint[T vector.Number](data []byte, out []T) error {
+	if len(data) < 5 {
+		return fmt.Errorf("unable to decode WKB point: not enough data")
+	}
+
+	var order binary.ByteOrder = binary.BigEndian
+	if data[0] == 1 {
+		order = binary.LittleEndian
+	}
+
+	geomType := order.Uint32(data[1:])
+	data = data[5:]
+
+	var hasZ, hasM bool
+	const (
+		ewkbZ    = 0x80000000
+		ewkbM    = 0x40000000
+		ewkbSRID = 0x20000000
+	)
+	if geomType&ewkbZ != 0 {
+		hasZ = true
+	}
+	if geomType&ewkbM != 0 {
+		hasM = true
+	}
+	if geomType&ewkbSRID != 0 {
+		if len(data) < 4 {
+			return fmt.Errorf("unable to decode WKB point: not enough data")
+		}
+		data = data[4:]
+	}
+
+	// ISO WKB encodes dimensionality in the thousands
+	base := geomType &^ (ewkbZ | ewkbM | ewkbSRID)
+	switch base / 1000 {
+	case 1:
+		hasZ = true
+	case 2:
+		hasM = true
+	case 3:
+		hasZ, hasM = true, true
+	}
+
+	if base%1000 != 1 {
+		return fmt.Errorf("unable to decode WKB point: geometry type %d is not a point", base)
+	}
+
+	if len(out) > 2 && !hasZ {
+		return fmt.Errorf("unable to decode WKB point: point has no Z coordinate")
+	}
+
+	dims := 2
+	if hasZ {
+		dims++
+	}
+	if hasM {
+		dims++
+	}
+
+	if len(data) < dims*8 {
+		return fmt.Errorf("unable to decode WKB point: not enough data")
+	}
+
+	// Ordinates are stored as X, Y, then Z and M when present, so the first
+	// len(out) never include M
+	for i := range out {
+		out[i] = T(math.Float64frombits(order.Uint64(data[i*8:])))
+	}
+	return nil
+}
+
+// ScanPostGIS decodes a PostGIS geometry point from a database value, which
+// may be hex encoded EWKB (the default output for geometry columns), raw WKB,
+// or WKT/EWKT text
+func ScanPostGIS[T vector.Number](src any, out []T) error {
+	var text string
+	switch s := src.(type) {
+	case []byte:
+		if len(s) > 0 && (s[0] == 0 || s[0] == 1) {
+			return DecodeWKBPoint(s, out)
+		}
+		text = string(s)
+	case string:
+		text = s
+	case nil:
+		return fmt.Errorf("unable to scan NULL into vector")
+	default:
+		return fmt.Errorf("unable to scan %T into vector", src)
+	}
+
+	text = strings.TrimSpace(text)
+	if raw, err := hex.DecodeString(text); err == nil {
+		return DecodeWKBPoint(raw, out)
+	}
+	return ParseWKTPoint(text, out)
+}
@@ -0,0 +1,45 @@
+package codec_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/EliCDavis/vector/internal/codec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeWKBPointMeasure(t *testing.T) {
+	tests := map[string]struct {
+		hex  string
+		want []float64
+		err  bool
+	}{
+		// SELECT ST_AsBinary('POINT Z (1 2 3)'::geometry)
+		"iso z": {hex: "01E9030000000000000000F03F00000000000000400000000000000840", want: []float64{1, 2, 3}},
+		// SELECT ST_AsBinary('POINT M (1 2 99)'::geometry)
+		"iso m": {hex: "01D1070000000000000000F03F00000000000000400000000000C05840", err: true},
+		// SELECT ST_AsBinary('POINT ZM (1 2 3 99)'::geometry)
+		"iso zm": {hex: "01B90B0000000000000000F03F000000000000004000000000000008400000000000C05840", want: []float64{1, 2, 3}},
+		// SELECT 'POINT M (1 2 99)'::geometry
+		"ewkb m": {hex: "0101000040000000000000F03F00000000000000400000000000C05840", err: true},
+		// SELECT 'POINT ZM (1 2 3 99)'::geometry
+		"ewkb zm": {hex: "01010000C0000000000000F03F000000000000004000000000000008400000000000C05840", want: []float64{1, 2, 3}},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			data, err := hex.DecodeString(tc.hex)
+			require.NoError(t, err)
+
+			out := make([]float64, 3)
+			err = codec.DecodeWKBPoint(data, out)
+			if tc.err {
+				assert.EqualError(t, err, "unable to decode WKB point: point has no Z coordinate")
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, out)
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package codec
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/EliCDavis/vector"
+)
+
+// DecodeYAML decodes a YAML mapping of named components, a sequence of
+// exactly len(out) components, or a scalar in the "x,y,z" text form into out.
+// It works through the unmarshal callback YAML packages pass to
+// UnmarshalYAML, so the vector packages don't need to import one
+func DecodeYAML[T vector.Number](unmarshal func(interface{}) error, keys []string, out []T) error {
+	var shape interface{}
+	if err := unmarshal(&shape); err != nil {
+		return err
+	}
+
+	switch shape.(type) {
+	case map[string]interface{}, map[interface{}]interface{}:
+		var mapping map[string]T
+		if err := unmarshal(&mapping); err != nil {
+			return fmt.Errorf("unable to decode vector components: %w", err)
+		}
+		for key, value := range mapping {
+			for c, k := range keys {
+				if strings.EqualFold(k, key) {
+					out[c] = value
+				}
+			}
+		}
+		return nil
+
+	case []interface{}:
+		var sequence []T
+		if err := unmarshal(&sequence); err != nil {
+			return fmt.Errorf("unable to decode vector components: %w", err)
+		}
+		if len(sequence) != len(out) {
+			return fmt.Errorf("unable to decode vector: expected %d components, got %d", len(out), len(sequence))
+		}
+		copy(out, sequence)
+		return nil
+	}
+
+	var text string
+	if err := unmarshal(&text); err != nil {
+		return fmt.Errorf("unable to decode vector: %w", err)
+	}
+	return ParseText([]byte(text), out)
+}
@@ -0,0 +1,48 @@
+// Package colorspace decodes color.Color values into floating point
+// channels for the vector packages' color constructors.
+package colorspace
+
+import (
+	"image/color"
+
+	"github.com/EliCDavis/vector/mathex"
+)
+
+// Straight returns the sRGB encoded channels of c in the range [0, 1], with
+// alpha divided back out of the color channels. 8-bit colors are read
+// directly rather than through the 16-bit premultiplied RGBA method. Fully
+// transparent colors carry no color information and result in zeros
+func Straight(c color.Color) (r, g, b, a float64) {
+	switch c := c.(type) {
+	case color.NRGBA:
+		return float64(c.R) / 0xff, float64(c.G) / 0xff, float64(c.B) / 0xff, float64(c.A) / 0xff
+	case color.RGBA:
+		if c.A == 0xff {
+			return float64(c.R) / 0xff, float64(c.G) / 0xff, float64(c.B) / 0xff, 1
+		}
+	}
+
+	r16, g16, b16, a16 := c.RGBA()
+	if a16 == 0 {
+		return 0, 0, 0, 0
+	}
+	alpha := float64(a16)
+	return float64(r16) / alpha, float64(g16) / alpha, float64(b16) / alpha, alpha / 0xffff
+}
+
+// Linear returns the channels of c like Straight, with the color channels
+// decoded from sRGB into linear light. Alpha is already linear and is left
+// as is
+func Linear(c color.Color) (r, g, b, a float64) {
+	switch c := c.(type) {
+	case color.NRGBA:
+		return mathex.SRGB8ToLinear(c.R), mathex.SRGB8ToLinear(c.G), mathex.SRGB8ToLinear(c.B), float64(c.A) / 0xff
+	case color.RGBA:
+		if c.A == 0xff {
+			return mathex.SRGB8ToLinear(c.R), mathex.SRGB8ToLinear(c.G), mathex.SRGB8ToLinear(c.B), 1
+		}
+	}
+
+	r, g, b, a = Straight(c)
+	return mathex.SRGBToLinear(r), mathex.SRGBToLinear(g), mathex.SRGBToLinear(b), a
+}
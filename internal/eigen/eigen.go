@@ -0,0 +1,116 @@
+// Package eigen solves the small symmetric eigenproblems behind fitting and
+// alignment, such as finding the normal of a best fitting plane from a
+// covariance matrix or the rotation quaternion of Horn's method.
+package eigen
+
+import "math"
+
+// maxSweeps bounds the Jacobi iteration. Convergence is quadratic, so small
+// matrices settle within a handful of sweeps
+const maxSweeps = 50
+
+// tolerance is how small the sum of the squared off diagonal entries must be,
+// relative to the sum of every squared entry, before the matrix is considered
+// diagonal. Being relative keeps the result independent of the scale of the
+// input, which an absolute threshold would stop iterating on for matrices of
+// tiny values
+const tolerance = 1e-30
+
+// Major returns the unit eigenvector of the symmetric matrix with the largest
+// eigenvalue
+func Major(a [][]float64) []float64 {
+	values, vectors := jacobi(a)
+	best := 0
+	for i := range values {
+		if values[i] > values[best] {
+			best = i
+		}
+	}
+	return column(vectors, best)
+}
+
+// Minor returns the unit eigenvector of the symmetric matrix with the
+// smallest eigenvalue
+func Minor(a [][]float64) []float64 {
+	values, vectors := jacobi(a)
+	best := 0
+	for i := range values {
+		if values[i] < values[best] {
+			best = i
+		}
+	}
+	return column(vectors, best)
+}
+
+func column(m [][]float64, c int) []float64 {
+	out := make([]float64, len(m))
+	for r := range m {
+		out[r] = m[r][c]
+	}
+	return out
+}
+
+// jacobi diagonalizes a copy of the symmetric matrix with cyclic Jacobi
+// rotations, returning its eigenvalues and a matrix whose columns are the
+// matching unit eigenvectors
+func jacobi(matrix [][]float64) ([]float64, [][]float64) {
+	n := len(matrix)
+	a := make([][]float64, n)
+	v := make([][]float64, n)
+	var norm float64
+	for i := range matrix {
+		a[i] = append([]float64(nil), matrix[i]...)
+		v[i] = make([]float64, n)
+		v[i][i] = 1
+		for _, x := range matrix[i] {
+			norm += x * x
+		}
+	}
+
+	for sweep := 0; sweep < maxSweeps; sweep++ {
+		var off float64
+		for p := 0; p < n; p++ {
+			for q := p + 1; q < n; q++ {
+				off += a[p][q] * a[p][q]
+			}
+		}
+		if off <= tolerance*norm {
+			break
+		}
+
+		for p := 0; p < n; p++ {
+			for q := p + 1; q < n; q++ {
+				if a[p][q] == 0 {
+					continue
+				}
+
+				theta := (a[q][q] - a[p][p]) / (2 * a[p][q])
+				t := 1 / (math.Abs(theta) + math.Sqrt(theta*theta+1))
+				if theta < 0 {
+					t = -t
+				}
+				c := 1 / math.Sqrt(t*t+1)
+				s := t * c
+
+				for k := 0; k < n; k++ {
+					akp, akq := a[k][p], a[k][q]
+					a[k][p], a[k][q] = c*akp-s*akq, s*akp+c*akq
+				}
+				for k := 0; k < n; k++ {
+					apk, aqk := a[p][k], a[q][k]
+					a[p][k], a[q][k] = c*apk-s*aqk, s*apk+c*aqk
+				}
+				for k := 0; k < n; k++ {
+					vkp, vkq := v[k][p], v[k][q]
+					v[k][p], v[k][q] = c*vkp-s*vkq, s*vkp+c*vkq
+				}
+			}
+		}
+	}
+
+	values := make([]float64, n)
+	for i := range values {
+		values[i] = a[i][i]
+	}
+	return values, v
+}
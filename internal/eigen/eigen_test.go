@@ -0,0 +1,47 @@
+package eigen_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/EliCDavis/vector/internal/eigen"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMajorMinor(t *testing.T) {
+	// Eigenvalues 4, 2 and 1 along (1, 1, 0), (1, -1, 0) and (0, 0, 1)
+	a := [][]float64{
+		{3, 1, 0},
+		{1, 3, 0},
+		{0, 0, 1},
+	}
+
+	major := eigen.Major(a)
+	assert.InDelta(t, 1, math.Abs(major[0]+major[1])/math.Sqrt2, 1e-12)
+	assert.InDelta(t, 0, major[2], 1e-12)
+
+	minor := eigen.Minor(a)
+	assert.InDelta(t, 0, minor[0], 1e-12)
+	assert.InDelta(t, 0, minor[1], 1e-12)
+	assert.InDelta(t, 1, math.Abs(minor[2]), 1e-12)
+
+	// The input is left untouched
+	assert.Equal(t, [][]float64{{3, 1, 0}, {1, 3, 0}, {0, 0, 1}}, a)
+}
+
+func TestScaleInvariant(t *testing.T) {
+	for _, scale := range []float64{1e-150, 1e-20, 1, 1e20, 1e150} {
+		a := [][]float64{
+			{2 * scale, scale, 0, 0},
+			{scale, 2 * scale, 0, 0},
+			{0, 0, 0.5 * scale, 0.1 * scale},
+			{0, 0, 0.1 * scale, 0.5 * scale},
+		}
+		major := eigen.Major(a)
+		assert.InDelta(t, 1, math.Abs(major[0]+major[1])/math.Sqrt2, 1e-12, "scale %g", scale)
+	}
+}
+
+func TestZero(t *testing.T) {
+	assert.Equal(t, []float64{1, 0}, eigen.Major([][]float64{{0, 0}, {0, 0}}))
+}
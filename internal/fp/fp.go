@@ -0,0 +1,14 @@
+// Package fp holds the multiply-add kernels behind the core vector
+// operations, such as dot and cross products, lengths, and interpolation.
+//
+// The Go spec allows the compiler to fuse a multiply and an add into a single
+// FMA instruction, skipping the rounding of the product. amd64 builds never
+// do, while arm64, ppc64, and s390x builds do, so the same floating point
+// expression can produce different bits on different machines. Building with
+// the vector_deterministic tag rounds every product explicitly before it's
+// summed, which the spec guarantees prevents fusion, making these kernels
+// bit-identical everywhere at a small cost on architectures with FMA.
+//
+// Integer components are unaffected by the tag, as integer arithmetic is
+// already exact.
+package fp
@@ -0,0 +1,35 @@
+package fp_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/EliCDavis/vector/internal/fp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKernels(t *testing.T) {
+	assert.Equal(t, 6., fp.Mul(2., 3.))
+	assert.Equal(t, 7., fp.MulAdd(2., 3., 1.))
+	assert.Equal(t, -2., fp.DiffOfProducts(2., 3., 4., 2.))
+	assert.Equal(t, 11., fp.Dot2(1., 2., 3., 4.))
+	assert.Equal(t, 32., fp.Dot3(1., 2., 3., 4., 5., 6.))
+	assert.Equal(t, 70., fp.Dot4(1., 2., 3., 4., 5., 6., 7., 8.))
+
+	assert.Equal(t, 7, fp.MulAdd(2, 3, 1))
+	assert.Equal(t, int8(-2), fp.DiffOfProducts[int8](2, 3, 4, 2))
+	assert.Equal(t, int64(70), fp.Dot4[int64](1, 2, 3, 4, 5, 6, 7, 8))
+}
+
+func TestDeterministicRounding(t *testing.T) {
+	if !fp.Deterministic {
+		t.Skip("requires the vector_deterministic build tag")
+	}
+
+	// (1 + 2^-30)^2 - (1 + 2^-29) is 2^-60 exactly, but the product rounds to
+	// 1 + 2^-29 first when it isn't fused, giving 0
+	a := 1 + math.Ldexp(1, -30)
+	c := -(1 + math.Ldexp(1, -29))
+	assert.Equal(t, 0., fp.MulAdd(a, a, c))
+	assert.Equal(t, 0., fp.DiffOfProducts(a, a, 1+math.Ldexp(1, -29), 1))
+}
@@ -0,0 +1,39 @@
+//go:build !vector_deterministic
+
+package fp
+
+import "github.com/EliCDavis/vector"
+
+// Deterministic reports whether the kernels round every product, preventing
+// the compiler from fusing them into FMA instructions
+const Deterministic = false
+
+// Mul returns a*b
+func Mul[T vector.Number](a, b T) T {
+	return a * b
+}
+
+// MulAdd returns a*b + c
+func MulAdd[T vector.Number](a, b, c T) T {
+	return a*b + c
+}
+
+// DiffOfProducts returns a*b - c*d
+func DiffOfProducts[T vector.Number](a, b, c, d T) T {
+	return a*b - c*d
+}
+
+// Dot2 returns ax*bx + ay*by
+func Dot2[T vector.Number](ax, ay, bx, by T) T {
+	return ax*bx + ay*by
+}
+
+// Dot3 returns ax*bx + ay*by + az*bz
+func Dot3[T vector.Number](ax, ay, az, bx, by, bz T) T {
+	return ax*bx + ay*by + az*bz
+}
+
+// Dot4 returns ax*bx + ay*by + az*bz + aw*bw
+func Dot4[T vector.Number](ax, ay, az, aw, bx, by, bz, bw T) T {
+	return ax*bx + ay*by + az*bz + aw*bw
+}
@@ -0,0 +1,6 @@
+// Package example holds types generated by cmd/vecgen, checking that its
+// output compiles and behaves
+package example
+
+//go:generate go run ../../../cmd/vecgen -type RGBA -components r,g,b,a
+//go:generate go run ../../../cmd/vecgen -type MinMax -components min,max -swizzle=false
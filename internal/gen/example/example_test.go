@@ -0,0 +1,32 @@
+package example_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector/internal/gen/example"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRGBA(t *testing.T) {
+	c := example.NewRGBA(0.1, 0.2, 0.3, 1.)
+	assert.Equal(t, 0.2, c.G())
+	assert.Equal(t, example.NewRGBA(0.3, 0.2, 0.1, 1.), c.BGRA())
+	assert.Equal(t, example.NewRGBA(0.1, 0.2, 0.5, 1.), c.SetB(0.5))
+	assert.Equal(t, example.NewRGBA(0.1, 0.2, 0.3, 0.), c.Da(-1))
+	assert.Equal(t, example.NewRGBA(0.2, 0.4, 0.6, 2.), c.Add(c))
+	assert.Equal(t, example.NewRGBA(0, 0, 0, 255), example.NewRGBA(0., 0., 0., 255.9).ToInt())
+	assert.InDelta(t, 0.5, example.LerpRGBA(c, c.Scale(2), 0.5).R()/c.R()-1, 1e-12)
+
+	r, g, b, a := c.Values()
+	assert.Equal(t, []float64{0.1, 0.2, 0.3, 1.}, []float64{r, g, b, a})
+}
+
+func TestMinMax(t *testing.T) {
+	m := example.NewMinMax(-3, 4)
+	assert.Equal(t, -3, m.Min())
+	assert.Equal(t, example.NewMinMax(3, 4), m.Abs())
+	assert.Equal(t, example.NewMinMax(3, 4), m.FlipMin())
+	assert.Equal(t, 25, m.LengthSquared())
+	assert.Equal(t, 5., m.Length())
+	assert.Equal(t, example.NewMinMax(-1, 1), m.Clamp(-1, 1))
+}
@@ -0,0 +1,150 @@
+// Code generated by vecgen; DO NOT EDIT.
+
+package example
+
+import (
+	"math"
+
+	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/mathex"
+)
+
+// MinMax is made up of the min and max components
+type MinMax[T vector.Number] struct {
+	min T
+	max T
+}
+
+// NewMinMax creates a MinMax from its components
+func NewMinMax[T vector.Number](min, max T) MinMax[T] {
+	return MinMax[T]{min: min, max: max}
+}
+
+// LerpMinMax linearly interpolates between a and b by t
+func LerpMinMax[T vector.Number](a, b MinMax[T], t float64) MinMax[T] {
+	return MinMax[T]{
+		min: T(float64(a.min) + (float64(b.min)-float64(a.min))*t),
+		max: T(float64(a.max) + (float64(b.max)-float64(a.max))*t),
+	}
+}
+
+// Add returns the component-wise sum of v and o
+func (v MinMax[T]) Add(o MinMax[T]) MinMax[T] {
+	return MinMax[T]{min: v.min + o.min, max: v.max + o.max}
+}
+
+// Sub returns the component-wise difference of v and o
+func (v MinMax[T]) Sub(o MinMax[T]) MinMax[T] {
+	return MinMax[T]{min: v.min - o.min, max: v.max - o.max}
+}
+
+// Scale multiplies every component by t
+func (v MinMax[T]) Scale(t float64) MinMax[T] {
+	return MinMax[T]{min: T(float64(v.min) * t), max: T(float64(v.max) * t)}
+}
+
+// MultByVector returns the component-wise product of v and o
+func (v MinMax[T]) MultByVector(o MinMax[T]) MinMax[T] {
+	return MinMax[T]{min: v.min * o.min, max: v.max * o.max}
+}
+
+// Dot returns the dot product of v and o
+func (v MinMax[T]) Dot(o MinMax[T]) T {
+	return v.min*o.min + v.max*o.max
+}
+
+// LengthSquared returns the squared length of the vector
+func (v MinMax[T]) LengthSquared() T {
+	return v.Dot(v)
+}
+
+// Length returns the length of the vector
+func (v MinMax[T]) Length() float64 {
+	return math.Sqrt(float64(v.LengthSquared()))
+}
+
+// Normalized scales the vector to a length of 1. Zero length vectors are
+// returned unchanged
+func (v MinMax[T]) Normalized() MinMax[T] {
+	l := v.Length()
+	if l == 0 {
+		return v
+	}
+	return v.Scale(1 / l)
+}
+
+// Abs returns the vector with every component made non-negative
+func (v MinMax[T]) Abs() MinMax[T] {
+	return MinMax[T]{min: mathex.Abs(v.min), max: mathex.Abs(v.max)}
+}
+
+// Clamp limits every component to within [vmin, vmax]
+func (v MinMax[T]) Clamp(vmin, vmax T) MinMax[T] {
+	return MinMax[T]{min: mathex.Clamp(v.min, vmin, vmax), max: mathex.Clamp(v.max, vmin, vmax)}
+}
+
+// Values returns every component of the vector
+func (v MinMax[T]) Values() (T, T) {
+	return v.min, v.max
+}
+
+// ToFloat32 converts every component to float32
+func (v MinMax[T]) ToFloat32() MinMax[float32] {
+	return MinMax[float32]{min: float32(v.min), max: float32(v.max)}
+}
+
+// ToFloat64 converts every component to float64
+func (v MinMax[T]) ToFloat64() MinMax[float64] {
+	return MinMax[float64]{min: float64(v.min), max: float64(v.max)}
+}
+
+// ToInt converts every component to int
+func (v MinMax[T]) ToInt() MinMax[int] {
+	return MinMax[int]{min: int(v.min), max: int(v.max)}
+}
+
+// Min returns the min component
+func (v MinMax[T]) Min() T {
+	return v.min
+}
+
+// SetMin returns a copy of the vector with the min component replaced
+func (v MinMax[T]) SetMin(newMin T) MinMax[T] {
+	v.min = newMin
+	return v
+}
+
+// Dmin returns a copy of the vector with dMin added to the min component
+func (v MinMax[T]) Dmin(dMin T) MinMax[T] {
+	v.min += dMin
+	return v
+}
+
+// FlipMin returns a copy of the vector with the min component negated
+func (v MinMax[T]) FlipMin() MinMax[T] {
+	v.min *= -1
+	return v
+}
+
+// Max returns the max component
+func (v MinMax[T]) Max() T {
+	return v.max
+}
+
+// SetMax returns a copy of the vector with the max component replaced
+func (v MinMax[T]) SetMax(newMax T) MinMax[T] {
+	v.max = newMax
+	return v
+}
+
+// Dmax returns a copy of the vector with dMax added to the max component
+func (v MinMax[T]) Dmax(dMax T) MinMax[T] {
+	v.max += dMax
+	return v
+}
+
+// FlipMax returns a copy of the vector with the max component negated
+func (v MinMax[T]) FlipMax() MinMax[T] {
+	v.max *= -1
+	return v
+}
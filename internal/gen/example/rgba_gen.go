@@ -0,0 +1,1480 @@
+// Code generated by vecgen; DO NOT EDIT.
+
+package example
+
+import (
+	"math"
+
+	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/mathex"
+)
+
+// RGBA is made up of the r, g, b and a components
+type RGBA[T vector.Number] struct {
+	r T
+	g T
+	b T
+	a T
+}
+
+// NewRGBA creates a RGBA from its components
+func NewRGBA[T vector.Number](r, g, b, a T) RGBA[T] {
+	return RGBA[T]{r: r, g: g, b: b, a: a}
+}
+
+// LerpRGBA linearly interpolates between a and b by t
+func LerpRGBA[T vector.Number](a, b RGBA[T], t float64) RGBA[T] {
+	return RGBA[T]{
+		r: T(float64(a.r) + (float64(b.r)-float64(a.r))*t),
+		g: T(float64(a.g) + (float64(b.g)-float64(a.g))*t),
+		b: T(float64(a.b) + (float64(b.b)-float64(a.b))*t),
+		a: T(float64(a.a) + (float64(b.a)-float64(a.a))*t),
+	}
+}
+
+// Add returns the component-wise sum of v and o
+func (v RGBA[T]) Add(o RGBA[T]) RGBA[T] {
+	return RGBA[T]{r: v.r + o.r, g: v.g + o.g, b: v.b + o.b, a: v.a + o.a}
+}
+
+// Sub returns the component-wise difference of v and o
+func (v RGBA[T]) Sub(o RGBA[T]) RGBA[T] {
+	return RGBA[T]{r: v.r - o.r, g: v.g - o.g, b: v.b - o.b, a: v.a - o.a}
+}
+
+// Scale multiplies every component by t
+func (v RGBA[T]) Scale(t float64) RGBA[T] {
+	return RGBA[T]{r: T(float64(v.r) * t), g: T(float64(v.g) * t), b: T(float64(v.b) * t), a: T(float64(v.a) * t)}
+}
+
+// MultByVector returns the component-wise product of v and o
+func (v RGBA[T]) MultByVector(o RGBA[T]) RGBA[T] {
+	return RGBA[T]{r: v.r * o.r, g: v.g * o.g, b: v.b * o.b, a: v.a * o.a}
+}
+
+// Dot returns the dot product of v and o
+func (v RGBA[T]) Dot(o RGBA[T]) T {
+	return v.r*o.r + v.g*o.g + v.b*o.b + v.a*o.a
+}
+
+// LengthSquared returns the squared length of the vector
+func (v RGBA[T]) LengthSquared() T {
+	return v.Dot(v)
+}
+
+// Length returns the length of the vector
+func (v RGBA[T]) Length() float64 {
+	return math.Sqrt(float64(v.LengthSquared()))
+}
+
+// Normalized scales the vector to a length of 1. Zero length vectors are
+// returned unchanged
+func (v RGBA[T]) Normalized() RGBA[T] {
+	l := v.Length()
+	if l == 0 {
+		return v
+	}
+	return v.Scale(1 / l)
+}
+
+// Abs returns the vector with every component made non-negative
+func (v RGBA[T]) Abs() RGBA[T] {
+	return RGBA[T]{r: mathex.Abs(v.r), g: mathex.Abs(v.g), b: mathex.Abs(v.b), a: mathex.Abs(v.a)}
+}
+
+// Clamp limits every component to within [vmin, vmax]
+func (v RGBA[T]) Clamp(vmin, vmax T) RGBA[T] {
+	return RGBA[T]{r: mathex.Clamp(v.r, vmin, vmax), g: mathex.Clamp(v.g, vmin, vmax), b: mathex.Clamp(v.b, vmin, vmax), a: mathex.Clamp(v.a, vmin, vmax)}
+}
+
+// Values returns every component of the vector
+func (v RGBA[T]) Values() (T, T, T, T) {
+	return v.r, v.g, v.b, v.a
+}
+
+// ToFloat32 converts every component to float32
+func (v RGBA[T]) ToFloat32() RGBA[float32] {
+	return RGBA[float32]{r: float32(v.r), g: float32(v.g), b: float32(v.b), a: float32(v.a)}
+}
+
+// ToFloat64 converts every component to float64
+func (v RGBA[T]) ToFloat64() RGBA[float64] {
+	return RGBA[float64]{r: float64(v.r), g: float64(v.g), b: float64(v.b), a: float64(v.a)}
+}
+
+// ToInt converts every component to int
+func (v RGBA[T]) ToInt() RGBA[int] {
+	return RGBA[int]{r: int(v.r), g: int(v.g), b: int(v.b), a: int(v.a)}
+}
+
+// R returns the r component
+func (v RGBA[T]) R() T {
+	return v.r
+}
+
+// SetR returns a copy of the vector with the r component replaced
+func (v RGBA[T]) SetR(newR T) RGBA[T] {
+	v.r = newR
+	return v
+}
+
+// Dr returns a copy of the vector with dR added to the r component
+func (v RGBA[T]) Dr(dR T) RGBA[T] {
+	v.r += dR
+	return v
+}
+
+// FlipR returns a copy of the vector with the r component negated
+func (v RGBA[T]) FlipR() RGBA[T] {
+	v.r *= -1
+	return v
+}
+
+// G returns the g component
+func (v RGBA[T]) G() T {
+	return v.g
+}
+
+// SetG returns a copy of the vector with the g component replaced
+func (v RGBA[T]) SetG(newG T) RGBA[T] {
+	v.g = newG
+	return v
+}
+
+// Dg returns a copy of the vector with dG added to the g component
+func (v RGBA[T]) Dg(dG T) RGBA[T] {
+	v.g += dG
+	return v
+}
+
+// FlipG returns a copy of the vector with the g component negated
+func (v RGBA[T]) FlipG() RGBA[T] {
+	v.g *= -1
+	return v
+}
+
+// B returns the b component
+func (v RGBA[T]) B() T {
+	return v.b
+}
+
+// SetB returns a copy of the vector with the b component replaced
+func (v RGBA[T]) SetB(newB T) RGBA[T] {
+	v.b = newB
+	return v
+}
+
+// Db returns a copy of the vector with dB added to the b component
+func (v RGBA[T]) Db(dB T) RGBA[T] {
+	v.b += dB
+	return v
+}
+
+// FlipB returns a copy of the vector with the b component negated
+func (v RGBA[T]) FlipB() RGBA[T] {
+	v.b *= -1
+	return v
+}
+
+// A returns the a component
+func (v RGBA[T]) A() T {
+	return v.a
+}
+
+// SetA returns a copy of the vector with the a component replaced
+func (v RGBA[T]) SetA(newA T) RGBA[T] {
+	v.a = newA
+	return v
+}
+
+// Da returns a copy of the vector with dA added to the a component
+func (v RGBA[T]) Da(dA T) RGBA[T] {
+	v.a += dA
+	return v
+}
+
+// FlipA returns a copy of the vector with the a component negated
+func (v RGBA[T]) FlipA() RGBA[T] {
+	v.a *= -1
+	return v
+}
+
+// RRRR returns a vector made up of the r, r, r and r components
+func (v RGBA[T]) RRRR() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.r, b: v.r, a: v.r}
+}
+
+// RRRG returns a vector made up of the r, r, r and g components
+func (v RGBA[T]) RRRG() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.r, b: v.r, a: v.g}
+}
+
+// RRRB returns a vector made up of the r, r, r and b components
+func (v RGBA[T]) RRRB() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.r, b: v.r, a: v.b}
+}
+
+// RRRA returns a vector made up of the r, r, r and a components
+func (v RGBA[T]) RRRA() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.r, b: v.r, a: v.a}
+}
+
+// RRGR returns a vector made up of the r, r, g and r components
+func (v RGBA[T]) RRGR() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.r, b: v.g, a: v.r}
+}
+
+// RRGG returns a vector made up of the r, r, g and g components
+func (v RGBA[T]) RRGG() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.r, b: v.g, a: v.g}
+}
+
+// RRGB returns a vector made up of the r, r, g and b components
+func (v RGBA[T]) RRGB() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.r, b: v.g, a: v.b}
+}
+
+// RRGA returns a vector made up of the r, r, g and a components
+func (v RGBA[T]) RRGA() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.r, b: v.g, a: v.a}
+}
+
+// RRBR returns a vector made up of the r, r, b and r components
+func (v RGBA[T]) RRBR() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.r, b: v.b, a: v.r}
+}
+
+// RRBG returns a vector made up of the r, r, b and g components
+func (v RGBA[T]) RRBG() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.r, b: v.b, a: v.g}
+}
+
+// RRBB returns a vector made up of the r, r, b and b components
+func (v RGBA[T]) RRBB() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.r, b: v.b, a: v.b}
+}
+
+// RRBA returns a vector made up of the r, r, b and a components
+func (v RGBA[T]) RRBA() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.r, b: v.b, a: v.a}
+}
+
+// RRAR returns a vector made up of the r, r, a and r components
+func (v RGBA[T]) RRAR() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.r, b: v.a, a: v.r}
+}
+
+// RRAG returns a vector made up of the r, r, a and g components
+func (v RGBA[T]) RRAG() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.r, b: v.a, a: v.g}
+}
+
+// RRAB returns a vector made up of the r, r, a and b components
+func (v RGBA[T]) RRAB() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.r, b: v.a, a: v.b}
+}
+
+// RRAA returns a vector made up of the r, r, a and a components
+func (v RGBA[T]) RRAA() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.r, b: v.a, a: v.a}
+}
+
+// RGRR returns a vector made up of the r, g, r and r components
+func (v RGBA[T]) RGRR() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.g, b: v.r, a: v.r}
+}
+
+// RGRG returns a vector made up of the r, g, r and g components
+func (v RGBA[T]) RGRG() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.g, b: v.r, a: v.g}
+}
+
+// RGRB returns a vector made up of the r, g, r and b components
+func (v RGBA[T]) RGRB() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.g, b: v.r, a: v.b}
+}
+
+// RGRA returns a vector made up of the r, g, r and a components
+func (v RGBA[T]) RGRA() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.g, b: v.r, a: v.a}
+}
+
+// RGGR returns a vector made up of the r, g, g and r components
+func (v RGBA[T]) RGGR() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.g, b: v.g, a: v.r}
+}
+
+// RGGG returns a vector made up of the r, g, g and g components
+func (v RGBA[T]) RGGG() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.g, b: v.g, a: v.g}
+}
+
+// RGGB returns a vector made up of the r, g, g and b components
+func (v RGBA[T]) RGGB() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.g, b: v.g, a: v.b}
+}
+
+// RGGA returns a vector made up of the r, g, g and a components
+func (v RGBA[T]) RGGA() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.g, b: v.g, a: v.a}
+}
+
+// RGBR returns a vector made up of the r, g, b and r components
+func (v RGBA[T]) RGBR() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.g, b: v.b, a: v.r}
+}
+
+// RGBG returns a vector made up of the r, g, b and g components
+func (v RGBA[T]) RGBG() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.g, b: v.b, a: v.g}
+}
+
+// RGBB returns a vector made up of the r, g, b and b components
+func (v RGBA[T]) RGBB() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.g, b: v.b, a: v.b}
+}
+
+// RGBA returns a vector made up of the r, g, b and a components
+func (v RGBA[T]) RGBA() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.g, b: v.b, a: v.a}
+}
+
+// RGAR returns a vector made up of the r, g, a and r components
+func (v RGBA[T]) RGAR() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.g, b: v.a, a: v.r}
+}
+
+// RGAG returns a vector made up of the r, g, a and g components
+func (v RGBA[T]) RGAG() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.g, b: v.a, a: v.g}
+}
+
+// RGAB returns a vector made up of the r, g, a and b components
+func (v RGBA[T]) RGAB() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.g, b: v.a, a: v.b}
+}
+
+// RGAA returns a vector made up of the r, g, a and a components
+func (v RGBA[T]) RGAA() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.g, b: v.a, a: v.a}
+}
+
+// RBRR returns a vector made up of the r, b, r and r components
+func (v RGBA[T]) RBRR() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.b, b: v.r, a: v.r}
+}
+
+// RBRG returns a vector made up of the r, b, r and g components
+func (v RGBA[T]) RBRG() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.b, b: v.r, a: v.g}
+}
+
+// RBRB returns a vector made up of the r, b, r and b components
+func (v RGBA[T]) RBRB() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.b, b: v.r, a: v.b}
+}
+
+// RBRA returns a vector made up of the r, b, r and a components
+func (v RGBA[T]) RBRA() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.b, b: v.r, a: v.a}
+}
+
+// RBGR returns a vector made up of the r, b, g and r components
+func (v RGBA[T]) RBGR() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.b, b: v.g, a: v.r}
+}
+
+// RBGG returns a vector made up of the r, b, g and g components
+func (v RGBA[T]) RBGG() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.b, b: v.g, a: v.g}
+}
+
+// RBGB returns a vector made up of the r, b, g and b components
+func (v RGBA[T]) RBGB() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.b, b: v.g, a: v.b}
+}
+
+// RBGA returns a vector made up of the r, b, g and a components
+func (v RGBA[T]) RBGA() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.b, b: v.g, a: v.a}
+}
+
+// RBBR returns a vector made up of the r, b, b and r components
+func (v RGBA[T]) RBBR() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.b, b: v.b, a: v.r}
+}
+
+// RBBG returns a vector made up of the r, b, b and g components
+func (v RGBA[T]) RBBG() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.b, b: v.b, a: v.g}
+}
+
+// RBBB returns a vector made up of the r, b, b and b components
+func (v RGBA[T]) RBBB() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.b, b: v.b, a: v.b}
+}
+
+// RBBA returns a vector made up of the r, b, b and a components
+func (v RGBA[T]) RBBA() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.b, b: v.b, a: v.a}
+}
+
+// RBAR returns a vector made up of the r, b, a and r components
+func (v RGBA[T]) RBAR() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.b, b: v.a, a: v.r}
+}
+
+// RBAG returns a vector made up of the r, b, a and g components
+func (v RGBA[T]) RBAG() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.b, b: v.a, a: v.g}
+}
+
+// RBAB returns a vector made up of the r, b, a and b components
+func (v RGBA[T]) RBAB() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.b, b: v.a, a: v.b}
+}
+
+// RBAA returns a vector made up of the r, b, a and a components
+func (v RGBA[T]) RBAA() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.b, b: v.a, a: v.a}
+}
+
+// RARR returns a vector made up of the r, a, r and r components
+func (v RGBA[T]) RARR() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.a, b: v.r, a: v.r}
+}
+
+// RARG returns a vector made up of the r, a, r and g components
+func (v RGBA[T]) RARG() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.a, b: v.r, a: v.g}
+}
+
+// RARB returns a vector made up of the r, a, r and b components
+func (v RGBA[T]) RARB() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.a, b: v.r, a: v.b}
+}
+
+// RARA returns a vector made up of the r, a, r and a components
+func (v RGBA[T]) RARA() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.a, b: v.r, a: v.a}
+}
+
+// RAGR returns a vector made up of the r, a, g and r components
+func (v RGBA[T]) RAGR() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.a, b: v.g, a: v.r}
+}
+
+// RAGG returns a vector made up of the r, a, g and g components
+func (v RGBA[T]) RAGG() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.a, b: v.g, a: v.g}
+}
+
+// RAGB returns a vector made up of the r, a, g and b components
+func (v RGBA[T]) RAGB() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.a, b: v.g, a: v.b}
+}
+
+// RAGA returns a vector made up of the r, a, g and a components
+func (v RGBA[T]) RAGA() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.a, b: v.g, a: v.a}
+}
+
+// RABR returns a vector made up of the r, a, b and r components
+func (v RGBA[T]) RABR() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.a, b: v.b, a: v.r}
+}
+
+// RABG returns a vector made up of the r, a, b and g components
+func (v RGBA[T]) RABG() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.a, b: v.b, a: v.g}
+}
+
+// RABB returns a vector made up of the r, a, b and b components
+func (v RGBA[T]) RABB() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.a, b: v.b, a: v.b}
+}
+
+// RABA returns a vector made up of the r, a, b and a components
+func (v RGBA[T]) RABA() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.a, b: v.b, a: v.a}
+}
+
+// RAAR returns a vector made up of the r, a, a and r components
+func (v RGBA[T]) RAAR() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.a, b: v.a, a: v.r}
+}
+
+// RAAG returns a vector made up of the r, a, a and g components
+func (v RGBA[T]) RAAG() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.a, b: v.a, a: v.g}
+}
+
+// RAAB returns a vector made up of the r, a, a and b components
+func (v RGBA[T]) RAAB() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.a, b: v.a, a: v.b}
+}
+
+// RAAA returns a vector made up of the r, a, a and a components
+func (v RGBA[T]) RAAA() RGBA[T] {
+	return RGBA[T]{r: v.r, g: v.a, b: v.a, a: v.a}
+}
+
+// GRRR returns a vector made up of the g, r, r and r components
+func (v RGBA[T]) GRRR() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.r, b: v.r, a: v.r}
+}
+
+// GRRG returns a vector made up of the g, r, r and g components
+func (v RGBA[T]) GRRG() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.r, b: v.r, a: v.g}
+}
+
+// GRRB returns a vector made up of the g, r, r and b components
+func (v RGBA[T]) GRRB() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.r, b: v.r, a: v.b}
+}
+
+// GRRA returns a vector made up of the g, r, r and a components
+func (v RGBA[T]) GRRA() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.r, b: v.r, a: v.a}
+}
+
+// GRGR returns a vector made up of the g, r, g and r components
+func (v RGBA[T]) GRGR() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.r, b: v.g, a: v.r}
+}
+
+// GRGG returns a vector made up of the g, r, g and g components
+func (v RGBA[T]) GRGG() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.r, b: v.g, a: v.g}
+}
+
+// GRGB returns a vector made up of the g, r, g and b components
+func (v RGBA[T]) GRGB() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.r, b: v.g, a: v.b}
+}
+
+// GRGA returns a vector made up of the g, r, g and a components
+func (v RGBA[T]) GRGA() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.r, b: v.g, a: v.a}
+}
+
+// GRBR returns a vector made up of the g, r, b and r components
+func (v RGBA[T]) GRBR() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.r, b: v.b, a: v.r}
+}
+
+// GRBG returns a vector made up of the g, r, b and g components
+func (v RGBA[T]) GRBG() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.r, b: v.b, a: v.g}
+}
+
+// GRBB returns a vector made up of the g, r, b and b components
+func (v RGBA[T]) GRBB() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.r, b: v.b, a: v.b}
+}
+
+// GRBA returns a vector made up of the g, r, b and a components
+func (v RGBA[T]) GRBA() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.r, b: v.b, a: v.a}
+}
+
+// GRAR returns a vector made up of the g, r, a and r components
+func (v RGBA[T]) GRAR() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.r, b: v.a, a: v.r}
+}
+
+// GRAG returns a vector made up of the g, r, a and g components
+func (v RGBA[T]) GRAG() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.r, b: v.a, a: v.g}
+}
+
+// GRAB returns a vector made up of the g, r, a and b components
+func (v RGBA[T]) GRAB() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.r, b: v.a, a: v.b}
+}
+
+// GRAA returns a vector made up of the g, r, a and a components
+func (v RGBA[T]) GRAA() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.r, b: v.a, a: v.a}
+}
+
+// GGRR returns a vector made up of the g, g, r and r components
+func (v RGBA[T]) GGRR() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.g, b: v.r, a: v.r}
+}
+
+// GGRG returns a vector made up of the g, g, r and g components
+func (v RGBA[T]) GGRG() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.g, b: v.r, a: v.g}
+}
+
+// GGRB returns a vector made up of the g, g, r and b components
+func (v RGBA[T]) GGRB() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.g, b: v.r, a: v.b}
+}
+
+// GGRA returns a vector made up of the g, g, r and a components
+func (v RGBA[T]) GGRA() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.g, b: v.r, a: v.a}
+}
+
+// GGGR returns a vector made up of the g, g, g and r components
+func (v RGBA[T]) GGGR() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.g, b: v.g, a: v.r}
+}
+
+// GGGG returns a vector made up of the g, g, g and g components
+func (v RGBA[T]) GGGG() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.g, b: v.g, a: v.g}
+}
+
+// GGGB returns a vector made up of the g, g, g and b components
+func (v RGBA[T]) GGGB() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.g, b: v.g, a: v.b}
+}
+
+// GGGA returns a vector made up of the g, g, g and a components
+func (v RGBA[T]) GGGA() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.g, b: v.g, a: v.a}
+}
+
+// GGBR returns a vector made up of the g, g, b and r components
+func (v RGBA[T]) GGBR() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.g, b: v.b, a: v.r}
+}
+
+// GGBG returns a vector made up of the g, g, b and g components
+func (v RGBA[T]) GGBG() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.g, b: v.b, a: v.g}
+}
+
+// GGBB returns a vector made up of the g, g, b and b components
+func (v RGBA[T]) GGBB() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.g, b: v.b, a: v.b}
+}
+
+// GGBA returns a vector made up of the g, g, b and a components
+func (v RGBA[T]) GGBA() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.g, b: v.b, a: v.a}
+}
+
+// GGAR returns a vector made up of the g, g, a and r components
+func (v RGBA[T]) GGAR() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.g, b: v.a, a: v.r}
+}
+
+// GGAG returns a vector made up of the g, g, a and g components
+func (v RGBA[T]) GGAG() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.g, b: v.a, a: v.g}
+}
+
+// GGAB returns a vector made up of the g, g, a and b components
+func (v RGBA[T]) GGAB() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.g, b: v.a, a: v.b}
+}
+
+// GGAA returns a vector made up of the g, g, a and a components
+func (v RGBA[T]) GGAA() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.g, b: v.a, a: v.a}
+}
+
+// GBRR returns a vector made up of the g, b, r and r components
+func (v RGBA[T]) GBRR() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.b, b: v.r, a: v.r}
+}
+
+// GBRG returns a vector made up of the g, b, r and g components
+func (v RGBA[T]) GBRG() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.b, b: v.r, a: v.g}
+}
+
+// GBRB returns a vector made up of the g, b, r and b components
+func (v RGBA[T]) GBRB() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.b, b: v.r, a: v.b}
+}
+
+// GBRA returns a vector made up of the g, b, r and a components
+func (v RGBA[T]) GBRA() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.b, b: v.r, a: v.a}
+}
+
+// GBGR returns a vector made up of the g, b, g and r components
+func (v RGBA[T]) GBGR() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.b, b: v.g, a: v.r}
+}
+
+// GBGG returns a vector made up of the g, b, g and g components
+func (v RGBA[T]) GBGG() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.b, b: v.g, a: v.g}
+}
+
+// GBGB returns a vector made up of the g, b, g and b components
+func (v RGBA[T]) GBGB() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.b, b: v.g, a: v.b}
+}
+
+// GBGA returns a vector made up of the g, b, g and a components
+func (v RGBA[T]) GBGA() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.b, b: v.g, a: v.a}
+}
+
+// GBBR returns a vector made up of the g, b, b and r components
+func (v RGBA[T]) GBBR() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.b, b: v.b, a: v.r}
+}
+
+// GBBG returns a vector made up of the g, b, b and g components
+func (v RGBA[T]) GBBG() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.b, b: v.b, a: v.g}
+}
+
+// GBBB returns a vector made up of the g, b, b and b components
+func (v RGBA[T]) GBBB() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.b, b: v.b, a: v.b}
+}
+
+// GBBA returns a vector made up of the g, b, b and a components
+func (v RGBA[T]) GBBA() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.b, b: v.b, a: v.a}
+}
+
+// GBAR returns a vector made up of the g, b, a and r components
+func (v RGBA[T]) GBAR() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.b, b: v.a, a: v.r}
+}
+
+// GBAG returns a vector made up of the g, b, a and g components
+func (v RGBA[T]) GBAG() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.b, b: v.a, a: v.g}
+}
+
+// GBAB returns a vector made up of the g, b, a and b components
+func (v RGBA[T]) GBAB() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.b, b: v.a, a: v.b}
+}
+
+// GBAA returns a vector made up of the g, b, a and a components
+func (v RGBA[T]) GBAA() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.b, b: v.a, a: v.a}
+}
+
+// GARR returns a vector made up of the g, a, r and r components
+func (v RGBA[T]) GARR() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.a, b: v.r, a: v.r}
+}
+
+// GARG returns a vector made up of the g, a, r and g components
+func (v RGBA[T]) GARG() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.a, b: v.r, a: v.g}
+}
+
+// GARB returns a vector made up of the g, a, r and b components
+func (v RGBA[T]) GARB() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.a, b: v.r, a: v.b}
+}
+
+// GARA returns a vector made up of the g, a, r and a components
+func (v RGBA[T]) GARA() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.a, b: v.r, a: v.a}
+}
+
+// GAGR returns a vector made up of the g, a, g and r components
+func (v RGBA[T]) GAGR() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.a, b: v.g, a: v.r}
+}
+
+// GAGG returns a vector made up of the g, a, g and g components
+func (v RGBA[T]) GAGG() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.a, b: v.g, a: v.g}
+}
+
+// GAGB returns a vector made up of the g, a, g and b components
+func (v RGBA[T]) GAGB() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.a, b: v.g, a: v.b}
+}
+
+// GAGA returns a vector made up of the g, a, g and a components
+func (v RGBA[T]) GAGA() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.a, b: v.g, a: v.a}
+}
+
+// GABR returns a vector made up of the g, a, b and r components
+func (v RGBA[T]) GABR() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.a, b: v.b, a: v.r}
+}
+
+// GABG returns a vector made up of the g, a, b and g components
+func (v RGBA[T]) GABG() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.a, b: v.b, a: v.g}
+}
+
+// GABB returns a vector made up of the g, a, b and b components
+func (v RGBA[T]) GABB() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.a, b: v.b, a: v.b}
+}
+
+// GABA returns a vector made up of the g, a, b and a components
+func (v RGBA[T]) GABA() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.a, b: v.b, a: v.a}
+}
+
+// GAAR returns a vector made up of the g, a, a and r components
+func (v RGBA[T]) GAAR() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.a, b: v.a, a: v.r}
+}
+
+// GAAG returns a vector made up of the g, a, a and g components
+func (v RGBA[T]) GAAG() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.a, b: v.a, a: v.g}
+}
+
+// GAAB returns a vector made up of the g, a, a and b components
+func (v RGBA[T]) GAAB() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.a, b: v.a, a: v.b}
+}
+
+// GAAA returns a vector made up of the g, a, a and a components
+func (v RGBA[T]) GAAA() RGBA[T] {
+	return RGBA[T]{r: v.g, g: v.a, b: v.a, a: v.a}
+}
+
+// BRRR returns a vector made up of the b, r, r and r components
+func (v RGBA[T]) BRRR() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.r, b: v.r, a: v.r}
+}
+
+// BRRG returns a vector made up of the b, r, r and g components
+func (v RGBA[T]) BRRG() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.r, b: v.r, a: v.g}
+}
+
+// BRRB returns a vector made up of the b, r, r and b components
+func (v RGBA[T]) BRRB() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.r, b: v.r, a: v.b}
+}
+
+// BRRA returns a vector made up of the b, r, r and a components
+func (v RGBA[T]) BRRA() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.r, b: v.r, a: v.a}
+}
+
+// BRGR returns a vector made up of the b, r, g and r components
+func (v RGBA[T]) BRGR() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.r, b: v.g, a: v.r}
+}
+
+// BRGG returns a vector made up of the b, r, g and g components
+func (v RGBA[T]) BRGG() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.r, b: v.g, a: v.g}
+}
+
+// BRGB returns a vector made up of the b, r, g and b components
+func (v RGBA[T]) BRGB() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.r, b: v.g, a: v.b}
+}
+
+// BRGA returns a vector made up of the b, r, g and a components
+func (v RGBA[T]) BRGA() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.r, b: v.g, a: v.a}
+}
+
+// BRBR returns a vector made up of the b, r, b and r components
+func (v RGBA[T]) BRBR() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.r, b: v.b, a: v.r}
+}
+
+// BRBG returns a vector made up of the b, r, b and g components
+func (v RGBA[T]) BRBG() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.r, b: v.b, a: v.g}
+}
+
+// BRBB returns a vector made up of the b, r, b and b components
+func (v RGBA[T]) BRBB() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.r, b: v.b, a: v.b}
+}
+
+// BRBA returns a vector made up of the b, r, b and a components
+func (v RGBA[T]) BRBA() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.r, b: v.b, a: v.a}
+}
+
+// BRAR returns a vector made up of the b, r, a and r components
+func (v RGBA[T]) BRAR() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.r, b: v.a, a: v.r}
+}
+
+// BRAG returns a vector made up of the b, r, a and g components
+func (v RGBA[T]) BRAG() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.r, b: v.a, a: v.g}
+}
+
+// BRAB returns a vector made up of the b, r, a and b components
+func (v RGBA[T]) BRAB() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.r, b: v.a, a: v.b}
+}
+
+// BRAA returns a vector made up of the b, r, a and a components
+func (v RGBA[T]) BRAA() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.r, b: v.a, a: v.a}
+}
+
+// BGRR returns a vector made up of the b, g, r and r components
+func (v RGBA[T]) BGRR() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.g, b: v.r, a: v.r}
+}
+
+// BGRG returns a vector made up of the b, g, r and g components
+func (v RGBA[T]) BGRG() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.g, b: v.r, a: v.g}
+}
+
+// BGRB returns a vector made up of the b, g, r and b components
+func (v RGBA[T]) BGRB() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.g, b: v.r, a: v.b}
+}
+
+// BGRA returns a vector made up of the b, g, r and a components
+func (v RGBA[T]) BGRA() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.g, b: v.r, a: v.a}
+}
+
+// BGGR returns a vector made up of the b, g, g and r components
+func (v RGBA[T]) BGGR() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.g, b: v.g, a: v.r}
+}
+
+// BGGG returns a vector made up of the b, g, g and g components
+func (v RGBA[T]) BGGG() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.g, b: v.g, a: v.g}
+}
+
+// BGGB returns a vector made up of the b, g, g and b components
+func (v RGBA[T]) BGGB() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.g, b: v.g, a: v.b}
+}
+
+// BGGA returns a vector made up of the b, g, g and a components
+func (v RGBA[T]) BGGA() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.g, b: v.g, a: v.a}
+}
+
+// BGBR returns a vector made up of the b, g, b and r components
+func (v RGBA[T]) BGBR() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.g, b: v.b, a: v.r}
+}
+
+// BGBG returns a vector made up of the b, g, b and g components
+func (v RGBA[T]) BGBG() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.g, b: v.b, a: v.g}
+}
+
+// BGBB returns a vector made up of the b, g, b and b components
+func (v RGBA[T]) BGBB() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.g, b: v.b, a: v.b}
+}
+
+// BGBA returns a vector made up of the b, g, b and a components
+func (v RGBA[T]) BGBA() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.g, b: v.b, a: v.a}
+}
+
+// BGAR returns a vector made up of the b, g, a and r components
+func (v RGBA[T]) BGAR() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.g, b: v.a, a: v.r}
+}
+
+// BGAG returns a vector made up of the b, g, a and g components
+func (v RGBA[T]) BGAG() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.g, b: v.a, a: v.g}
+}
+
+// BGAB returns a vector made up of the b, g, a and b components
+func (v RGBA[T]) BGAB() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.g, b: v.a, a: v.b}
+}
+
+// BGAA returns a vector made up of the b, g, a and a components
+func (v RGBA[T]) BGAA() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.g, b: v.a, a: v.a}
+}
+
+// BBRR returns a vector made up of the b, b, r and r components
+func (v RGBA[T]) BBRR() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.b, b: v.r, a: v.r}
+}
+
+// BBRG returns a vector made up of the b, b, r and g components
+func (v RGBA[T]) BBRG() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.b, b: v.r, a: v.g}
+}
+
+// BBRB returns a vector made up of the b, b, r and b components
+func (v RGBA[T]) BBRB() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.b, b: v.r, a: v.b}
+}
+
+// BBRA returns a vector made up of the b, b, r and a components
+func (v RGBA[T]) BBRA() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.b, b: v.r, a: v.a}
+}
+
+// BBGR returns a vector made up of the b, b, g and r components
+func (v RGBA[T]) BBGR() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.b, b: v.g, a: v.r}
+}
+
+// BBGG returns a vector made up of the b, b, g and g components
+func (v RGBA[T]) BBGG() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.b, b: v.g, a: v.g}
+}
+
+// BBGB returns a vector made up of the b, b, g and b components
+func (v RGBA[T]) BBGB() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.b, b: v.g, a: v.b}
+}
+
+// BBGA returns a vector made up of the b, b, g and a components
+func (v RGBA[T]) BBGA() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.b, b: v.g, a: v.a}
+}
+
+// BBBR returns a vector made up of the b, b, b and r components
+func (v RGBA[T]) BBBR() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.b, b: v.b, a: v.r}
+}
+
+// BBBG returns a vector made up of the b, b, b and g components
+func (v RGBA[T]) BBBG() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.b, b: v.b, a: v.g}
+}
+
+// BBBB returns a vector made up of the b, b, b and b components
+func (v RGBA[T]) BBBB() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.b, b: v.b, a: v.b}
+}
+
+// BBBA returns a vector made up of the b, b, b and a components
+func (v RGBA[T]) BBBA() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.b, b: v.b, a: v.a}
+}
+
+// BBAR returns a vector made up of the b, b, a and r components
+func (v RGBA[T]) BBAR() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.b, b: v.a, a: v.r}
+}
+
+// BBAG returns a vector made up of the b, b, a and g components
+func (v RGBA[T]) BBAG() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.b, b: v.a, a: v.g}
+}
+
+// BBAB returns a vector made up of the b, b, a and b components
+func (v RGBA[T]) BBAB() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.b, b: v.a, a: v.b}
+}
+
+// BBAA returns a vector made up of the b, b, a and a components
+func (v RGBA[T]) BBAA() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.b, b: v.a, a: v.a}
+}
+
+// BARR returns a vector made up of the b, a, r and r components
+func (v RGBA[T]) BARR() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.a, b: v.r, a: v.r}
+}
+
+// BARG returns a vector made up of the b, a, r and g components
+func (v RGBA[T]) BARG() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.a, b: v.r, a: v.g}
+}
+
+// BARB returns a vector made up of the b, a, r and b components
+func (v RGBA[T]) BARB() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.a, b: v.r, a: v.b}
+}
+
+// BARA returns a vector made up of the b, a, r and a components
+func (v RGBA[T]) BARA() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.a, b: v.r, a: v.a}
+}
+
+// BAGR returns a vector made up of the b, a, g and r components
+func (v RGBA[T]) BAGR() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.a, b: v.g, a: v.r}
+}
+
+// BAGG returns a vector made up of the b, a, g and g components
+func (v RGBA[T]) BAGG() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.a, b: v.g, a: v.g}
+}
+
+// BAGB returns a vector made up of the b, a, g and b components
+func (v RGBA[T]) BAGB() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.a, b: v.g, a: v.b}
+}
+
+// BAGA returns a vector made up of the b, a, g and a components
+func (v RGBA[T]) BAGA() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.a, b: v.g, a: v.a}
+}
+
+// BABR returns a vector made up of the b, a, b and r components
+func (v RGBA[T]) BABR() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.a, b: v.b, a: v.r}
+}
+
+// BABG returns a vector made up of the b, a, b and g components
+func (v RGBA[T]) BABG() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.a, b: v.b, a: v.g}
+}
+
+// BABB returns a vector made up of the b, a, b and b components
+func (v RGBA[T]) BABB() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.a, b: v.b, a: v.b}
+}
+
+// BABA returns a vector made up of the b, a, b and a components
+func (v RGBA[T]) BABA() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.a, b: v.b, a: v.a}
+}
+
+// BAAR returns a vector made up of the b, a, a and r components
+func (v RGBA[T]) BAAR() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.a, b: v.a, a: v.r}
+}
+
+// BAAG returns a vector made up of the b, a, a and g components
+func (v RGBA[T]) BAAG() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.a, b: v.a, a: v.g}
+}
+
+// BAAB returns a vector made up of the b, a, a and b components
+func (v RGBA[T]) BAAB() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.a, b: v.a, a: v.b}
+}
+
+// BAAA returns a vector made up of the b, a, a and a components
+func (v RGBA[T]) BAAA() RGBA[T] {
+	return RGBA[T]{r: v.b, g: v.a, b: v.a, a: v.a}
+}
+
+// ARRR returns a vector made up of the a, r, r and r components
+func (v RGBA[T]) ARRR() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.r, b: v.r, a: v.r}
+}
+
+// ARRG returns a vector made up of the a, r, r and g components
+func (v RGBA[T]) ARRG() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.r, b: v.r, a: v.g}
+}
+
+// ARRB returns a vector made up of the a, r, r and b components
+func (v RGBA[T]) ARRB() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.r, b: v.r, a: v.b}
+}
+
+// ARRA returns a vector made up of the a, r, r and a components
+func (v RGBA[T]) ARRA() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.r, b: v.r, a: v.a}
+}
+
+// ARGR returns a vector made up of the a, r, g and r components
+func (v RGBA[T]) ARGR() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.r, b: v.g, a: v.r}
+}
+
+// ARGG returns a vector made up of the a, r, g and g components
+func (v RGBA[T]) ARGG() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.r, b: v.g, a: v.g}
+}
+
+// ARGB returns a vector made up of the a, r, g and b components
+func (v RGBA[T]) ARGB() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.r, b: v.g, a: v.b}
+}
+
+// ARGA returns a vector made up of the a, r, g and a components
+func (v RGBA[T]) ARGA() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.r, b: v.g, a: v.a}
+}
+
+// ARBR returns a vector made up of the a, r, b and r components
+func (v RGBA[T]) ARBR() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.r, b: v.b, a: v.r}
+}
+
+// ARBG returns a vector made up of the a, r, b and g components
+func (v RGBA[T]) ARBG() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.r, b: v.b, a: v.g}
+}
+
+// ARBB returns a vector made up of the a, r, b and b components
+func (v RGBA[T]) ARBB() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.r, b: v.b, a: v.b}
+}
+
+// ARBA returns a vector made up of the a, r, b and a components
+func (v RGBA[T]) ARBA() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.r, b: v.b, a: v.a}
+}
+
+// ARAR returns a vector made up of the a, r, a and r components
+func (v RGBA[T]) ARAR() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.r, b: v.a, a: v.r}
+}
+
+// ARAG returns a vector made up of the a, r, a and g components
+func (v RGBA[T]) ARAG() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.r, b: v.a, a: v.g}
+}
+
+// ARAB returns a vector made up of the a, r, a and b components
+func (v RGBA[T]) ARAB() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.r, b: v.a, a: v.b}
+}
+
+// ARAA returns a vector made up of the a, r, a and a components
+func (v RGBA[T]) ARAA() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.r, b: v.a, a: v.a}
+}
+
+// AGRR returns a vector made up of the a, g, r and r components
+func (v RGBA[T]) AGRR() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.g, b: v.r, a: v.r}
+}
+
+// AGRG returns a vector made up of the a, g, r and g components
+func (v RGBA[T]) AGRG() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.g, b: v.r, a: v.g}
+}
+
+// AGRB returns a vector made up of the a, g, r and b components
+func (v RGBA[T]) AGRB() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.g, b: v.r, a: v.b}
+}
+
+// AGRA returns a vector made up of the a, g, r and a components
+func (v RGBA[T]) AGRA() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.g, b: v.r, a: v.a}
+}
+
+// AGGR returns a vector made up of the a, g, g and r components
+func (v RGBA[T]) AGGR() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.g, b: v.g, a: v.r}
+}
+
+// AGGG returns a vector made up of the a, g, g and g components
+func (v RGBA[T]) AGGG() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.g, b: v.g, a: v.g}
+}
+
+// AGGB returns a vector made up of the a, g, g and b components
+func (v RGBA[T]) AGGB() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.g, b: v.g, a: v.b}
+}
+
+// AGGA returns a vector made up of the a, g, g and a components
+func (v RGBA[T]) AGGA() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.g, b: v.g, a: v.a}
+}
+
+// AGBR returns a vector made up of the a, g, b and r components
+func (v RGBA[T]) AGBR() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.g, b: v.b, a: v.r}
+}
+
+// AGBG returns a vector made up of the a, g, b and g components
+func (v RGBA[T]) AGBG() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.g, b: v.b, a: v.g}
+}
+
+// AGBB returns a vector made up of the a, g, b and b components
+func (v RGBA[T]) AGBB() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.g, b: v.b, a: v.b}
+}
+
+// AGBA returns a vector made up of the a, g, b and a components
+func (v RGBA[T]) AGBA() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.g, b: v.b, a: v.a}
+}
+
+// AGAR returns a vector made up of the a, g, a and r components
+func (v RGBA[T]) AGAR() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.g, b: v.a, a: v.r}
+}
+
+// AGAG returns a vector made up of the a, g, a and g components
+func (v RGBA[T]) AGAG() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.g, b: v.a, a: v.g}
+}
+
+// AGAB returns a vector made up of the a, g, a and b components
+func (v RGBA[T]) AGAB() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.g, b: v.a, a: v.b}
+}
+
+// AGAA returns a vector made up of the a, g, a and a components
+func (v RGBA[T]) AGAA() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.g, b: v.a, a: v.a}
+}
+
+// ABRR returns a vector made up of the a, b, r and r components
+func (v RGBA[T]) ABRR() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.b, b: v.r, a: v.r}
+}
+
+// ABRG returns a vector made up of the a, b, r and g components
+func (v RGBA[T]) ABRG() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.b, b: v.r, a: v.g}
+}
+
+// ABRB returns a vector made up of the a, b, r and b components
+func (v RGBA[T]) ABRB() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.b, b: v.r, a: v.b}
+}
+
+// ABRA returns a vector made up of the a, b, r and a components
+func (v RGBA[T]) ABRA() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.b, b: v.r, a: v.a}
+}
+
+// ABGR returns a vector made up of the a, b, g and r components
+func (v RGBA[T]) ABGR() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.b, b: v.g, a: v.r}
+}
+
+// ABGG returns a vector made up of the a, b, g and g components
+func (v RGBA[T]) ABGG() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.b, b: v.g, a: v.g}
+}
+
+// ABGB returns a vector made up of the a, b, g and b components
+func (v RGBA[T]) ABGB() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.b, b: v.g, a: v.b}
+}
+
+// ABGA returns a vector made up of the a, b, g and a components
+func (v RGBA[T]) ABGA() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.b, b: v.g, a: v.a}
+}
+
+// ABBR returns a vector made up of the a, b, b and r components
+func (v RGBA[T]) ABBR() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.b, b: v.b, a: v.r}
+}
+
+// ABBG returns a vector made up of the a, b, b and g components
+func (v RGBA[T]) ABBG() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.b, b: v.b, a: v.g}
+}
+
+// ABBB returns a vector made up of the a, b, b and b components
+func (v RGBA[T]) ABBB() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.b, b: v.b, a: v.b}
+}
+
+// ABBA returns a vector made up of the a, b, b and a components
+func (v RGBA[T]) ABBA() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.b, b: v.b, a: v.a}
+}
+
+// ABAR returns a vector made up of the a, b, a and r components
+func (v RGBA[T]) ABAR() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.b, b: v.a, a: v.r}
+}
+
+// ABAG returns a vector made up of the a, b, a and g components
+func (v RGBA[T]) ABAG() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.b, b: v.a, a: v.g}
+}
+
+// ABAB returns a vector made up of the a, b, a and b components
+func (v RGBA[T]) ABAB() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.b, b: v.a, a: v.b}
+}
+
+// ABAA returns a vector made up of the a, b, a and a components
+func (v RGBA[T]) ABAA() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.b, b: v.a, a: v.a}
+}
+
+// AARR returns a vector made up of the a, a, r and r components
+func (v RGBA[T]) AARR() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.a, b: v.r, a: v.r}
+}
+
+// AARG returns a vector made up of the a, a, r and g components
+func (v RGBA[T]) AARG() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.a, b: v.r, a: v.g}
+}
+
+// AARB returns a vector made up of the a, a, r and b components
+func (v RGBA[T]) AARB() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.a, b: v.r, a: v.b}
+}
+
+// AARA returns a vector made up of the a, a, r and a components
+func (v RGBA[T]) AARA() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.a, b: v.r, a: v.a}
+}
+
+// AAGR returns a vector made up of the a, a, g and r components
+func (v RGBA[T]) AAGR() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.a, b: v.g, a: v.r}
+}
+
+// AAGG returns a vector made up of the a, a, g and g components
+func (v RGBA[T]) AAGG() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.a, b: v.g, a: v.g}
+}
+
+// AAGB returns a vector made up of the a, a, g and b components
+func (v RGBA[T]) AAGB() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.a, b: v.g, a: v.b}
+}
+
+// AAGA returns a vector made up of the a, a, g and a components
+func (v RGBA[T]) AAGA() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.a, b: v.g, a: v.a}
+}
+
+// AABR returns a vector made up of the a, a, b and r components
+func (v RGBA[T]) AABR() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.a, b: v.b, a: v.r}
+}
+
+// AABG returns a vector made up of the a, a, b and g components
+func (v RGBA[T]) AABG() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.a, b: v.b, a: v.g}
+}
+
+// AABB returns a vector made up of the a, a, b and b components
+func (v RGBA[T]) AABB() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.a, b: v.b, a: v.b}
+}
+
+// AABA returns a vector made up of the a, a, b and a components
+func (v RGBA[T]) AABA() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.a, b: v.b, a: v.a}
+}
+
+// AAAR returns a vector made up of the a, a, a and r components
+func (v RGBA[T]) AAAR() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.a, b: v.a, a: v.r}
+}
+
+// AAAG returns a vector made up of the a, a, a and g components
+func (v RGBA[T]) AAAG() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.a, b: v.a, a: v.g}
+}
+
+// AAAB returns a vector made up of the a, a, a and b components
+func (v RGBA[T]) AAAB() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.a, b: v.a, a: v.b}
+}
+
+// AAAA returns a vector made up of the a, a, a and a components
+func (v RGBA[T]) AAAA() RGBA[T] {
+	return RGBA[T]{r: v.a, g: v.a, b: v.a, a: v.a}
+}
@@ -0,0 +1,345 @@
+// Package gen generates the repetitive per-component methods of vector
+// types, such as accessors and swizzles, from a single template so that
+// every vector package exposes the same API.
+package gen
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/format"
+	"go/token"
+	"strings"
+	"text/template"
+)
+
+// Swizzle describes how to build the result of swizzles producing a
+// particular number of components
+type Swizzle struct {
+	// Import is the package the result type lives in, left empty for the
+	// package being generated
+	Import string
+
+	// Type is the result type, such as "vector2.Vector[T]"
+	Type string
+
+	// Constructor builds the result from its components, such as
+	// "vector2.New"
+	Constructor string
+}
+
+// Spec describes a vector type to generate methods for
+type Spec struct {
+	// Package is the name of the package the file belongs to
+	Package string
+
+	// Type is the name of the generic vector type, which must have a single
+	// type parameter T
+	Type string
+
+	// Components are the names of the type's unexported fields, in order
+	Components []string
+
+	// Swizzles maps the number of components a swizzle produces to how its
+	// result is built. Lengths without an entry aren't generated
+	Swizzles map[int]Swizzle
+
+	// Generator is the command recorded in the generated file's header
+	Generator string
+
+	// Declare generates the type itself, a New<Type> constructor and the
+	// common arithmetic operations alongside the per-component methods, for
+	// types that don't already have them written by hand
+	Declare bool
+}
+
+// operations are the methods generated for declared types, which component
+// methods may not collide with
+var operations = []string{
+	"Add", "Sub", "Scale", "MultByVector", "Dot", "LengthSquared", "Length",
+	"Normalized", "Abs", "Clamp", "Values", "ToFloat64", "ToFloat32", "ToInt",
+}
+
+// Validate checks that the spec describes a type that can be generated
+func (s Spec) Validate() error {
+	if !token.IsIdentifier(s.Package) {
+		return fmt.Errorf("invalid package name %q", s.Package)
+	}
+	if !token.IsIdentifier(s.Type) {
+		return fmt.Errorf("invalid type name %q", s.Type)
+	}
+	if len(s.Components) < 1 {
+		return errors.New("at least one component is required")
+	}
+
+	methods := map[string]string{}
+	if s.Declare {
+		for _, op := range operations {
+			methods[op] = "operation " + op
+		}
+	}
+	claim := func(name, owner string) error {
+		if other, ok := methods[name]; ok {
+			return fmt.Errorf("method %s of %s collides with %s", name, owner, other)
+		}
+		methods[name] = owner
+		return nil
+	}
+
+	for _, c := range s.Components {
+		if !token.IsIdentifier(c) || token.IsExported(c) || c == "_" {
+			return fmt.Errorf("component %q must be an unexported identifier", c)
+		}
+		owner := "component " + c
+		for _, name := range []string{Method(c), "Set" + Method(c), "D" + c, "Flip" + Method(c)} {
+			if err := claim(name, owner); err != nil {
+				return err
+			}
+		}
+	}
+	for _, sw := range s.swizzles() {
+		if err := claim(sw.Name, "swizzle "+sw.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Method is the name of the exported accessor for a component
+func Method(component string) string {
+	return strings.ToUpper(component[:1]) + component[1:]
+}
+
+type swizzleMethod struct {
+	Name       string
+	Components []string
+	Swizzle
+}
+
+// permutations returns every sequence of length n drawn from components,
+// repeats included, in lexicographic order of component position
+func permutations(components []string, n int) [][]string {
+	if n == 0 {
+		return [][]string{nil}
+	}
+	var out [][]string
+	for _, c := range components {
+		for _, rest := range permutations(components, n-1) {
+			out = append(out, append([]string{c}, rest...))
+		}
+	}
+	return out
+}
+
+func (s Spec) swizzles() []swizzleMethod {
+	var out []swizzleMethod
+	for n := 2; n <= 4; n++ {
+		target, ok := s.Swizzles[n]
+		if !ok {
+			continue
+		}
+		for _, p := range permutations(s.Components, n) {
+			name := ""
+			for _, c := range p {
+				name += Method(c)
+			}
+			out = append(out, swizzleMethod{Name: name, Components: p, Swizzle: target})
+		}
+	}
+	return out
+}
+
+func (s Spec) imports() []string {
+	seen := map[string]bool{}
+	var out []string
+	if s.Declare {
+		out = append(out, "math", module, module+"/mathex")
+	}
+	for n := 2; n <= 4; n++ {
+		if imp := s.Swizzles[n].Import; imp != "" && !seen[imp] {
+			seen[imp] = true
+			out = append(out, imp)
+		}
+	}
+	return out
+}
+
+var funcs = template.FuncMap{
+	"method": Method,
+	"join":   strings.Join,
+	"conversions": func() map[string]string {
+		return map[string]string{"ToFloat64": "float64", "ToFloat32": "float32", "ToInt": "int"}
+	},
+	"list": func(components []string) string {
+		switch len(components) {
+		case 1:
+			return components[0]
+		case 2:
+			return components[0] + " and " + components[1]
+		}
+		return strings.Join(components[:len(components)-1], ", ") + " and " + components[len(components)-1]
+	},
+}
+
+var file = template.Must(template.New("file").Funcs(funcs).Parse(`// Code generated by {{.Spec.Generator}}; DO NOT EDIT.
+
+package {{.Spec.Package}}
+{{if .Imports}}
+import (
+{{- range .Imports}}
+{{- if eq . "math"}}
+	"{{.}}"
+{{else}}
+	"{{.}}"
+{{- end}}
+{{- end}}
+)
+{{end}}
+{{- $spec := .Spec}}
+{{- if .Spec.Declare}}
+// {{$spec.Type}} is made up of the {{list $spec.Components}} components
+type {{$spec.Type}}[T vector.Number] struct {
+{{- range $spec.Components}}
+	{{.}} T
+{{- end}}
+}
+
+// New{{$spec.Type}} creates a {{$spec.Type}} from its components
+func New{{$spec.Type}}[T vector.Number]({{join $spec.Components ", "}} T) {{$spec.Type}}[T] {
+	return {{$spec.Type}}[T]{ {{- range $i, $c := $spec.Components}}{{if $i}}, {{end}}{{$c}}: {{$c}}{{end -}} }
+}
+
+// Lerp{{$spec.Type}} linearly interpolates between a and b by t
+func Lerp{{$spec.Type}}[T vector.Number](a, b {{$spec.Type}}[T], t float64) {{$spec.Type}}[T] {
+	return {{$spec.Type}}[T]{
+{{- range $spec.Components}}
+		{{.}}: T(float64(a.{{.}}) + (float64(b.{{.}})-float64(a.{{.}}))*t),
+{{- end}}
+	}
+}
+
+// Add returns the component-wise sum of v and o
+func (v {{$spec.Type}}[T]) Add(o {{$spec.Type}}[T]) {{$spec.Type}}[T] {
+	return {{$spec.Type}}[T]{ {{- range $i, $c := $spec.Components}}{{if $i}}, {{end}}{{$c}}: v.{{$c}} + o.{{$c}}{{end -}} }
+}
+
+// Sub returns the component-wise difference of v and o
+func (v {{$spec.Type}}[T]) Sub(o {{$spec.Type}}[T]) {{$spec.Type}}[T] {
+	return {{$spec.Type}}[T]{ {{- range $i, $c := $spec.Components}}{{if $i}}, {{end}}{{$c}}: v.{{$c}} - o.{{$c}}{{end -}} }
+}
+
+// Scale multiplies every component by t
+func (v {{$spec.Type}}[T]) Scale(t float64) {{$spec.Type}}[T] {
+	return {{$spec.Type}}[T]{ {{- range $i, $c := $spec.Components}}{{if $i}}, {{end}}{{$c}}: T(float64(v.{{$c}}) * t){{end -}} }
+}
+
+// MultByVector returns the component-wise product of v and o
+func (v {{$spec.Type}}[T]) MultByVector(o {{$spec.Type}}[T]) {{$spec.Type}}[T] {
+	return {{$spec.Type}}[T]{ {{- range $i, $c := $spec.Components}}{{if $i}}, {{end}}{{$c}}: v.{{$c}} * o.{{$c}}{{end -}} }
+}
+
+// Dot returns the dot product of v and o
+func (v {{$spec.Type}}[T]) Dot(o {{$spec.Type}}[T]) T {
+	return {{range $i, $c := $spec.Components}}{{if $i}} + {{end}}v.{{$c}}*o.{{$c}}{{end}}
+}
+
+// LengthSquared returns the squared length of the vector
+func (v {{$spec.Type}}[T]) LengthSquared() T {
+	return v.Dot(v)
+}
+
+// Length returns the length of the vector
+func (v {{$spec.Type}}[T]) Length() float64 {
+	return math.Sqrt(float64(v.LengthSquared()))
+}
+
+// Normalized scales the vector to a length of 1. Zero length vectors are
+// returned unchanged
+func (v {{$spec.Type}}[T]) Normalized() {{$spec.Type}}[T] {
+	l := v.Length()
+	if l == 0 {
+		return v
+	}
+	return v.Scale(1 / l)
+}
+
+// Abs returns the vector with every component made non-negative
+func (v {{$spec.Type}}[T]) Abs() {{$spec.Type}}[T] {
+	return {{$spec.Type}}[T]{ {{- range $i, $c := $spec.Components}}{{if $i}}, {{end}}{{$c}}: mathex.Abs(v.{{$c}}){{end -}} }
+}
+
+// Clamp limits every component to within [vmin, vmax]
+func (v {{$spec.Type}}[T]) Clamp(vmin, vmax T) {{$spec.Type}}[T] {
+	return {{$spec.Type}}[T]{ {{- range $i, $c := $spec.Components}}{{if $i}}, {{end}}{{$c}}: mathex.Clamp(v.{{$c}}, vmin, vmax){{end -}} }
+}
+
+// Values returns every component of the vector
+func (v {{$spec.Type}}[T]) Values() ({{range $i, $c := $spec.Components}}{{if $i}}, {{end}}T{{end}}) {
+	return {{range $i, $c := $spec.Components}}{{if $i}}, {{end}}v.{{$c}}{{end}}
+}
+{{range $name, $type := conversions}}
+// {{$name}} converts every component to {{$type}}
+func (v {{$spec.Type}}[T]) {{$name}}() {{$spec.Type}}[{{$type}}] {
+	return {{$spec.Type}}[{{$type}}]{ {{- range $i, $c := $spec.Components}}{{if $i}}, {{end}}{{$c}}: {{$type}}(v.{{$c}}){{end -}} }
+}
+{{end}}
+{{- end}}
+{{- range $c := .Spec.Components}}
+// {{method $c}} returns the {{$c}} component
+func (v {{$spec.Type}}[T]) {{method $c}}() T {
+	return v.{{$c}}
+}
+
+// Set{{method $c}} returns a copy of the vector with the {{$c}} component replaced
+func (v {{$spec.Type}}[T]) Set{{method $c}}(new{{method $c}} T) {{$spec.Type}}[T] {
+	v.{{$c}} = new{{method $c}}
+	return v
+}
+
+// D{{$c}} returns a copy of the vector with d{{method $c}} added to the {{$c}} component
+func (v {{$spec.Type}}[T]) D{{$c}}(d{{method $c}} T) {{$spec.Type}}[T] {
+	v.{{$c}} += d{{method $c}}
+	return v
+}
+
+// Flip{{method $c}} returns a copy of the vector with the {{$c}} component negated
+func (v {{$spec.Type}}[T]) Flip{{method $c}}() {{$spec.Type}}[T] {
+	v.{{$c}} *= -1
+	return v
+}
+{{end}}
+{{- range .Swizzles}}
+// {{.Name}} returns a vector made up of the {{list .Components}} components
+{{- if .Constructor}}
+func (v {{$spec.Type}}[T]) {{.Name}}() {{.Type}} {
+	return {{.Constructor}}({{range $i, $c := .Components}}{{if $i}}, {{end}}v.{{$c}}{{end}})
+}
+{{- else}}
+func (v {{$spec.Type}}[T]) {{.Name}}() {{$spec.Type}}[T] {
+	return {{$spec.Type}}[T]{ {{- range $i, $c := .Components}}{{if $i}}, {{end}}{{index $spec.Components $i}}: v.{{$c}}{{end -}} }
+}
+{{- end}}
+{{end}}`))
+
+// Generate renders the methods described by spec as a formatted Go file
+func Generate(spec Spec) ([]byte, error) {
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	err := file.Execute(&buf, struct {
+		Spec     Spec
+		Imports  []string
+		Swizzles []swizzleMethod
+	}{spec, spec.imports(), spec.swizzles()})
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated source: %w", err)
+	}
+	return out, nil
+}
@@ -0,0 +1,78 @@
+package gen_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/EliCDavis/vector/internal/gen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The checked in files must match what the generator produces, so edits to
+// the template aren't forgotten in any of the packages
+func TestVectorFilesUpToDate(t *testing.T) {
+	for dim := 2; dim <= 4; dim++ {
+		t.Run(fmt.Sprintf("vector%d", dim), func(t *testing.T) {
+			spec, err := gen.VectorSpec(dim)
+			require.NoError(t, err)
+
+			want, err := gen.Generate(spec)
+			require.NoError(t, err)
+
+			got, err := os.ReadFile(filepath.Join("..", "..", spec.Package, gen.VectorFile))
+			require.NoError(t, err)
+			assert.Equal(t, string(want), string(got), "run go generate ./... to update")
+		})
+	}
+}
+
+func TestVectorSpecDimension(t *testing.T) {
+	_, err := gen.VectorSpec(5)
+	assert.EqualError(t, err, "vector dimension must be 2, 3 or 4, got 5")
+}
+
+func TestValidate(t *testing.T) {
+	valid := gen.Spec{Package: "colors", Type: "RGB", Components: []string{"r", "g", "b"}, Declare: true}
+	assert.NoError(t, valid.Validate())
+
+	tests := map[string]struct {
+		spec gen.Spec
+		err  string
+	}{
+		"no components": {
+			spec: gen.Spec{Package: "p", Type: "T"},
+			err:  "at least one component is required",
+		},
+		"exported component": {
+			spec: gen.Spec{Package: "p", Type: "T", Components: []string{"X"}},
+			err:  `component "X" must be an unexported identifier`,
+		},
+		"invalid type": {
+			spec: gen.Spec{Package: "p", Type: "my type", Components: []string{"x"}},
+			err:  `invalid type name "my type"`,
+		},
+		"duplicate component": {
+			spec: gen.Spec{Package: "p", Type: "T", Components: []string{"x", "x"}},
+			err:  "method X of component x collides with component x",
+		},
+		"collides with operation": {
+			spec: gen.Spec{Package: "p", Type: "T", Components: []string{"length"}, Declare: true},
+			err:  "method Length of component length collides with operation Length",
+		},
+		"swizzle collides with component": {
+			spec: gen.Spec{Package: "p", Type: "T", Components: []string{"set", "x"}, Swizzles: map[int]gen.Swizzle{2: {}}},
+			err:  "method SetSet of swizzle SetSet collides with component set",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.EqualError(t, tc.spec.Validate(), tc.err)
+			_, err := gen.Generate(tc.spec)
+			assert.EqualError(t, err, tc.err)
+		})
+	}
+}
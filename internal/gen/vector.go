@@ -0,0 +1,34 @@
+package gen
+
+import "fmt"
+
+const module = "github.com/EliCDavis/vector"
+
+// VectorFile is the name of the file generated into each vector package
+const VectorFile = "vector_gen.go"
+
+// VectorSpec describes the generated methods of the vector2, vector3 or
+// vector4 package. Swizzles produce vectors of every dimension up to dim,
+// as lower dimension packages can't import higher ones without a cycle
+func VectorSpec(dim int) (Spec, error) {
+	if dim < 2 || dim > 4 {
+		return Spec{}, fmt.Errorf("vector dimension must be 2, 3 or 4, got %d", dim)
+	}
+
+	spec := Spec{
+		Package:    fmt.Sprintf("vector%d", dim),
+		Type:       "Vector",
+		Components: []string{"x", "y", "z", "w"}[:dim],
+		Swizzles:   map[int]Swizzle{dim: {}},
+		Generator:  "internal/cmd/swizzlegen",
+	}
+	for n := 2; n < dim; n++ {
+		pkg := fmt.Sprintf("vector%d", n)
+		spec.Swizzles[n] = Swizzle{
+			Import:      module + "/" + pkg,
+			Type:        pkg + ".Vector[T]",
+			Constructor: pkg + ".New",
+		}
+	}
+	return spec, nil
+}
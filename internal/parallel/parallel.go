@@ -0,0 +1,49 @@
+// Package parallel splits slice operations into contiguous chunks processed
+// by separate goroutines.
+package parallel
+
+import (
+	"runtime"
+	"sync"
+)
+
+// minChunk is the smallest number of elements worth handing to a goroutine
+const minChunk = 4096
+
+// Chunks returns the number of chunks n elements are split into when using
+// the given number of workers. A workers value <= 0 uses GOMAXPROCS
+func Chunks(n, workers int) int {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if limit := (n + minChunk - 1) / minChunk; workers > limit {
+		workers = limit
+	}
+	if workers < 1 {
+		workers = 1
+	}
+	return workers
+}
+
+// For splits [0, n) into Chunks(n, workers) contiguous ranges of near equal
+// size and calls fn with each chunk's index and range concurrently, returning
+// once all calls have. The ranges depend only on n and workers, so results
+// merged by chunk index are deterministic
+func For(n, workers int, fn func(chunk, start, end int)) {
+	chunks := Chunks(n, workers)
+	if chunks == 1 {
+		fn(0, 0, n)
+		return
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(chunks)
+	for c := 0; c < chunks; c++ {
+		start, end := c*n/chunks, (c+1)*n/chunks
+		go func(c, start, end int) {
+			defer wg.Done()
+			fn(c, start, end)
+		}(c, start, end)
+	}
+	wg.Wait()
+}
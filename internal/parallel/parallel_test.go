@@ -0,0 +1,32 @@
+package parallel_test
+
+import (
+	"sync/atomic"
+	"testing"
+
+	"github.com/EliCDavis/vector/internal/parallel"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChunks(t *testing.T) {
+	assert.Equal(t, 1, parallel.Chunks(0, 8))
+	assert.Equal(t, 1, parallel.Chunks(100, 8))
+	assert.Equal(t, 2, parallel.Chunks(5000, 8))
+	assert.Equal(t, 8, parallel.Chunks(1_000_000, 8))
+	assert.Equal(t, 3, parallel.Chunks(1_000_000, 3))
+	assert.GreaterOrEqual(t, parallel.Chunks(1_000_000, 0), 1)
+}
+
+func TestForCoversRange(t *testing.T) {
+	for _, n := range []int{0, 1, 4095, 4096, 4097, 100_000} {
+		seen := make([]int32, n)
+		parallel.For(n, 7, func(chunk, start, end int) {
+			for i := start; i < end; i++ {
+				atomic.AddInt32(&seen[i], 1)
+			}
+		})
+		for i, s := range seen {
+			assert.Equal(t, int32(1), s, "n=%d index=%d", n, i)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package simd
+
+import "math"
+
+func addFloat64Generic(dst, a, b []float64) {
+	a, b = a[:len(dst)], b[:len(dst)]
+	for i := range dst {
+		dst[i] = a[i] + b[i]
+	}
+}
+
+func subFloat64Generic(dst, a, b []float64) {
+	a, b = a[:len(dst)], b[:len(dst)]
+	for i := range dst {
+		dst[i] = a[i] - b[i]
+	}
+}
+
+func mulFloat64Generic(dst, a, b []float64) {
+	a, b = a[:len(dst)], b[:len(dst)]
+	for i := range dst {
+		dst[i] = a[i] * b[i]
+	}
+}
+
+func addFloat32Generic(dst, a, b []float32) {
+	a, b = a[:len(dst)], b[:len(dst)]
+	for i := range dst {
+		dst[i] = a[i] + b[i]
+	}
+}
+
+func subFloat32Generic(dst, a, b []float32) {
+	a, b = a[:len(dst)], b[:len(dst)]
+	for i := range dst {
+		dst[i] = a[i] - b[i]
+	}
+}
+
+func mulFloat32Generic(dst, a, b []float32) {
+	a, b = a[:len(dst)], b[:len(dst)]
+	for i := range dst {
+		dst[i] = a[i] * b[i]
+	}
+}
+
+// The xyz kernels below work on vectors stored as flat runs of their three
+// components. Each product is wrapped in an explicit conversion, which stops
+// the compiler fusing it with the following addition, so that the results
+// match the assembly kernels bit for bit on every architecture.
+
+func dot3Float64Generic(dst, a, b []float64) {
+	a, b = a[:3*len(dst)], b[:3*len(dst)]
+	for i := range dst {
+		j := 3 * i
+		dst[i] = float64(float64(a[j]*b[j])+float64(a[j+1]*b[j+1])) + float64(a[j+2]*b[j+2])
+	}
+}
+
+func dot3Float32Generic(dst, a, b []float32) {
+	a, b = a[:3*len(dst)], b[:3*len(dst)]
+	for i := range dst {
+		j := 3 * i
+		dst[i] = float32(float32(a[j]*b[j])+float32(a[j+1]*b[j+1])) + float32(a[j+2]*b[j+2])
+	}
+}
+
+func normalize3Float64Generic(dst, src []float64) {
+	src = src[:len(dst)]
+	for i := 0; i+2 < len(dst); i += 3 {
+		x, y, z := src[i], src[i+1], src[i+2]
+		l := math.Sqrt(float64(float64(x*x)+float64(y*y)) + float64(z*z))
+		if l == 0 {
+			dst[i], dst[i+1], dst[i+2] = 0, 0, 0
+			continue
+		}
+		inv := 1 / l
+		dst[i], dst[i+1], dst[i+2] = x*inv, y*inv, z*inv
+	}
+}
+
+func normalize3Float32Generic(dst, src []float32) {
+	src = src[:len(dst)]
+	for i := 0; i+2 < len(dst); i += 3 {
+		x, y, z := src[i], src[i+1], src[i+2]
+		l := float32(math.Sqrt(float64(float32(float32(x*x)+float32(y*y)) + float32(z*z))))
+		if l == 0 {
+			dst[i], dst[i+1], dst[i+2] = 0, 0, 0
+			continue
+		}
+		inv := 1 / l
+		dst[i], dst[i+1], dst[i+2] = x*inv, y*inv, z*inv
+	}
+}
+
+func minMax3Float64Generic(src []float64) (vmin, vmax [3]float64) {
+	vmin, vmax = [3]float64(src[:3]), [3]float64(src[:3])
+	minMax3Generic(&vmin, &vmax, src[3:])
+	return
+}
+
+func minMax3Float32Generic(src []float32) (vmin, vmax [3]float32) {
+	vmin, vmax = [3]float32(src[:3]), [3]float32(src[:3])
+	minMax3Generic(&vmin, &vmax, src[3:])
+	return
+}
+
+// minMax3Generic widens vmin and vmax to take in every xyz triple of src
+func minMax3Generic[T float32 | float64](vmin, vmax *[3]T, src []T) {
+	for i := 0; i+2 < len(src); i += 3 {
+		for c, v := range src[i : i+3] {
+			if v < vmin[c] {
+				vmin[c] = v
+			}
+			if v > vmax[c] {
+				vmax[c] = v
+			}
+		}
+	}
+}
@@ -0,0 +1,115 @@
+// Package simd contains the kernels behind the slice operations of the vector
+// packages. They're implemented in assembly using AVX2 on amd64 machines that
+// support it and NEON on arm64, and fall back to plain Go loops everywhere
+// else, or when built with the purego tag.
+package simd
+
+var (
+	// AVX2 reports whether the amd64 assembly kernels are in use
+	AVX2 = false
+
+	// NEON reports whether the arm64 assembly kernels are in use
+	NEON = false
+)
+
+// AddFloat64 sets dst[i] = a[i] + b[i]. All slices must share a length
+func AddFloat64(dst, a, b []float64) {
+	checkLengths(len(dst), len(a), len(b))
+	addFloat64(dst, a, b)
+}
+
+// SubFloat64 sets dst[i] = a[i] - b[i]. All slices must share a length
+func SubFloat64(dst, a, b []float64) {
+	checkLengths(len(dst), len(a), len(b))
+	subFloat64(dst, a, b)
+}
+
+// MulFloat64 sets dst[i] = a[i] * b[i]. All slices must share a length
+func MulFloat64(dst, a, b []float64) {
+	checkLengths(len(dst), len(a), len(b))
+	mulFloat64(dst, a, b)
+}
+
+// AddFloat32 sets dst[i] = a[i] + b[i]. All slices must share a length
+func AddFloat32(dst, a, b []float32) {
+	checkLengths(len(dst), len(a), len(b))
+	addFloat32(dst, a, b)
+}
+
+// SubFloat32 sets dst[i] = a[i] - b[i]. All slices must share a length
+func SubFloat32(dst, a, b []float32) {
+	checkLengths(len(dst), len(a), len(b))
+	subFloat32(dst, a, b)
+}
+
+// MulFloat32 sets dst[i] = a[i] * b[i]. All slices must share a length
+func MulFloat32(dst, a, b []float32) {
+	checkLengths(len(dst), len(a), len(b))
+	mulFloat32(dst, a, b)
+}
+
+// Dot3Float64 sets dst[i] to the dot product of the i-th xyz triples of a and
+// b. a and b must hold three times as many values as dst
+func Dot3Float64(dst, a, b []float64) {
+	checkLengths(3*len(dst), len(a), len(b))
+	dot3Float64(dst, a, b)
+}
+
+// Dot3Float32 sets dst[i] to the dot product of the i-th xyz triples of a and
+// b. a and b must hold three times as many values as dst
+func Dot3Float32(dst, a, b []float32) {
+	checkLengths(3*len(dst), len(a), len(b))
+	dot3Float32(dst, a, b)
+}
+
+// Normalize3Float64 sets every xyz triple of dst to the matching triple of src
+// scaled to a length of 1, or to zero if it has no length. Both slices must
+// share a length that's a multiple of 3, and dst may be src
+func Normalize3Float64(dst, src []float64) {
+	checkLengths(len(dst), len(src), len(src))
+	checkTriples(len(dst))
+	normalize3Float64(dst, src)
+}
+
+// Normalize3Float32 sets every xyz triple of dst to the matching triple of src
+// scaled to a length of 1, or to zero if it has no length. Both slices must
+// share a length that's a multiple of 3, and dst may be src
+func Normalize3Float32(dst, src []float32) {
+	checkLengths(len(dst), len(src), len(src))
+	checkTriples(len(dst))
+	normalize3Float32(dst, src)
+}
+
+// MinMax3Float64 returns the component-wise minimum and maximum of the xyz
+// triples of src, which must hold at least one. Which value wins for NaN
+// components is unspecified
+func MinMax3Float64(src []float64) (vmin, vmax [3]float64) {
+	checkTriples(len(src))
+	if len(src) == 0 {
+		panic("simd: no values to reduce")
+	}
+	return minMax3Float64(src)
+}
+
+// MinMax3Float32 returns the component-wise minimum and maximum of the xyz
+// triples of src, which must hold at least one. Which value wins for NaN
+// components is unspecified
+func MinMax3Float32(src []float32) (vmin, vmax [3]float32) {
+	checkTriples(len(src))
+	if len(src) == 0 {
+		panic("simd: no values to reduce")
+	}
+	return minMax3Float32(src)
+}
+
+func checkLengths(dst, a, b int) {
+	if dst != a || dst != b {
+		panic("simd: slices must have the same length")
+	}
+}
+
+func checkTriples(n int) {
+	if n%3 != 0 {
+		panic("simd: length must be a multiple of 3")
+	}
+}
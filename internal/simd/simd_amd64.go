@@ -0,0 +1,163 @@
+//go:build amd64 && !purego
+
+package simd
+
+var (
+	addFloat64 = addFloat64Generic
+	subFloat64 = subFloat64Generic
+	mulFloat64 = mulFloat64Generic
+	addFloat32 = addFloat32Generic
+	subFloat32 = subFloat32Generic
+	mulFloat32 = mulFloat32Generic
+
+	dot3Float64       = dot3Float64Generic
+	dot3Float32       = dot3Float32Generic
+	normalize3Float64 = normalize3Float64Generic
+	normalize3Float32 = normalize3Float32Generic
+	minMax3Float64    = minMax3Float64Generic
+	minMax3Float32    = minMax3Float32Generic
+)
+
+func init() {
+	if !hasAVX2() {
+		return
+	}
+
+	AVX2 = true
+	addFloat64 = addFloat64AVX2
+	subFloat64 = subFloat64AVX2
+	mulFloat64 = mulFloat64AVX2
+	addFloat32 = addFloat32AVX2
+	subFloat32 = subFloat32AVX2
+	mulFloat32 = mulFloat32AVX2
+
+	dot3Float64 = dot3Float64AVX2
+	dot3Float32 = dot3Float32AVX2
+	normalize3Float64 = normalize3Float64AVX2
+	normalize3Float32 = normalize3Float32AVX2
+	minMax3Float64 = minMax3Float64AVX2
+	minMax3Float32 = minMax3Float32AVX2
+}
+
+// The xyz kernels run whole blocks of 4 float64 or 8 float32 vectors through
+// the assembly, which fills three YMM registers, and leave the remainder to
+// the Go loops
+
+func dot3Float64AVX2(dst, a, b []float64) {
+	n := len(dst) &^ 3
+	dot3Float64AVX2Blocks(dst[:n], a[:3*n], b[:3*n])
+	dot3Float64Generic(dst[n:], a[3*n:], b[3*n:])
+}
+
+func dot3Float32AVX2(dst, a, b []float32) {
+	n := len(dst) &^ 7
+	dot3Float32AVX2Blocks(dst[:n], a[:3*n], b[:3*n])
+	dot3Float32Generic(dst[n:], a[3*n:], b[3*n:])
+}
+
+func normalize3Float64AVX2(dst, src []float64) {
+	n := len(dst) - len(dst)%12
+	normalize3Float64AVX2Blocks(dst[:n], src[:n])
+	normalize3Float64Generic(dst[n:], src[n:])
+}
+
+func normalize3Float32AVX2(dst, src []float32) {
+	n := len(dst) - len(dst)%24
+	normalize3Float32AVX2Blocks(dst[:n], src[:n])
+	normalize3Float32Generic(dst[n:], src[n:])
+}
+
+func minMax3Float64AVX2(src []float64) (vmin, vmax [3]float64) {
+	n := len(src) - len(src)%12
+	if n == 0 {
+		return minMax3Float64Generic(src)
+	}
+
+	// Every lane of the registers holds a single component of the vectors,
+	// and they're written out in xyz order to be reduced here
+	var lanes [24]float64
+	minMax3Float64AVX2Blocks(src[:n], &lanes)
+	vmin, vmax = [3]float64(lanes[:3]), [3]float64(lanes[:3])
+	minMax3Generic(&vmin, &vmax, lanes[3:])
+	minMax3Generic(&vmin, &vmax, src[n:])
+	return
+}
+
+func minMax3Float32AVX2(src []float32) (vmin, vmax [3]float32) {
+	n := len(src) - len(src)%24
+	if n == 0 {
+		return minMax3Float32Generic(src)
+	}
+
+	var lanes [48]float32
+	minMax3Float32AVX2Blocks(src[:n], &lanes)
+	vmin, vmax = [3]float32(lanes[:3]), [3]float32(lanes[:3])
+	minMax3Generic(&vmin, &vmax, lanes[3:])
+	minMax3Generic(&vmin, &vmax, src[n:])
+	return
+}
+
+func hasAVX2() bool {
+	maxID, _, _, _ := cpuid(0, 0)
+	if maxID < 7 {
+		return false
+	}
+
+	_, _, ecx1, _ := cpuid(1, 0)
+	const (
+		osxsave = 1 << 27
+		avx     = 1 << 28
+	)
+	if ecx1&osxsave == 0 || ecx1&avx == 0 {
+		return false
+	}
+
+	// The OS must save the XMM and YMM registers on context switches
+	if xcr0, _ := xgetbv(); xcr0&0b110 != 0b110 {
+		return false
+	}
+
+	_, ebx7, _, _ := cpuid(7, 0)
+	const avx2 = 1 << 5
+	return ebx7&avx2 != 0
+}
+
+func cpuid(eaxArg, ecxArg uint32) (eax, ebx, ecx, edx uint32)
+
+func xgetbv() (eax, edx uint32)
+
+//go:noescape
+func addFloat64AVX2(dst, a, b []float64)
+
+//go:noescape
+func subFloat64AVX2(dst, a, b []float64)
+
+//go:noescape
+func mulFloat64AVX2(dst, a, b []float64)
+
+//go:noescape
+func addFloat32AVX2(dst, a, b []float32)
+
+//go:noescape
+func subFloat32AVX2(dst, a, b []float32)
+
+//go:noescape
+func mulFloat32AVX2(dst, a, b []float32)
+
+//go:noescape
+func dot3Float64AVX2Blocks(dst, a, b []float64)
+
+//go:noescape
+func dot3Float32AVX2Blocks(dst, a, b []float32)
+
+//go:noescape
+func normalize3Float64AVX2Blocks(dst, src []float64)
+
+//go:noescape
+func normalize3Float32AVX2Blocks(dst, src []float32)
+
+//go:noescape
+func minMax3Float64AVX2Blocks(src []float64, lanes *[24]float64)
+
+//go:noescape
+func minMax3Float32AVX2Blocks(src []float32, lanes *[48]float32)
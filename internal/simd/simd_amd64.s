@@ -0,0 +1,622 @@
+//go:build amd64 && !purego
+
+#include "textflag.h"
+
+// func cpuid(eaxArg, ecxArg uint32) (eax, ebx, ecx, edx uint32)
+TEXT ·cpuid(SB), NOSPLIT, $0-24
+	MOVL eaxArg+0(FP), AX
+	MOVL ecxArg+4(FP), CX
+	CPUID
+	MOVL AX, eax+8(FP)
+	MOVL BX, ebx+12(FP)
+	MOVL CX, ecx+16(FP)
+	MOVL DX, edx+20(FP)
+	RET
+
+// func xgetbv() (eax, edx uint32)
+TEXT ·xgetbv(SB), NOSPLIT, $0-8
+	MOVL $0, CX
+	XGETBV
+	MOVL AX, eax+0(FP)
+	MOVL DX, edx+4(FP)
+	RET
+
+// func addFloat64AVX2(dst, a, b []float64)
+TEXT ·addFloat64AVX2(SB), NOSPLIT, $0-72
+	MOVQ dst_base+0(FP), DI
+	MOVQ dst_len+8(FP), CX
+	MOVQ a_base+24(FP), SI
+	MOVQ b_base+48(FP), DX
+	XORQ AX, AX
+
+	// Process 8 elements per iteration using two YMM registers
+	MOVQ CX, BX
+	ANDQ $-8, BX
+
+add64_loop:
+	CMPQ AX, BX
+	JGE  add64_tail
+	VMOVUPD (SI)(AX*8), Y0
+	VMOVUPD 32(SI)(AX*8), Y1
+	VADDPD (DX)(AX*8), Y0, Y0
+	VADDPD 32(DX)(AX*8), Y1, Y1
+	VMOVUPD Y0, (DI)(AX*8)
+	VMOVUPD Y1, 32(DI)(AX*8)
+	ADDQ $8, AX
+	JMP  add64_loop
+
+add64_tail:
+	CMPQ AX, CX
+	JGE  add64_done
+	VMOVSD (SI)(AX*8), X0
+	VADDSD (DX)(AX*8), X0, X0
+	VMOVSD X0, (DI)(AX*8)
+	INCQ AX
+	JMP  add64_tail
+
+add64_done:
+	VZEROUPPER
+	RET
+
+// func addFloat32AVX2(dst, a, b []float32)
+TEXT ·addFloat32AVX2(SB), NOSPLIT, $0-72
+	MOVQ dst_base+0(FP), DI
+	MOVQ dst_len+8(FP), CX
+	MOVQ a_base+24(FP), SI
+	MOVQ b_base+48(FP), DX
+	XORQ AX, AX
+
+	// Process 16 elements per iteration using two YMM registers
+	MOVQ CX, BX
+	ANDQ $-16, BX
+
+add32_loop:
+	CMPQ AX, BX
+	JGE  add32_tail
+	VMOVUPS (SI)(AX*4), Y0
+	VMOVUPS 32(SI)(AX*4), Y1
+	VADDPS (DX)(AX*4), Y0, Y0
+	VADDPS 32(DX)(AX*4), Y1, Y1
+	VMOVUPS Y0, (DI)(AX*4)
+	VMOVUPS Y1, 32(DI)(AX*4)
+	ADDQ $16, AX
+	JMP  add32_loop
+
+add32_tail:
+	CMPQ AX, CX
+	JGE  add32_done
+	VMOVSS (SI)(AX*4), X0
+	VADDSS (DX)(AX*4), X0, X0
+	VMOVSS X0, (DI)(AX*4)
+	INCQ AX
+	JMP  add32_tail
+
+add32_done:
+	VZEROUPPER
+	RET
+
+// func subFloat64AVX2(dst, a, b []float64)
+TEXT ·subFloat64AVX2(SB), NOSPLIT, $0-72
+	MOVQ dst_base+0(FP), DI
+	MOVQ dst_len+8(FP), CX
+	MOVQ a_base+24(FP), SI
+	MOVQ b_base+48(FP), DX
+	XORQ AX, AX
+
+	// Process 8 elements per iteration using two YMM registers
+	MOVQ CX, BX
+	ANDQ $-8, BX
+
+sub64_loop:
+	CMPQ AX, BX
+	JGE  sub64_tail
+	VMOVUPD (SI)(AX*8), Y0
+	VMOVUPD 32(SI)(AX*8), Y1
+	VSUBPD (DX)(AX*8), Y0, Y0
+	VSUBPD 32(DX)(AX*8), Y1, Y1
+	VMOVUPD Y0, (DI)(AX*8)
+	VMOVUPD Y1, 32(DI)(AX*8)
+	ADDQ $8, AX
+	JMP  sub64_loop
+
+sub64_tail:
+	CMPQ AX, CX
+	JGE  sub64_done
+	VMOVSD (SI)(AX*8), X0
+	VSUBSD (DX)(AX*8), X0, X0
+	VMOVSD X0, (DI)(AX*8)
+	INCQ AX
+	JMP  sub64_tail
+
+sub64_done:
+	VZEROUPPER
+	RET
+
+// func subFloat32AVX2(dst, a, b []float32)
+TEXT ·subFloat32AVX2(SB), NOSPLIT, $0-72
+	MOVQ dst_base+0(FP), DI
+	MOVQ dst_len+8(FP), CX
+	MOVQ a_base+24(FP), SI
+	MOVQ b_base+48(FP), DX
+	XORQ AX, AX
+
+	// Process 16 elements per iteration using two YMM registers
+	MOVQ CX, BX
+	ANDQ $-16, BX
+
+sub32_loop:
+	CMPQ AX, BX
+	JGE  sub32_tail
+	VMOVUPS (SI)(AX*4), Y0
+	VMOVUPS 32(SI)(AX*4), Y1
+	VSUBPS (DX)(AX*4), Y0, Y0
+	VSUBPS 32(DX)(AX*4), Y1, Y1
+	VMOVUPS Y0, (DI)(AX*4)
+	VMOVUPS Y1, 32(DI)(AX*4)
+	ADDQ $16, AX
+	JMP  sub32_loop
+
+sub32_tail:
+	CMPQ AX, CX
+	JGE  sub32_done
+	VMOVSS (SI)(AX*4), X0
+	VSUBSS (DX)(AX*4), X0, X0
+	VMOVSS X0, (DI)(AX*4)
+	INCQ AX
+	JMP  sub32_tail
+
+sub32_done:
+	VZEROUPPER
+	RET
+
+// func mulFloat64AVX2(dst, a, b []float64)
+TEXT ·mulFloat64AVX2(SB), NOSPLIT, $0-72
+	MOVQ dst_base+0(FP), DI
+	MOVQ dst_len+8(FP), CX
+	MOVQ a_base+24(FP), SI
+	MOVQ b_base+48(FP), DX
+	XORQ AX, AX
+
+	// Process 8 elements per iteration using two YMM registers
+	MOVQ CX, BX
+	ANDQ $-8, BX
+
+mul64_loop:
+	CMPQ AX, BX
+	JGE  mul64_tail
+	VMOVUPD (SI)(AX*8), Y0
+	VMOVUPD 32(SI)(AX*8), Y1
+	VMULPD (DX)(AX*8), Y0, Y0
+	VMULPD 32(DX)(AX*8), Y1, Y1
+	VMOVUPD Y0, (DI)(AX*8)
+	VMOVUPD Y1, 32(DI)(AX*8)
+	ADDQ $8, AX
+	JMP  mul64_loop
+
+mul64_tail:
+	CMPQ AX, CX
+	JGE  mul64_done
+	VMOVSD (SI)(AX*8), X0
+	VMULSD (DX)(AX*8), X0, X0
+	VMOVSD X0, (DI)(AX*8)
+	INCQ AX
+	JMP  mul64_tail
+
+mul64_done:
+	VZEROUPPER
+	RET
+
+// func mulFloat32AVX2(dst, a, b []float32)
+TEXT ·mulFloat32AVX2(SB), NOSPLIT, $0-72
+	MOVQ dst_base+0(FP), DI
+	MOVQ dst_len+8(FP), CX
+	MOVQ a_base+24(FP), SI
+	MOVQ b_base+48(FP), DX
+	XORQ AX, AX
+
+	// Process 16 elements per iteration using two YMM registers
+	MOVQ CX, BX
+	ANDQ $-16, BX
+
+mul32_loop:
+	CMPQ AX, BX
+	JGE  mul32_tail
+	VMOVUPS (SI)(AX*4), Y0
+	VMOVUPS 32(SI)(AX*4), Y1
+	VMULPS (DX)(AX*4), Y0, Y0
+	VMULPS 32(DX)(AX*4), Y1, Y1
+	VMOVUPS Y0, (DI)(AX*4)
+	VMOVUPS Y1, 32(DI)(AX*4)
+	ADDQ $16, AX
+	JMP  mul32_loop
+
+mul32_tail:
+	CMPQ AX, CX
+	JGE  mul32_done
+	VMOVSS (SI)(AX*4), X0
+	VMULSS (DX)(AX*4), X0, X0
+	VMOVSS X0, (DI)(AX*4)
+	INCQ AX
+	JMP  mul32_tail
+
+mul32_done:
+	VZEROUPPER
+	RET
+
+// The xyz kernels below load a block of vectors into three YMM registers,
+// where the components of consecutive vectors are interleaved across lanes.
+// Blending the three registers puts every x, y, or z component in a distinct
+// lane, and a cross-lane permute then sorts them into vector order.
+
+// VPERMPS indices sorting the blended x, y, and z components of 8 vectors
+DATA deinterleaveX32<>+0(SB)/4, $0
+DATA deinterleaveX32<>+4(SB)/4, $3
+DATA deinterleaveX32<>+8(SB)/4, $6
+DATA deinterleaveX32<>+12(SB)/4, $1
+DATA deinterleaveX32<>+16(SB)/4, $4
+DATA deinterleaveX32<>+20(SB)/4, $7
+DATA deinterleaveX32<>+24(SB)/4, $2
+DATA deinterleaveX32<>+28(SB)/4, $5
+GLOBL deinterleaveX32<>(SB), RODATA|NOPTR, $32
+
+DATA deinterleaveY32<>+0(SB)/4, $1
+DATA deinterleaveY32<>+4(SB)/4, $4
+DATA deinterleaveY32<>+8(SB)/4, $7
+DATA deinterleaveY32<>+12(SB)/4, $2
+DATA deinterleaveY32<>+16(SB)/4, $5
+DATA deinterleaveY32<>+20(SB)/4, $0
+DATA deinterleaveY32<>+24(SB)/4, $3
+DATA deinterleaveY32<>+28(SB)/4, $6
+GLOBL deinterleaveY32<>(SB), RODATA|NOPTR, $32
+
+DATA deinterleaveZ32<>+0(SB)/4, $2
+DATA deinterleaveZ32<>+4(SB)/4, $5
+DATA deinterleaveZ32<>+8(SB)/4, $0
+DATA deinterleaveZ32<>+12(SB)/4, $3
+DATA deinterleaveZ32<>+16(SB)/4, $6
+DATA deinterleaveZ32<>+20(SB)/4, $1
+DATA deinterleaveZ32<>+24(SB)/4, $4
+DATA deinterleaveZ32<>+28(SB)/4, $7
+GLOBL deinterleaveZ32<>(SB), RODATA|NOPTR, $32
+
+// VPERMPS indices spreading one value per vector back over the lanes of its
+// three components
+DATA expand32<>+0(SB)/4, $0
+DATA expand32<>+4(SB)/4, $0
+DATA expand32<>+8(SB)/4, $0
+DATA expand32<>+12(SB)/4, $1
+DATA expand32<>+16(SB)/4, $1
+DATA expand32<>+20(SB)/4, $1
+DATA expand32<>+24(SB)/4, $2
+DATA expand32<>+28(SB)/4, $2
+DATA expand32<>+32(SB)/4, $2
+DATA expand32<>+36(SB)/4, $3
+DATA expand32<>+40(SB)/4, $3
+DATA expand32<>+44(SB)/4, $3
+DATA expand32<>+48(SB)/4, $4
+DATA expand32<>+52(SB)/4, $4
+DATA expand32<>+56(SB)/4, $4
+DATA expand32<>+60(SB)/4, $5
+DATA expand32<>+64(SB)/4, $5
+DATA expand32<>+68(SB)/4, $5
+DATA expand32<>+72(SB)/4, $6
+DATA expand32<>+76(SB)/4, $6
+DATA expand32<>+80(SB)/4, $6
+DATA expand32<>+84(SB)/4, $7
+DATA expand32<>+88(SB)/4, $7
+DATA expand32<>+92(SB)/4, $7
+GLOBL expand32<>(SB), RODATA|NOPTR, $96
+
+DATA one32<>+0(SB)/4, $0x3f800000
+GLOBL one32<>(SB), RODATA|NOPTR, $4
+
+DATA one64<>+0(SB)/8, $0x3ff0000000000000
+GLOBL one64<>(SB), RODATA|NOPTR, $8
+
+// func dot3Float64AVX2Blocks(dst, a, b []float64)
+TEXT ·dot3Float64AVX2Blocks(SB), NOSPLIT, $0-72
+	MOVQ dst_base+0(FP), DI
+	MOVQ dst_len+8(FP), CX
+	MOVQ a_base+24(FP), SI
+	MOVQ b_base+48(FP), DX
+
+	// 4 vectors per iteration
+	SHRQ $2, CX
+	JZ   dot3_64_done
+
+dot3_64_loop:
+	VMOVUPD (SI), Y0
+	VMOVUPD 32(SI), Y1
+	VMOVUPD 64(SI), Y2
+	VMULPD  (DX), Y0, Y0
+	VMULPD  32(DX), Y1, Y1
+	VMULPD  64(DX), Y2, Y2
+
+	VBLENDPD $0x4, Y1, Y0, Y3
+	VBLENDPD $0x2, Y2, Y3, Y3
+	VPERMPD  $0x6c, Y3, Y3
+	VBLENDPD $0x9, Y1, Y0, Y4
+	VBLENDPD $0x4, Y2, Y4, Y4
+	VPERMPD  $0xb1, Y4, Y4
+	VBLENDPD $0x2, Y1, Y0, Y5
+	VBLENDPD $0x9, Y2, Y5, Y5
+	VPERMPD  $0xc6, Y5, Y5
+
+	VADDPD  Y4, Y3, Y3
+	VADDPD  Y5, Y3, Y3
+	VMOVUPD Y3, (DI)
+
+	ADDQ $96, SI
+	ADDQ $96, DX
+	ADDQ $32, DI
+	DECQ CX
+	JNZ  dot3_64_loop
+
+dot3_64_done:
+	VZEROUPPER
+	RET
+
+// func dot3Float32AVX2Blocks(dst, a, b []float32)
+TEXT ·dot3Float32AVX2Blocks(SB), NOSPLIT, $0-72
+	MOVQ dst_base+0(FP), DI
+	MOVQ dst_len+8(FP), CX
+	MOVQ a_base+24(FP), SI
+	MOVQ b_base+48(FP), DX
+
+	// 8 vectors per iteration
+	SHRQ $3, CX
+	JZ   dot3_32_done
+
+	VMOVUPS deinterleaveX32<>(SB), Y13
+	VMOVUPS deinterleaveY32<>(SB), Y14
+	VMOVUPS deinterleaveZ32<>(SB), Y15
+
+dot3_32_loop:
+	VMOVUPS (SI), Y0
+	VMOVUPS 32(SI), Y1
+	VMOVUPS 64(SI), Y2
+	VMULPS  (DX), Y0, Y0
+	VMULPS  32(DX), Y1, Y1
+	VMULPS  64(DX), Y2, Y2
+
+	VBLENDPS $0x92, Y1, Y0, Y3
+	VBLENDPS $0x24, Y2, Y3, Y3
+	VPERMPS  Y3, Y13, Y3
+	VBLENDPS $0x24, Y1, Y0, Y4
+	VBLENDPS $0x49, Y2, Y4, Y4
+	VPERMPS  Y4, Y14, Y4
+	VBLENDPS $0x49, Y1, Y0, Y5
+	VBLENDPS $0x92, Y2, Y5, Y5
+	VPERMPS  Y5, Y15, Y5
+
+	VADDPS  Y4, Y3, Y3
+	VADDPS  Y5, Y3, Y3
+	VMOVUPS Y3, (DI)
+
+	ADDQ $96, SI
+	ADDQ $96, DX
+	ADDQ $32, DI
+	DECQ CX
+	JNZ  dot3_32_loop
+
+dot3_32_done:
+	VZEROUPPER
+	RET
+
+// func normalize3Float64AVX2Blocks(dst, src []float64)
+TEXT ·normalize3Float64AVX2Blocks(SB), NOSPLIT, $0-48
+	MOVQ dst_base+0(FP), DI
+	MOVQ dst_len+8(FP), CX
+	MOVQ src_base+24(FP), SI
+	LEAQ (SI)(CX*8), BX
+
+	VBROADCASTSD one64<>(SB), Y15
+	VXORPD       Y14, Y14, Y14
+
+norm64_loop:
+	CMPQ SI, BX
+	JAE  norm64_done
+
+	VMOVUPD (SI), Y0
+	VMOVUPD 32(SI), Y1
+	VMOVUPD 64(SI), Y2
+	VMULPD  Y0, Y0, Y3
+	VMULPD  Y1, Y1, Y4
+	VMULPD  Y2, Y2, Y5
+
+	VBLENDPD $0x4, Y4, Y3, Y6
+	VBLENDPD $0x2, Y5, Y6, Y6
+	VPERMPD  $0x6c, Y6, Y6
+	VBLENDPD $0x9, Y4, Y3, Y7
+	VBLENDPD $0x4, Y5, Y7, Y7
+	VPERMPD  $0xb1, Y7, Y7
+	VBLENDPD $0x2, Y4, Y3, Y8
+	VBLENDPD $0x9, Y5, Y8, Y8
+	VPERMPD  $0xc6, Y8, Y8
+
+	// Y6 holds the lengths, Y7 flags the zero ones
+	VADDPD  Y7, Y6, Y6
+	VADDPD  Y8, Y6, Y6
+	VSQRTPD Y6, Y6
+	VCMPPD  $0, Y14, Y6, Y7
+	VDIVPD  Y6, Y15, Y6
+
+	VPERMPD $0x40, Y6, Y3
+	VPERMPD $0x40, Y7, Y8
+	VMULPD  Y3, Y0, Y0
+	VANDNPD Y0, Y8, Y0
+	VPERMPD $0xa5, Y6, Y4
+	VPERMPD $0xa5, Y7, Y9
+	VMULPD  Y4, Y1, Y1
+	VANDNPD Y1, Y9, Y1
+	VPERMPD $0xfe, Y6, Y5
+	VPERMPD $0xfe, Y7, Y10
+	VMULPD  Y5, Y2, Y2
+	VANDNPD Y2, Y10, Y2
+
+	VMOVUPD Y0, (DI)
+	VMOVUPD Y1, 32(DI)
+	VMOVUPD Y2, 64(DI)
+
+	ADDQ $96, SI
+	ADDQ $96, DI
+	JMP  norm64_loop
+
+norm64_done:
+	VZEROUPPER
+	RET
+
+// func normalize3Float32AVX2Blocks(dst, src []float32)
+TEXT ·normalize3Float32AVX2Blocks(SB), NOSPLIT, $0-48
+	MOVQ dst_base+0(FP), DI
+	MOVQ dst_len+8(FP), CX
+	MOVQ src_base+24(FP), SI
+	LEAQ (SI)(CX*4), BX
+
+	VBROADCASTSS one32<>(SB), Y15
+	VXORPS       Y14, Y14, Y14
+	VMOVUPS      deinterleaveX32<>(SB), Y13
+	VMOVUPS      deinterleaveY32<>(SB), Y12
+	VMOVUPS      deinterleaveZ32<>(SB), Y11
+
+norm32_loop:
+	CMPQ SI, BX
+	JAE  norm32_done
+
+	VMOVUPS (SI), Y0
+	VMOVUPS 32(SI), Y1
+	VMOVUPS 64(SI), Y2
+	VMULPS  Y0, Y0, Y3
+	VMULPS  Y1, Y1, Y4
+	VMULPS  Y2, Y2, Y5
+
+	VBLENDPS $0x92, Y4, Y3, Y6
+	VBLENDPS $0x24, Y5, Y6, Y6
+	VPERMPS  Y6, Y13, Y6
+	VBLENDPS $0x24, Y4, Y3, Y7
+	VBLENDPS $0x49, Y5, Y7, Y7
+	VPERMPS  Y7, Y12, Y7
+	VBLENDPS $0x49, Y4, Y3, Y8
+	VBLENDPS $0x92, Y5, Y8, Y8
+	VPERMPS  Y8, Y11, Y8
+
+	// Y6 holds the lengths, Y7 flags the zero ones
+	VADDPS  Y7, Y6, Y6
+	VADDPS  Y8, Y6, Y6
+	VSQRTPS Y6, Y6
+	VCMPPS  $0, Y14, Y6, Y7
+	VDIVPS  Y6, Y15, Y6
+
+	VMOVUPS expand32<>+0(SB), Y9
+	VPERMPS Y6, Y9, Y3
+	VPERMPS Y7, Y9, Y4
+	VMULPS  Y3, Y0, Y0
+	VANDNPS Y0, Y4, Y0
+	VMOVUPS expand32<>+32(SB), Y9
+	VPERMPS Y6, Y9, Y3
+	VPERMPS Y7, Y9, Y4
+	VMULPS  Y3, Y1, Y1
+	VANDNPS Y1, Y4, Y1
+	VMOVUPS expand32<>+64(SB), Y9
+	VPERMPS Y6, Y9, Y3
+	VPERMPS Y7, Y9, Y4
+	VMULPS  Y3, Y2, Y2
+	VANDNPS Y2, Y4, Y2
+
+	VMOVUPS Y0, (DI)
+	VMOVUPS Y1, 32(DI)
+	VMOVUPS Y2, 64(DI)
+
+	ADDQ $96, SI
+	ADDQ $96, DI
+	JMP  norm32_loop
+
+norm32_done:
+	VZEROUPPER
+	RET
+
+// func minMax3Float64AVX2Blocks(src []float64, lanes *[24]float64)
+TEXT ·minMax3Float64AVX2Blocks(SB), NOSPLIT, $0-32
+	MOVQ src_base+0(FP), SI
+	MOVQ src_len+8(FP), CX
+	MOVQ lanes+24(FP), DI
+	LEAQ (SI)(CX*8), BX
+
+	// Lanes keep to a single component, as 12 values hold whole vectors
+	VMOVUPD (SI), Y0
+	VMOVUPD 32(SI), Y1
+	VMOVUPD 64(SI), Y2
+	VMOVUPD Y0, Y3
+	VMOVUPD Y1, Y4
+	VMOVUPD Y2, Y5
+	ADDQ    $96, SI
+
+minmax64_loop:
+	CMPQ SI, BX
+	JAE  minmax64_done
+
+	VMOVUPD (SI), Y6
+	VMOVUPD 32(SI), Y7
+	VMOVUPD 64(SI), Y8
+	VMINPD  Y6, Y0, Y0
+	VMINPD  Y7, Y1, Y1
+	VMINPD  Y8, Y2, Y2
+	VMAXPD  Y6, Y3, Y3
+	VMAXPD  Y7, Y4, Y4
+	VMAXPD  Y8, Y5, Y5
+
+	ADDQ $96, SI
+	JMP  minmax64_loop
+
+minmax64_done:
+	VMOVUPD Y0, (DI)
+	VMOVUPD Y1, 32(DI)
+	VMOVUPD Y2, 64(DI)
+	VMOVUPD Y3, 96(DI)
+	VMOVUPD Y4, 128(DI)
+	VMOVUPD Y5, 160(DI)
+	VZEROUPPER
+	RET
+
+// func minMax3Float32AVX2Blocks(src []float32, lanes *[48]float32)
+TEXT ·minMax3Float32AVX2Blocks(SB), NOSPLIT, $0-32
+	MOVQ src_base+0(FP), SI
+	MOVQ src_len+8(FP), CX
+	MOVQ lanes+24(FP), DI
+	LEAQ (SI)(CX*4), BX
+
+	// Lanes keep to a single component, as 24 values hold whole vectors
+	VMOVUPS (SI), Y0
+	VMOVUPS 32(SI), Y1
+	VMOVUPS 64(SI), Y2
+	VMOVUPS Y0, Y3
+	VMOVUPS Y1, Y4
+	VMOVUPS Y2, Y5
+	ADDQ    $96, SI
+
+minmax32_loop:
+	CMPQ SI, BX
+	JAE  minmax32_done
+
+	VMOVUPS (SI), Y6
+	VMOVUPS 32(SI), Y7
+	VMOVUPS 64(SI), Y8
+	VMINPS  Y6, Y0, Y0
+	VMINPS  Y7, Y1, Y1
+	VMINPS  Y8, Y2, Y2
+	VMAXPS  Y6, Y3, Y3
+	VMAXPS  Y7, Y4, Y4
+	VMAXPS  Y8, Y5, Y5
+
+	ADDQ $96, SI
+	JMP  minmax32_loop
+
+minmax32_done:
+	VMOVUPS Y0, (DI)
+	VMOVUPS Y1, 32(DI)
+	VMOVUPS Y2, 64(DI)
+	VMOVUPS Y3, 96(DI)
+	VMOVUPS Y4, 128(DI)
+	VMOVUPS Y5, 160(DI)
+	VZEROUPPER
+	RET
@@ -0,0 +1,158 @@
+//go:build arm64 && !purego
+
+package simd
+
+// NEON is part of the arm64 baseline, so unlike AVX2 the assembly kernels
+// don't need to be selected at runtime
+
+var (
+	addFloat64 = addFloat64NEON
+	subFloat64 = subFloat64NEON
+	mulFloat64 = mulFloat64NEON
+	addFloat32 = addFloat32NEON
+	subFloat32 = subFloat32NEON
+	mulFloat32 = mulFloat32NEON
+
+	dot3Float64       = dot3Float64NEON
+	dot3Float32       = dot3Float32NEON
+	normalize3Float64 = normalize3Float64NEON
+	normalize3Float32 = normalize3Float32NEON
+	minMax3Float64    = minMax3Float64NEON
+	minMax3Float32    = minMax3Float32NEON
+)
+
+func init() {
+	NEON = true
+}
+
+// Every kernel runs whole blocks of four Q registers worth of values, or of
+// vectors, through the assembly and leaves the remainder to the Go loops
+
+func addFloat64NEON(dst, a, b []float64) {
+	n := len(dst) &^ 7
+	addFloat64NEONBlocks(dst[:n], a[:n], b[:n])
+	addFloat64Generic(dst[n:], a[n:], b[n:])
+}
+
+func subFloat64NEON(dst, a, b []float64) {
+	n := len(dst) &^ 7
+	subFloat64NEONBlocks(dst[:n], a[:n], b[:n])
+	subFloat64Generic(dst[n:], a[n:], b[n:])
+}
+
+func mulFloat64NEON(dst, a, b []float64) {
+	n := len(dst) &^ 7
+	mulFloat64NEONBlocks(dst[:n], a[:n], b[:n])
+	mulFloat64Generic(dst[n:], a[n:], b[n:])
+}
+
+func addFloat32NEON(dst, a, b []float32) {
+	n := len(dst) &^ 15
+	addFloat32NEONBlocks(dst[:n], a[:n], b[:n])
+	addFloat32Generic(dst[n:], a[n:], b[n:])
+}
+
+func subFloat32NEON(dst, a, b []float32) {
+	n := len(dst) &^ 15
+	subFloat32NEONBlocks(dst[:n], a[:n], b[:n])
+	subFloat32Generic(dst[n:], a[n:], b[n:])
+}
+
+func mulFloat32NEON(dst, a, b []float32) {
+	n := len(dst) &^ 15
+	mulFloat32NEONBlocks(dst[:n], a[:n], b[:n])
+	mulFloat32Generic(dst[n:], a[n:], b[n:])
+}
+
+// The xyz kernels use structured loads, which split 2 float64 or 4 float32
+// vectors into a register per component
+
+func dot3Float64NEON(dst, a, b []float64) {
+	n := len(dst) &^ 1
+	dot3Float64NEONBlocks(dst[:n], a[:3*n], b[:3*n])
+	dot3Float64Generic(dst[n:], a[3*n:], b[3*n:])
+}
+
+func dot3Float32NEON(dst, a, b []float32) {
+	n := len(dst) &^ 3
+	dot3Float32NEONBlocks(dst[:n], a[:3*n], b[:3*n])
+	dot3Float32Generic(dst[n:], a[3*n:], b[3*n:])
+}
+
+func normalize3Float64NEON(dst, src []float64) {
+	n := len(dst) - len(dst)%6
+	normalize3Float64NEONBlocks(dst[:n], src[:n])
+	normalize3Float64Generic(dst[n:], src[n:])
+}
+
+func normalize3Float32NEON(dst, src []float32) {
+	n := len(dst) - len(dst)%12
+	normalize3Float32NEONBlocks(dst[:n], src[:n])
+	normalize3Float32Generic(dst[n:], src[n:])
+}
+
+func minMax3Float64NEON(src []float64) (vmin, vmax [3]float64) {
+	n := len(src) - len(src)%6
+	if n == 0 {
+		return minMax3Float64Generic(src)
+	}
+
+	// The registers are written back interleaved, in xyz order, to be
+	// reduced here
+	var lanes [12]float64
+	minMax3Float64NEONBlocks(src[:n], &lanes)
+	vmin, vmax = [3]float64(lanes[:3]), [3]float64(lanes[:3])
+	minMax3Generic(&vmin, &vmax, lanes[3:])
+	minMax3Generic(&vmin, &vmax, src[n:])
+	return
+}
+
+func minMax3Float32NEON(src []float32) (vmin, vmax [3]float32) {
+	n := len(src) - len(src)%12
+	if n == 0 {
+		return minMax3Float32Generic(src)
+	}
+
+	var lanes [24]float32
+	minMax3Float32NEONBlocks(src[:n], &lanes)
+	vmin, vmax = [3]float32(lanes[:3]), [3]float32(lanes[:3])
+	minMax3Generic(&vmin, &vmax, lanes[3:])
+	minMax3Generic(&vmin, &vmax, src[n:])
+	return
+}
+
+//go:noescape
+func addFloat64NEONBlocks(dst, a, b []float64)
+
+//go:noescape
+func subFloat64NEONBlocks(dst, a, b []float64)
+
+//go:noescape
+func mulFloat64NEONBlocks(dst, a, b []float64)
+
+//go:noescape
+func addFloat32NEONBlocks(dst, a, b []float32)
+
+//go:noescape
+func subFloat32NEONBlocks(dst, a, b []float32)
+
+//go:noescape
+func mulFloat32NEONBlocks(dst, a, b []float32)
+
+//go:noescape
+func dot3Float64NEONBlocks(dst, a, b []float64)
+
+//go:noescape
+func dot3Float32NEONBlocks(dst, a, b []float32)
+
+//go:noescape
+func normalize3Float64NEONBlocks(dst, src []float64)
+
+//go:noescape
+func normalize3Float32NEONBlocks(dst, src []float32)
+
+//go:noescape
+func minMax3Float64NEONBlocks(src []float64, lanes *[12]float64)
+
+//go:noescape
+func minMax3Float32NEONBlocks(src []float32, lanes *[24]float32)
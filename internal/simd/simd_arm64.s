@@ -0,0 +1,349 @@
+//go:build arm64 && !purego
+
+#include "textflag.h"
+
+// Floating point vector instructions and structured loads and stores are
+// spelled out as WORD encodings, with the instruction in a comment, so that
+// the file assembles with toolchains predating their mnemonics.
+
+// func addFloat64NEONBlocks(dst, a, b []float64)
+TEXT ·addFloat64NEONBlocks(SB), NOSPLIT, $0-72
+	MOVD dst_base+0(FP), R0
+	MOVD dst_len+8(FP), R3
+	MOVD a_base+24(FP), R1
+	MOVD b_base+48(FP), R2
+
+	// 8 elements per iteration using four registers
+	LSR  $3, R3, R3
+	CBZ  R3, add64_done
+
+add64_loop:
+	VLD1.P 64(R1), [V0.D2, V1.D2, V2.D2, V3.D2]
+	VLD1.P 64(R2), [V4.D2, V5.D2, V6.D2, V7.D2]
+	WORD $0x4e64d400 // VFADD V4.D2, V0.D2, V0.D2
+	WORD $0x4e65d421 // VFADD V5.D2, V1.D2, V1.D2
+	WORD $0x4e66d442 // VFADD V6.D2, V2.D2, V2.D2
+	WORD $0x4e67d463 // VFADD V7.D2, V3.D2, V3.D2
+	VST1.P [V0.D2, V1.D2, V2.D2, V3.D2], 64(R0)
+	SUB  $1, R3
+	CBNZ R3, add64_loop
+
+add64_done:
+	RET
+
+// func addFloat32NEONBlocks(dst, a, b []float32)
+TEXT ·addFloat32NEONBlocks(SB), NOSPLIT, $0-72
+	MOVD dst_base+0(FP), R0
+	MOVD dst_len+8(FP), R3
+	MOVD a_base+24(FP), R1
+	MOVD b_base+48(FP), R2
+
+	// 16 elements per iteration using four registers
+	LSR  $4, R3, R3
+	CBZ  R3, add32_done
+
+add32_loop:
+	VLD1.P 64(R1), [V0.S4, V1.S4, V2.S4, V3.S4]
+	VLD1.P 64(R2), [V4.S4, V5.S4, V6.S4, V7.S4]
+	WORD $0x4e24d400 // VFADD V4.S4, V0.S4, V0.S4
+	WORD $0x4e25d421 // VFADD V5.S4, V1.S4, V1.S4
+	WORD $0x4e26d442 // VFADD V6.S4, V2.S4, V2.S4
+	WORD $0x4e27d463 // VFADD V7.S4, V3.S4, V3.S4
+	VST1.P [V0.S4, V1.S4, V2.S4, V3.S4], 64(R0)
+	SUB  $1, R3
+	CBNZ R3, add32_loop
+
+add32_done:
+	RET
+
+// func subFloat64NEONBlocks(dst, a, b []float64)
+TEXT ·subFloat64NEONBlocks(SB), NOSPLIT, $0-72
+	MOVD dst_base+0(FP), R0
+	MOVD dst_len+8(FP), R3
+	MOVD a_base+24(FP), R1
+	MOVD b_base+48(FP), R2
+
+	// 8 elements per iteration using four registers
+	LSR  $3, R3, R3
+	CBZ  R3, sub64_done
+
+sub64_loop:
+	VLD1.P 64(R1), [V0.D2, V1.D2, V2.D2, V3.D2]
+	VLD1.P 64(R2), [V4.D2, V5.D2, V6.D2, V7.D2]
+	WORD $0x4ee4d400 // VFSUB V4.D2, V0.D2, V0.D2
+	WORD $0x4ee5d421 // VFSUB V5.D2, V1.D2, V1.D2
+	WORD $0x4ee6d442 // VFSUB V6.D2, V2.D2, V2.D2
+	WORD $0x4ee7d463 // VFSUB V7.D2, V3.D2, V3.D2
+	VST1.P [V0.D2, V1.D2, V2.D2, V3.D2], 64(R0)
+	SUB  $1, R3
+	CBNZ R3, sub64_loop
+
+sub64_done:
+	RET
+
+// func subFloat32NEONBlocks(dst, a, b []float32)
+TEXT ·subFloat32NEONBlocks(SB), NOSPLIT, $0-72
+	MOVD dst_base+0(FP), R0
+	MOVD dst_len+8(FP), R3
+	MOVD a_base+24(FP), R1
+	MOVD b_base+48(FP), R2
+
+	// 16 elements per iteration using four registers
+	LSR  $4, R3, R3
+	CBZ  R3, sub32_done
+
+sub32_loop:
+	VLD1.P 64(R1), [V0.S4, V1.S4, V2.S4, V3.S4]
+	VLD1.P 64(R2), [V4.S4, V5.S4, V6.S4, V7.S4]
+	WORD $0x4ea4d400 // VFSUB V4.S4, V0.S4, V0.S4
+	WORD $0x4ea5d421 // VFSUB V5.S4, V1.S4, V1.S4
+	WORD $0x4ea6d442 // VFSUB V6.S4, V2.S4, V2.S4
+	WORD $0x4ea7d463 // VFSUB V7.S4, V3.S4, V3.S4
+	VST1.P [V0.S4, V1.S4, V2.S4, V3.S4], 64(R0)
+	SUB  $1, R3
+	CBNZ R3, sub32_loop
+
+sub32_done:
+	RET
+
+// func mulFloat64NEONBlocks(dst, a, b []float64)
+TEXT ·mulFloat64NEONBlocks(SB), NOSPLIT, $0-72
+	MOVD dst_base+0(FP), R0
+	MOVD dst_len+8(FP), R3
+	MOVD a_base+24(FP), R1
+	MOVD b_base+48(FP), R2
+
+	// 8 elements per iteration using four registers
+	LSR  $3, R3, R3
+	CBZ  R3, mul64_done
+
+mul64_loop:
+	VLD1.P 64(R1), [V0.D2, V1.D2, V2.D2, V3.D2]
+	VLD1.P 64(R2), [V4.D2, V5.D2, V6.D2, V7.D2]
+	WORD $0x6e64dc00 // VFMUL V4.D2, V0.D2, V0.D2
+	WORD $0x6e65dc21 // VFMUL V5.D2, V1.D2, V1.D2
+	WORD $0x6e66dc42 // VFMUL V6.D2, V2.D2, V2.D2
+	WORD $0x6e67dc63 // VFMUL V7.D2, V3.D2, V3.D2
+	VST1.P [V0.D2, V1.D2, V2.D2, V3.D2], 64(R0)
+	SUB  $1, R3
+	CBNZ R3, mul64_loop
+
+mul64_done:
+	RET
+
+// func mulFloat32NEONBlocks(dst, a, b []float32)
+TEXT ·mulFloat32NEONBlocks(SB), NOSPLIT, $0-72
+	MOVD dst_base+0(FP), R0
+	MOVD dst_len+8(FP), R3
+	MOVD a_base+24(FP), R1
+	MOVD b_base+48(FP), R2
+
+	// 16 elements per iteration using four registers
+	LSR  $4, R3, R3
+	CBZ  R3, mul32_done
+
+mul32_loop:
+	VLD1.P 64(R1), [V0.S4, V1.S4, V2.S4, V3.S4]
+	VLD1.P 64(R2), [V4.S4, V5.S4, V6.S4, V7.S4]
+	WORD $0x6e24dc00 // VFMUL V4.S4, V0.S4, V0.S4
+	WORD $0x6e25dc21 // VFMUL V5.S4, V1.S4, V1.S4
+	WORD $0x6e26dc42 // VFMUL V6.S4, V2.S4, V2.S4
+	WORD $0x6e27dc63 // VFMUL V7.S4, V3.S4, V3.S4
+	VST1.P [V0.S4, V1.S4, V2.S4, V3.S4], 64(R0)
+	SUB  $1, R3
+	CBNZ R3, mul32_loop
+
+mul32_done:
+	RET
+// The xyz kernels below use VLD3 and VST3, which split consecutive vectors
+// into one register per component and interleave them back on the way out.
+
+// func dot3Float64NEONBlocks(dst, a, b []float64)
+TEXT ·dot3Float64NEONBlocks(SB), NOSPLIT, $0-72
+	MOVD dst_base+0(FP), R0
+	MOVD dst_len+8(FP), R3
+	MOVD a_base+24(FP), R1
+	MOVD b_base+48(FP), R2
+
+	// 2 vectors per iteration
+	LSR $1, R3, R3
+	CBZ R3, dot3_64_done
+
+dot3_64_loop:
+	WORD $0x4cdf4c20 // VLD3.P 48(R1), [V0.D2, V1.D2, V2.D2]
+	WORD $0x4cdf4c43 // VLD3.P 48(R2), [V3.D2, V4.D2, V5.D2]
+	WORD $0x6e63dc00 // VFMUL V3.D2, V0.D2, V0.D2
+	WORD $0x6e64dc21 // VFMUL V4.D2, V1.D2, V1.D2
+	WORD $0x6e65dc42 // VFMUL V5.D2, V2.D2, V2.D2
+	WORD $0x4e61d400 // VFADD V1.D2, V0.D2, V0.D2
+	WORD $0x4e62d400 // VFADD V2.D2, V0.D2, V0.D2
+	VST1.P [V0.D2], 16(R0)
+	SUB  $1, R3
+	CBNZ R3, dot3_64_loop
+
+dot3_64_done:
+	RET
+
+// func dot3Float32NEONBlocks(dst, a, b []float32)
+TEXT ·dot3Float32NEONBlocks(SB), NOSPLIT, $0-72
+	MOVD dst_base+0(FP), R0
+	MOVD dst_len+8(FP), R3
+	MOVD a_base+24(FP), R1
+	MOVD b_base+48(FP), R2
+
+	// 4 vectors per iteration
+	LSR $2, R3, R3
+	CBZ R3, dot3_32_done
+
+dot3_32_loop:
+	WORD $0x4cdf4820 // VLD3.P 48(R1), [V0.S4, V1.S4, V2.S4]
+	WORD $0x4cdf4843 // VLD3.P 48(R2), [V3.S4, V4.S4, V5.S4]
+	WORD $0x6e23dc00 // VFMUL V3.S4, V0.S4, V0.S4
+	WORD $0x6e24dc21 // VFMUL V4.S4, V1.S4, V1.S4
+	WORD $0x6e25dc42 // VFMUL V5.S4, V2.S4, V2.S4
+	WORD $0x4e21d400 // VFADD V1.S4, V0.S4, V0.S4
+	WORD $0x4e22d400 // VFADD V2.S4, V0.S4, V0.S4
+	VST1.P [V0.S4], 16(R0)
+	SUB  $1, R3
+	CBNZ R3, dot3_32_loop
+
+dot3_32_done:
+	RET
+
+// func normalize3Float64NEONBlocks(dst, src []float64)
+TEXT ·normalize3Float64NEONBlocks(SB), NOSPLIT, $0-48
+	MOVD dst_base+0(FP), R0
+	MOVD dst_len+8(FP), R3
+	MOVD src_base+24(FP), R1
+	ADD  R3<<3, R1, R4
+
+	MOVD $0x3ff0000000000000, R5
+	VDUP R5, V31.D2
+	VEOR V30.B16, V30.B16, V30.B16
+
+norm64_loop:
+	CMP R4, R1
+	BHS norm64_done
+
+	WORD $0x4cdf4c20 // VLD3.P 48(R1), [V0.D2, V1.D2, V2.D2]
+	WORD $0x6e60dc03 // VFMUL V0.D2, V0.D2, V3.D2
+	WORD $0x6e61dc24 // VFMUL V1.D2, V1.D2, V4.D2
+	WORD $0x6e62dc45 // VFMUL V2.D2, V2.D2, V5.D2
+	WORD $0x4e64d463 // VFADD V4.D2, V3.D2, V3.D2
+	WORD $0x4e65d463 // VFADD V5.D2, V3.D2, V3.D2
+
+	// V3 holds the lengths, V5 flags the zero ones
+	WORD $0x6ee1f863 // VFSQRT V3.D2, V3.D2
+	WORD $0x4e7ee465 // VFCMEQ V30.D2, V3.D2, V5.D2
+	WORD $0x6e63ffe4 // VFDIV V3.D2, V31.D2, V4.D2
+
+	WORD $0x6e64dc00 // VFMUL V4.D2, V0.D2, V0.D2
+	WORD $0x6e64dc21 // VFMUL V4.D2, V1.D2, V1.D2
+	WORD $0x6e64dc42 // VFMUL V4.D2, V2.D2, V2.D2
+	WORD $0x4e651c00 // VBIC V5.B16, V0.B16, V0.B16
+	WORD $0x4e651c21 // VBIC V5.B16, V1.B16, V1.B16
+	WORD $0x4e651c42 // VBIC V5.B16, V2.B16, V2.B16
+	WORD $0x4c9f4c00 // VST3.P [V0.D2, V1.D2, V2.D2], 48(R0)
+	B    norm64_loop
+
+norm64_done:
+	RET
+
+// func normalize3Float32NEONBlocks(dst, src []float32)
+TEXT ·normalize3Float32NEONBlocks(SB), NOSPLIT, $0-48
+	MOVD dst_base+0(FP), R0
+	MOVD dst_len+8(FP), R3
+	MOVD src_base+24(FP), R1
+	ADD  R3<<2, R1, R4
+
+	MOVW $0x3f800000, R5
+	VDUP R5, V31.S4
+	VEOR V30.B16, V30.B16, V30.B16
+
+norm32_loop:
+	CMP R4, R1
+	BHS norm32_done
+
+	WORD $0x4cdf4820 // VLD3.P 48(R1), [V0.S4, V1.S4, V2.S4]
+	WORD $0x6e20dc03 // VFMUL V0.S4, V0.S4, V3.S4
+	WORD $0x6e21dc24 // VFMUL V1.S4, V1.S4, V4.S4
+	WORD $0x6e22dc45 // VFMUL V2.S4, V2.S4, V5.S4
+	WORD $0x4e24d463 // VFADD V4.S4, V3.S4, V3.S4
+	WORD $0x4e25d463 // VFADD V5.S4, V3.S4, V3.S4
+
+	// V3 holds the lengths, V5 flags the zero ones
+	WORD $0x6ea1f863 // VFSQRT V3.S4, V3.S4
+	WORD $0x4e3ee465 // VFCMEQ V30.S4, V3.S4, V5.S4
+	WORD $0x6e23ffe4 // VFDIV V3.S4, V31.S4, V4.S4
+
+	WORD $0x6e24dc00 // VFMUL V4.S4, V0.S4, V0.S4
+	WORD $0x6e24dc21 // VFMUL V4.S4, V1.S4, V1.S4
+	WORD $0x6e24dc42 // VFMUL V4.S4, V2.S4, V2.S4
+	WORD $0x4e651c00 // VBIC V5.B16, V0.B16, V0.B16
+	WORD $0x4e651c21 // VBIC V5.B16, V1.B16, V1.B16
+	WORD $0x4e651c42 // VBIC V5.B16, V2.B16, V2.B16
+	WORD $0x4c9f4800 // VST3.P [V0.S4, V1.S4, V2.S4], 48(R0)
+	B    norm32_loop
+
+norm32_done:
+	RET
+
+// func minMax3Float64NEONBlocks(src []float64, lanes *[12]float64)
+TEXT ·minMax3Float64NEONBlocks(SB), NOSPLIT, $0-32
+	MOVD src_base+0(FP), R1
+	MOVD src_len+8(FP), R3
+	MOVD lanes+24(FP), R0
+	ADD  R3<<3, R1, R4
+
+	WORD $0x4cdf4c30 // VLD3.P 48(R1), [V16.D2, V17.D2, V18.D2]
+	VORR   V16.B16, V16.B16, V19.B16
+	VORR   V17.B16, V17.B16, V20.B16
+	VORR   V18.B16, V18.B16, V21.B16
+
+minmax64_loop:
+	CMP R4, R1
+	BHS minmax64_done
+
+	WORD $0x4cdf4c20 // VLD3.P 48(R1), [V0.D2, V1.D2, V2.D2]
+	WORD $0x4ee0f610 // VFMIN V0.D2, V16.D2, V16.D2
+	WORD $0x4ee1f631 // VFMIN V1.D2, V17.D2, V17.D2
+	WORD $0x4ee2f652 // VFMIN V2.D2, V18.D2, V18.D2
+	WORD $0x4e60f673 // VFMAX V0.D2, V19.D2, V19.D2
+	WORD $0x4e61f694 // VFMAX V1.D2, V20.D2, V20.D2
+	WORD $0x4e62f6b5 // VFMAX V2.D2, V21.D2, V21.D2
+	B    minmax64_loop
+
+minmax64_done:
+	WORD $0x4c9f4c10 // VST3.P [V16.D2, V17.D2, V18.D2], 48(R0)
+	WORD $0x4c004c13 // VST3 [V19.D2, V20.D2, V21.D2], (R0)
+	RET
+
+// func minMax3Float32NEONBlocks(src []float32, lanes *[24]float32)
+TEXT ·minMax3Float32NEONBlocks(SB), NOSPLIT, $0-32
+	MOVD src_base+0(FP), R1
+	MOVD src_len+8(FP), R3
+	MOVD lanes+24(FP), R0
+	ADD  R3<<2, R1, R4
+
+	WORD $0x4cdf4830 // VLD3.P 48(R1), [V16.S4, V17.S4, V18.S4]
+	VORR   V16.B16, V16.B16, V19.B16
+	VORR   V17.B16, V17.B16, V20.B16
+	VORR   V18.B16, V18.B16, V21.B16
+
+minmax32_loop:
+	CMP R4, R1
+	BHS minmax32_done
+
+	WORD $0x4cdf4820 // VLD3.P 48(R1), [V0.S4, V1.S4, V2.S4]
+	WORD $0x4ea0f610 // VFMIN V0.S4, V16.S4, V16.S4
+	WORD $0x4ea1f631 // VFMIN V1.S4, V17.S4, V17.S4
+	WORD $0x4ea2f652 // VFMIN V2.S4, V18.S4, V18.S4
+	WORD $0x4e20f673 // VFMAX V0.S4, V19.S4, V19.S4
+	WORD $0x4e21f694 // VFMAX V1.S4, V20.S4, V20.S4
+	WORD $0x4e22f6b5 // VFMAX V2.S4, V21.S4, V21.S4
+	B    minmax32_loop
+
+minmax32_done:
+	WORD $0x4c9f4810 // VST3.P [V16.S4, V17.S4, V18.S4], 48(R0)
+	WORD $0x4c004813 // VST3 [V19.S4, V20.S4, V21.S4], (R0)
+	RET
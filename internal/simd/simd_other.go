@@ -0,0 +1,19 @@
+//go:build !(amd64 || arm64) || purego
+
+package simd
+
+var (
+	addFloat64 = addFloat64Generic
+	subFloat64 = subFloat64Generic
+	mulFloat64 = mulFloat64Generic
+	addFloat32 = addFloat32Generic
+	subFloat32 = subFloat32Generic
+	mulFloat32 = mulFloat32Generic
+
+	dot3Float64       = dot3Float64Generic
+	dot3Float32       = dot3Float32Generic
+	normalize3Float64 = normalize3Float64Generic
+	normalize3Float32 = normalize3Float32Generic
+	minMax3Float64    = minMax3Float64Generic
+	minMax3Float32    = minMax3Float32Generic
+)
@@ -0,0 +1,247 @@
+package simd
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFloat64Kernels(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	kernels := map[string]struct {
+		kernel, generic func(dst, a, b []float64)
+	}{
+		"add": {AddFloat64, addFloat64Generic},
+		"sub": {SubFloat64, subFloat64Generic},
+		"mul": {MulFloat64, mulFloat64Generic},
+	}
+
+	for name, k := range kernels {
+		t.Run(name, func(t *testing.T) {
+			// cover both the vector loop and the scalar tail
+			for n := 0; n < 40; n++ {
+				a, b := make([]float64, n), make([]float64, n)
+				for i := range a {
+					a[i] = r.NormFloat64()
+					b[i] = r.NormFloat64()
+				}
+
+				got, want := make([]float64, n), make([]float64, n)
+				k.kernel(got, a, b)
+				k.generic(want, a, b)
+				assert.Equal(t, want, got)
+			}
+		})
+	}
+}
+
+func TestFloat32Kernels(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	kernels := map[string]struct {
+		kernel, generic func(dst, a, b []float32)
+	}{
+		"add": {AddFloat32, addFloat32Generic},
+		"sub": {SubFloat32, subFloat32Generic},
+		"mul": {MulFloat32, mulFloat32Generic},
+	}
+
+	for name, k := range kernels {
+		t.Run(name, func(t *testing.T) {
+			for n := 0; n < 40; n++ {
+				a, b := make([]float32, n), make([]float32, n)
+				for i := range a {
+					a[i] = float32(r.NormFloat64())
+					b[i] = float32(r.NormFloat64())
+				}
+
+				got, want := make([]float32, n), make([]float32, n)
+				k.kernel(got, a, b)
+				k.generic(want, a, b)
+				assert.Equal(t, want, got)
+			}
+		})
+	}
+}
+
+func randomFloat64s(r *rand.Rand, n int) []float64 {
+	out := make([]float64, n)
+	for i := range out {
+		out[i] = r.NormFloat64() * 10
+	}
+	return out
+}
+
+func randomFloat32s(r *rand.Rand, n int) []float32 {
+	out := make([]float32, n)
+	for i := range out {
+		out[i] = float32(r.NormFloat64() * 10)
+	}
+	return out
+}
+
+func TestXYZKernels(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+
+	// cover several whole blocks along with every length of remainder
+	for n := 0; n < 40; n++ {
+		a64, b64 := randomFloat64s(r, 3*n), randomFloat64s(r, 3*n)
+		a32, b32 := randomFloat32s(r, 3*n), randomFloat32s(r, 3*n)
+		if n > 5 {
+			// zero length vectors normalize to zero, including ones whose
+			// squared components underflow
+			copy(a64[6:], []float64{0, 0, 0, 1e-200, -1e-200, 0})
+			copy(a32[6:], []float32{0, 0, 0, 1e-30, -1e-30, 0})
+		}
+
+		got64, want64 := make([]float64, n), make([]float64, n)
+		Dot3Float64(got64, a64, b64)
+		dot3Float64Generic(want64, a64, b64)
+		assert.Equal(t, want64, got64)
+
+		got32, want32 := make([]float32, n), make([]float32, n)
+		Dot3Float32(got32, a32, b32)
+		dot3Float32Generic(want32, a32, b32)
+		assert.Equal(t, want32, got32)
+
+		gotNorm64, wantNorm64 := make([]float64, 3*n), make([]float64, 3*n)
+		Normalize3Float64(gotNorm64, a64)
+		normalize3Float64Generic(wantNorm64, a64)
+		assert.Equal(t, wantNorm64, gotNorm64)
+
+		gotNorm32, wantNorm32 := make([]float32, 3*n), make([]float32, 3*n)
+		Normalize3Float32(gotNorm32, a32)
+		normalize3Float32Generic(wantNorm32, a32)
+		assert.Equal(t, wantNorm32, gotNorm32)
+
+		if n == 0 {
+			continue
+		}
+
+		gotMin64, gotMax64 := MinMax3Float64(a64)
+		wantMin64, wantMax64 := minMax3Float64Generic(a64)
+		assert.Equal(t, wantMin64, gotMin64)
+		assert.Equal(t, wantMax64, gotMax64)
+
+		gotMin32, gotMax32 := MinMax3Float32(a32)
+		wantMin32, wantMax32 := minMax3Float32Generic(a32)
+		assert.Equal(t, wantMin32, gotMin32)
+		assert.Equal(t, wantMax32, gotMax32)
+	}
+}
+
+func TestNormalizeInPlace(t *testing.T) {
+	v := []float32{3, 0, 4, 0, 0, 0, 0, 2, 0, 3, 0, 4, 0, 0, 0, 0, 2, 0, 3, 0, 4, 0, 0, 0, 0, 2, 0}
+	Normalize3Float32(v, v)
+	assert.Equal(t, []float32{.6, 0, .8, 0, 0, 0, 0, 1, 0, .6, 0, .8, 0, 0, 0, 0, 1, 0, .6, 0, .8, 0, 0, 0, 0, 1, 0}, v)
+}
+
+func TestXYZKernelsLengths(t *testing.T) {
+	assert.PanicsWithValue(t, "simd: slices must have the same length", func() {
+		Dot3Float64(make([]float64, 2), make([]float64, 6), make([]float64, 3))
+	})
+	assert.PanicsWithValue(t, "simd: length must be a multiple of 3", func() {
+		Normalize3Float32(make([]float32, 4), make([]float32, 4))
+	})
+	assert.PanicsWithValue(t, "simd: no values to reduce", func() {
+		MinMax3Float64(nil)
+	})
+}
+
+func TestKernelsInPlace(t *testing.T) {
+	a := []float64{1, 2, 3, 4, 5, 6, 7, 8, 9}
+	AddFloat64(a, a, a)
+	assert.Equal(t, []float64{2, 4, 6, 8, 10, 12, 14, 16, 18}, a)
+}
+
+func TestKernelsLengthMismatch(t *testing.T) {
+	assert.PanicsWithValue(t, "simd: slices must have the same length", func() {
+		AddFloat32(make([]float32, 2), make([]float32, 3), make([]float32, 2))
+	})
+}
+
+func BenchmarkAddFloat32(b *testing.B) {
+	x := make([]float32, 300_000)
+	b.SetBytes(int64(len(x) * 4 * 3))
+	for i := 0; i < b.N; i++ {
+		AddFloat32(x, x, x)
+	}
+}
+
+func BenchmarkAddFloat32Generic(b *testing.B) {
+	x := make([]float32, 300_000)
+	b.SetBytes(int64(len(x) * 4 * 3))
+	for i := 0; i < b.N; i++ {
+		addFloat32Generic(x, x, x)
+	}
+}
+
+func BenchmarkDot3Float32(b *testing.B) {
+	x := make([]float32, 300_000)
+	dst := make([]float32, len(x)/3)
+	b.SetBytes(int64(len(x) * 4 * 2))
+	for i := 0; i < b.N; i++ {
+		Dot3Float32(dst, x, x)
+	}
+}
+
+func BenchmarkDot3Float32Generic(b *testing.B) {
+	x := make([]float32, 300_000)
+	dst := make([]float32, len(x)/3)
+	b.SetBytes(int64(len(x) * 4 * 2))
+	for i := 0; i < b.N; i++ {
+		dot3Float32Generic(dst, x, x)
+	}
+}
+
+func BenchmarkNormalize3Float32(b *testing.B) {
+	x := randomFloat32s(rand.New(rand.NewSource(42)), 300_000)
+	dst := make([]float32, len(x))
+	b.SetBytes(int64(len(x) * 4))
+	for i := 0; i < b.N; i++ {
+		Normalize3Float32(dst, x)
+	}
+}
+
+func BenchmarkNormalize3Float32Generic(b *testing.B) {
+	x := randomFloat32s(rand.New(rand.NewSource(42)), 300_000)
+	dst := make([]float32, len(x))
+	b.SetBytes(int64(len(x) * 4))
+	for i := 0; i < b.N; i++ {
+		normalize3Float32Generic(dst, x)
+	}
+}
+
+func BenchmarkMinMax3Float32(b *testing.B) {
+	x := randomFloat32s(rand.New(rand.NewSource(42)), 300_000)
+	b.SetBytes(int64(len(x) * 4))
+	for i := 0; i < b.N; i++ {
+		MinMax3Float32(x)
+	}
+}
+
+func BenchmarkMinMax3Float32Generic(b *testing.B) {
+	x := randomFloat32s(rand.New(rand.NewSource(42)), 300_000)
+	b.SetBytes(int64(len(x) * 4))
+	for i := 0; i < b.N; i++ {
+		minMax3Float32Generic(x)
+	}
+}
+
+func BenchmarkNormalize3Float64(b *testing.B) {
+	x := randomFloat64s(rand.New(rand.NewSource(42)), 300_000)
+	dst := make([]float64, len(x))
+	b.SetBytes(int64(len(x) * 8))
+	for i := 0; i < b.N; i++ {
+		Normalize3Float64(dst, x)
+	}
+}
+
+func BenchmarkNormalize3Float64Generic(b *testing.B) {
+	x := randomFloat64s(rand.New(rand.NewSource(42)), 300_000)
+	dst := make([]float64, len(x))
+	b.SetBytes(int64(len(x) * 8))
+	for i := 0; i < b.N; i++ {
+		normalize3Float64Generic(dst, x)
+	}
+}
@@ -0,0 +1,114 @@
+package intersect_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector/intersect"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/EliCDavis/vector/vectortest"
+	"github.com/stretchr/testify/assert"
+)
+
+func ray(ox, oy, oz, dx, dy, dz float64) intersect.Ray {
+	return intersect.Ray{Origin: vector3.New(ox, oy, oz), Direction: vector3.New(dx, dy, dz)}
+}
+
+func TestRaySphere(t *testing.T) {
+	sphere := intersect.Sphere{Center: vector3.New(0., 0., 5.), Radius: 1}
+
+	hit, ok := intersect.RaySphere(ray(0, 0, 0, 0, 0, 1), sphere)
+	assert.True(t, ok)
+	assert.Equal(t, 4., hit.Distance)
+	assert.Equal(t, vector3.New(0., 0., 4.), hit.Point)
+	assert.Equal(t, vector3.New(0., 0., -1.), hit.Normal)
+
+	// From inside, the far side is hit with the normal facing inwards
+	hit, ok = intersect.RaySphere(ray(0, 0, 5, 0, 0, 1), sphere)
+	assert.True(t, ok)
+	assert.Equal(t, 1., hit.Distance)
+	assert.Equal(t, vector3.New(0., 0., -1.), hit.Normal)
+
+	_, ok = intersect.RaySphere(ray(0, 0, 0, 0, 0, -1), sphere)
+	assert.False(t, ok)
+	_, ok = intersect.RaySphere(ray(0, 2, 0, 0, 0, 1), sphere)
+	assert.False(t, ok)
+}
+
+func TestRayAABB(t *testing.T) {
+	box := intersect.AABB{Min: vector3.New(-1., -1., 2.), Max: vector3.New(1., 1., 4.)}
+
+	hit, ok := intersect.RayAABB(ray(0, 0, 0, 0, 0, 2), box)
+	assert.True(t, ok)
+	assert.Equal(t, 1., hit.Distance)
+	assert.Equal(t, vector3.New(0., 0., 2.), hit.Point)
+	assert.Equal(t, vector3.New(0., 0., -1.), hit.Normal)
+
+	hit, ok = intersect.RayAABB(ray(5, 0.5, 3, -1, 0, 0), box)
+	assert.True(t, ok)
+	assert.Equal(t, 4., hit.Distance)
+	assert.Equal(t, vector3.New(1., 0., 0.), hit.Normal)
+
+	hit, ok = intersect.RayAABB(ray(0, 0, 3, 0, 1, 0), box)
+	assert.True(t, ok)
+	assert.Equal(t, 1., hit.Distance)
+	assert.Equal(t, vector3.New(0., -1., 0.), hit.Normal)
+
+	_, ok = intersect.RayAABB(ray(0, 0, 0, 0, 0, -1), box)
+	assert.False(t, ok)
+	_, ok = intersect.RayAABB(ray(2, 0, 0, 0, 0, 1), box)
+	assert.False(t, ok)
+	_, ok = intersect.RayAABB(ray(-3, 0, 0, 1, 0, 0.1), box)
+	assert.False(t, ok)
+}
+
+func TestRayTriangle(t *testing.T) {
+	tri := intersect.Triangle{A: vector3.New(0., 0., 1.), B: vector3.New(1., 0., 1.), C: vector3.New(0., 1., 1.)}
+
+	hit, ok := intersect.RayTriangle(ray(0.25, 0.25, 3, 0, 0, -1), tri)
+	assert.True(t, ok)
+	assert.Equal(t, 2., hit.Distance)
+	vectortest.AssertVector3InDelta(t, vector3.New(0.25, 0.25, 1.), hit.Point, 1e-12)
+	assert.Equal(t, vector3.New(0., 0., 1.), hit.Normal)
+
+	hit, ok = intersect.RayTriangle(ray(0.25, 0.25, 0, 0, 0, 1), tri)
+	assert.True(t, ok)
+	assert.Equal(t, vector3.New(0., 0., -1.), hit.Normal)
+
+	_, ok = intersect.RayTriangle(ray(0.75, 0.75, 3, 0, 0, -1), tri)
+	assert.False(t, ok)
+	_, ok = intersect.RayTriangle(ray(0.25, 0.25, 3, 1, 0, 0), tri)
+	assert.False(t, ok)
+	_, ok = intersect.RayTriangle(ray(0.25, 0.25, 3, 0, 0, 1), tri)
+	assert.False(t, ok)
+}
+
+func TestRayPlane(t *testing.T) {
+	ground := intersect.Plane{Normal: vector3.Up[float64](), Distance: -2}
+
+	hit, ok := intersect.RayPlane(ray(1, 3, 1, 0, -1, 0), ground)
+	assert.True(t, ok)
+	assert.Equal(t, 5., hit.Distance)
+	assert.Equal(t, vector3.New(1., -2., 1.), hit.Point)
+	assert.Equal(t, vector3.Up[float64](), hit.Normal)
+
+	hit, ok = intersect.RayPlane(ray(0, -5, 0, 0, 1, 0), ground)
+	assert.True(t, ok)
+	assert.Equal(t, vector3.Down[float64](), hit.Normal)
+
+	_, ok = intersect.RayPlane(ray(0, 3, 0, 1, 0, 0), ground)
+	assert.False(t, ok)
+	_, ok = intersect.RayPlane(ray(0, 3, 0, 0, 1, 0), ground)
+	assert.False(t, ok)
+}
+
+func TestOverlaps(t *testing.T) {
+	box := intersect.AABB{Min: vector3.Zero[float64](), Max: vector3.One[float64]()}
+
+	assert.True(t, intersect.SphereAABB(intersect.Sphere{Center: vector3.Fill(0.5), Radius: 0.1}, box))
+	assert.True(t, intersect.SphereAABB(intersect.Sphere{Center: vector3.New(2., 0.5, 0.5), Radius: 1}, box))
+	assert.False(t, intersect.SphereAABB(intersect.Sphere{Center: vector3.Fill(2.), Radius: 1.7}, box))
+
+	assert.True(t, intersect.AABBAABB(box, intersect.AABB{Min: vector3.Fill(0.5), Max: vector3.Fill(3.)}))
+	assert.True(t, intersect.AABBAABB(box, intersect.AABB{Min: vector3.New(1., 0., 0.), Max: vector3.Fill(3.)}))
+	assert.False(t, intersect.AABBAABB(box, intersect.AABB{Min: vector3.New(1.1, 0., 0.), Max: vector3.Fill(3.)}))
+}
@@ -0,0 +1,18 @@
+package intersect
+
+import "github.com/EliCDavis/vector/vector3"
+
+// SphereAABB reports whether the sphere and box overlap, including when they
+// only touch
+func SphereAABB(s Sphere, b AABB) bool {
+	closest := vector3.Max(b.Min, vector3.Min(b.Max, s.Center))
+	return closest.DistanceSquared(s.Center) <= s.Radius*s.Radius
+}
+
+// AABBAABB reports whether the two boxes overlap, including when they only
+// touch
+func AABBAABB(a, b AABB) bool {
+	return a.Min.X() <= b.Max.X() && a.Max.X() >= b.Min.X() &&
+		a.Min.Y() <= b.Max.Y() && a.Max.Y() >= b.Min.Y() &&
+		a.Min.Z() <= b.Max.Z() && a.Max.Z() >= b.Min.Z()
+}
@@ -0,0 +1,160 @@
+package intersect
+
+import (
+	"math"
+
+	"github.com/EliCDavis/vector/mathex"
+	"github.com/EliCDavis/vector/vector3"
+)
+
+// epsilon is the tolerance used to reject rays parallel to planes and
+// triangles
+const epsilon = 1e-12
+
+// RaySphere intersects the ray with the sphere, returning the closest hit in
+// front of the ray's origin. Rays starting inside the sphere hit its far side
+func RaySphere(r Ray, s Sphere) (Hit, bool) {
+	oc := r.Origin.Sub(s.Center)
+	a := r.Direction.LengthSquared()
+	halfB := oc.Dot(r.Direction)
+	c := oc.LengthSquared() - s.Radius*s.Radius
+
+	discriminant := halfB*halfB - a*c
+	if a == 0 || discriminant < 0 {
+		return Hit{}, false
+	}
+
+	root := math.Sqrt(discriminant)
+	t := (-halfB - root) / a
+	if t < 0 {
+		t = (-halfB + root) / a
+		if t < 0 {
+			return Hit{}, false
+		}
+	}
+
+	point := r.At(t)
+	normal := point.Sub(s.Center).Normalized()
+	if c < 0 {
+		normal = normal.Scale(-1)
+	}
+	return Hit{Distance: t, Point: point, Normal: normal}, true
+}
+
+// RayAABB intersects the ray with the box using the slab method, returning
+// the closest hit in front of the ray's origin. Rays starting inside the box
+// hit the face they exit through
+func RayAABB(r Ray, b AABB) (Hit, bool) {
+	origin := [3]float64{r.Origin.X(), r.Origin.Y(), r.Origin.Z()}
+	dir := [3]float64{r.Direction.X(), r.Direction.Y(), r.Direction.Z()}
+	lo := [3]float64{b.Min.X(), b.Min.Y(), b.Min.Z()}
+	hi := [3]float64{b.Max.X(), b.Max.Y(), b.Max.Z()}
+
+	tNear, tFar := math.Inf(-1), math.Inf(1)
+	nearAxis, farAxis := -1, -1
+	var nearSign, farSign float64
+
+	for i := 0; i < 3; i++ {
+		if dir[i] == 0 {
+			if origin[i] < lo[i] || origin[i] > hi[i] {
+				return Hit{}, false
+			}
+			continue
+		}
+
+		t0 := (lo[i] - origin[i]) / dir[i]
+		t1 := (hi[i] - origin[i]) / dir[i]
+		sign := -1.
+		if t0 > t1 {
+			t0, t1 = t1, t0
+			sign = 1
+		}
+
+		if t0 > tNear {
+			tNear, nearAxis, nearSign = t0, i, sign
+		}
+		if t1 < tFar {
+			tFar, farAxis, farSign = t1, i, -sign
+		}
+		if tNear > tFar {
+			return Hit{}, false
+		}
+	}
+
+	if tFar < 0 {
+		return Hit{}, false
+	}
+
+	t, axis, sign := tNear, nearAxis, nearSign
+	if tNear < 0 {
+		// Starting inside the box, the normal of the exit face points back
+		// inwards towards the origin
+		t, axis, sign = tFar, farAxis, -farSign
+	}
+	if axis == -1 {
+		// A zero direction from inside the box never reaches a face
+		return Hit{}, false
+	}
+
+	var n [3]float64
+	n[axis] = sign
+	return Hit{Distance: t, Point: r.At(t), Normal: vector3.New(n[0], n[1], n[2])}, true
+}
+
+// RayTriangle intersects the ray with the triangle using the Möller–Trumbore
+// algorithm. Both faces of the triangle can be hit, with the reported normal
+// facing the side the ray arrived from
+func RayTriangle(r Ray, tri Triangle) (Hit, bool) {
+	e1 := tri.B.Sub(tri.A)
+	e2 := tri.C.Sub(tri.A)
+
+	p := r.Direction.Cross(e2)
+	det := e1.Dot(p)
+	if mathex.ApproximatelyWithin(det, 0, epsilon) {
+		return Hit{}, false
+	}
+	inv := 1 / det
+
+	s := r.Origin.Sub(tri.A)
+	u := s.Dot(p) * inv
+	if u < 0 || u > 1 {
+		return Hit{}, false
+	}
+
+	q := s.Cross(e1)
+	v := r.Direction.Dot(q) * inv
+	if v < 0 || u+v > 1 {
+		return Hit{}, false
+	}
+
+	t := e2.Dot(q) * inv
+	if t < 0 {
+		return Hit{}, false
+	}
+
+	normal := e1.Cross(e2).Normalized()
+	if det < 0 {
+		normal = normal.Scale(-1)
+	}
+	return Hit{Distance: t, Point: r.At(t), Normal: normal}, true
+}
+
+// RayPlane intersects the ray with the plane. The reported normal faces the
+// side of the plane the ray arrived from
+func RayPlane(r Ray, p Plane) (Hit, bool) {
+	denom := p.Normal.Dot(r.Direction)
+	if mathex.ApproximatelyWithin(denom, 0, epsilon) {
+		return Hit{}, false
+	}
+
+	t := (p.Distance - p.Normal.Dot(r.Origin)) / denom
+	if t < 0 {
+		return Hit{}, false
+	}
+
+	normal := p.Normal
+	if denom > 0 {
+		normal = normal.Scale(-1)
+	}
+	return Hit{Distance: t, Point: r.At(t), Normal: normal}, true
+}
@@ -0,0 +1,58 @@
+// Package intersect implements the standard intersection tests between rays
+// and common 3D primitives, along with overlap tests between the primitives
+// themselves.
+package intersect
+
+import "github.com/EliCDavis/vector/vector3"
+
+// Ray is a half line starting at Origin and extending along Direction.
+// Distances reported along the ray are measured in multiples of Direction's
+// length, so they're true distances when Direction is normalized
+type Ray struct {
+	Origin    vector3.Float64
+	Direction vector3.Float64
+}
+
+// At returns the point along the ray at distance t
+func (r Ray) At(t float64) vector3.Float64 {
+	return r.Origin.Add(r.Direction.Scale(t))
+}
+
+// Sphere is the solid ball of points within Radius of Center
+type Sphere struct {
+	Center vector3.Float64
+	Radius float64
+}
+
+// AABB is an axis aligned bounding box spanning from Min to Max
+type AABB struct {
+	Min vector3.Float64
+	Max vector3.Float64
+}
+
+// Triangle is the triangle with corners A, B, and C. Its front face is the
+// one from which the corners appear counter-clockwise
+type Triangle struct {
+	A, B, C vector3.Float64
+}
+
+// Plane is the set of points p where Normal·p = Distance. Normal is expected
+// to be unit length
+type Plane struct {
+	Normal   vector3.Float64
+	Distance float64
+}
+
+// Hit describes where a ray struck a primitive
+type Hit struct {
+	// Distance along the ray at which the hit occurred
+	Distance float64
+
+	// Point is the position of the hit
+	Point vector3.Float64
+
+	// Normal is the unit surface normal of the primitive at Point. For rays
+	// starting inside a sphere or box it points inwards, towards the ray's
+	// origin
+	Normal vector3.Float64
+}
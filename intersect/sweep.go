@@ -0,0 +1,145 @@
+package intersect
+
+import (
+	"math"
+
+	"github.com/EliCDavis/vector/mathex"
+	"github.com/EliCDavis/vector/vector3"
+)
+
+// Impact describes when and where a moving shape first touches another
+type Impact struct {
+	// Time is the fraction of the movement, from 0 to 1, completed when the
+	// shapes first touch
+	Time float64
+
+	// Normal is the unit normal of the struck shape's surface at the point of
+	// contact, pointing towards the moving shape. It's zero when the shapes
+	// overlap before moving
+	Normal vector3.Float64
+}
+
+// SweepAABBAABB moves box a by velocity and reports the first time it touches
+// box b. Boxes that already overlap report an impact at time 0. To sweep two
+// moving boxes against each other, move a by the difference of their
+// velocities.
+func SweepAABBAABB(a AABB, velocity vector3.Float64, b AABB) (Impact, bool) {
+	if AABBAABB(a, b) {
+		return Impact{}, true
+	}
+
+	// Sweeping a against b is the same as casting a ray from a's center
+	// against b grown by a's half size
+	half := a.Max.Sub(a.Min).Scale(0.5)
+	grown := AABB{Min: b.Min.Sub(half), Max: b.Max.Add(half)}
+	hit, ok := RayAABB(Ray{Origin: a.Min.Add(half), Direction: velocity}, grown)
+	if !ok || hit.Distance > 1 {
+		return Impact{}, false
+	}
+	return Impact{Time: hit.Distance, Normal: hit.Normal}, true
+}
+
+// SweepSphereAABB moves the sphere by velocity and reports the first time it
+// touches the box. A sphere that already overlaps the box reports an impact
+// at time 0.
+func SweepSphereAABB(s Sphere, velocity vector3.Float64, b AABB) (Impact, bool) {
+	if SphereAABB(s, b) {
+		return Impact{}, true
+	}
+
+	// The sphere's center touches the box grown by the sphere's radius with
+	// rounded edges and corners, which is the union of the box grown along
+	// each axis alone, a cylinder around each edge, and a sphere around each
+	// corner. The ray starts outside all of them, so its first hit on any of
+	// them is its first hit on the union.
+	ray := Ray{Origin: s.Center, Direction: velocity}
+	lo := [3]float64{b.Min.X(), b.Min.Y(), b.Min.Z()}
+	hi := [3]float64{b.Max.X(), b.Max.Y(), b.Max.Z()}
+	corner := func(x, y, z int) vector3.Float64 {
+		return vector3.New(pick(lo[0], hi[0], x), pick(lo[1], hi[1], y), pick(lo[2], hi[2], z))
+	}
+
+	t := math.Inf(1)
+	for axis := 0; axis < 3; axis++ {
+		var grow [3]float64
+		grow[axis] = s.Radius
+		g := vector3.New(grow[0], grow[1], grow[2])
+		if hit, ok := RayAABB(ray, AABB{Min: b.Min.Sub(g), Max: b.Max.Add(g)}); ok {
+			t = math.Min(t, hit.Distance)
+		}
+	}
+
+	for x := 0; x < 2; x++ {
+		for y := 0; y < 2; y++ {
+			for z := 0; z < 2; z++ {
+				c := corner(x, y, z)
+				if hit, ok := RaySphere(ray, Sphere{Center: c, Radius: s.Radius}); ok {
+					t = math.Min(t, hit.Distance)
+				}
+
+				// Each corner starts the edges running along the axes it's
+				// at the minimum of
+				for _, end := range []vector3.Float64{corner(1, y, z), corner(x, 1, z), corner(x, y, 1)} {
+					if end == c {
+						continue
+					}
+					if d, ok := rayCylinder(ray, c, end, s.Radius); ok {
+						t = math.Min(t, d)
+					}
+				}
+			}
+		}
+	}
+
+	if t > 1 {
+		return Impact{}, false
+	}
+
+	center := ray.At(t)
+	closest := vector3.Max(b.Min, vector3.Min(b.Max, center))
+	return Impact{Time: t, Normal: center.Sub(closest).Normalized()}, true
+}
+
+func pick(lo, hi float64, i int) float64 {
+	if i == 0 {
+		return lo
+	}
+	return hi
+}
+
+// rayCylinder intersects the ray with the curved side of the cylinder of the
+// given radius around the segment from p to q, ignoring its end caps, and
+// returns the distance along the ray of the point where it enters
+func rayCylinder(r Ray, p, q vector3.Float64, radius float64) (float64, bool) {
+	d := q.Sub(p)
+	m := r.Origin.Sub(p)
+	n := r.Direction
+
+	md, nd, dd := m.Dot(d), n.Dot(d), d.Dot(d)
+	nn, mn := n.Dot(n), m.Dot(n)
+
+	a := dd*nn - nd*nd
+	if mathex.ApproximatelyWithin(a, 0, epsilon) {
+		// Moving parallel to the axis can only reach the caps
+		return 0, false
+	}
+
+	k := m.Dot(m) - radius*radius
+	c := dd*k - md*md
+	b := dd*mn - nd*md
+	discriminant := b*b - a*c
+	if discriminant < 0 {
+		return 0, false
+	}
+
+	t := (-b - math.Sqrt(discriminant)) / a
+	if t < 0 {
+		return 0, false
+	}
+
+	// The hit has to lie alongside the segment rather than past its ends
+	if along := md + t*nd; along < 0 || along > dd {
+		return 0, false
+	}
+	return t, true
+}
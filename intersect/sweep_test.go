@@ -0,0 +1,96 @@
+package intersect_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/EliCDavis/vector/intersect"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/EliCDavis/vector/vectortest"
+	"github.com/stretchr/testify/assert"
+)
+
+var unitBox = intersect.AABB{Min: vector3.New(-1., -1., -1.), Max: vector3.New(1., 1., 1.)}
+
+func TestSweepAABBAABB(t *testing.T) {
+	box := intersect.AABB{Min: vector3.New(3., -0.5, -0.5), Max: vector3.New(4., 0.5, 0.5)}
+
+	impact, ok := intersect.SweepAABBAABB(box, vector3.New(-4., 0., 0.), unitBox)
+	assert.True(t, ok)
+	assert.InDelta(t, 0.5, impact.Time, 1e-12)
+	assert.Equal(t, vector3.New(1., 0., 0.), impact.Normal)
+
+	// Too short a move, and moving away, both miss
+	_, ok = intersect.SweepAABBAABB(box, vector3.New(-1., 0., 0.), unitBox)
+	assert.False(t, ok)
+	_, ok = intersect.SweepAABBAABB(box, vector3.New(4., 0., 0.), unitBox)
+	assert.False(t, ok)
+
+	// Passing above the box misses, while clipping its top edge hits
+	above := intersect.AABB{Min: vector3.New(3., 1.5, -0.5), Max: vector3.New(4., 2.5, 0.5)}
+	_, ok = intersect.SweepAABBAABB(above, vector3.New(-8., 0., 0.), unitBox)
+	assert.False(t, ok)
+	impact, ok = intersect.SweepAABBAABB(above, vector3.New(-4., -0.8, 0.), unitBox)
+	assert.True(t, ok)
+	assert.InDelta(t, 0.625, impact.Time, 1e-12)
+	assert.Equal(t, vector3.New(0., 1., 0.), impact.Normal)
+
+	impact, ok = intersect.SweepAABBAABB(unitBox, vector3.New(5., 0., 0.), unitBox)
+	assert.True(t, ok)
+	assert.Equal(t, intersect.Impact{}, impact)
+}
+
+func TestSweepSphereAABB(t *testing.T) {
+	tests := map[string]struct {
+		sphere   intersect.Sphere
+		velocity vector3.Float64
+		time     float64
+		normal   vector3.Float64
+	}{
+		"face": {
+			sphere:   intersect.Sphere{Center: vector3.New(0., 5., 0.), Radius: 1},
+			velocity: vector3.New(0., -6., 0.),
+			time:     0.5,
+			normal:   vector3.New(0., 1., 0.),
+		},
+		"edge": {
+			sphere:   intersect.Sphere{Center: vector3.New(3., 3., 0.), Radius: math.Sqrt2},
+			velocity: vector3.New(-2., -2., 0.),
+			time:     0.5,
+			normal:   vector3.New(1., 1., 0.).Normalized(),
+		},
+		"corner": {
+			sphere:   intersect.Sphere{Center: vector3.New(-3., -3., -3.), Radius: math.Sqrt(3)},
+			velocity: vector3.New(4., 4., 4.),
+			time:     0.25,
+			normal:   vector3.New(-1., -1., -1.).Normalized(),
+		},
+		"glancing edge": {
+			sphere:   intersect.Sphere{Center: vector3.New(5., 1.5, 0.), Radius: 1},
+			velocity: vector3.New(-10., 0., 0.),
+			time:     (4 - math.Sqrt(0.75)) / 10,
+			normal:   vector3.New(math.Sqrt(0.75), 0.5, 0.),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			impact, ok := intersect.SweepSphereAABB(tc.sphere, tc.velocity, unitBox)
+			assert.True(t, ok)
+			assert.InDelta(t, tc.time, impact.Time, 1e-9)
+			vectortest.AssertVector3InDelta(t, tc.normal, impact.Normal, 1e-9)
+		})
+	}
+
+	// Passing just beyond a corner misses, even though it would hit the
+	// box grown by the radius without rounding
+	_, ok := intersect.SweepSphereAABB(intersect.Sphere{Center: vector3.New(5., 1.9, 1.9), Radius: 1}, vector3.New(-10., 0., 0.), unitBox)
+	assert.False(t, ok)
+
+	_, ok = intersect.SweepSphereAABB(intersect.Sphere{Center: vector3.New(0., 5., 0.), Radius: 1}, vector3.New(0., -2., 0.), unitBox)
+	assert.False(t, ok)
+
+	impact, ok := intersect.SweepSphereAABB(intersect.Sphere{Center: vector3.New(1.5, 0., 0.), Radius: 1}, vector3.New(1., 0., 0.), unitBox)
+	assert.True(t, ok)
+	assert.Equal(t, intersect.Impact{}, impact)
+}
@@ -0,0 +1,115 @@
+package mathex
+
+import (
+	"math"
+)
+
+const (
+	float32RelativeTolerance = 1e-6
+	float32AbsoluteTolerance = 1e-7
+	float64RelativeTolerance = 1e-9
+	float64AbsoluteTolerance = 1e-12
+)
+
+// Approximately compares two values, returning true if they are close enough
+// to be considered equal. The difference between the two values is compared
+// relative to their magnitude, falling back to an absolute tolerance for
+// values close to zero. Tolerances are chosen based on the precision of the
+// type, and integer types are compared exactly.
+func Approximately[T Number](a, b T) bool {
+	if a == b {
+		return true
+	}
+
+	var rel, abs float64
+	switch any(a).(type) {
+	case float32:
+		rel, abs = float32RelativeTolerance, float32AbsoluteTolerance
+	case float64:
+		rel, abs = float64RelativeTolerance, float64AbsoluteTolerance
+	default:
+		return false
+	}
+
+	fa, fb := float64(a), float64(b)
+	if math.IsNaN(fa) || math.IsNaN(fb) || math.IsInf(fa, 0) || math.IsInf(fb, 0) {
+		return false
+	}
+
+	diff := math.Abs(fa - fb)
+	if diff <= abs {
+		return true
+	}
+	return diff <= rel*math.Max(math.Abs(fa), math.Abs(fb))
+}
+
+// ApproximatelyWithin compares two values, returning true if the difference
+// between them is no greater than tolerance, either absolutely or relative to
+// the largest magnitude of the two values.
+func ApproximatelyWithin[T Number](a, b T, tolerance float64) bool {
+	if a == b {
+		return true
+	}
+
+	fa, fb := float64(a), float64(b)
+	diff := math.Abs(fa - fb)
+	return diff <= tolerance || diff <= tolerance*math.Max(math.Abs(fa), math.Abs(fb))
+}
+
+// WithinULP returns true if a and b are no more than n units in the last place
+// apart. Values of opposite sign are measured through zero, so -0 and +0 are
+// equal and the smallest non-zero values either side of zero are 2 ULP apart.
+// NaN is never equal to anything. Integer types are compared by their
+// absolute difference.
+func WithinULP[T Number](a, b T, n uint64) bool {
+	switch fa := any(a).(type) {
+	case float32:
+		fb := any(b).(float32)
+		if fa != fa || fb != fb {
+			return false
+		}
+		return ulpDistance(float32Ordered(fa), float32Ordered(fb)) <= n
+
+	case float64:
+		fb := any(b).(float64)
+		if math.IsNaN(fa) || math.IsNaN(fb) {
+			return false
+		}
+		return ulpDistance(float64Ordered(fa), float64Ordered(fb)) <= n
+	}
+
+	// Subtracting in T can overflow, as int8(100) - int8(-100) does, but the
+	// difference always fits in a uint64 and unsigned arithmetic there wraps
+	// back to it exactly
+	if a > b {
+		return uint64(a)-uint64(b) <= n
+	}
+	return uint64(b)-uint64(a) <= n
+}
+
+// float32Ordered maps the bits of a float32 onto a monotonically increasing
+// integer line so that adjacent floats differ by exactly one.
+func float32Ordered(f float32) int64 {
+	bits := int64(int32(math.Float32bits(f)))
+	if bits < 0 {
+		return math.MinInt32 - bits
+	}
+	return bits
+}
+
+// float64Ordered maps the bits of a float64 onto a monotonically increasing
+// integer line so that adjacent floats differ by exactly one.
+func float64Ordered(f float64) int64 {
+	bits := int64(math.Float64bits(f))
+	if bits < 0 {
+		return math.MinInt64 - bits
+	}
+	return bits
+}
+
+func ulpDistance(a, b int64) uint64 {
+	if a > b {
+		return uint64(a) - uint64(b)
+	}
+	return uint64(b) - uint64(a)
+}
@@ -0,0 +1,75 @@
+package mathex_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/EliCDavis/vector/mathex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApproximately(t *testing.T) {
+	tests := map[string]struct {
+		a, b float64
+		want bool
+	}{
+		"equal":               {a: 1.5, b: 1.5, want: true},
+		"tiny relative diff":  {a: 1e10, b: 1e10 + 1e-1, want: true},
+		"large relative diff": {a: 1, b: 1.001, want: false},
+		"near zero":           {a: 0, b: 1e-13, want: true},
+		"opposite sign":       {a: -1, b: 1, want: false},
+		"NaN":                 {a: math.NaN(), b: math.NaN(), want: false},
+		"Inf":                 {a: math.Inf(1), b: math.Inf(1), want: true},
+		"Inf and max":         {a: math.Inf(1), b: math.MaxFloat64, want: false},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, mathex.Approximately(tc.a, tc.b))
+		})
+	}
+}
+
+func TestApproximatelyFloat32(t *testing.T) {
+	assert.True(t, mathex.Approximately(float32(0.1)+float32(0.2), float32(0.3)))
+	assert.False(t, mathex.Approximately(float32(1), float32(1.001)))
+}
+
+func TestApproximatelyInt(t *testing.T) {
+	assert.True(t, mathex.Approximately(3, 3))
+	assert.False(t, mathex.Approximately(3, 4))
+}
+
+func TestApproximatelyWithin(t *testing.T) {
+	assert.True(t, mathex.ApproximatelyWithin(1., 1.05, 0.1))
+	assert.False(t, mathex.ApproximatelyWithin(1., 1.2, 0.1))
+	assert.True(t, mathex.ApproximatelyWithin(1000., 1050., 0.1))
+}
+
+func TestWithinULP(t *testing.T) {
+	one := 1.
+	next := math.Nextafter(one, 2)
+	nextNext := math.Nextafter(next, 2)
+
+	assert.True(t, mathex.WithinULP(one, one, 0))
+	assert.True(t, mathex.WithinULP(one, next, 1))
+	assert.False(t, mathex.WithinULP(one, nextNext, 1))
+	assert.True(t, mathex.WithinULP(one, nextNext, 2))
+	assert.True(t, mathex.WithinULP(0., math.Copysign(0, -1), 0))
+	assert.True(t, mathex.WithinULP(-math.SmallestNonzeroFloat64, math.SmallestNonzeroFloat64, 2))
+	assert.False(t, mathex.WithinULP(-math.SmallestNonzeroFloat64, math.SmallestNonzeroFloat64, 1))
+	assert.False(t, mathex.WithinULP(math.NaN(), math.NaN(), math.MaxUint64))
+
+	f := float32(1)
+	assert.True(t, mathex.WithinULP(f, math.Nextafter32(f, 2), 1))
+	assert.False(t, mathex.WithinULP(f, math.Nextafter32(math.Nextafter32(f, 2), 2), 1))
+
+	assert.True(t, mathex.WithinULP(10, 12, 2))
+	assert.False(t, mathex.WithinULP(12, 10, 1))
+	assert.True(t, mathex.WithinULP(int8(100), int8(-100), 200))
+	assert.False(t, mathex.WithinULP(int8(100), int8(-100), 199))
+	assert.True(t, mathex.WithinULP(int16(30000), int16(-30000), 60000))
+	assert.True(t, mathex.WithinULP(int64(math.MinInt64), int64(math.MaxInt64), math.MaxUint64))
+	assert.False(t, mathex.WithinULP(int64(math.MinInt64), int64(math.MaxInt64), math.MaxUint64-1))
+	assert.True(t, mathex.WithinULP(uint8(0), uint8(255), 255))
+}
@@ -0,0 +1,35 @@
+package mathex
+
+import "math"
+
+// SRGBToLinear decodes a color channel in the range [0, 1] from the sRGB
+// transfer function, which most images and color pickers use, into linear
+// light suitable for lighting and blending math
+func SRGBToLinear(c float64) float64 {
+	if c <= 0.04045 {
+		return c / 12.92
+	}
+	return math.Pow((c+0.055)/1.055, 2.4)
+}
+
+// LinearToSRGB encodes a linear color channel in the range [0, 1] with the
+// sRGB transfer function, the inverse of SRGBToLinear
+func LinearToSRGB(c float64) float64 {
+	if c <= 0.0031308 {
+		return c * 12.92
+	}
+	return 1.055*math.Pow(c, 1/2.4) - 0.055
+}
+
+var srgb8ToLinear = func() (table [256]float64) {
+	for i := range table {
+		table[i] = SRGBToLinear(float64(i) / 0xff)
+	}
+	return
+}()
+
+// SRGB8ToLinear decodes an 8-bit sRGB color channel into linear light using
+// a lookup table, giving the same result as SRGBToLinear(float64(c) / 255)
+func SRGB8ToLinear(c uint8) float64 {
+	return srgb8ToLinear[c]
+}
@@ -0,0 +1,21 @@
+package mathex_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector/mathex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSRGB(t *testing.T) {
+	assert.Equal(t, 0., mathex.SRGBToLinear(0))
+	assert.InDelta(t, 1., mathex.SRGBToLinear(1), 1e-12)
+	assert.InDelta(t, 0.2140, mathex.SRGBToLinear(0.5), 1e-4)
+	assert.InDelta(t, 0.04045/12.92, mathex.SRGBToLinear(0.04045), 1e-12)
+
+	for i := 0; i <= 255; i++ {
+		c := float64(i) / 255
+		assert.InDelta(t, c, mathex.LinearToSRGB(mathex.SRGBToLinear(c)), 1e-12)
+		assert.Equal(t, mathex.SRGBToLinear(c), mathex.SRGB8ToLinear(uint8(i)))
+	}
+}
@@ -0,0 +1,57 @@
+package mathex
+
+import (
+	"math/bits"
+
+	"golang.org/x/exp/constraints"
+)
+
+// IsPowerOfTwo returns true if v is a positive power of two
+func IsPowerOfTwo[T constraints.Integer](v T) bool {
+	return v > 0 && v&(v-1) == 0
+}
+
+// NextPowerOfTwo returns the smallest power of two that is greater than or
+// equal to v. Values less than or equal to 1, including negative values,
+// return 1. NextPowerOfTwo panics if the result doesn't fit in T, which
+// happens for v above the largest power of two T can hold, such as 64 for
+// int8 or 128 for uint8.
+func NextPowerOfTwo[T constraints.Integer](v T) T {
+	if v <= 1 {
+		return 1
+	}
+	p := T(1) << bits.Len64(uint64(v-1))
+	if p <= 0 {
+		panic("mathex: NextPowerOfTwo overflows")
+	}
+	return p
+}
+
+// ILog2 returns the floor of the binary logarithm of v. ILog2 panics if v is
+// not positive.
+func ILog2[T constraints.Integer](v T) int {
+	if v <= 0 {
+		panic("mathex: ILog2 of non-positive value")
+	}
+	return bits.Len64(uint64(v)) - 1
+}
+
+// CeilDiv divides a by b, rounding the result towards positive infinity
+func CeilDiv[T constraints.Integer](a, b T) T {
+	q := a / b
+	if a%b != 0 && (a < 0) == (b < 0) {
+		q++
+	}
+	return q
+}
+
+// Mod computes a modulo b with the result taking the sign of b, so that for
+// a positive b the result always falls within [0, b), unlike the % operator
+// which takes the sign of a
+func Mod[T constraints.Integer](a, b T) T {
+	r := a % b
+	if r != 0 && (r < 0) != (b < 0) {
+		r += b
+	}
+	return r
+}
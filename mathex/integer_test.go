@@ -0,0 +1,82 @@
+package mathex_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/EliCDavis/vector/mathex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsPowerOfTwo(t *testing.T) {
+	tests := map[int]bool{
+		-4: false, 0: false, 1: true, 2: true, 3: false, 4: true, 6: false, 1024: true, 1023: false,
+	}
+
+	for v, want := range tests {
+		assert.Equal(t, want, mathex.IsPowerOfTwo(v), "%d", v)
+	}
+}
+
+func TestNextPowerOfTwo(t *testing.T) {
+	tests := map[int]int{
+		-3: 1, 0: 1, 1: 1, 2: 2, 3: 4, 5: 8, 8: 8, 9: 16, 1000: 1024,
+	}
+
+	for v, want := range tests {
+		assert.Equal(t, want, mathex.NextPowerOfTwo(v), "%d", v)
+	}
+
+	assert.Equal(t, uint8(128), mathex.NextPowerOfTwo(uint8(100)))
+	assert.Equal(t, uint8(128), mathex.NextPowerOfTwo(uint8(128)))
+	assert.Equal(t, int8(64), mathex.NextPowerOfTwo(int8(64)))
+	assert.Equal(t, int8(1), mathex.NextPowerOfTwo(int8(math.MinInt8)))
+	assert.Equal(t, int64(1<<62), mathex.NextPowerOfTwo(int64(1<<62)))
+	assert.Equal(t, uint64(1<<63), mathex.NextPowerOfTwo(uint64(1<<62+1)))
+
+	assert.Panics(t, func() { mathex.NextPowerOfTwo(int8(100)) })
+	assert.Panics(t, func() { mathex.NextPowerOfTwo(int8(math.MaxInt8)) })
+	assert.Panics(t, func() { mathex.NextPowerOfTwo(uint8(129)) })
+	assert.Panics(t, func() { mathex.NextPowerOfTwo(uint8(math.MaxUint8)) })
+	assert.Panics(t, func() { mathex.NextPowerOfTwo(int64(math.MaxInt64)) })
+	assert.Panics(t, func() { mathex.NextPowerOfTwo(uint64(math.MaxUint64)) })
+}
+
+func TestILog2(t *testing.T) {
+	tests := map[int64]int{
+		1: 0, 2: 1, 3: 1, 4: 2, 7: 2, 8: 3, 1 << 40: 40,
+	}
+
+	for v, want := range tests {
+		assert.Equal(t, want, mathex.ILog2(v), "%d", v)
+	}
+
+	assert.Panics(t, func() { mathex.ILog2(0) })
+}
+
+func TestCeilDiv(t *testing.T) {
+	tests := map[string]struct {
+		a, b, want int
+	}{
+		"exact":          {a: 8, b: 4, want: 2},
+		"round up":       {a: 9, b: 4, want: 3},
+		"negative":       {a: -9, b: 4, want: -2},
+		"both negative":  {a: -9, b: -4, want: 3},
+		"negative denom": {a: 9, b: -4, want: -2},
+		"zero":           {a: 0, b: 4, want: 0},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, mathex.CeilDiv(tc.a, tc.b))
+		})
+	}
+}
+
+func TestMod(t *testing.T) {
+	assert.Equal(t, 2, mathex.Mod(7, 5))
+	assert.Equal(t, 3, mathex.Mod(-7, 5))
+	assert.Equal(t, 0, mathex.Mod(-10, 5))
+	assert.Equal(t, -3, mathex.Mod(7, -5))
+	assert.Equal(t, int8(126), mathex.Mod(int8(-1), int8(127)))
+}
@@ -49,23 +49,77 @@ func Wrap[T Number](value, min, max T) T {
 	return T(float64(value) - float64(max-min)*Floor(float64(value-min)/float64(max-min)))
 }
 
-func Clamp[T Number](f, vmin, vmax T) T {
-	return max(min(f, vmax), vmin)
+// Snap - Round value to the nearest multiple of step. A step of zero leaves
+// the value unchanged
+func Snap[T Number](value, step T) T {
+	if step == 0 {
+		return value
+	}
+	return T(math.Round(float64(value)/float64(step)) * float64(step))
+}
+
+// SnapOffset - Round value to the nearest multiple of step shifted by offset
+func SnapOffset[T Number](value, step, offset T) T {
+	return Snap(value-offset, step) + offset
+}
+
+// IsFloat reports whether T is a floating point type. Methods use it to keep
+// float32 math in float32 rather than round-tripping through float64, while
+// integer types keep their existing truncating behavior
+func IsFloat[T Number]() bool {
+	return T(1)/2 != 0
 }
 
+// Abs - Returns the absolute value of v. Integers are handled natively so
+// large int64 values don't lose precision through float64
 func Abs[T Number](v T) T {
-	return T(math.Abs(float64(v)))
+	if IsFloat[T]() {
+		return T(math.Abs(float64(v)))
+	}
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+// Sign - Returns -1 for negative values, 1 for positive values, and 0 for
+// zero. NaN is returned unchanged
+func Sign[T Number](v T) T {
+	switch {
+	case v > 0:
+		return 1
+	case v < 0:
+		return T(0) - 1
+	}
+	return v
+}
+
+// CopySign - Returns a value with the magnitude of mag and the sign of sign
+func CopySign[T Number](mag, sign T) T {
+	if (mag < 0) == (sign < 0) {
+		return mag
+	}
+	return -mag
 }
 
 func Round[T Number](v T) T {
+	if !IsFloat[T]() {
+		return v
+	}
 	return T(math.Round(float64(v)))
 }
 
 func Ceil[T Number](v T) T {
+	if !IsFloat[T]() {
+		return v
+	}
 	return T(math.Ceil(float64(v)))
 }
 
 func Floor[T Number](v T) T {
+	if !IsFloat[T]() {
+		return v
+	}
 	return T(math.Floor(float64(v)))
 }
 
@@ -88,3 +142,14 @@ func Acos[T constraints.Float](v T) T {
 func Asin[T constraints.Float](v T) T {
 	return T(math.Asin(float64(v)))
 }
+
+// InvSqrtFast approximates 1/sqrt(v) using the well known bit level initial
+// guess refined by two Newton-Raphson iterations, giving a relative error
+// below 1e-5. Results for zero, negative, and non finite values are undefined
+func InvSqrtFast(v float32) float32 {
+	half := v * 0.5
+	y := math.Float32frombits(0x5f375a86 - math.Float32bits(v)>>1)
+	y *= 1.5 - half*y*y
+	y *= 1.5 - half*y*y
+	return y
+}
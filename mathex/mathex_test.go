@@ -0,0 +1,88 @@
+package mathex_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/EliCDavis/vector/mathex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnap(t *testing.T) {
+	tests := map[string]struct {
+		value, step float64
+		want        float64
+	}{
+		"round down":  {value: 1.2, step: 0.5, want: 1.0},
+		"round up":    {value: 1.3, step: 0.5, want: 1.5},
+		"negative":    {value: -1.3, step: 0.5, want: -1.5},
+		"zero step":   {value: 1.3, step: 0, want: 1.3},
+		"large step":  {value: 120, step: 100, want: 100},
+		"exact value": {value: 2, step: 0.25, want: 2},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.InDelta(t, tc.want, mathex.Snap(tc.value, tc.step), 0.000001)
+		})
+	}
+}
+
+func TestSnapInt(t *testing.T) {
+	assert.Equal(t, 16, mathex.Snap(17, 8))
+	assert.Equal(t, 24, mathex.Snap(21, 8))
+	assert.Equal(t, -16, mathex.Snap(-17, 8))
+}
+
+func TestSnapOffset(t *testing.T) {
+	assert.InDelta(t, 1.25, mathex.SnapOffset(1.3, 0.5, 0.25), 0.000001)
+	assert.InDelta(t, 0.75, mathex.SnapOffset(0.9, 0.5, 0.25), 0.000001)
+	assert.Equal(t, 19, mathex.SnapOffset(17, 8, 3))
+}
+
+func TestSign(t *testing.T) {
+	assert.Equal(t, 1., mathex.Sign(3.2))
+	assert.Equal(t, -1., mathex.Sign(-0.1))
+	assert.Equal(t, 0., mathex.Sign(0.))
+	assert.True(t, math.IsNaN(mathex.Sign(math.NaN())))
+	assert.Equal(t, -1, mathex.Sign(-7))
+	assert.Equal(t, int8(1), mathex.Sign(int8(100)))
+}
+
+func TestCopySign(t *testing.T) {
+	assert.Equal(t, -3., mathex.CopySign(3., -1.))
+	assert.Equal(t, 3., mathex.CopySign(-3., 2.))
+	assert.Equal(t, -3., mathex.CopySign(-3., -2.))
+	assert.Equal(t, 5, mathex.CopySign(-5, 0))
+	assert.Equal(t, int64(-5), mathex.CopySign(int64(5), -1))
+}
+
+func TestIsFloat(t *testing.T) {
+	assert.True(t, mathex.IsFloat[float32]())
+	assert.True(t, mathex.IsFloat[float64]())
+	assert.False(t, mathex.IsFloat[int]())
+	assert.False(t, mathex.IsFloat[int8]())
+}
+
+func TestIntegerNative(t *testing.T) {
+	big := int64(1<<62 + 1)
+
+	assert.Equal(t, big, mathex.Abs(-big))
+	assert.Equal(t, big, mathex.Abs(big))
+	assert.Equal(t, big, mathex.Round(big))
+	assert.Equal(t, big, mathex.Floor(big))
+	assert.Equal(t, big, mathex.Ceil(big))
+	assert.Equal(t, -big, mathex.Min(big, -big))
+	assert.Equal(t, big, mathex.Max(big, -big))
+	assert.Equal(t, big-1, mathex.Clamp(big, 0, big-1))
+
+	assert.Equal(t, 1.5, mathex.Abs(-1.5))
+	assert.Equal(t, float32(-2), mathex.Floor(float32(-1.5)))
+}
+
+func TestInvSqrtFast(t *testing.T) {
+	for _, v := range []float32{1e-20, 0.001, 0.5, 1, 2, 3, 10, 12345.678, 1e20} {
+		want := 1 / math.Sqrt(float64(v))
+		assert.InEpsilon(t, want, mathex.InvSqrtFast(v), 1e-5, "%v", v)
+	}
+}
@@ -0,0 +1,68 @@
+package mathex
+
+import (
+	"math"
+
+	"golang.org/x/exp/constraints"
+)
+
+// MoveTowards moves current towards target by no more than maxDelta. A
+// negative maxDelta moves current away from target.
+func MoveTowards[T constraints.Signed | constraints.Float](current, target, maxDelta T) T {
+	if Abs(target-current) <= maxDelta {
+		return target
+	}
+	if target > current {
+		return current + maxDelta
+	}
+	return current - maxDelta
+}
+
+// SmoothDamp gradually moves current towards target over roughly smoothTime
+// seconds, behaving like a critically damped spring that never overshoots.
+// velocity holds the current rate of change and is updated in place, so the
+// same variable should be passed in on every call. dt is the time elapsed
+// since the previous call.
+//
+// Based on Game Programming Gems 4, chapter 1.10
+func SmoothDamp[T constraints.Float](current, target T, velocity *T, smoothTime, dt T) T {
+	smoothTime = max(smoothTime, 0.0001)
+	omega := 2 / smoothTime
+
+	x := omega * dt
+	exp := 1 / (1 + x + 0.48*x*x + 0.235*x*x*x)
+
+	change := current - target
+	temp := (*velocity + omega*change) * dt
+	*velocity = (*velocity - omega*temp) * exp
+	output := target + (change+temp)*exp
+
+	// Prevent overshooting the target
+	if (target-current > 0) == (output > target) {
+		output = target
+		*velocity = 0
+	}
+
+	return output
+}
+
+// Spring moves current towards target as if attached to it by a damped
+// spring, returning the new position. velocity holds the current rate of
+// change and is updated in place, so the same variable should be passed in
+// on every call. dt is the time elapsed since the previous call.
+//
+// stiffness controls how strongly the spring pulls towards target, and
+// damping how quickly motion dies down. A damping of CriticalDamping(stiffness)
+// settles as fast as possible without oscillating, while lower values
+// overshoot and bounce. The velocity is solved implicitly, which keeps the
+// spring stable even for large time steps or stiffness values
+func Spring[T constraints.Float](current T, velocity *T, target, stiffness, damping, dt T) T {
+	*velocity = (*velocity - dt*stiffness*(current-target)) / (1 + dt*damping + dt*dt*stiffness)
+	return current + *velocity*dt
+}
+
+// CriticalDamping returns the damping that brings a spring of the given
+// stiffness to rest as quickly as possible without overshooting
+func CriticalDamping[T constraints.Float](stiffness T) T {
+	return 2 * T(math.Sqrt(float64(stiffness)))
+}
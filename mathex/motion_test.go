@@ -0,0 +1,95 @@
+package mathex_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/EliCDavis/vector/mathex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMoveTowards(t *testing.T) {
+	tests := map[string]struct {
+		current, target, maxDelta float64
+		want                      float64
+	}{
+		"step up":          {current: 0, target: 10, maxDelta: 3, want: 3},
+		"step down":        {current: 10, target: 0, maxDelta: 3, want: 7},
+		"reaches target":   {current: 9, target: 10, maxDelta: 3, want: 10},
+		"already there":    {current: 10, target: 10, maxDelta: 3, want: 10},
+		"negative delta":   {current: 5, target: 10, maxDelta: -2, want: 3},
+		"zero delta stays": {current: 5, target: 10, maxDelta: 0, want: 5},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.InDelta(t, tc.want, mathex.MoveTowards(tc.current, tc.target, tc.maxDelta), 0.000001)
+		})
+	}
+}
+
+func TestMoveTowardsInt(t *testing.T) {
+	assert.Equal(t, 3, mathex.MoveTowards(0, 10, 3))
+	assert.Equal(t, -3, mathex.MoveTowards(0, -10, 3))
+}
+
+func TestSmoothDamp(t *testing.T) {
+	current := 0.
+	velocity := 0.
+	previous := current
+
+	for i := 0; i < 200; i++ {
+		current = mathex.SmoothDamp(current, 10., &velocity, 0.5, 1./60.)
+		assert.GreaterOrEqual(t, current, previous)
+		assert.LessOrEqual(t, current, 10.)
+		previous = current
+	}
+
+	assert.InDelta(t, 10., current, 0.01)
+}
+
+func TestSmoothDampDoesNotOvershoot(t *testing.T) {
+	velocity := 100.
+	got := mathex.SmoothDamp(9.9, 10., &velocity, 0.1, 1.)
+	assert.Equal(t, 10., got)
+	assert.Equal(t, 0., velocity)
+}
+
+func TestSpringCriticallyDamped(t *testing.T) {
+	current := 0.
+	velocity := 0.
+	stiffness := 100.
+	damping := mathex.CriticalDamping(stiffness)
+	assert.Equal(t, 20., damping)
+
+	previous := current
+	for i := 0; i < 120; i++ {
+		current = mathex.Spring(current, &velocity, 10., stiffness, damping, 1./60.)
+		assert.GreaterOrEqual(t, current, previous)
+		assert.LessOrEqual(t, current, 10.)
+		previous = current
+	}
+	assert.InDelta(t, 10., current, 0.01)
+	assert.InDelta(t, 0., velocity, 0.01)
+}
+
+func TestSpringUnderdampedOvershoots(t *testing.T) {
+	current := 0.
+	velocity := 0.
+	peak := 0.
+	for i := 0; i < 600; i++ {
+		current = mathex.Spring(current, &velocity, 10., 100., 2., 1./60.)
+		peak = max(peak, current)
+	}
+	assert.Greater(t, peak, 10.)
+	assert.InDelta(t, 10., current, 0.01)
+}
+
+func TestSpringStableWithLargeSteps(t *testing.T) {
+	current := 0.
+	velocity := 0.
+	for i := 0; i < 50; i++ {
+		current = mathex.Spring(current, &velocity, 10., 1000., 0., 1.)
+		assert.LessOrEqual(t, math.Abs(current), 20.)
+	}
+}
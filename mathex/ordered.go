@@ -0,0 +1,23 @@
+package mathex
+
+import "golang.org/x/exp/constraints"
+
+// Min returns the smaller of a and b, comparing the values directly in their
+// own type rather than converting through float64. Like the builtin min, it
+// returns NaN if either argument is NaN, regardless of argument order
+func Min[T constraints.Ordered](a, b T) T {
+	return min(a, b)
+}
+
+// Max returns the larger of a and b, comparing the values directly in their
+// own type rather than converting through float64. Like the builtin max, it
+// returns NaN if either argument is NaN, regardless of argument order
+func Max[T constraints.Ordered](a, b T) T {
+	return max(a, b)
+}
+
+// Clamp restricts f to the range [vmin, vmax]. It returns NaN if any argument
+// is NaN
+func Clamp[T constraints.Ordered](f, vmin, vmax T) T {
+	return max(min(f, vmax), vmin)
+}
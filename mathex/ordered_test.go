@@ -0,0 +1,45 @@
+package mathex_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/EliCDavis/vector/mathex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMinMaxInt64Precision(t *testing.T) {
+	a := int64(math.MaxInt64)
+	b := int64(math.MaxInt64 - 1)
+
+	assert.Equal(t, b, mathex.Min(a, b))
+	assert.Equal(t, a, mathex.Max(a, b))
+}
+
+func TestClamp(t *testing.T) {
+	tests := map[string]struct {
+		v, vmin, vmax int64
+		want          int64
+	}{
+		"below": {v: -5, vmin: 0, vmax: 10, want: 0},
+		"above": {v: 15, vmin: 0, vmax: 10, want: 10},
+		"in":    {v: 5, vmin: 0, vmax: 10, want: 5},
+		"large": {v: math.MaxInt64, vmin: 0, vmax: math.MaxInt64 - 1, want: math.MaxInt64 - 1},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, mathex.Clamp(tc.v, tc.vmin, tc.vmax))
+		})
+	}
+}
+
+func TestMinMaxNaN(t *testing.T) {
+	nan := math.NaN()
+
+	assert.True(t, math.IsNaN(mathex.Min(nan, 0)))
+	assert.True(t, math.IsNaN(mathex.Min(0, nan)))
+	assert.True(t, math.IsNaN(mathex.Max(nan, 0)))
+	assert.True(t, math.IsNaN(mathex.Max(0, nan)))
+	assert.True(t, math.IsNaN(mathex.Clamp(nan, 0, 1)))
+}
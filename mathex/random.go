@@ -0,0 +1,97 @@
+package mathex
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/EliCDavis/vector"
+)
+
+// RandNormal returns a normally distributed value with the given mean and
+// standard deviation, sampled using the Box–Muller transform
+func RandNormal(r *rand.Rand, mean, stddev float64) float64 {
+	// 1 - Float64 keeps u1 within (0, 1], avoiding log(0)
+	u1 := 1 - r.Float64()
+	u2 := r.Float64()
+	z := math.Sqrt(-2*math.Log(u1)) * math.Cos(2*math.Pi*u2)
+	return mean + z*stddev
+}
+
+// RandTriangular returns a value within [low, high] following a triangular
+// distribution that peaks at mode
+func RandTriangular(r *rand.Rand, low, mode, high float64) float64 {
+	if high <= low {
+		return low
+	}
+
+	u := r.Float64()
+	c := (mode - low) / (high - low)
+	if u < c {
+		return low + math.Sqrt(u*(high-low)*(mode-low))
+	}
+	return high - math.Sqrt((1-u)*(high-low)*(high-mode))
+}
+
+// RandExponential returns an exponentially distributed value with the given
+// rate (lambda). The mean of the distribution is 1 / rate
+func RandExponential(r *rand.Rand, rate float64) float64 {
+	return -math.Log(1-r.Float64()) / rate
+}
+
+// RandSized returns a random value within [-size, size], matching the values
+// testing/quick generates for built in numeric types. Floating point values
+// are uniformly distributed while integers are whole numbers, limited to the
+// range of T
+func RandSized[T vector.Number](r *rand.Rand, size int) T {
+	if IsFloat[T]() {
+		return T((r.Float64()*2 - 1) * float64(size))
+	}
+
+	limit := int64(Max(size, 0))
+	if bits := vector.ComponentTypeOf[T]().Size() * 8; bits < 64 {
+		limit = Min(limit, int64(1)<<(bits-1)-1)
+	}
+	limit = Min(limit, math.MaxInt64/2)
+	return T(r.Int63n(2*limit+1) - limit)
+}
+
+// Noise constants and coordinate primes of Squirrel Eiserloh's Squirrel3 hash
+const (
+	squirrelNoise1 = 0xb5297a4d
+	squirrelNoise2 = 0x68e31da4
+	squirrelNoise3 = 0x1b56c4e9
+
+	squirrelPrime1 = 198491317
+	squirrelPrime2 = 6542989
+)
+
+// Hash1 returns a well mixed 32 bit hash of position and seed, for
+// deterministic randomness that needs no stored generator state. The same
+// inputs always produce the same result, on every platform. It's Squirrel
+// Eiserloh's Squirrel3 noise function
+func Hash1(position int, seed uint32) uint32 {
+	h := uint32(position)
+	h *= squirrelNoise1
+	h += seed
+	h ^= h >> 8
+	h += squirrelNoise2
+	h ^= h << 8
+	h *= squirrelNoise3
+	h ^= h >> 8
+	return h
+}
+
+// Hash2 returns a hash of a 2D integer coordinate. See Hash1
+func Hash2(x, y int, seed uint32) uint32 {
+	return Hash1(x+squirrelPrime1*y, seed)
+}
+
+// Hash3 returns a hash of a 3D integer coordinate. See Hash1
+func Hash3(x, y, z int, seed uint32) uint32 {
+	return Hash1(x+squirrelPrime1*y+squirrelPrime2*z, seed)
+}
+
+// HashFloat maps a hash onto a uniformly distributed value in [0, 1)
+func HashFloat(h uint32) float64 {
+	return float64(h) / (1 << 32)
+}
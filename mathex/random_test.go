@@ -0,0 +1,83 @@
+package mathex_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/EliCDavis/vector/mathex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const randomSamples = 100000
+
+func sampleStats(f func() float64) (mean, stddev, lo, hi float64) {
+	lo, hi = math.Inf(1), math.Inf(-1)
+	sum, sumSq := 0., 0.
+	for i := 0; i < randomSamples; i++ {
+		v := f()
+		sum += v
+		sumSq += v * v
+		lo = math.Min(lo, v)
+		hi = math.Max(hi, v)
+	}
+	mean = sum / randomSamples
+	stddev = math.Sqrt(sumSq/randomSamples - mean*mean)
+	return
+}
+
+func TestRandNormal(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	mean, stddev, _, _ := sampleStats(func() float64 { return mathex.RandNormal(r, 3, 2) })
+
+	assert.InDelta(t, 3, mean, 0.05)
+	assert.InDelta(t, 2, stddev, 0.05)
+}
+
+func TestRandTriangular(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	mean, _, lo, hi := sampleStats(func() float64 { return mathex.RandTriangular(r, 1, 2, 6) })
+
+	assert.InDelta(t, 3, mean, 0.05)
+	assert.GreaterOrEqual(t, lo, 1.)
+	assert.LessOrEqual(t, hi, 6.)
+	assert.Equal(t, 4., mathex.RandTriangular(r, 4, 4, 4))
+}
+
+func TestRandExponential(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	mean, _, lo, _ := sampleStats(func() float64 { return mathex.RandExponential(r, 4) })
+
+	assert.InDelta(t, 0.25, mean, 0.01)
+	assert.GreaterOrEqual(t, lo, 0.)
+}
+
+func TestHash(t *testing.T) {
+	// Pinned so any change to the hash, which would break saved worlds, is
+	// caught
+	assert.Equal(t, uint32(0x1a0a96c2), mathex.Hash1(0, 0))
+	assert.Equal(t, uint32(0x1d848924), mathex.Hash3(1, -2, 3, 42))
+	assert.NotEqual(t, mathex.Hash1(0, 0), mathex.Hash1(0, 1))
+	assert.NotEqual(t, mathex.Hash2(1, 0, 0), mathex.Hash2(0, 1, 0))
+	assert.NotEqual(t, mathex.Hash3(0, 0, 1, 0), mathex.Hash3(0, 1, 0, 0))
+	assert.Equal(t, mathex.Hash1(5, 9), mathex.Hash2(5, 0, 9))
+	assert.Equal(t, mathex.Hash2(5, -3, 9), mathex.Hash3(5, -3, 0, 9))
+
+	// Hashes of neighboring cells are uniformly distributed
+	buckets := make([]int, 10)
+	for x := -50; x < 50; x++ {
+		for y := -50; y < 50; y++ {
+			f := mathex.HashFloat(mathex.Hash2(x, y, 1234))
+			require.GreaterOrEqual(t, f, 0.)
+			require.Less(t, f, 1.)
+			buckets[int(f*10)]++
+		}
+	}
+	for _, count := range buckets {
+		assert.InDelta(t, 1000, count, 150)
+	}
+
+	assert.Equal(t, 0., mathex.HashFloat(0))
+	assert.Less(t, mathex.HashFloat(math.MaxUint32), 1.)
+}
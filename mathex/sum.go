@@ -0,0 +1,84 @@
+package mathex
+
+import "math"
+
+// CompensatedSum accumulates float64 values using Neumaier's variant of
+// Kahan summation, tracking the low order bits lost at each addition so that
+// the total of millions of values stays accurate. The zero value is an empty
+// sum ready for use.
+type CompensatedSum struct {
+	sum          float64
+	compensation float64
+}
+
+// Add adds v to the running total
+func (s *CompensatedSum) Add(v float64) {
+	t := s.sum + v
+	if math.Abs(s.sum) >= math.Abs(v) {
+		s.compensation += (s.sum - t) + v
+	} else {
+		s.compensation += (v - t) + s.sum
+	}
+	s.sum = t
+}
+
+// Value returns the compensated total of all values added
+func (s CompensatedSum) Value() float64 {
+	return s.sum + s.compensation
+}
+
+// DoubleDouble accumulates float64 values as the unevaluated sum of two
+// float64s, hi + lo, giving roughly twice the precision of a float64 (about 32
+// significant digits). Unlike CompensatedSum the total is renormalized after
+// every operation, so lo always holds the bits below hi's last place and the
+// value can be read back in full with Parts. The zero value is an empty sum
+// ready for use.
+type DoubleDouble struct {
+	hi float64
+	lo float64
+}
+
+// Add adds v to the running total
+func (d *DoubleDouble) Add(v float64) {
+	s, e := twoSum(d.hi, v)
+	d.hi, d.lo = fastTwoSum(s, e+d.lo)
+}
+
+// Sub subtracts v from the running total
+func (d *DoubleDouble) Sub(v float64) {
+	d.Add(-v)
+}
+
+// AddDoubleDouble adds another extended precision total to this one
+func (d *DoubleDouble) AddDoubleDouble(o DoubleDouble) {
+	s, e := twoSum(d.hi, o.hi)
+	t, f := twoSum(d.lo, o.lo)
+	s, e = fastTwoSum(s, e+t)
+	d.hi, d.lo = fastTwoSum(s, e+f)
+}
+
+// Value returns the total rounded to the nearest float64
+func (d DoubleDouble) Value() float64 {
+	return d.hi + d.lo
+}
+
+// Parts returns the total as the unevaluated sum hi + lo, where lo is smaller
+// than half of hi's last place
+func (d DoubleDouble) Parts() (hi, lo float64) {
+	return d.hi, d.lo
+}
+
+// twoSum returns a + b along with the exact rounding error of the addition
+func twoSum(a, b float64) (s, e float64) {
+	s = a + b
+	bb := s - a
+	e = (a - (s - bb)) + (b - bb)
+	return s, e
+}
+
+// fastTwoSum is twoSum for |a| >= |b|
+func fastTwoSum(a, b float64) (s, e float64) {
+	s = a + b
+	e = b - (s - a)
+	return s, e
+}
@@ -0,0 +1,59 @@
+package mathex_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector/mathex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompensatedSum(t *testing.T) {
+	var naive float64
+	var sum mathex.CompensatedSum
+	for _, v := range []float64{1, 1e100, 1, -1e100} {
+		naive += v
+		sum.Add(v)
+	}
+	assert.Equal(t, 0., naive)
+	assert.Equal(t, 2., sum.Value())
+
+	naive = 0
+	sum = mathex.CompensatedSum{}
+	for i := 0; i < 1000000; i++ {
+		naive += 0.1
+		sum.Add(0.1)
+	}
+	assert.NotEqual(t, 100000., naive)
+	assert.Equal(t, 100000., sum.Value())
+}
+
+func TestDoubleDouble(t *testing.T) {
+	var sum mathex.DoubleDouble
+	sum.Add(1e16)
+	for i := 0; i < 10; i++ {
+		sum.Add(1)
+		sum.Add(0.25)
+	}
+	sum.Sub(1e16)
+	assert.Equal(t, 12.5, sum.Value())
+
+	// Values far below a float64's precision are kept in the low part
+	sum = mathex.DoubleDouble{}
+	sum.Add(1)
+	sum.Add(1e-20)
+	hi, lo := sum.Parts()
+	assert.Equal(t, 1., hi)
+	assert.Equal(t, 1e-20, lo)
+	assert.Equal(t, 1., sum.Value())
+
+	sum.Sub(1)
+	assert.Equal(t, 1e-20, sum.Value())
+
+	var a, b mathex.DoubleDouble
+	a.Add(1)
+	a.Add(1e-20)
+	b.Add(-1)
+	b.Add(2e-20)
+	a.AddDoubleDouble(b)
+	assert.InEpsilon(t, 3e-20, a.Value(), 1e-15)
+}
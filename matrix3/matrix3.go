@@ -0,0 +1,206 @@
+// Package matrix3 provides a 3x3 matrix for 2D affine transformation of
+// vector2 positions and directions, along with a canvas style transform
+// stack.
+package matrix3
+
+import (
+	"math"
+
+	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vector3"
+)
+
+// Matrix is a 3x3 matrix stored in column-major order. Vectors are treated
+// as columns and multiplied on the right, so a 2D translation lives in the
+// last column.
+type Matrix[T vector.Number] struct {
+	m [9]T
+}
+
+type (
+	Float64 = Matrix[float64]
+	Float32 = Matrix[float32]
+)
+
+// Identity returns the identity matrix
+func Identity[T vector.Number]() Matrix[T] {
+	return Matrix[T]{m: [9]T{
+		1, 0, 0,
+		0, 1, 0,
+		0, 0, 1,
+	}}
+}
+
+// FromRows creates a matrix from its three rows
+func FromRows[T vector.Number](r0, r1, r2 vector3.Vector[T]) Matrix[T] {
+	return Matrix[T]{m: [9]T{
+		r0.X(), r1.X(), r2.X(),
+		r0.Y(), r1.Y(), r2.Y(),
+		r0.Z(), r1.Z(), r2.Z(),
+	}}
+}
+
+// FromColumns creates a matrix from its three columns
+func FromColumns[T vector.Number](c0, c1, c2 vector3.Vector[T]) Matrix[T] {
+	return Matrix[T]{m: [9]T{
+		c0.X(), c0.Y(), c0.Z(),
+		c1.X(), c1.Y(), c1.Z(),
+		c2.X(), c2.Y(), c2.Z(),
+	}}
+}
+
+// FromArray creates a matrix from 9 values in column-major order
+func FromArray[T vector.Number](data [9]T) Matrix[T] {
+	return Matrix[T]{m: data}
+}
+
+// Translation returns a matrix translating by v
+func Translation[T vector.Number](v vector2.Vector[T]) Matrix[T] {
+	m := Identity[T]()
+	m.m[6] = v.X()
+	m.m[7] = v.Y()
+	return m
+}
+
+// Rotation returns a matrix rotating counterclockwise by angle radians, as
+// seen with +Y up
+func Rotation[T vector.Number](angle float64) Matrix[T] {
+	sin, cos := math.Sincos(angle)
+	m := Identity[T]()
+	m.m[0] = T(cos)
+	m.m[1] = T(sin)
+	m.m[3] = T(-sin)
+	m.m[4] = T(cos)
+	return m
+}
+
+// Scale returns a matrix scaling each axis by the matching component of v
+func Scale[T vector.Number](v vector2.Vector[T]) Matrix[T] {
+	m := Identity[T]()
+	m.m[0] = v.X()
+	m.m[4] = v.Y()
+	return m
+}
+
+// At returns the element at the given row and column
+func (m Matrix[T]) At(row, col int) T {
+	return m.m[col*3+row]
+}
+
+// Row returns the row at index i
+func (m Matrix[T]) Row(i int) vector3.Vector[T] {
+	return vector3.New(m.m[i], m.m[3+i], m.m[6+i])
+}
+
+// Column returns the column at index i
+func (m Matrix[T]) Column(i int) vector3.Vector[T] {
+	return vector3.New(m.m[i*3], m.m[i*3+1], m.m[i*3+2])
+}
+
+// ToArray returns the elements of the matrix in column-major order
+func (m Matrix[T]) ToArray() [9]T {
+	return m.m
+}
+
+// Transpose swaps the rows and columns of the matrix
+func (m Matrix[T]) Transpose() Matrix[T] {
+	var out Matrix[T]
+	for r := 0; r < 3; r++ {
+		for c := 0; c < 3; c++ {
+			out.m[r*3+c] = m.m[c*3+r]
+		}
+	}
+	return out
+}
+
+// Multiply returns m × o, the transform that applies o first and then m
+func (m Matrix[T]) Multiply(o Matrix[T]) Matrix[T] {
+	var out Matrix[T]
+	for c := 0; c < 3; c++ {
+		for r := 0; r < 3; r++ {
+			out.m[c*3+r] = m.m[r]*o.m[c*3] +
+				m.m[3+r]*o.m[c*3+1] +
+				m.m[6+r]*o.m[c*3+2]
+		}
+	}
+	return out
+}
+
+// MulVector3 returns m × v
+func (m Matrix[T]) MulVector3(v vector3.Vector[T]) vector3.Vector[T] {
+	x, y, z := v.X(), v.Y(), v.Z()
+	return vector3.New(
+		m.m[0]*x+m.m[3]*y+m.m[6]*z,
+		m.m[1]*x+m.m[4]*y+m.m[7]*z,
+		m.m[2]*x+m.m[5]*y+m.m[8]*z,
+	)
+}
+
+// MulPosition transforms v as a point, with an implicit z of 1. When the
+// matrix is projective the result is divided by the resulting z
+func (m Matrix[T]) MulPosition(v vector2.Vector[T]) vector2.Vector[T] {
+	x, y := v.X(), v.Y()
+	out := vector2.New(
+		m.m[0]*x+m.m[3]*y+m.m[6],
+		m.m[1]*x+m.m[4]*y+m.m[7],
+	)
+	if w := m.m[2]*x + m.m[5]*y + m.m[8]; w != 1 && w != 0 {
+		out = vector2.New(out.X()/w, out.Y()/w)
+	}
+	return out
+}
+
+// MulDirection transforms v as a direction, with an implicit z of 0, so
+// translation has no effect
+func (m Matrix[T]) MulDirection(v vector2.Vector[T]) vector2.Vector[T] {
+	x, y := v.X(), v.Y()
+	return vector2.New(
+		m.m[0]*x+m.m[3]*y,
+		m.m[1]*x+m.m[4]*y,
+	)
+}
+
+// Determinant returns the determinant of the matrix
+func (m Matrix[T]) Determinant() float64 {
+	_, det := m.cofactors()
+	return det
+}
+
+// Inverse returns the matrix that undoes m, and false if m is singular and
+// has no inverse
+func (m Matrix[T]) Inverse() (Matrix[T], bool) {
+	inv, det := m.cofactors()
+	if det == 0 {
+		return Matrix[T]{}, false
+	}
+
+	var out Matrix[T]
+	for i, v := range inv {
+		out.m[i] = T(v / det)
+	}
+	return out, true
+}
+
+// cofactors returns the transposed cofactor matrix, the adjugate, along with
+// the determinant, computed in float64
+func (m Matrix[T]) cofactors() ([9]float64, float64) {
+	var a [9]float64
+	for i, v := range m.m {
+		a[i] = float64(v)
+	}
+
+	var inv [9]float64
+	inv[0] = a[4]*a[8] - a[7]*a[5]
+	inv[3] = a[6]*a[5] - a[3]*a[8]
+	inv[6] = a[3]*a[7] - a[6]*a[4]
+	inv[1] = a[7]*a[2] - a[1]*a[8]
+	inv[4] = a[0]*a[8] - a[6]*a[2]
+	inv[7] = a[6]*a[1] - a[0]*a[7]
+	inv[2] = a[1]*a[5] - a[4]*a[2]
+	inv[5] = a[3]*a[2] - a[0]*a[5]
+	inv[8] = a[0]*a[4] - a[3]*a[1]
+
+	det := a[0]*inv[0] + a[1]*inv[3] + a[2]*inv[6]
+	return inv, det
+}
@@ -0,0 +1,67 @@
+package matrix3_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/EliCDavis/vector/matrix3"
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/EliCDavis/vector/vectortest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConstructors(t *testing.T) {
+	rows := matrix3.FromRows(vector3.New(1., 2., 3.), vector3.New(4., 5., 6.), vector3.New(7., 8., 9.))
+	cols := matrix3.FromColumns(vector3.New(1., 4., 7.), vector3.New(2., 5., 8.), vector3.New(3., 6., 9.))
+	assert.Equal(t, rows, cols)
+	assert.Equal(t, 6., rows.At(1, 2))
+	assert.Equal(t, vector3.New(4., 5., 6.), rows.Row(1))
+	assert.Equal(t, vector3.New(3., 6., 9.), rows.Column(2))
+	assert.Equal(t, [9]float64{1, 4, 7, 2, 5, 8, 3, 6, 9}, rows.ToArray())
+	assert.Equal(t, rows, matrix3.FromArray(rows.ToArray()))
+	assert.Equal(t, rows.Row(0), rows.Transpose().Column(0))
+}
+
+func TestTransforms(t *testing.T) {
+	p := vector2.New(1., 2.)
+	assert.Equal(t, vector2.New(4., 6.), matrix3.Translation(vector2.New(3., 4.)).MulPosition(p))
+	assert.Equal(t, p, matrix3.Translation(vector2.New(3., 4.)).MulDirection(p))
+	assert.Equal(t, vector2.New(2., -6.), matrix3.Scale(vector2.New(2., -3.)).MulPosition(p))
+	vectortest.AssertVector2InDelta(t, vector2.New(-2., 1.), matrix3.Rotation[float64](math.Pi/2).MulPosition(p), 1e-12)
+
+	// Multiply applies the right hand side first
+	m := matrix3.Translation(vector2.New(10., 0.)).Multiply(matrix3.Scale(vector2.New(2., 2.)))
+	assert.Equal(t, vector2.New(12., 4.), m.MulPosition(p))
+	assert.Equal(t, vector3.New(12., 4., 1.), m.MulVector3(vector3.New(1., 2., 1.)))
+}
+
+func assertIdentity(t *testing.T, m matrix3.Float64) {
+	t.Helper()
+	for r := 0; r < 3; r++ {
+		for c := 0; c < 3; c++ {
+			assert.InDelta(t, matrix3.Identity[float64]().At(r, c), m.At(r, c), 1e-12)
+		}
+	}
+}
+
+func TestInverse(t *testing.T) {
+	m := matrix3.Translation(vector2.New(3., -1.)).
+		Multiply(matrix3.Rotation[float64](0.7)).
+		Multiply(matrix3.Scale(vector2.New(2., 0.5)))
+	assert.InDelta(t, 1., m.Determinant(), 1e-12)
+
+	inv, ok := m.Inverse()
+	assert.True(t, ok)
+	assertIdentity(t, m.Multiply(inv))
+
+	general := matrix3.FromRows(vector3.New(2., 0., 1.), vector3.New(1., 3., 2.), vector3.New(1., 1., 2.))
+	assert.InDelta(t, 6., general.Determinant(), 1e-12)
+	inv, ok = general.Inverse()
+	assert.True(t, ok)
+	assertIdentity(t, general.Multiply(inv))
+	assertIdentity(t, inv.Multiply(general))
+
+	_, ok = matrix3.Scale(vector2.New(1., 0.)).Inverse()
+	assert.False(t, ok)
+}
@@ -0,0 +1,91 @@
+package matrix3
+
+import (
+	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/vector2"
+)
+
+// Stack tracks the current transform of an immediate mode drawing API or a
+// scene graph traversal, like the transform state of an HTML canvas. Each
+// operation applies in the local space of the current transform, so
+// translating and then rotating spins things in place around the translated
+// origin. Push saves the current transform and Pop restores it, letting
+// nested drawing code change it temporarily. Create one with NewStack.
+type Stack[T vector.Number] struct {
+	current Matrix[T]
+	saved   []Matrix[T]
+}
+
+// NewStack creates a stack whose current transform is the identity
+func NewStack[T vector.Number]() *Stack[T] {
+	return &Stack[T]{current: Identity[T]()}
+}
+
+// Current returns the current transform
+func (s *Stack[T]) Current() Matrix[T] {
+	return s.current
+}
+
+// Depth returns the number of transforms saved by Push that haven't been
+// restored by Pop
+func (s *Stack[T]) Depth() int {
+	return len(s.saved)
+}
+
+// Push saves the current transform, to be restored by the matching Pop
+func (s *Stack[T]) Push() {
+	s.saved = append(s.saved, s.current)
+}
+
+// Pop restores the transform saved by the most recent Push. Popping more
+// than was pushed panics
+func (s *Stack[T]) Pop() {
+	if len(s.saved) == 0 {
+		panic("matrix3: Pop called without a matching Push")
+	}
+	s.current = s.saved[len(s.saved)-1]
+	s.saved = s.saved[:len(s.saved)-1]
+}
+
+// Reset sets the current transform back to the identity, leaving saved
+// transforms in place
+func (s *Stack[T]) Reset() {
+	s.SetCurrent(Identity[T]())
+}
+
+// SetCurrent replaces the current transform
+func (s *Stack[T]) SetCurrent(m Matrix[T]) {
+	s.current = m
+}
+
+// Transform applies m in the local space of the current transform
+func (s *Stack[T]) Transform(m Matrix[T]) {
+	s.current = s.current.Multiply(m)
+}
+
+// Translate moves the origin by v
+func (s *Stack[T]) Translate(v vector2.Vector[T]) {
+	s.Transform(Translation(v))
+}
+
+// Rotate rotates counterclockwise by angle radians around the origin, as
+// seen with +Y up
+func (s *Stack[T]) Rotate(angle float64) {
+	s.Transform(Rotation[T](angle))
+}
+
+// Scale scales each axis by the matching component of v, around the origin
+func (s *Stack[T]) Scale(v vector2.Vector[T]) {
+	s.Transform(Scale(v))
+}
+
+// Apply transforms the point v from local space by the current transform
+func (s *Stack[T]) Apply(v vector2.Vector[T]) vector2.Vector[T] {
+	return s.current.MulPosition(v)
+}
+
+// ApplyDirection transforms the direction v from local space by the current
+// transform, ignoring translation
+func (s *Stack[T]) ApplyDirection(v vector2.Vector[T]) vector2.Vector[T] {
+	return s.current.MulDirection(v)
+}
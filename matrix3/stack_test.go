@@ -0,0 +1,43 @@
+package matrix3_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/EliCDavis/vector/matrix3"
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vectortest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStack(t *testing.T) {
+	s := matrix3.NewStack[float64]()
+	assert.Equal(t, matrix3.Identity[float64](), s.Current())
+
+	// Operations apply in local space, so the rotation happens around the
+	// translated origin
+	s.Translate(vector2.New(10., 0.))
+	s.Rotate(math.Pi / 2)
+	vectortest.AssertVector2InDelta(t, vector2.New(10., 1.), s.Apply(vector2.New(1., 0.)), 1e-12)
+	vectortest.AssertVector2InDelta(t, vector2.New(0., 1.), s.ApplyDirection(vector2.New(1., 0.)), 1e-12)
+
+	s.Push()
+	s.Scale(vector2.New(2., 2.))
+	assert.Equal(t, 1, s.Depth())
+	vectortest.AssertVector2InDelta(t, vector2.New(10., 2.), s.Apply(vector2.New(1., 0.)), 1e-12)
+
+	s.Pop()
+	assert.Equal(t, 0, s.Depth())
+	vectortest.AssertVector2InDelta(t, vector2.New(10., 1.), s.Apply(vector2.New(1., 0.)), 1e-12)
+
+	s.Push()
+	s.Reset()
+	assert.Equal(t, vector2.New(1., 0.), s.Apply(vector2.New(1., 0.)))
+	s.SetCurrent(matrix3.Translation(vector2.New(0., 5.)))
+	s.Transform(matrix3.Scale(vector2.New(3., 3.)))
+	assert.Equal(t, vector2.New(3., 5.), s.Apply(vector2.New(1., 0.)))
+	s.Pop()
+	vectortest.AssertVector2InDelta(t, vector2.New(10., 1.), s.Apply(vector2.New(1., 0.)), 1e-12)
+
+	assert.PanicsWithValue(t, "matrix3: Pop called without a matching Push", s.Pop)
+}
@@ -0,0 +1,208 @@
+// Package matrix4 provides a 4x4 matrix for transforming vector3 positions
+// and directions, along with bulk transformation of whole vector slices.
+package matrix4
+
+import (
+	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/EliCDavis/vector/vector4"
+)
+
+// Matrix is a 4x4 matrix stored in column-major order, the layout used by
+// OpenGL and glTF. Vectors are treated as columns and multiplied on the
+// right, so a translation lives in the last column.
+type Matrix[T vector.Number] struct {
+	m [16]T
+}
+
+type (
+	Float64 = Matrix[float64]
+	Float32 = Matrix[float32]
+)
+
+// Identity returns the identity matrix
+func Identity[T vector.Number]() Matrix[T] {
+	return Matrix[T]{m: [16]T{
+		1, 0, 0, 0,
+		0, 1, 0, 0,
+		0, 0, 1, 0,
+		0, 0, 0, 1,
+	}}
+}
+
+// FromRows creates a matrix from its four rows
+func FromRows[T vector.Number](r0, r1, r2, r3 vector4.Vector[T]) Matrix[T] {
+	return Matrix[T]{m: [16]T{
+		r0.X(), r1.X(), r2.X(), r3.X(),
+		r0.Y(), r1.Y(), r2.Y(), r3.Y(),
+		r0.Z(), r1.Z(), r2.Z(), r3.Z(),
+		r0.W(), r1.W(), r2.W(), r3.W(),
+	}}
+}
+
+// FromColumns creates a matrix from its four columns
+func FromColumns[T vector.Number](c0, c1, c2, c3 vector4.Vector[T]) Matrix[T] {
+	return Matrix[T]{m: [16]T{
+		c0.X(), c0.Y(), c0.Z(), c0.W(),
+		c1.X(), c1.Y(), c1.Z(), c1.W(),
+		c2.X(), c2.Y(), c2.Z(), c2.W(),
+		c3.X(), c3.Y(), c3.Z(), c3.W(),
+	}}
+}
+
+// FromArray creates a matrix from 16 values in column-major order, such as a
+// glTF node's matrix
+func FromArray[T vector.Number](data [16]T) Matrix[T] {
+	return Matrix[T]{m: data}
+}
+
+// Translation returns a matrix translating by v
+func Translation[T vector.Number](v vector3.Vector[T]) Matrix[T] {
+	m := Identity[T]()
+	m.m[12] = v.X()
+	m.m[13] = v.Y()
+	m.m[14] = v.Z()
+	return m
+}
+
+// Scale returns a matrix scaling each axis by the matching component of v
+func Scale[T vector.Number](v vector3.Vector[T]) Matrix[T] {
+	m := Identity[T]()
+	m.m[0] = v.X()
+	m.m[5] = v.Y()
+	m.m[10] = v.Z()
+	return m
+}
+
+// At returns the element at the given row and column
+func (m Matrix[T]) At(row, col int) T {
+	return m.m[col*4+row]
+}
+
+// Row returns the row at index i
+func (m Matrix[T]) Row(i int) vector4.Vector[T] {
+	return vector4.New(m.m[i], m.m[4+i], m.m[8+i], m.m[12+i])
+}
+
+// Column returns the column at index i
+func (m Matrix[T]) Column(i int) vector4.Vector[T] {
+	return vector4.New(m.m[i*4], m.m[i*4+1], m.m[i*4+2], m.m[i*4+3])
+}
+
+// ToArray returns the elements of the matrix in column-major order
+func (m Matrix[T]) ToArray() [16]T {
+	return m.m
+}
+
+// Transpose swaps the rows and columns of the matrix
+func (m Matrix[T]) Transpose() Matrix[T] {
+	var out Matrix[T]
+	for r := 0; r < 4; r++ {
+		for c := 0; c < 4; c++ {
+			out.m[r*4+c] = m.m[c*4+r]
+		}
+	}
+	return out
+}
+
+// Multiply returns m × o, the transform that applies o first and then m
+func (m Matrix[T]) Multiply(o Matrix[T]) Matrix[T] {
+	var out Matrix[T]
+	for c := 0; c < 4; c++ {
+		for r := 0; r < 4; r++ {
+			out.m[c*4+r] = m.m[r]*o.m[c*4] +
+				m.m[4+r]*o.m[c*4+1] +
+				m.m[8+r]*o.m[c*4+2] +
+				m.m[12+r]*o.m[c*4+3]
+		}
+	}
+	return out
+}
+
+// MulVector4 returns m × v
+func (m Matrix[T]) MulVector4(v vector4.Vector[T]) vector4.Vector[T] {
+	x, y, z, w := v.X(), v.Y(), v.Z(), v.W()
+	return vector4.New(
+		m.m[0]*x+m.m[4]*y+m.m[8]*z+m.m[12]*w,
+		m.m[1]*x+m.m[5]*y+m.m[9]*z+m.m[13]*w,
+		m.m[2]*x+m.m[6]*y+m.m[10]*z+m.m[14]*w,
+		m.m[3]*x+m.m[7]*y+m.m[11]*z+m.m[15]*w,
+	)
+}
+
+// MulPosition transforms v as a point, with an implicit w of 1. When the
+// matrix is projective the result is divided by the resulting w
+func (m Matrix[T]) MulPosition(v vector3.Vector[T]) vector3.Vector[T] {
+	x, y, z := v.X(), v.Y(), v.Z()
+	out := vector3.New(
+		m.m[0]*x+m.m[4]*y+m.m[8]*z+m.m[12],
+		m.m[1]*x+m.m[5]*y+m.m[9]*z+m.m[13],
+		m.m[2]*x+m.m[6]*y+m.m[10]*z+m.m[14],
+	)
+	if w := m.m[3]*x + m.m[7]*y + m.m[11]*z + m.m[15]; w != 1 && w != 0 {
+		out = vector3.New(out.X()/w, out.Y()/w, out.Z()/w)
+	}
+	return out
+}
+
+// MulDirection transforms v as a direction, with an implicit w of 0, so
+// translation has no effect
+func (m Matrix[T]) MulDirection(v vector3.Vector[T]) vector3.Vector[T] {
+	x, y, z := v.X(), v.Y(), v.Z()
+	return vector3.New(
+		m.m[0]*x+m.m[4]*y+m.m[8]*z,
+		m.m[1]*x+m.m[5]*y+m.m[9]*z,
+		m.m[2]*x+m.m[6]*y+m.m[10]*z,
+	)
+}
+
+// Determinant returns the determinant of the matrix
+func (m Matrix[T]) Determinant() float64 {
+	_, det := m.cofactors()
+	return det
+}
+
+// Inverse returns the matrix that undoes m, and false if m is singular and
+// has no inverse
+func (m Matrix[T]) Inverse() (Matrix[T], bool) {
+	inv, det := m.cofactors()
+	if det == 0 {
+		return Matrix[T]{}, false
+	}
+
+	var out Matrix[T]
+	for i, v := range inv {
+		out.m[i] = T(v / det)
+	}
+	return out, true
+}
+
+// cofactors returns the transposed cofactor matrix, the adjugate, along with
+// the determinant, computed in float64
+func (m Matrix[T]) cofactors() ([16]float64, float64) {
+	var a [16]float64
+	for i, v := range m.m {
+		a[i] = float64(v)
+	}
+
+	var inv [16]float64
+	inv[0] = a[5]*a[10]*a[15] - a[5]*a[11]*a[14] - a[9]*a[6]*a[15] + a[9]*a[7]*a[14] + a[13]*a[6]*a[11] - a[13]*a[7]*a[10]
+	inv[4] = -a[4]*a[10]*a[15] + a[4]*a[11]*a[14] + a[8]*a[6]*a[15] - a[8]*a[7]*a[14] - a[12]*a[6]*a[11] + a[12]*a[7]*a[10]
+	inv[8] = a[4]*a[9]*a[15] - a[4]*a[11]*a[13] - a[8]*a[5]*a[15] + a[8]*a[7]*a[13] + a[12]*a[5]*a[11] - a[12]*a[7]*a[9]
+	inv[12] = -a[4]*a[9]*a[14] + a[4]*a[10]*a[13] + a[8]*a[5]*a[14] - a[8]*a[6]*a[13] - a[12]*a[5]*a[10] + a[12]*a[6]*a[9]
+	inv[1] = -a[1]*a[10]*a[15] + a[1]*a[11]*a[14] + a[9]*a[2]*a[15] - a[9]*a[3]*a[14] - a[13]*a[2]*a[11] + a[13]*a[3]*a[10]
+	inv[5] = a[0]*a[10]*a[15] - a[0]*a[11]*a[14] - a[8]*a[2]*a[15] + a[8]*a[3]*a[14] + a[12]*a[2]*a[11] - a[12]*a[3]*a[10]
+	inv[9] = -a[0]*a[9]*a[15] + a[0]*a[11]*a[13] + a[8]*a[1]*a[15] - a[8]*a[3]*a[13] - a[12]*a[1]*a[11] + a[12]*a[3]*a[9]
+	inv[13] = a[0]*a[9]*a[14] - a[0]*a[10]*a[13] - a[8]*a[1]*a[14] + a[8]*a[2]*a[13] + a[12]*a[1]*a[10] - a[12]*a[2]*a[9]
+	inv[2] = a[1]*a[6]*a[15] - a[1]*a[7]*a[14] - a[5]*a[2]*a[15] + a[5]*a[3]*a[14] + a[13]*a[2]*a[7] - a[13]*a[3]*a[6]
+	inv[6] = -a[0]*a[6]*a[15] + a[0]*a[7]*a[14] + a[4]*a[2]*a[15] - a[4]*a[3]*a[14] - a[12]*a[2]*a[7] + a[12]*a[3]*a[6]
+	inv[10] = a[0]*a[5]*a[15] - a[0]*a[7]*a[13] - a[4]*a[1]*a[15] + a[4]*a[3]*a[13] + a[12]*a[1]*a[7] - a[12]*a[3]*a[5]
+	inv[14] = -a[0]*a[5]*a[14] + a[0]*a[6]*a[13] + a[4]*a[1]*a[14] - a[4]*a[2]*a[13] - a[12]*a[1]*a[6] + a[12]*a[2]*a[5]
+	inv[3] = -a[1]*a[6]*a[11] + a[1]*a[7]*a[10] + a[5]*a[2]*a[11] - a[5]*a[3]*a[10] - a[9]*a[2]*a[7] + a[9]*a[3]*a[6]
+	inv[7] = a[0]*a[6]*a[11] - a[0]*a[7]*a[10] - a[4]*a[2]*a[11] + a[4]*a[3]*a[10] + a[8]*a[2]*a[7] - a[8]*a[3]*a[6]
+	inv[11] = -a[0]*a[5]*a[11] + a[0]*a[7]*a[9] + a[4]*a[1]*a[11] - a[4]*a[3]*a[9] - a[8]*a[1]*a[7] + a[8]*a[3]*a[5]
+	inv[15] = a[0]*a[5]*a[10] - a[0]*a[6]*a[9] - a[4]*a[1]*a[10] + a[4]*a[2]*a[9] + a[8]*a[1]*a[6] - a[8]*a[2]*a[5]
+
+	det := a[0]*inv[0] + a[1]*inv[4] + a[2]*inv[8] + a[3]*inv[12]
+	return inv, det
+}
@@ -0,0 +1,57 @@
+package matrix4_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector/matrix4"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/EliCDavis/vector/vector4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConstructors(t *testing.T) {
+	rows := matrix4.FromRows(
+		vector4.New(1., 2., 3., 4.),
+		vector4.New(5., 6., 7., 8.),
+		vector4.New(9., 10., 11., 12.),
+		vector4.New(13., 14., 15., 16.),
+	)
+	assert.Equal(t, 7., rows.At(1, 2))
+	assert.Equal(t, vector4.New(5., 6., 7., 8.), rows.Row(1))
+	assert.Equal(t, vector4.New(3., 7., 11., 15.), rows.Column(2))
+	assert.Equal(t, [16]float64{1, 5, 9, 13, 2, 6, 10, 14, 3, 7, 11, 15, 4, 8, 12, 16}, rows.ToArray())
+
+	columns := matrix4.FromColumns(rows.Column(0), rows.Column(1), rows.Column(2), rows.Column(3))
+	assert.Equal(t, rows, columns)
+	assert.Equal(t, rows, matrix4.FromArray(rows.ToArray()))
+	assert.Equal(t, rows.Row(2), rows.Transpose().Column(2))
+}
+
+func TestMultiply(t *testing.T) {
+	translate := matrix4.Translation(vector3.New(1., 2., 3.))
+	scale := matrix4.Scale(vector3.New(2., 2., 2.))
+
+	assert.Equal(t, translate, matrix4.Identity[float64]().Multiply(translate))
+	assert.Equal(t, translate, translate.Multiply(matrix4.Identity[float64]()))
+
+	// Scale first, then translate
+	m := translate.Multiply(scale)
+	assert.Equal(t, vector3.New(3., 4., 5.), m.MulPosition(vector3.One[float64]()))
+	assert.Equal(t, vector3.New(2., 2., 2.), m.MulDirection(vector3.One[float64]()))
+	assert.Equal(t, vector4.New(3., 4., 5., 1.), m.MulVector4(vector4.One[float64]()))
+
+	// Translate first, then scale
+	m = scale.Multiply(translate)
+	assert.Equal(t, vector3.New(4., 6., 8.), m.MulPosition(vector3.One[float64]()))
+}
+
+func TestMulPositionProjective(t *testing.T) {
+	// Copies z into w, so points are divided by their depth
+	m := matrix4.FromRows(
+		vector4.New(1., 0., 0., 0.),
+		vector4.New(0., 1., 0., 0.),
+		vector4.New(0., 0., 1., 0.),
+		vector4.New(0., 0., 1., 0.),
+	)
+	assert.Equal(t, vector3.New(1., 2., 1.), m.MulPosition(vector3.New(2., 4., 2.)))
+}
@@ -0,0 +1,53 @@
+package matrix4
+
+import (
+	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/rect2"
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vector3"
+)
+
+// Project maps a world space position onto the screen, given the combined
+// view and projection matrix and the viewport being rendered into. Screen
+// space has its origin at the top left of the viewport with Y pointing down.
+// depth is the normalized device coordinate depth of the position, within
+// the depth range the projection was built for. ok is false when the
+// position lies behind the camera and has no meaningful screen position
+func Project[T vector.Number](world vector3.Vector[T], viewProj Matrix[T], viewport rect2.Rectangle[T]) (screen vector2.Vector[T], depth float64, ok bool) {
+	m := viewProj.m
+	x, y, z := float64(world.X()), float64(world.Y()), float64(world.Z())
+	w := float64(m[3])*x + float64(m[7])*y + float64(m[11])*z + float64(m[15])
+	if w <= 0 {
+		return screen, 0, false
+	}
+
+	ndcX := (float64(m[0])*x + float64(m[4])*y + float64(m[8])*z + float64(m[12])) / w
+	ndcY := (float64(m[1])*x + float64(m[5])*y + float64(m[9])*z + float64(m[13])) / w
+	depth = (float64(m[2])*x + float64(m[6])*y + float64(m[10])*z + float64(m[14])) / w
+
+	screen = vector2.New(
+		T(float64(viewport.X())+(ndcX+1)/2*float64(viewport.Width())),
+		T(float64(viewport.Y())+(1-ndcY)/2*float64(viewport.Height())),
+	)
+	return screen, depth, true
+}
+
+// Unproject maps a screen position at the given normalized device
+// coordinate depth back into world space, undoing Project. invViewProj is
+// the inverse of the combined view and projection matrix. Unprojecting the
+// same screen position at the near and far depths gives the ray through
+// that pixel, as used for picking
+func Unproject[T vector.Number](screen vector2.Vector[T], depth float64, invViewProj Matrix[T], viewport rect2.Rectangle[T]) vector3.Vector[T] {
+	ndcX := (float64(screen.X())-float64(viewport.X()))/float64(viewport.Width())*2 - 1
+	ndcY := 1 - (float64(screen.Y())-float64(viewport.Y()))/float64(viewport.Height())*2
+
+	m := invViewProj.m
+	out := [4]float64{}
+	for r := range out {
+		out[r] = float64(m[r])*ndcX + float64(m[4+r])*ndcY + float64(m[8+r])*depth + float64(m[12+r])
+	}
+	if out[3] != 0 {
+		out[0], out[1], out[2] = out[0]/out[3], out[1]/out[3], out[2]/out[3]
+	}
+	return vector3.New(T(out[0]), T(out[1]), T(out[2]))
+}
@@ -0,0 +1,63 @@
+package matrix4_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/EliCDavis/vector/matrix4"
+	"github.com/EliCDavis/vector/rect2"
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/EliCDavis/vector/vectortest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInverse(t *testing.T) {
+	m := matrix4.Translation(vector3.New(1., 2., 3.)).
+		Multiply(matrix4.Scale(vector3.New(2., 4., 0.5))).
+		Multiply(matrix4.LookAt(vector3.New(3., 1., 2.), vector3.Zero[float64](), vector3.New(0., 1., 0.), matrix4.RightHanded))
+
+	inv, ok := m.Inverse()
+	require.True(t, ok)
+	assert.InDelta(t, 4., m.Determinant(), 1e-12)
+
+	v := vector3.New(5., -6., 7.)
+	vectortest.AssertVector3InDelta(t, v, inv.MulPosition(m.MulPosition(v)), 1e-12)
+
+	_, ok = matrix4.Scale(vector3.New(1., 0., 1.)).Inverse()
+	assert.False(t, ok)
+}
+
+func TestProjectUnproject(t *testing.T) {
+	view := matrix4.LookAt(vector3.New(0., 0., 10.), vector3.Zero[float64](), vector3.New(0., 1., 0.), matrix4.RightHanded)
+	proj := matrix4.Perspective[float64](math.Pi/2, 2, 1, 100, matrix4.OpenGL)
+	viewProj := proj.Multiply(view)
+	inv, ok := viewProj.Inverse()
+	require.True(t, ok)
+
+	viewport := rect2.New(vector2.New(100., 50.), vector2.New(800., 400.))
+
+	screen, depth, ok := matrix4.Project(vector3.Zero[float64](), viewProj, viewport)
+	require.True(t, ok)
+	vectortest.AssertVector2InDelta(t, vector2.New(500., 250.), screen, 1e-9)
+
+	// The top left corner of the frustum at the origin's depth
+	screen, _, ok = matrix4.Project(vector3.New(-20., 10., 0.), viewProj, viewport)
+	require.True(t, ok)
+	vectortest.AssertVector2InDelta(t, vector2.New(100., 50.), screen, 1e-9)
+
+	world := vector3.New(3., -2., 1.)
+	screen, depth, ok = matrix4.Project(world, viewProj, viewport)
+	require.True(t, ok)
+	vectortest.AssertVector3InDelta(t, world, matrix4.Unproject(screen, depth, inv, viewport), 1e-9)
+
+	// Picking ray through the center of the screen
+	near := matrix4.Unproject(vector2.New(500., 250.), -1, inv, viewport)
+	far := matrix4.Unproject(vector2.New(500., 250.), 1, inv, viewport)
+	vectortest.AssertVector3InDelta(t, vector3.New(0., 0., 9.), near, 1e-9)
+	vectortest.AssertVector3InDelta(t, vector3.New(0., 0., -90.), far, 1e-6)
+
+	_, _, ok = matrix4.Project(vector3.New(0., 0., 20.), viewProj, viewport)
+	assert.False(t, ok)
+}
@@ -0,0 +1,137 @@
+package matrix4
+
+import (
+	"math"
+
+	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/vector3"
+)
+
+// Handedness determines which way the camera looks along the Z axis in view
+// space
+type Handedness int
+
+const (
+	// RightHanded cameras look down -Z, as in OpenGL and glTF
+	RightHanded Handedness = iota
+
+	// LeftHanded cameras look down +Z, as in Direct3D and Unity
+	LeftHanded
+)
+
+// DepthRange is the range of normalized device coordinates depth is mapped
+// into, from the near plane to the far plane
+type DepthRange int
+
+const (
+	// DepthNegativeOneToOne maps depth into [-1, 1], as OpenGL expects
+	DepthNegativeOneToOne DepthRange = iota
+
+	// DepthZeroToOne maps depth into [0, 1], as Direct3D, Vulkan, Metal and
+	// WebGPU expect
+	DepthZeroToOne
+)
+
+// ClipConvention is the combination of handedness and depth range a
+// graphics API expects projection matrices to follow
+type ClipConvention struct {
+	Handedness Handedness
+	Depth      DepthRange
+}
+
+var (
+	// OpenGL is the convention of OpenGL and WebGL
+	OpenGL = ClipConvention{Handedness: RightHanded, Depth: DepthNegativeOneToOne}
+
+	// Direct3D is the convention of Direct3D
+	Direct3D = ClipConvention{Handedness: LeftHanded, Depth: DepthZeroToOne}
+)
+
+// forward is the sign of the Z axis the camera looks down
+func (h Handedness) forward() float64 {
+	if h == LeftHanded {
+		return 1
+	}
+	return -1
+}
+
+func fromFloat64s[T vector.Number](m [16]float64) Matrix[T] {
+	var out Matrix[T]
+	for i, v := range m {
+		out.m[i] = T(v)
+	}
+	return out
+}
+
+// Perspective returns a perspective projection with a vertical field of view
+// of fovY radians, the given width over height aspect ratio, and depth
+// clipped to the near and far planes, both given as positive distances from
+// the camera
+func Perspective[T vector.Number](fovY, aspect, near, far float64, convention ClipConvention) Matrix[T] {
+	f := 1 / math.Tan(fovY/2)
+	s := convention.Handedness.forward()
+
+	var m22, m23 float64
+	if convention.Depth == DepthZeroToOne {
+		m22 = s * far / (far - near)
+		m23 = -far * near / (far - near)
+	} else {
+		m22 = s * (far + near) / (far - near)
+		m23 = -2 * far * near / (far - near)
+	}
+
+	return fromFloat64s[T]([16]float64{
+		f / aspect, 0, 0, 0,
+		0, f, 0, 0,
+		0, 0, m22, s,
+		0, 0, m23, 0,
+	})
+}
+
+// Orthographic returns an orthographic projection mapping the box bounded by
+// left, right, bottom and top in view space, and by the near and far planes
+// given as distances from the camera, onto the clip volume
+func Orthographic[T vector.Number](left, right, bottom, top, near, far float64, convention ClipConvention) Matrix[T] {
+	s := convention.Handedness.forward()
+
+	var m22, m23 float64
+	if convention.Depth == DepthZeroToOne {
+		m22 = s / (far - near)
+		m23 = -near / (far - near)
+	} else {
+		m22 = s * 2 / (far - near)
+		m23 = -(far + near) / (far - near)
+	}
+
+	return fromFloat64s[T]([16]float64{
+		2 / (right - left), 0, 0, 0,
+		0, 2 / (top - bottom), 0, 0,
+		0, 0, m22, 0,
+		-(right + left) / (right - left), -(top + bottom) / (top - bottom), m23, 1,
+	})
+}
+
+// LookAt returns a view matrix for a camera at eye looking towards target,
+// rotated so that up points as close to the top of the view as possible. up
+// must not be parallel to the direction being looked in
+func LookAt[T vector.Number](eye, target, up vector3.Vector[T], handedness Handedness) Matrix[T] {
+	e := eye.ToFloat64()
+	forward := target.ToFloat64().Sub(e).Normalized()
+	right := forward.Cross(up.ToFloat64()).Normalized()
+	trueUp := right.Cross(forward)
+
+	// Right handed view space looks down -Z, so the forward axis flips,
+	// while left handed view space mirrors X instead
+	z := forward.Scale(-1)
+	if handedness == LeftHanded {
+		z = forward
+		right = right.Scale(-1)
+	}
+
+	return fromFloat64s[T]([16]float64{
+		right.X(), trueUp.X(), z.X(), 0,
+		right.Y(), trueUp.Y(), z.Y(), 0,
+		right.Z(), trueUp.Z(), z.Z(), 0,
+		-right.Dot(e), -trueUp.Dot(e), -z.Dot(e), 1,
+	})
+}
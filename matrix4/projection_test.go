@@ -0,0 +1,68 @@
+package matrix4_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/EliCDavis/vector/matrix4"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/EliCDavis/vector/vectortest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPerspectiveDepthRange(t *testing.T) {
+	tests := map[string]struct {
+		convention matrix4.ClipConvention
+		forward    float64
+		nearDepth  float64
+	}{
+		"opengl":     {convention: matrix4.OpenGL, forward: -1, nearDepth: -1},
+		"direct3d":   {convention: matrix4.Direct3D, forward: 1, nearDepth: 0},
+		"rh 0 to 1":  {convention: matrix4.ClipConvention{Handedness: matrix4.RightHanded, Depth: matrix4.DepthZeroToOne}, forward: -1, nearDepth: 0},
+		"lh -1 to 1": {convention: matrix4.ClipConvention{Handedness: matrix4.LeftHanded, Depth: matrix4.DepthNegativeOneToOne}, forward: 1, nearDepth: -1},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			p := matrix4.Perspective[float64](math.Pi/2, 2, 0.5, 100, tc.convention)
+
+			assert.InDelta(t, tc.nearDepth, p.MulPosition(vector3.New(0., 0., 0.5*tc.forward)).Z(), 1e-12)
+			assert.InDelta(t, 1., p.MulPosition(vector3.New(0., 0., 100*tc.forward)).Z(), 1e-12)
+
+			// The top edge of a 90 degree field of view, and the right edge
+			// being twice as wide
+			edge := p.MulPosition(vector3.New(20., 10., 10*tc.forward))
+			assert.InDelta(t, 1., edge.X(), 1e-12)
+			assert.InDelta(t, 1., edge.Y(), 1e-12)
+		})
+	}
+}
+
+func TestOrthographic(t *testing.T) {
+	gl := matrix4.Orthographic[float64](-2, 6, -1, 3, 1, 11, matrix4.OpenGL)
+	vectortest.AssertVector3InDelta(t, vector3.New(-1., -1., -1.), gl.MulPosition(vector3.New(-2., -1., -1.)), 1e-12)
+	vectortest.AssertVector3InDelta(t, vector3.New(1., 1., 1.), gl.MulPosition(vector3.New(6., 3., -11.)), 1e-12)
+
+	d3d := matrix4.Orthographic[float64](-2, 6, -1, 3, 1, 11, matrix4.Direct3D)
+	vectortest.AssertVector3InDelta(t, vector3.New(-1., -1., 0.), d3d.MulPosition(vector3.New(-2., -1., 1.)), 1e-12)
+	vectortest.AssertVector3InDelta(t, vector3.New(1., 1., 1.), d3d.MulPosition(vector3.New(6., 3., 11.)), 1e-12)
+}
+
+func TestLookAt(t *testing.T) {
+	eye := vector3.New(1., 2., 3.)
+	target := vector3.New(1., 2., -7.)
+	up := vector3.New(0., 1., 0.)
+
+	rh := matrix4.LookAt(eye, target, up, matrix4.RightHanded)
+	vectortest.AssertVector3InDelta(t, vector3.Zero[float64](), rh.MulPosition(eye), 1e-12)
+	vectortest.AssertVector3InDelta(t, vector3.New(0., 0., -10.), rh.MulPosition(target), 1e-12)
+	vectortest.AssertVector3InDelta(t, vector3.New(0., 1., 0.), rh.MulDirection(up), 1e-12)
+	vectortest.AssertVector3InDelta(t, vector3.New(1., 0., 0.), rh.MulDirection(vector3.New(1., 0., 0.)), 1e-12)
+
+	lh := matrix4.LookAt(eye, target, up, matrix4.LeftHanded)
+	vectortest.AssertVector3InDelta(t, vector3.New(0., 0., 10.), lh.MulPosition(target), 1e-12)
+	vectortest.AssertVector3InDelta(t, vector3.New(0., 1., 0.), lh.MulDirection(up), 1e-12)
+	// Looking down -Z in world space, world +X is to the left of a left
+	// handed camera
+	vectortest.AssertVector3InDelta(t, vector3.New(-1., 0., 0.), lh.MulDirection(vector3.New(1., 0., 0.)), 1e-12)
+}
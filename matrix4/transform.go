@@ -0,0 +1,84 @@
+package matrix4
+
+import (
+	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/internal/parallel"
+	"github.com/EliCDavis/vector/vector3"
+)
+
+func checkLengths(dst, src int) {
+	if dst != src {
+		panic("matrix4: dst and src must have the same length")
+	}
+}
+
+// TransformPositions sets dst[i] to src[i] transformed by m as a point, see
+// MulPosition. The slices must share a length, and dst may be src. The
+// elements of m are loaded once up front, keeping the loop body to plain
+// multiply-adds.
+func TransformPositions[T vector.Number](dst, src []vector3.Vector[T], m Matrix[T]) {
+	checkLengths(len(dst), len(src))
+
+	m00, m10, m20, m30 := m.m[0], m.m[1], m.m[2], m.m[3]
+	m01, m11, m21, m31 := m.m[4], m.m[5], m.m[6], m.m[7]
+	m02, m12, m22, m32 := m.m[8], m.m[9], m.m[10], m.m[11]
+	m03, m13, m23, m33 := m.m[12], m.m[13], m.m[14], m.m[15]
+
+	if m30 == 0 && m31 == 0 && m32 == 0 && m33 == 1 {
+		// Affine, no divide needed
+		src = src[:len(dst)]
+		for i, v := range src {
+			x, y, z := v.X(), v.Y(), v.Z()
+			dst[i] = vector3.New(
+				m00*x+m01*y+m02*z+m03,
+				m10*x+m11*y+m12*z+m13,
+				m20*x+m21*y+m22*z+m23,
+			)
+		}
+		return
+	}
+
+	for i, v := range src {
+		dst[i] = m.MulPosition(v)
+	}
+}
+
+// TransformDirections sets dst[i] to src[i] transformed by m as a direction,
+// see MulDirection. The slices must share a length, and dst may be src
+func TransformDirections[T vector.Number](dst, src []vector3.Vector[T], m Matrix[T]) {
+	checkLengths(len(dst), len(src))
+
+	m00, m10, m20 := m.m[0], m.m[1], m.m[2]
+	m01, m11, m21 := m.m[4], m.m[5], m.m[6]
+	m02, m12, m22 := m.m[8], m.m[9], m.m[10]
+
+	src = src[:len(dst)]
+	for i, v := range src {
+		x, y, z := v.X(), v.Y(), v.Z()
+		dst[i] = vector3.New(
+			m00*x+m01*y+m02*z,
+			m10*x+m11*y+m12*z,
+			m20*x+m21*y+m22*z,
+		)
+	}
+}
+
+// ParallelTransformPositions is TransformPositions split across goroutines.
+// workers bounds the number of goroutines used, with values <= 0 using
+// GOMAXPROCS
+func ParallelTransformPositions[T vector.Number](dst, src []vector3.Vector[T], m Matrix[T], workers int) {
+	checkLengths(len(dst), len(src))
+	parallel.For(len(src), workers, func(_, start, end int) {
+		TransformPositions(dst[start:end], src[start:end], m)
+	})
+}
+
+// ParallelTransformDirections is TransformDirections split across goroutines.
+// workers bounds the number of goroutines used, with values <= 0 using
+// GOMAXPROCS
+func ParallelTransformDirections[T vector.Number](dst, src []vector3.Vector[T], m Matrix[T], workers int) {
+	checkLengths(len(dst), len(src))
+	parallel.For(len(src), workers, func(_, start, end int) {
+		TransformDirections(dst[start:end], src[start:end], m)
+	})
+}
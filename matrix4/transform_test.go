@@ -0,0 +1,81 @@
+package matrix4_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/EliCDavis/vector/matrix4"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/EliCDavis/vector/vector4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransformPositions(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	src := make([]vector3.Float64, 100)
+	for i := range src {
+		src[i] = vector3.RandRange(r, -10., 10.)
+	}
+
+	m := matrix4.Translation(vector3.New(1., 2., 3.)).Multiply(matrix4.Scale(vector3.New(2., -1., 0.5)))
+
+	dst := make([]vector3.Float64, len(src))
+	matrix4.TransformPositions(dst, src, m)
+	for i, v := range src {
+		assert.Equal(t, m.MulPosition(v), dst[i])
+	}
+
+	directions := make([]vector3.Float64, len(src))
+	matrix4.TransformDirections(directions, src, m)
+	for i, v := range src {
+		assert.Equal(t, m.MulDirection(v), directions[i])
+	}
+}
+
+func TestTransformPositionsProjective(t *testing.T) {
+	m := matrix4.FromRows(
+		vector4.New(1., 0., 0., 0.),
+		vector4.New(0., 1., 0., 0.),
+		vector4.New(0., 0., 1., 0.),
+		vector4.New(0., 0., 1., 0.),
+	)
+
+	points := []vector3.Float64{vector3.New(2., 4., 2.), vector3.New(3., 3., 3.)}
+	matrix4.TransformPositions(points, points, m)
+	assert.Equal(t, []vector3.Float64{vector3.New(1., 2., 1.), vector3.New(1., 1., 1.)}, points)
+}
+
+func TestTransformLengthMismatch(t *testing.T) {
+	assert.PanicsWithValue(t, "matrix4: dst and src must have the same length", func() {
+		matrix4.TransformPositions(make([]vector3.Float64, 1), make([]vector3.Float64, 2), matrix4.Identity[float64]())
+	})
+}
+
+func BenchmarkTransformPositions(b *testing.B) {
+	points := make([]vector3.Float32, 100_000)
+	m := matrix4.Translation(vector3.New[float32](1, 2, 3))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		matrix4.TransformPositions(points, points, m)
+	}
+}
+
+func TestParallelTransform(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	src := make([]vector3.Float64, 20_000)
+	for i := range src {
+		src[i] = vector3.RandRange(r, -10., 10.)
+	}
+	m := matrix4.Translation(vector3.New(1., 2., 3.)).Multiply(matrix4.Scale(vector3.New(2., -1., 0.5)))
+
+	want := make([]vector3.Float64, len(src))
+	got := make([]vector3.Float64, len(src))
+
+	matrix4.TransformPositions(want, src, m)
+	matrix4.ParallelTransformPositions(got, src, m, 4)
+	assert.Equal(t, want, got)
+
+	matrix4.TransformDirections(want, src, m)
+	matrix4.ParallelTransformDirections(got, src, m, 4)
+	assert.Equal(t, want, got)
+}
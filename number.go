@@ -3,3 +3,13 @@ package vector
 type Number interface {
 	int8 | int16 | int | int32 | int64 | float32 | float64
 }
+
+// Integer is the subset of Number made up of the signed integer types
+type Integer interface {
+	int8 | int16 | int | int32 | int64
+}
+
+// Float is the subset of Number made up of the floating point types
+type Float interface {
+	float32 | float64
+}
@@ -0,0 +1,414 @@
+package pointcloud
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/EliCDavis/vector/vector4"
+)
+
+// Format is the encoding of the body of a PLY file
+type Format int
+
+const (
+	ASCII Format = iota
+	BinaryLittleEndian
+	BinaryBigEndian
+)
+
+func (f Format) String() string {
+	switch f {
+	case ASCII:
+		return "ascii"
+	case BinaryLittleEndian:
+		return "binary_little_endian"
+	case BinaryBigEndian:
+		return "binary_big_endian"
+	}
+	return "Format(" + strconv.Itoa(int(f)) + ")"
+}
+
+type plyProperty struct {
+	name string
+	typ  string
+
+	// countType is the type of the length prefix of list properties, and
+	// empty for scalar ones
+	countType string
+}
+
+type plyElement struct {
+	name       string
+	count      int
+	properties []plyProperty
+}
+
+// plyTypeSizes is the size in bytes of every PLY scalar type, under both its
+// original and its sized name
+var plyTypeSizes = map[string]int{
+	"char": 1, "int8": 1,
+	"uchar": 1, "uint8": 1,
+	"short": 2, "int16": 2,
+	"ushort": 2, "uint16": 2,
+	"int": 4, "int32": 4,
+	"uint": 4, "uint32": 4,
+	"float": 4, "float32": 4,
+	"double": 8, "float64": 8,
+}
+
+// maxPLYPrealloc caps how many vertices ReadPLY allocates room for up front
+const maxPLYPrealloc = 1 << 16
+
+// ReadPLY reads the vertex element of an ASCII or binary PLY file. Points come
+// from the x, y, and z properties. When red, green, and blue properties are
+// present they're read as colors, along with alpha if there is one, with
+// integer channels scaled from the full range of their type to [0, 1]. Other
+// properties and elements, like faces, are skipped
+func ReadPLY(r io.Reader) (Cloud, error) {
+	reader := bufio.NewReader(r)
+	format, elements, err := readPLYHeader(reader)
+	if err != nil {
+		return Cloud{}, err
+	}
+
+	var order binary.ByteOrder = binary.LittleEndian
+	if format == BinaryBigEndian {
+		order = binary.BigEndian
+	}
+
+	for _, element := range elements {
+		if element.name != "vertex" {
+			// Elements before the vertices have to be read past
+			if format == ASCII {
+				err = readPLYElementASCII(reader, element, func([]float64) {})
+			} else {
+				err = readPLYElementBinary(reader, order, element, func([]float64) {})
+			}
+			if err != nil {
+				return Cloud{}, err
+			}
+			continue
+		}
+
+		return readPLYVertices(reader, format, order, element)
+	}
+	return Cloud{}, errors.New("ply: file has no vertex element")
+}
+
+func readPLYVertices(reader *bufio.Reader, format Format, order binary.ByteOrder, element plyElement) (Cloud, error) {
+	index := map[string]int{}
+	for i, p := range element.properties {
+		if p.countType == "" {
+			index[p.name] = i
+		}
+	}
+
+	x, hasX := index["x"]
+	y, hasY := index["y"]
+	z, hasZ := index["z"]
+	if !hasX || !hasY || !hasZ {
+		return Cloud{}, errors.New("ply: vertex element is missing x, y, or z")
+	}
+
+	red, hasRed := index["red"]
+	green, hasGreen := index["green"]
+	blue, hasBlue := index["blue"]
+	alpha, hasAlpha := index["alpha"]
+	colored := hasRed && hasGreen && hasBlue
+
+	// The count comes straight from the header, so it's only trusted up to a
+	// point, past which append grows the slices as vertices actually arrive
+	capacity := min(element.count, maxPLYPrealloc)
+	cloud := Cloud{Points: make([]vector3.Float64, 0, capacity)}
+	if colored {
+		cloud.Colors = make([]vector4.Float64, 0, capacity)
+	}
+
+	// Color channels stored as integers span the full range of their type
+	scale := func(i int) float64 {
+		switch element.properties[i].typ {
+		case "uchar", "uint8":
+			return 1. / math.MaxUint8
+		case "ushort", "uint16":
+			return 1. / math.MaxUint16
+		case "uint", "uint32":
+			return 1. / math.MaxUint32
+		case "char", "int8":
+			return 1. / math.MaxInt8
+		case "short", "int16":
+			return 1. / math.MaxInt16
+		case "int", "int32":
+			return 1. / math.MaxInt32
+		}
+		return 1
+	}
+
+	vertex := func(values []float64) {
+		cloud.Points = append(cloud.Points, vector3.New(values[x], values[y], values[z]))
+		if !colored {
+			return
+		}
+		a := 1.
+		if hasAlpha {
+			a = values[alpha] * scale(alpha)
+		}
+		cloud.Colors = append(cloud.Colors, vector4.New(
+			values[red]*scale(red),
+			values[green]*scale(green),
+			values[blue]*scale(blue),
+			a,
+		))
+	}
+
+	var err error
+	if format == ASCII {
+		err = readPLYElementASCII(reader, element, vertex)
+	} else {
+		err = readPLYElementBinary(reader, order, element, vertex)
+	}
+	if err != nil {
+		return Cloud{}, err
+	}
+	return cloud, nil
+}
+
+func readPLYHeader(reader *bufio.Reader) (Format, []plyElement, error) {
+	line, err := reader.ReadString('\n')
+	if err != nil || strings.TrimSpace(line) != "ply" {
+		return 0, nil, errors.New("ply: not a PLY file")
+	}
+
+	format := Format(-1)
+	var elements []plyElement
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return 0, nil, fmt.Errorf("ply: reading header: %w", err)
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch fields[0] {
+		case "comment", "obj_info":
+
+		case "format":
+			if len(fields) != 3 || fields[2] != "1.0" {
+				return 0, nil, fmt.Errorf("ply: unsupported format %q", strings.TrimSpace(line))
+			}
+			for _, f := range []Format{ASCII, BinaryLittleEndian, BinaryBigEndian} {
+				if f.String() == fields[1] {
+					format = f
+				}
+			}
+			if format < 0 {
+				return 0, nil, fmt.Errorf("ply: unsupported format %q", fields[1])
+			}
+
+		case "element":
+			if len(fields) != 3 {
+				return 0, nil, fmt.Errorf("ply: malformed element %q", strings.TrimSpace(line))
+			}
+			count, err := strconv.Atoi(fields[2])
+			if err != nil || count < 0 {
+				return 0, nil, fmt.Errorf("ply: invalid element count %q", fields[2])
+			}
+			elements = append(elements, plyElement{name: fields[1], count: count})
+
+		case "property":
+			if len(elements) == 0 {
+				return 0, nil, errors.New("ply: property declared before any element")
+			}
+			var p plyProperty
+			switch {
+			case len(fields) == 3:
+				p = plyProperty{typ: fields[1], name: fields[2]}
+			case len(fields) == 5 && fields[1] == "list":
+				p = plyProperty{countType: fields[2], typ: fields[3], name: fields[4]}
+				if _, ok := plyTypeSizes[p.countType]; !ok {
+					return 0, nil, fmt.Errorf("ply: unknown property type %q", p.countType)
+				}
+			default:
+				return 0, nil, fmt.Errorf("ply: malformed property %q", strings.TrimSpace(line))
+			}
+			if _, ok := plyTypeSizes[p.typ]; !ok {
+				return 0, nil, fmt.Errorf("ply: unknown property type %q", p.typ)
+			}
+			e := &elements[len(elements)-1]
+			e.properties = append(e.properties, p)
+
+		case "end_header":
+			if format < 0 {
+				return 0, nil, errors.New("ply: header is missing its format")
+			}
+			return format, elements, nil
+
+		default:
+			return 0, nil, fmt.Errorf("ply: unknown header line %q", strings.TrimSpace(line))
+		}
+	}
+}
+
+// readPLYElementASCII reads every instance of the element, one per line,
+// passing the values of its scalar properties to fn. List properties are
+// skipped
+func readPLYElementASCII(reader *bufio.Reader, element plyElement, fn func(values []float64)) error {
+	values := make([]float64, len(element.properties))
+	for i := 0; i < element.count; i++ {
+		line, err := reader.ReadString('\n')
+		if err != nil && (err != io.EOF || line == "") {
+			return fmt.Errorf("ply: reading %s %d: %w", element.name, i, err)
+		}
+
+		fields := strings.Fields(line)
+		for j, p := range element.properties {
+			if len(fields) == 0 {
+				return fmt.Errorf("ply: %s %d is missing property %s", element.name, i, p.name)
+			}
+			if p.countType != "" {
+				count, err := strconv.Atoi(fields[0])
+				if err != nil || count < 0 || count >= len(fields) {
+					return fmt.Errorf("ply: %s %d has an invalid list %s", element.name, i, p.name)
+				}
+				fields = fields[count+1:]
+				continue
+			}
+
+			v, err := strconv.ParseFloat(fields[0], 64)
+			if err != nil {
+				return fmt.Errorf("ply: %s %d: %w", element.name, i, err)
+			}
+			values[j] = v
+			fields = fields[1:]
+		}
+		fn(values)
+	}
+	return nil
+}
+
+// readPLYElementBinary reads every instance of the element, passing the
+// values of its scalar properties to fn. List properties are skipped
+func readPLYElementBinary(reader *bufio.Reader, order binary.ByteOrder, element plyElement, fn func(values []float64)) error {
+	values := make([]float64, len(element.properties))
+	buf := make([]byte, 8)
+	read := func(typ string) (float64, error) {
+		b := buf[:plyTypeSizes[typ]]
+		if _, err := io.ReadFull(reader, b); err != nil {
+			return 0, err
+		}
+		return decodePLYValue(b, typ, order), nil
+	}
+
+	for i := 0; i < element.count; i++ {
+		for j, p := range element.properties {
+			if p.countType == "" {
+				v, err := read(p.typ)
+				if err != nil {
+					return fmt.Errorf("ply: reading %s %d: %w", element.name, i, err)
+				}
+				values[j] = v
+				continue
+			}
+
+			count, err := read(p.countType)
+			if err != nil {
+				return fmt.Errorf("ply: reading %s %d: %w", element.name, i, err)
+			}
+			if count < 0 {
+				return fmt.Errorf("ply: %s %d has an invalid list %s", element.name, i, p.name)
+			}
+			if _, err := reader.Discard(int(count) * plyTypeSizes[p.typ]); err != nil {
+				return fmt.Errorf("ply: reading %s %d: %w", element.name, i, err)
+			}
+		}
+		fn(values)
+	}
+	return nil
+}
+
+func decodePLYValue(b []byte, typ string, order binary.ByteOrder) float64 {
+	switch typ {
+	case "char", "int8":
+		return float64(int8(b[0]))
+	case "uchar", "uint8":
+		return float64(b[0])
+	case "short", "int16":
+		return float64(int16(order.Uint16(b)))
+	case "ushort", "uint16":
+		return float64(order.Uint16(b))
+	case "int", "int32":
+		return float64(int32(order.Uint32(b)))
+	case "uint", "uint32":
+		return float64(order.Uint32(b))
+	case "float", "float32":
+		return float64(math.Float32frombits(order.Uint32(b)))
+	}
+	return math.Float64frombits(order.Uint64(b))
+}
+
+// WritePLY writes the cloud as a PLY file with a single vertex element, with
+// points stored as doubles and colors, when present, as uchar red, green,
+// blue, and alpha properties
+func WritePLY(w io.Writer, cloud Cloud, format Format) error {
+	if cloud.Colors != nil && len(cloud.Colors) != len(cloud.Points) {
+		return fmt.Errorf("cloud has %d colors for %d points", len(cloud.Colors), len(cloud.Points))
+	}
+
+	var order binary.AppendByteOrder
+	switch format {
+	case ASCII:
+	case BinaryLittleEndian:
+		order = binary.LittleEndian
+	case BinaryBigEndian:
+		order = binary.BigEndian
+	default:
+		return fmt.Errorf("ply: unsupported format %s", format)
+	}
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "ply\nformat %s 1.0\nelement vertex %d\n", format, len(cloud.Points))
+	bw.WriteString("property double x\nproperty double y\nproperty double z\n")
+	if cloud.Colors != nil {
+		bw.WriteString("property uchar red\nproperty uchar green\nproperty uchar blue\nproperty uchar alpha\n")
+	}
+	bw.WriteString("end_header\n")
+
+	buf := make([]byte, 0, 128)
+	for i, p := range cloud.Points {
+		buf = buf[:0]
+		var rgba [4]uint8
+		if cloud.Colors != nil {
+			c := cloud.Colors[i]
+			rgba = [4]uint8{toByte(c.X()), toByte(c.Y()), toByte(c.Z()), toByte(c.W())}
+		}
+
+		if format == ASCII {
+			buf = appendFloats(buf, p.X(), p.Y(), p.Z())
+			if cloud.Colors != nil {
+				buf = fmt.Appendf(buf, " %d %d %d %d", rgba[0], rgba[1], rgba[2], rgba[3])
+			}
+			buf = append(buf, '\n')
+		} else {
+			buf = order.AppendUint64(buf, math.Float64bits(p.X()))
+			buf = order.AppendUint64(buf, math.Float64bits(p.Y()))
+			buf = order.AppendUint64(buf, math.Float64bits(p.Z()))
+			if cloud.Colors != nil {
+				buf = append(buf, rgba[:]...)
+			}
+		}
+
+		if _, err := bw.Write(buf); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
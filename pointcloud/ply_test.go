@@ -0,0 +1,151 @@
+package pointcloud_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strings"
+	"testing"
+
+	"github.com/EliCDavis/vector/pointcloud"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/EliCDavis/vector/vector4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPLYRoundTrip(t *testing.T) {
+	clouds := map[string]pointcloud.Cloud{
+		"uncolored": {
+			Points: []vector3.Float64{vector3.New(1., 0.1, -3.), vector3.New(4., 5., 6e10)},
+		},
+		"colored": {
+			Points: []vector3.Float64{vector3.New(1., 0.1, -3.), vector3.New(4., 5., 6e10)},
+			Colors: []vector4.Float64{vector4.New(1., 0., 0.2, 1.), vector4.New(0., 1., 0., 0.)},
+		},
+		"empty": {},
+	}
+
+	for name, cloud := range clouds {
+		for _, format := range []pointcloud.Format{pointcloud.ASCII, pointcloud.BinaryLittleEndian, pointcloud.BinaryBigEndian} {
+			t.Run(name+" "+format.String(), func(t *testing.T) {
+				buf := bytes.Buffer{}
+				require.NoError(t, pointcloud.WritePLY(&buf, cloud, format))
+
+				back, err := pointcloud.ReadPLY(&buf)
+				require.NoError(t, err)
+				if len(cloud.Points) == 0 {
+					assert.Empty(t, back.Points)
+				} else {
+					assert.Equal(t, cloud.Points, back.Points)
+				}
+				if cloud.Colors == nil {
+					assert.Nil(t, back.Colors)
+				} else {
+					assert.Equal(t, cloud.Colors, back.Colors)
+				}
+			})
+		}
+	}
+}
+
+func TestReadPLYASCII(t *testing.T) {
+	ply := `ply
+format ascii 1.0
+comment made by hand
+element vertex 2
+property float x
+property float y
+property float z
+property list uchar int vertex_indices
+property ushort red
+property ushort green
+property ushort blue
+element face 1
+property list uchar int vertex_indices
+end_header
+1 2 3 2 7 8 65535 0 0
+4 5 6 0 0 65535 0
+3 0 1 1
+`
+	cloud, err := pointcloud.ReadPLY(strings.NewReader(ply))
+	require.NoError(t, err)
+	assert.Equal(t, []vector3.Float64{vector3.New(1., 2., 3.), vector3.New(4., 5., 6.)}, cloud.Points)
+	assert.Equal(t, []vector4.Float64{vector4.New(1., 0., 0., 1.), vector4.New(0., 1., 0., 1.)}, cloud.Colors)
+}
+
+func TestReadPLYBinarySkipsLeadingElements(t *testing.T) {
+	buf := bytes.Buffer{}
+	buf.WriteString(`ply
+format binary_big_endian 1.0
+element camera 1
+property list uchar short ids
+property double fov
+element vertex 1
+property short x
+property int y
+property float z
+property uchar alpha
+end_header
+`)
+	binary.Write(&buf, binary.BigEndian, uint8(2))
+	binary.Write(&buf, binary.BigEndian, []int16{1, 2})
+	binary.Write(&buf, binary.BigEndian, float64(60))
+	binary.Write(&buf, binary.BigEndian, int16(-7))
+	binary.Write(&buf, binary.BigEndian, int32(100000))
+	binary.Write(&buf, binary.BigEndian, float32(0.5))
+	binary.Write(&buf, binary.BigEndian, uint8(255))
+
+	cloud, err := pointcloud.ReadPLY(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, []vector3.Float64{vector3.New(-7., 100000., 0.5)}, cloud.Points)
+	assert.Nil(t, cloud.Colors)
+}
+
+func TestReadPLYErrors(t *testing.T) {
+	tests := map[string]struct {
+		data string
+		err  string
+	}{
+		"not ply": {
+			data: "solid cube\n",
+			err:  "ply: not a PLY file",
+		},
+		"format": {
+			data: "ply\nformat binary_middle_endian 1.0\nend_header\n",
+			err:  `ply: unsupported format "binary_middle_endian"`,
+		},
+		"no vertices": {
+			data: "ply\nformat ascii 1.0\nelement face 0\nproperty list uchar int vertex_indices\nend_header\n",
+			err:  "ply: file has no vertex element",
+		},
+		"no z": {
+			data: "ply\nformat ascii 1.0\nelement vertex 1\nproperty float x\nproperty float y\nend_header\n1 2\n",
+			err:  "ply: vertex element is missing x, y, or z",
+		},
+		"unknown type": {
+			data: "ply\nformat ascii 1.0\nelement vertex 1\nproperty float128 x\nend_header\n",
+			err:  `ply: unknown property type "float128"`,
+		},
+		"truncated": {
+			data: "ply\nformat ascii 1.0\nelement vertex 2\nproperty float x\nproperty float y\nproperty float z\nend_header\n1 2 3\n",
+			err:  "ply: reading vertex 1: EOF",
+		},
+		"oversized count": {
+			data: "ply\nformat binary_little_endian 1.0\nelement vertex 999999999999999\nproperty float x\nproperty float y\nproperty float z\nend_header\n",
+			err:  "ply: reading vertex 0: EOF",
+		},
+		"short line": {
+			data: "ply\nformat ascii 1.0\nelement vertex 1\nproperty float x\nproperty float y\nproperty float z\nend_header\n1 2\n",
+			err:  "ply: vertex 0 is missing property z",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := pointcloud.ReadPLY(strings.NewReader(tc.data))
+			assert.EqualError(t, err, tc.err)
+		})
+	}
+
+	assert.EqualError(t, pointcloud.WritePLY(&bytes.Buffer{}, pointcloud.Cloud{}, pointcloud.Format(7)), "ply: unsupported format Format(7)")
+}
@@ -0,0 +1,43 @@
+// Package pointcloud loads and saves point clouds in the ASCII XYZ and the
+// ASCII or binary PLY formats, enough to get scan data into and out of the
+// library's algorithms.
+package pointcloud
+
+import (
+	"math"
+
+	"github.com/EliCDavis/vector/mathex"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/EliCDavis/vector/vector4"
+)
+
+// Cloud is a set of points with optional per-point colors
+type Cloud struct {
+	Points []vector3.Float64
+
+	// Colors holds the red, green, blue, and alpha of each point in the range
+	// [0, 1], or is nil when the points are uncolored. Formats without alpha
+	// read as opaque
+	Colors []vector4.Float64
+}
+
+// RGB returns the colors without their alpha, or nil when the points are
+// uncolored
+func (c Cloud) RGB() []vector3.Float64 {
+	if c.Colors == nil {
+		return nil
+	}
+	rgb := make([]vector3.Float64, len(c.Colors))
+	for i, color := range c.Colors {
+		rgb[i] = color.XYZ()
+	}
+	return rgb
+}
+
+// toByte converts a color channel in [0, 1] to [0, 255], treating NaN as 0
+func toByte(c float64) uint8 {
+	if math.IsNaN(c) {
+		return 0
+	}
+	return uint8(mathex.Clamp(c, 0, 1)*255 + 0.5)
+}
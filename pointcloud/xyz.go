@@ -0,0 +1,100 @@
+package pointcloud
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/EliCDavis/vector/vector4"
+)
+
+// ReadXYZ reads a point cloud in the ASCII XYZ format, with one point per line
+// as "x y z", optionally followed by "r g b" in the range [0, 255]. Values may
+// be separated by spaces, tabs, or commas. Blank lines and lines starting
+// with # are skipped, and columns beyond the color are ignored. Whether the
+// points are colored is decided by the first point, and every following point
+// must match
+func ReadXYZ(r io.Reader) (Cloud, error) {
+	var cloud Cloud
+	colored := false
+
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || text[0] == '#' {
+			continue
+		}
+
+		fields := strings.FieldsFunc(text, func(r rune) bool {
+			return r == ' ' || r == '\t' || r == ','
+		})
+		if len(cloud.Points) == 0 {
+			colored = len(fields) >= 6
+		}
+
+		want := 3
+		if colored {
+			want = 6
+		}
+		if len(fields) < want {
+			return Cloud{}, fmt.Errorf("line %d: expected at least %d values, got %d", line, want, len(fields))
+		}
+
+		var values [6]float64
+		for i := 0; i < want; i++ {
+			v, err := strconv.ParseFloat(fields[i], 64)
+			if err != nil {
+				return Cloud{}, fmt.Errorf("line %d: %w", line, err)
+			}
+			values[i] = v
+		}
+
+		cloud.Points = append(cloud.Points, vector3.New(values[0], values[1], values[2]))
+		if colored {
+			cloud.Colors = append(cloud.Colors, vector4.New(values[3]/255, values[4]/255, values[5]/255, 1))
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return Cloud{}, err
+	}
+	return cloud, nil
+}
+
+// WriteXYZ writes the cloud in the ASCII XYZ format, with colors written as
+// "r g b" in the range [0, 255] after each point when present. Alpha is
+// dropped, as the format has no place for it
+func WriteXYZ(w io.Writer, cloud Cloud) error {
+	if cloud.Colors != nil && len(cloud.Colors) != len(cloud.Points) {
+		return fmt.Errorf("cloud has %d colors for %d points", len(cloud.Colors), len(cloud.Points))
+	}
+
+	bw := bufio.NewWriter(w)
+	buf := make([]byte, 0, 128)
+	for i, p := range cloud.Points {
+		buf = appendFloats(buf[:0], p.X(), p.Y(), p.Z())
+		if cloud.Colors != nil {
+			c := cloud.Colors[i]
+			buf = fmt.Appendf(buf, " %d %d %d", toByte(c.X()), toByte(c.Y()), toByte(c.Z()))
+		}
+		buf = append(buf, '\n')
+		if _, err := bw.Write(buf); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+// appendFloats appends the values separated by spaces, using the fewest
+// digits that read back exactly
+func appendFloats(dst []byte, values ...float64) []byte {
+	for i, v := range values {
+		if i > 0 {
+			dst = append(dst, ' ')
+		}
+		dst = strconv.AppendFloat(dst, v, 'g', -1, 64)
+	}
+	return dst
+}
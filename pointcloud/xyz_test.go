@@ -0,0 +1,64 @@
+package pointcloud_test
+
+import (
+	"bytes"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/EliCDavis/vector/pointcloud"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/EliCDavis/vector/vector4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadXYZ(t *testing.T) {
+	cloud, err := pointcloud.ReadXYZ(strings.NewReader("# scan\n1 2 3\n\n4.5,-5,6e2\n-1\t0\t1 0.7\n"))
+	require.NoError(t, err)
+	assert.Equal(t, []vector3.Float64{
+		vector3.New(1., 2., 3.),
+		vector3.New(4.5, -5., 600.),
+		vector3.New(-1., 0., 1.),
+	}, cloud.Points)
+	assert.Nil(t, cloud.Colors)
+	assert.Nil(t, cloud.RGB())
+}
+
+func TestReadXYZColored(t *testing.T) {
+	cloud, err := pointcloud.ReadXYZ(strings.NewReader("1 2 3 255 0 51\n4 5 6 0 255 0 0.5\n"))
+	require.NoError(t, err)
+	assert.Equal(t, []vector4.Float64{vector4.New(1., 0., 0.2, 1.), vector4.New(0., 1., 0., 1.)}, cloud.Colors)
+	assert.Equal(t, []vector3.Float64{vector3.New(1., 0., 0.2), vector3.New(0., 1., 0.)}, cloud.RGB())
+
+	_, err = pointcloud.ReadXYZ(strings.NewReader("1 2 3 255 0 51\n4 5 6\n"))
+	assert.EqualError(t, err, "line 2: expected at least 6 values, got 3")
+
+	_, err = pointcloud.ReadXYZ(strings.NewReader("1 2 x\n"))
+	assert.EqualError(t, err, `line 1: strconv.ParseFloat: parsing "x": invalid syntax`)
+}
+
+func TestWriteXYZ(t *testing.T) {
+	cloud := pointcloud.Cloud{
+		Points: []vector3.Float64{vector3.New(1., 0.1, -3.), vector3.New(4., 5., 6.)},
+		Colors: []vector4.Float64{vector4.New(1., 0., 0.2, 1.), vector4.New(0., 2., -1., 0.)},
+	}
+
+	buf := bytes.Buffer{}
+	require.NoError(t, pointcloud.WriteXYZ(&buf, cloud))
+	assert.Equal(t, "1 0.1 -3 255 0 51\n4 5 6 0 255 0\n", buf.String())
+
+	// Alpha can't be written to XYZ files
+	back, err := pointcloud.ReadXYZ(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, cloud.Points, back.Points)
+	assert.Equal(t, cloud.RGB()[0], back.RGB()[0])
+
+	cloud.Colors[1] = vector4.New(math.NaN(), 1., 0., 1.)
+	buf.Reset()
+	require.NoError(t, pointcloud.WriteXYZ(&buf, cloud))
+	assert.Equal(t, "1 0.1 -3 255 0 51\n4 5 6 0 255 0\n", buf.String())
+
+	cloud.Colors = cloud.Colors[:1]
+	assert.EqualError(t, pointcloud.WriteXYZ(&buf, cloud), "cloud has 1 colors for 2 points")
+}
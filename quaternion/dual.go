@@ -0,0 +1,129 @@
+package quaternion
+
+import (
+	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/matrix4"
+	"github.com/EliCDavis/vector/vector3"
+)
+
+// Dual is a dual quaternion, a real quaternion holding a rotation paired
+// with a dual quaternion encoding a translation. Unit dual quaternions
+// represent rigid transforms, and unlike matrices can be blended without
+// introducing scale or shear, which makes them well suited to skeletal
+// skinning
+type Dual[T vector.Float] struct {
+	real, dual Quaternion[T]
+}
+
+// NewDual creates a dual quaternion from its real and dual parts
+func NewDual[T vector.Float](realPart, dualPart Quaternion[T]) Dual[T] {
+	return Dual[T]{real: realPart, dual: dualPart}
+}
+
+// DualIdentity returns the dual quaternion representing no transform
+func DualIdentity[T vector.Float]() Dual[T] {
+	return Dual[T]{real: Identity[T]()}
+}
+
+// DualFromRotationTranslation creates the rigid transform that applies the
+// unit quaternion rotation followed by translation
+func DualFromRotationTranslation[T vector.Float](rotation Quaternion[T], translation vector3.Vector[T]) Dual[T] {
+	t := Quaternion[T]{x: translation.X(), y: translation.Y(), z: translation.Z()}
+	return Dual[T]{real: rotation, dual: t.Multiply(rotation).Scale(0.5)}
+}
+
+// Real returns the real part, the transform's rotation
+func (d Dual[T]) Real() Quaternion[T] {
+	return d.real
+}
+
+// DualPart returns the dual part, which encodes the transform's translation
+func (d Dual[T]) DualPart() Quaternion[T] {
+	return d.dual
+}
+
+// Rotation returns the rotation of the rigid transform
+func (d Dual[T]) Rotation() Quaternion[T] {
+	return d.real
+}
+
+// Translation returns the translation of the rigid transform
+func (d Dual[T]) Translation() vector3.Vector[T] {
+	return d.dual.Multiply(d.real.Conjugate()).Scale(2).Imaginary()
+}
+
+func (d Dual[T]) Add(o Dual[T]) Dual[T] {
+	return Dual[T]{real: d.real.Add(o.real), dual: d.dual.Add(o.dual)}
+}
+
+func (d Dual[T]) Scale(t float64) Dual[T] {
+	return Dual[T]{real: d.real.Scale(t), dual: d.dual.Scale(t)}
+}
+
+// Multiply returns d × o, the transform that applies o first and then d
+func (d Dual[T]) Multiply(o Dual[T]) Dual[T] {
+	return Dual[T]{
+		real: d.real.Multiply(o.real),
+		dual: d.real.Multiply(o.dual).Add(d.dual.Multiply(o.real)),
+	}
+}
+
+// Conjugate conjugates both parts, which for unit dual quaternions gives the
+// inverse transform
+func (d Dual[T]) Conjugate() Dual[T] {
+	return Dual[T]{real: d.real.Conjugate(), dual: d.dual.Conjugate()}
+}
+
+// Normalized scales the dual quaternion so that it represents a rigid
+// transform, bringing the real part to unit length and making the dual part
+// orthogonal to it. A zero real part becomes the identity
+func (d Dual[T]) Normalized() Dual[T] {
+	l := d.real.Length()
+	if l == 0 {
+		return DualIdentity[T]()
+	}
+	r := d.real.Scale(1 / l)
+	du := d.dual.Scale(1 / l)
+	return Dual[T]{real: r, dual: du.Sub(r.Scale(float64(r.Dot(du))))}
+}
+
+// TransformPoint applies the rigid transform to the point v
+func (d Dual[T]) TransformPoint(v vector3.Vector[T]) vector3.Vector[T] {
+	return d.real.Rotate(v).Add(d.Translation())
+}
+
+// TransformDirection applies only the rotation of the transform to v
+func (d Dual[T]) TransformDirection(v vector3.Vector[T]) vector3.Vector[T] {
+	return d.real.Rotate(v)
+}
+
+// Matrix returns the transformation matrix equivalent to the unit dual
+// quaternion
+func (d Dual[T]) Matrix() matrix4.Matrix[T] {
+	return matrix4.Translation(d.Translation()).Multiply(d.real.Matrix())
+}
+
+// BlendDual blends the rigid transforms by their weights using dual
+// quaternion linear blending (DLB), as used for skinning a vertex to several
+// bones. Transforms are flipped as needed to blend along the shorter path
+// from the first. The weights don't need to sum to one. Mismatched lengths
+// panic
+func BlendDual[T vector.Float](transforms []Dual[T], weights []float64) Dual[T] {
+	if len(transforms) != len(weights) {
+		panic("quaternion: transforms and weights must have the same length")
+	}
+	if len(transforms) == 0 {
+		return DualIdentity[T]()
+	}
+
+	pivot := transforms[0].real
+	var sum Dual[T]
+	for i, d := range transforms {
+		w := weights[i]
+		if pivot.Dot(d.real) < 0 {
+			w = -w
+		}
+		sum = sum.Add(d.Scale(w))
+	}
+	return sum.Normalized()
+}
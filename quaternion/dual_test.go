@@ -0,0 +1,65 @@
+package quaternion_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/EliCDavis/vector/quaternion"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/EliCDavis/vector/vectortest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDualTransform(t *testing.T) {
+	r := quaternion.FromAxisAngle(vector3.New(0., 0., 1.), math.Pi/2)
+	d := quaternion.DualFromRotationTranslation(r, vector3.New(1., 2., 3.))
+
+	vectortest.AssertVector3InDelta(t, vector3.New(1., 2., 3.), d.Translation(), 1e-12)
+	vectortest.AssertVector3InDelta(t, vector3.New(1., 3., 3.), d.TransformPoint(vector3.New(1., 0., 0.)), 1e-12)
+	vectortest.AssertVector3InDelta(t, vector3.New(0., 1., 0.), d.TransformDirection(vector3.New(1., 0., 0.)), 1e-12)
+	vectortest.AssertVector3InDelta(t, d.TransformPoint(vector3.New(4., 5., 6.)), d.Matrix().MulPosition(vector3.New(4., 5., 6.)), 1e-12)
+
+	back := d.Conjugate().TransformPoint(d.TransformPoint(vector3.New(4., 5., 6.)))
+	vectortest.AssertVector3InDelta(t, vector3.New(4., 5., 6.), back, 1e-12)
+}
+
+func TestDualMultiplyComposes(t *testing.T) {
+	a := quaternion.DualFromRotationTranslation(quaternion.FromAxisAngle(vector3.New(1., 0., 0.), 0.7), vector3.New(0., 1., 0.))
+	b := quaternion.DualFromRotationTranslation(quaternion.FromAxisAngle(vector3.New(0., 1., 1.), -1.3), vector3.New(5., 0., -2.))
+	v := vector3.New(1., -2., 0.5)
+
+	vectortest.AssertVector3InDelta(t, a.TransformPoint(b.TransformPoint(v)), a.Multiply(b).TransformPoint(v), 1e-12)
+	vectortest.AssertVector3InDelta(t, v, quaternion.DualIdentity[float64]().TransformPoint(v), 0)
+}
+
+func TestDualNormalized(t *testing.T) {
+	d := quaternion.DualFromRotationTranslation(quaternion.FromAxisAngle(vector3.New(1., 0., 0.), 0.7), vector3.New(0., 1., 0.))
+	n := d.Scale(3).Normalized()
+	assert.InDelta(t, 1., n.Real().Length(), 1e-12)
+	assert.InDelta(t, 0., n.Real().Dot(n.DualPart()), 1e-12)
+	vectortest.AssertVector3InDelta(t, d.Translation(), n.Translation(), 1e-12)
+}
+
+func TestBlendDual(t *testing.T) {
+	a := quaternion.DualFromRotationTranslation(quaternion.Identity[float64](), vector3.New(0., 0., 0.))
+	b := quaternion.DualFromRotationTranslation(quaternion.FromAxisAngle(vector3.New(0., 0., 1.), math.Pi/2), vector3.New(2., 0., 0.))
+
+	blended := quaternion.BlendDual([]quaternion.Dual[float64]{a, b}, []float64{1, 1})
+	_, angle := blended.Rotation().AxisAngle()
+	assert.InDelta(t, math.Pi/4, angle, 1e-12)
+	assert.InDelta(t, 1., blended.Real().Length(), 1e-12)
+
+	// Antipodal representations of the same transform blend identically
+	flipped := quaternion.BlendDual([]quaternion.Dual[float64]{a, b.Scale(-1)}, []float64{1, 1})
+	v := vector3.New(1., 1., 1.)
+	vectortest.AssertVector3InDelta(t, blended.TransformPoint(v), flipped.TransformPoint(v), 1e-12)
+
+	// A single bone reproduces its transform
+	only := quaternion.BlendDual([]quaternion.Dual[float64]{b}, []float64{0.5})
+	vectortest.AssertVector3InDelta(t, b.TransformPoint(v), only.TransformPoint(v), 1e-12)
+
+	assert.Equal(t, quaternion.DualIdentity[float64](), quaternion.BlendDual[float64](nil, nil))
+	assert.Panics(t, func() {
+		quaternion.BlendDual([]quaternion.Dual[float64]{a}, nil)
+	})
+}
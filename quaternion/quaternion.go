@@ -0,0 +1,190 @@
+// Package quaternion provides quaternions for representing 3D rotations,
+// along with dual quaternions for representing rigid transforms.
+package quaternion
+
+import (
+	"math"
+
+	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/matrix4"
+	"github.com/EliCDavis/vector/vector3"
+)
+
+// Quaternion is made up of an imaginary part x, y, z and a real part w,
+// stored in the same order as glTF. Rotations are represented by
+// quaternions of unit length
+type Quaternion[T vector.Float] struct {
+	x, y, z, w T
+}
+
+type (
+	Float64 = Quaternion[float64]
+	Float32 = Quaternion[float32]
+)
+
+// New creates a quaternion from its components
+func New[T vector.Float](x, y, z, w T) Quaternion[T] {
+	return Quaternion[T]{x: x, y: y, z: z, w: w}
+}
+
+// Identity returns the quaternion representing no rotation
+func Identity[T vector.Float]() Quaternion[T] {
+	return Quaternion[T]{w: 1}
+}
+
+// FromAxisAngle creates a quaternion rotating counter-clockwise by angle
+// radians around axis, following the right hand rule. The axis does not
+// need to be normalized
+func FromAxisAngle[T vector.Float](axis vector3.Vector[T], angle float64) Quaternion[T] {
+	l := axis.Length()
+	if l == 0 {
+		return Identity[T]()
+	}
+	sin, cos := math.Sincos(angle / 2)
+	s := T(sin / l)
+	return Quaternion[T]{x: axis.X() * s, y: axis.Y() * s, z: axis.Z() * s, w: T(cos)}
+}
+
+func (q Quaternion[T]) X() T {
+	return q.x
+}
+
+func (q Quaternion[T]) Y() T {
+	return q.y
+}
+
+func (q Quaternion[T]) Z() T {
+	return q.z
+}
+
+func (q Quaternion[T]) W() T {
+	return q.w
+}
+
+// Imaginary returns the x, y, z part of the quaternion as a vector
+func (q Quaternion[T]) Imaginary() vector3.Vector[T] {
+	return vector3.New(q.x, q.y, q.z)
+}
+
+func (q Quaternion[T]) Add(o Quaternion[T]) Quaternion[T] {
+	return Quaternion[T]{q.x + o.x, q.y + o.y, q.z + o.z, q.w + o.w}
+}
+
+func (q Quaternion[T]) Sub(o Quaternion[T]) Quaternion[T] {
+	return Quaternion[T]{q.x - o.x, q.y - o.y, q.z - o.z, q.w - o.w}
+}
+
+func (q Quaternion[T]) Scale(t float64) Quaternion[T] {
+	s := T(t)
+	return Quaternion[T]{q.x * s, q.y * s, q.z * s, q.w * s}
+}
+
+func (q Quaternion[T]) Dot(o Quaternion[T]) T {
+	return q.x*o.x + q.y*o.y + q.z*o.z + q.w*o.w
+}
+
+func (q Quaternion[T]) LengthSquared() T {
+	return q.Dot(q)
+}
+
+func (q Quaternion[T]) Length() float64 {
+	return math.Sqrt(float64(q.LengthSquared()))
+}
+
+// Normalized scales the quaternion to unit length. The zero quaternion
+// becomes the identity
+func (q Quaternion[T]) Normalized() Quaternion[T] {
+	l := q.Length()
+	if l == 0 {
+		return Identity[T]()
+	}
+	return q.Scale(1 / l)
+}
+
+// Conjugate negates the imaginary part, which for unit quaternions gives
+// the opposite rotation
+func (q Quaternion[T]) Conjugate() Quaternion[T] {
+	return Quaternion[T]{-q.x, -q.y, -q.z, q.w}
+}
+
+// Inverse returns the quaternion that multiplied with q gives the identity
+func (q Quaternion[T]) Inverse() Quaternion[T] {
+	lensq := q.LengthSquared()
+	if lensq == 0 {
+		return q
+	}
+	return q.Conjugate().Scale(1 / float64(lensq))
+}
+
+// Multiply returns the Hamilton product q × o. As rotations, the result
+// applies o first and then q
+func (q Quaternion[T]) Multiply(o Quaternion[T]) Quaternion[T] {
+	return Quaternion[T]{
+		x: q.w*o.x + q.x*o.w + q.y*o.z - q.z*o.y,
+		y: q.w*o.y - q.x*o.z + q.y*o.w + q.z*o.x,
+		z: q.w*o.z + q.x*o.y - q.y*o.x + q.z*o.w,
+		w: q.w*o.w - q.x*o.x - q.y*o.y - q.z*o.z,
+	}
+}
+
+// Rotate applies the rotation the unit quaternion represents to v
+func (q Quaternion[T]) Rotate(v vector3.Vector[T]) vector3.Vector[T] {
+	// v + 2w(u × v) + 2u × (u × v), where u is the imaginary part
+	u := q.Imaginary()
+	t := u.Cross(v).Scale(2)
+	return v.Add(t.Scale(float64(q.w))).Add(u.Cross(t))
+}
+
+// AxisAngle returns the axis and angle in radians of the rotation the unit
+// quaternion represents. The identity results in an axis of +X and an angle
+// of 0
+func (q Quaternion[T]) AxisAngle() (vector3.Vector[T], float64) {
+	if q.w < 0 {
+		q = q.Scale(-1)
+	}
+	sin := math.Sqrt(float64(q.x*q.x + q.y*q.y + q.z*q.z))
+	if sin == 0 {
+		return vector3.New[T](1, 0, 0), 0
+	}
+	return q.Imaginary().Scale(1 / sin), 2 * math.Atan2(sin, float64(q.w))
+}
+
+// Matrix returns the rotation matrix equivalent to the unit quaternion
+func (q Quaternion[T]) Matrix() matrix4.Matrix[T] {
+	x, y, z, w := q.x, q.y, q.z, q.w
+	return matrix4.FromArray([16]T{
+		1 - 2*(y*y+z*z), 2 * (x*y + z*w), 2 * (x*z - y*w), 0,
+		2 * (x*y - z*w), 1 - 2*(x*x+z*z), 2 * (y*z + x*w), 0,
+		2 * (x*z + y*w), 2 * (y*z - x*w), 1 - 2*(x*x+y*y), 0,
+		0, 0, 0, 1,
+	})
+}
+
+// Nlerp linearly interpolates between a and b and normalizes the result,
+// taking the shorter path between the two rotations. It's cheaper than Slerp
+// but doesn't rotate at a constant rate
+func Nlerp[T vector.Float](a, b Quaternion[T], t float64) Quaternion[T] {
+	if a.Dot(b) < 0 {
+		b = b.Scale(-1)
+	}
+	return a.Scale(1 - t).Add(b.Scale(t)).Normalized()
+}
+
+// Slerp spherically interpolates between the unit quaternions a and b at a
+// constant rate, taking the shorter path between the two rotations
+func Slerp[T vector.Float](a, b Quaternion[T], t float64) Quaternion[T] {
+	cos := float64(a.Dot(b))
+	if cos < 0 {
+		b = b.Scale(-1)
+		cos = -cos
+	}
+
+	// Nearly identical rotations, where dividing by sin would be unstable
+	if cos > 0.9995 {
+		return Nlerp(a, b, t)
+	}
+
+	theta := math.Acos(cos)
+	sin := math.Sin(theta)
+	return a.Scale(math.Sin((1-t)*theta) / sin).Add(b.Scale(math.Sin(t*theta) / sin))
+}
@@ -0,0 +1,60 @@
+package quaternion_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/EliCDavis/vector/quaternion"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/EliCDavis/vector/vectortest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRotate(t *testing.T) {
+	q := quaternion.FromAxisAngle(vector3.New(0., 0., 2.), math.Pi/2)
+	assert.InDelta(t, 1., q.Length(), 1e-12)
+
+	vectortest.AssertVector3InDelta(t, vector3.New(0., 1., 0.), q.Rotate(vector3.New(1., 0., 0.)), 1e-12)
+	vectortest.AssertVector3InDelta(t, vector3.New(1., 0., 0.), q.Conjugate().Rotate(vector3.New(0., 1., 0.)), 1e-12)
+	vectortest.AssertVector3InDelta(t, vector3.New(1., 2., 3.), quaternion.Identity[float64]().Rotate(vector3.New(1., 2., 3.)), 0)
+}
+
+func TestMultiplyComposes(t *testing.T) {
+	rz := quaternion.FromAxisAngle(vector3.New(0., 0., 1.), math.Pi/2)
+	rx := quaternion.FromAxisAngle(vector3.New(1., 0., 0.), math.Pi/2)
+	v := vector3.New(1., 2., 3.)
+
+	vectortest.AssertVector3InDelta(t, rx.Rotate(rz.Rotate(v)), rx.Multiply(rz).Rotate(v), 1e-12)
+	vectortest.AssertVector3InDelta(t, rx.Multiply(rz).Matrix().MulPosition(v), rx.Multiply(rz).Rotate(v), 1e-12)
+
+	inv := rz.Scale(2).Inverse()
+	identity := rz.Scale(2).Multiply(inv)
+	assert.InDelta(t, 1., identity.W(), 1e-12)
+	vectortest.AssertVector3InDelta(t, vector3.Zero[float64](), identity.Imaginary(), 1e-12)
+}
+
+func TestAxisAngle(t *testing.T) {
+	axis, angle := quaternion.FromAxisAngle(vector3.New(1., 1., 0.), 1.2).AxisAngle()
+	vectortest.AssertVector3InDelta(t, vector3.New(1., 1., 0.).Normalized(), axis, 1e-12)
+	assert.InDelta(t, 1.2, angle, 1e-12)
+
+	_, angle = quaternion.Identity[float64]().AxisAngle()
+	assert.Equal(t, 0., angle)
+}
+
+func TestSlerp(t *testing.T) {
+	a := quaternion.Identity[float64]()
+	b := quaternion.FromAxisAngle(vector3.New(0., 1., 0.), math.Pi/2)
+
+	half := quaternion.Slerp(a, b, 0.5)
+	_, angle := half.AxisAngle()
+	assert.InDelta(t, math.Pi/4, angle, 1e-12)
+
+	// Takes the short way around when b is negated
+	half = quaternion.Slerp(a, b.Scale(-1), 0.5)
+	_, angle = half.AxisAngle()
+	assert.InDelta(t, math.Pi/4, angle, 1e-12)
+
+	assert.InDelta(t, 1., quaternion.Nlerp(a, b, 0.3).Length(), 1e-12)
+	assert.Equal(t, a, quaternion.Slerp(a, a, 0.5))
+}
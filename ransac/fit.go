@@ -0,0 +1,262 @@
+package ransac
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/EliCDavis/vector/internal/eigen"
+	"github.com/EliCDavis/vector/intersect"
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vector3"
+)
+
+// Line2Fit is the result of fitting a line to 2D points
+type Line2Fit struct {
+	// Line is the least squares fit through the inliers
+	Line vector2.LineFit
+
+	// Inliers are the indices of the points within the threshold of the
+	// line, in increasing order
+	Inliers []int
+}
+
+// FitLine2 fits a line to the points, ignoring outliers further than
+// opts.Threshold from it. It returns false when there are fewer than two
+// distinct points
+func FitLine2(r *rand.Rand, points []vector2.Float64, opts Options) (Line2Fit, bool) {
+	if len(points) < 2 {
+		return Line2Fit{}, false
+	}
+
+	type line struct{ point, normal vector2.Float64 }
+	best, _, ok := search(r, len(points), 2, opts,
+		func(indices []int) (line, bool) {
+			a, b := points[indices[0]], points[indices[1]]
+			if a == b {
+				return line{}, false
+			}
+			return line{a, b.Sub(a).Normalized().Perpendicular()}, true
+		},
+		func(l line, i int) float64 {
+			return math.Abs(points[i].Sub(l.point).Dot(l.normal))
+		},
+	)
+	if !ok {
+		return Line2Fit{}, false
+	}
+
+	inliers := make([]vector2.Float64, len(best.inliers))
+	for i, index := range best.inliers {
+		inliers[i] = points[index]
+	}
+	refined, ok := vector2.FitLine(inliers)
+	if !ok {
+		return Line2Fit{}, false
+	}
+
+	return Line2Fit{
+		Line: refined,
+		Inliers: measure(len(points), opts.Threshold, func(i int) float64 {
+			return math.Abs(refined.Residual(points[i]))
+		}).inliers,
+	}, true
+}
+
+// Line3Fit is the result of fitting a line to 3D points
+type Line3Fit struct {
+	// Point is a point on the line, the centroid of the inliers
+	Point vector3.Float64
+
+	// Direction is the unit length direction of the line. Its sign is
+	// arbitrary
+	Direction vector3.Float64
+
+	// Inliers are the indices of the points within the threshold of the
+	// line, in increasing order
+	Inliers []int
+}
+
+func lineDistance(point, direction, p vector3.Float64) float64 {
+	return p.Sub(point).Cross(direction).Length()
+}
+
+// FitLine3 fits a line to the points, ignoring outliers further than
+// opts.Threshold from it. It returns false when there are fewer than two
+// distinct points
+func FitLine3(r *rand.Rand, points []vector3.Float64, opts Options) (Line3Fit, bool) {
+	if len(points) < 2 {
+		return Line3Fit{}, false
+	}
+
+	type line struct{ point, direction vector3.Float64 }
+	best, sampled, ok := search(r, len(points), 2, opts,
+		func(indices []int) (line, bool) {
+			a, b := points[indices[0]], points[indices[1]]
+			if a == b {
+				return line{}, false
+			}
+			return line{a, b.Sub(a).Normalized()}, true
+		},
+		func(l line, i int) float64 {
+			return lineDistance(l.point, l.direction, points[i])
+		},
+	)
+	if !ok {
+		return Line3Fit{}, false
+	}
+
+	centroid, cov := covariance(points, best.inliers)
+
+	// The line runs along the covariance's major eigenvector, found with
+	// power iteration starting from the direction of the winning sample,
+	// which is already close to it and never degenerate
+	direction := sampled.direction
+	for i := 0; i < 64; i++ {
+		next := cov.mul(direction)
+		if next.LengthSquared() == 0 {
+			break
+		}
+		direction = next.Normalized()
+	}
+	if direction.ContainsNaN() || math.IsInf(direction.LengthSquared(), 0) {
+		return Line3Fit{}, false
+	}
+
+	return Line3Fit{
+		Point:     centroid,
+		Direction: direction,
+		Inliers: measure(len(points), opts.Threshold, func(i int) float64 {
+			return lineDistance(centroid, direction, points[i])
+		}).inliers,
+	}, true
+}
+
+// PlaneFit is the result of fitting a plane to 3D points
+type PlaneFit struct {
+	// Plane is the total least squares fit through the inliers, minimizing
+	// their perpendicular distance to it, with a unit length normal
+	Plane intersect.Plane
+
+	// Inliers are the indices of the points within the threshold of the
+	// plane, in increasing order
+	Inliers []int
+}
+
+func planeDistance(plane intersect.Plane, p vector3.Float64) float64 {
+	return math.Abs(plane.Normal.Dot(p) - plane.Distance)
+}
+
+// FitPlane fits a plane to the points, ignoring outliers further than
+// opts.Threshold from it. It returns false when there are fewer than three
+// points, or they're all collinear
+func FitPlane(r *rand.Rand, points []vector3.Float64, opts Options) (PlaneFit, bool) {
+	if len(points) < 3 {
+		return PlaneFit{}, false
+	}
+
+	best, _, ok := search(r, len(points), 3, opts,
+		func(indices []int) (intersect.Plane, bool) {
+			a, b, c := points[indices[0]], points[indices[1]], points[indices[2]]
+			normal := b.Sub(a).Cross(c.Sub(a))
+			if normal.LengthSquared() == 0 {
+				return intersect.Plane{}, false
+			}
+			normal = normal.Normalized()
+			return intersect.Plane{Normal: normal, Distance: normal.Dot(a)}, true
+		},
+		func(plane intersect.Plane, i int) float64 {
+			return planeDistance(plane, points[i])
+		},
+	)
+	if !ok {
+		return PlaneFit{}, false
+	}
+
+	centroid, cov := covariance(points, best.inliers)
+	normal, ok := cov.minorEigenvector()
+	if !ok {
+		return PlaneFit{}, false
+	}
+	plane := intersect.Plane{Normal: normal, Distance: normal.Dot(centroid)}
+
+	return PlaneFit{
+		Plane: plane,
+		Inliers: measure(len(points), opts.Threshold, func(i int) float64 {
+			return planeDistance(plane, points[i])
+		}).inliers,
+	}, true
+}
+
+// symmetric3 is a symmetric 3x3 matrix
+type symmetric3 struct {
+	xx, xy, xz, yy, yz, zz float64
+}
+
+func (m symmetric3) mul(v vector3.Float64) vector3.Float64 {
+	return vector3.New(
+		m.xx*v.X()+m.xy*v.Y()+m.xz*v.Z(),
+		m.xy*v.X()+m.yy*v.Y()+m.yz*v.Z(),
+		m.xz*v.X()+m.yz*v.Y()+m.zz*v.Z(),
+	)
+}
+
+// minorEigenvector returns the unit eigenvector of the covariance matrix
+// with the smallest eigenvalue, the normal of the plane minimizing the summed
+// squared perpendicular distance to the points. It returns false when the
+// points are collinear or coincide, leaving the normal undetermined
+func (m symmetric3) minorEigenvector() (vector3.Float64, bool) {
+	values := [][]float64{
+		{m.xx, m.xy, m.xz},
+		{m.xy, m.yy, m.yz},
+		{m.xz, m.yz, m.zz},
+	}
+	n := eigen.Minor(values)
+	normal := vector3.New(n[0], n[1], n[2])
+
+	// With the points on a line, two eigenvalues vanish and any direction
+	// perpendicular to it fits equally well
+	if m.collinear() || normal.ContainsNaN() {
+		return vector3.Float64{}, false
+	}
+	return normal.Normalized(), true
+}
+
+// collinear reports whether the covariance is that of points along a single
+// line, or all at one spot, by checking that every 2x2 minor is negligible
+// next to the squared scale of the matrix
+func (m symmetric3) collinear() bool {
+	scale := max(m.xx, m.yy, m.zz)
+	if scale <= 0 {
+		return true
+	}
+	minors := max(
+		math.Abs(m.yy*m.zz-m.yz*m.yz),
+		math.Abs(m.xx*m.zz-m.xz*m.xz),
+		math.Abs(m.xx*m.yy-m.xy*m.xy),
+		math.Abs(m.xy*m.yz-m.xz*m.yy),
+		math.Abs(m.xy*m.xz-m.yz*m.xx),
+		math.Abs(m.xz*m.yz-m.xy*m.zz),
+	)
+	return minors <= 1e-12*scale*scale
+}
+
+// covariance returns the centroid and covariance of the points at indices
+func covariance(points []vector3.Float64, indices []int) (vector3.Float64, symmetric3) {
+	var centroid vector3.Float64
+	for _, i := range indices {
+		centroid = centroid.Add(points[i])
+	}
+	centroid = centroid.DivByConstant(float64(len(indices)))
+
+	var m symmetric3
+	for _, i := range indices {
+		d := points[i].Sub(centroid)
+		m.xx += d.X() * d.X()
+		m.xy += d.X() * d.Y()
+		m.xz += d.X() * d.Z()
+		m.yy += d.Y() * d.Y()
+		m.yz += d.Y() * d.Z()
+		m.zz += d.Z() * d.Z()
+	}
+	return centroid, m
+}
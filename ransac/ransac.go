@@ -0,0 +1,129 @@
+// Package ransac robustly fits lines and planes to noisy point sets using
+// random sample consensus. Least squares fits get dragged off course by even
+// a few outliers, which are common in sensor and scan data. RANSAC instead
+// repeatedly fits a model to a minimal random sample of points and keeps the
+// one the most points agree with, then refines it using only those points.
+//
+// Every fit draws from the provided random source, so the same seed
+// reproduces the same result.
+package ransac
+
+import (
+	"math"
+	"math/rand"
+)
+
+// DefaultIterations is the number of samples tried when Options.Iterations
+// isn't set
+const DefaultIterations = 100
+
+// Options controls a RANSAC fit
+type Options struct {
+	// Threshold is the largest distance a point can be from a model and
+	// still count as one of its inliers
+	Threshold float64
+
+	// Iterations is the maximum number of random samples to try. It defaults
+	// to DefaultIterations when zero
+	Iterations int
+
+	// Confidence, when within (0, 1), stops sampling early once the best
+	// model has been found with this probability, estimated from the
+	// fraction of inliers seen so far. Zero always runs every iteration
+	Confidence float64
+}
+
+func (o Options) iterations() int {
+	if o.Iterations <= 0 {
+		return DefaultIterations
+	}
+	return o.Iterations
+}
+
+// requiredIterations returns how many samples of size n need to be drawn to
+// pick one made entirely of inliers with the given confidence, when
+// inlierRatio of the points are inliers
+func requiredIterations(confidence, inlierRatio float64, n int) float64 {
+	if confidence <= 0 || confidence >= 1 {
+		return math.Inf(1)
+	}
+	allInliers := math.Pow(inlierRatio, float64(n))
+	if allInliers >= 1 {
+		return 0
+	}
+	if allInliers <= 0 {
+		return math.Inf(1)
+	}
+	return math.Log(1-confidence) / math.Log(1-allInliers)
+}
+
+// consensus is the set of points agreeing with a model
+type consensus struct {
+	inliers []int
+
+	// residual is the summed distance of the inliers from the model, used
+	// to break ties between models with as many inliers
+	residual float64
+}
+
+func (c consensus) betterThan(o consensus) bool {
+	if len(c.inliers) != len(o.inliers) {
+		return len(c.inliers) > len(o.inliers)
+	}
+	return c.residual < o.residual
+}
+
+// measure returns the consensus of count points, given the distance of each
+// from the model
+func measure(count int, threshold float64, distance func(i int) float64) consensus {
+	var c consensus
+	for i := 0; i < count; i++ {
+		if d := distance(i); d <= threshold {
+			c.inliers = append(c.inliers, i)
+			c.residual += d
+		}
+	}
+	return c
+}
+
+// sample fills indices with distinct random indices below count
+func sample(r *rand.Rand, count int, indices []int) {
+	for i := range indices {
+		indices[i] = r.Intn(count)
+		for j := 0; j < i; j++ {
+			if indices[j] == indices[i] {
+				indices[i] = r.Intn(count)
+				j = -1
+			}
+		}
+	}
+}
+
+// search runs the RANSAC loop, fitting a model to each sample of n points and
+// measuring its consensus, and returns the best consensus along with the
+// model that produced it. fit returns false for degenerate samples
+func search[M any](
+	r *rand.Rand,
+	count, n int,
+	opts Options,
+	fit func(indices []int) (M, bool),
+	distance func(model M, i int) float64,
+) (best consensus, bestModel M, ok bool) {
+	indices := make([]int, n)
+	limit := float64(opts.iterations())
+	for iteration := 0; float64(iteration) < limit; iteration++ {
+		sample(r, count, indices)
+		model, valid := fit(indices)
+		if !valid {
+			continue
+		}
+
+		c := measure(count, opts.Threshold, func(i int) float64 { return distance(model, i) })
+		if !ok || c.betterThan(best) {
+			best, bestModel, ok = c, model, true
+			ratio := float64(len(best.inliers)) / float64(count)
+			limit = min(limit, requiredIterations(opts.Confidence, ratio, n))
+		}
+	}
+	return best, bestModel, ok
+}
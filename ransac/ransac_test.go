@@ -0,0 +1,149 @@
+package ransac_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/EliCDavis/vector/ransac"
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFitLine2(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	// 80 points on y = 0.5x + 2 with a little noise, and 20 outliers
+	var points []vector2.Float64
+	for i := 0; i < 80; i++ {
+		x := float64(i) / 4
+		points = append(points, vector2.New(x, 0.5*x+2+(r.Float64()-0.5)*0.02))
+	}
+	for i := 0; i < 20; i++ {
+		points = append(points, vector2.New(r.Float64()*20, 20+r.Float64()*20))
+	}
+
+	fit, ok := ransac.FitLine2(r, points, ransac.Options{Threshold: 0.05})
+	require.True(t, ok)
+	assert.Len(t, fit.Inliers, 80)
+	assert.Equal(t, 79, fit.Inliers[len(fit.Inliers)-1])
+
+	expected := vector2.New(1., 0.5).Normalized()
+	assert.InDelta(t, 1., math.Abs(fit.Line.Direction.Dot(expected)), 1e-4)
+	assert.InDelta(t, 0., fit.Line.Residual(vector2.New(4., 4.)), 0.01)
+}
+
+func TestFitLine3(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+
+	direction := vector3.New(1., 2., -1.).Normalized()
+	origin := vector3.New(3., -1., 4.)
+	var points []vector3.Float64
+	for i := 0; i < 50; i++ {
+		points = append(points, origin.Add(direction.Scale(float64(i)-25)))
+	}
+	for i := 0; i < 25; i++ {
+		points = append(points, vector3.New(r.Float64(), r.Float64(), r.Float64()).Scale(50).Add(vector3.Fill(20.)))
+	}
+
+	fit, ok := ransac.FitLine3(r, points, ransac.Options{Threshold: 0.01, Iterations: 200})
+	require.True(t, ok)
+	assert.Len(t, fit.Inliers, 50)
+	assert.InDelta(t, 1., math.Abs(fit.Direction.Dot(direction)), 1e-9)
+	assert.InDelta(t, 0., fit.Point.Sub(origin).Cross(direction).Length(), 1e-9)
+}
+
+func TestFitLine3DuplicateEnds(t *testing.T) {
+	r := rand.New(rand.NewSource(5))
+
+	// The first and last inliers coincide, which used to seed the power
+	// iteration with a zero length direction
+	points := []vector3.Float64{
+		vector3.New(0., 0., 0.),
+		vector3.New(1., 1., 1.),
+		vector3.New(2., 2., 2.),
+		vector3.New(0., 0., 0.),
+	}
+
+	fit, ok := ransac.FitLine3(r, points, ransac.Options{Threshold: 0.01})
+	require.True(t, ok)
+	assert.False(t, fit.Direction.ContainsNaN())
+	assert.InDelta(t, 1., math.Abs(fit.Direction.Dot(vector3.One[float64]().Normalized())), 1e-12)
+	assert.Equal(t, []int{0, 1, 2, 3}, fit.Inliers)
+}
+
+func TestFitPlane(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+
+	// The plane z = 0.2x - 0.1y + 5
+	normal := vector3.New(-0.2, 0.1, 1.).Normalized()
+	var points []vector3.Float64
+	for i := 0; i < 100; i++ {
+		x, y := r.Float64()*10, r.Float64()*10
+		points = append(points, vector3.New(x, y, 0.2*x-0.1*y+5+(r.Float64()-0.5)*0.01))
+	}
+	for i := 0; i < 40; i++ {
+		points = append(points, vector3.New(r.Float64()*10, r.Float64()*10, 10+r.Float64()*10))
+	}
+
+	fit, ok := ransac.FitPlane(r, points, ransac.Options{Threshold: 0.02, Confidence: 0.999})
+	require.True(t, ok)
+	assert.Len(t, fit.Inliers, 100)
+	assert.InDelta(t, 1., math.Abs(fit.Plane.Normal.Dot(normal)), 1e-5)
+	assert.InDelta(t, 1., fit.Plane.Normal.Length(), 1e-12)
+
+	onPlane := vector3.New(0., 0., 5.)
+	assert.InDelta(t, 0., fit.Plane.Normal.Dot(onPlane)-fit.Plane.Distance, 0.01)
+}
+
+func TestFitPlaneNoisy(t *testing.T) {
+	r := rand.New(rand.NewSource(6))
+
+	// The 45 degree plane z = x, with noise on every coordinate. Fixing one
+	// component of the normal and regressing the others is biased here,
+	// while a total least squares fit isn't
+	normal := vector3.New(1., 0., -1.).Normalized()
+	var points []vector3.Float64
+	for i := 0; i < 20000; i++ {
+		x, y := r.Float64()*2-1, r.Float64()*2-1
+		noise := vector3.New(r.NormFloat64(), r.NormFloat64(), r.NormFloat64()).Scale(0.3)
+		points = append(points, vector3.New(x, y, x).Add(noise))
+	}
+
+	fit, ok := ransac.FitPlane(r, points, ransac.Options{Threshold: 10, Iterations: 10})
+	require.True(t, ok)
+	require.Len(t, fit.Inliers, len(points))
+	angle := math.Acos(math.Min(1, math.Abs(fit.Plane.Normal.Dot(normal))))
+	assert.Less(t, angle, 0.5*math.Pi/180)
+}
+
+func TestFitDegenerate(t *testing.T) {
+	r := rand.New(rand.NewSource(4))
+	opts := ransac.Options{Threshold: 0.1}
+
+	_, ok := ransac.FitLine2(r, []vector2.Float64{vector2.New(1., 1.)}, opts)
+	assert.False(t, ok)
+
+	_, ok = ransac.FitLine2(r, []vector2.Float64{vector2.New(1., 1.), vector2.New(1., 1.)}, opts)
+	assert.False(t, ok)
+
+	_, ok = ransac.FitPlane(r, []vector3.Float64{
+		vector3.New(0., 0., 0.),
+		vector3.New(1., 1., 1.),
+		vector3.New(2., 2., 2.),
+	}, opts)
+	assert.False(t, ok)
+}
+
+func TestFitDeterministic(t *testing.T) {
+	var points []vector2.Float64
+	for i := 0; i < 30; i++ {
+		points = append(points, vector2.New(float64(i), float64(i%7)))
+	}
+
+	a, _ := ransac.FitLine2(rand.New(rand.NewSource(5)), points, ransac.Options{Threshold: 0.5})
+	b, _ := ransac.FitLine2(rand.New(rand.NewSource(5)), points, ransac.Options{Threshold: 0.5})
+	assert.Equal(t, a, b)
+}
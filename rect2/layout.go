@@ -0,0 +1,103 @@
+package rect2
+
+import (
+	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/vector2"
+)
+
+// Edges holds a distance for each side of a rectangle, such as the margins or
+// padding of a UI element, or the border widths of a 9-slice image. Top is
+// the side at the rectangle's minimum Y
+type Edges[T vector.Number] struct {
+	Left, Top, Right, Bottom T
+}
+
+// UniformEdges returns edges that are all d
+func UniformEdges[T vector.Number](d T) Edges[T] {
+	return Edges[T]{Left: d, Top: d, Right: d, Bottom: d}
+}
+
+// Inset shrinks the rectangle by moving each side inwards by the matching
+// edge. Negative edges grow the rectangle instead
+func (r Rectangle[T]) Inset(edges Edges[T]) Rectangle[T] {
+	return r.ShrinkXYWH(edges.Left, edges.Top, edges.Right, edges.Bottom)
+}
+
+// SplitH cuts the rectangle vertically into a left part at wide and a right
+// part taking up the rest. at is clamped to the rectangle's width
+func (r Rectangle[T]) SplitH(at T) (left, right Rectangle[T]) {
+	at = min(max(at, 0), r.wh.X())
+	left = New(r.xy, vector2.New(at, r.wh.Y()))
+	right = New(r.xy.AddXY(at, 0), vector2.New(r.wh.X()-at, r.wh.Y()))
+	return left, right
+}
+
+// SplitV cuts the rectangle horizontally into a top part at tall and a bottom
+// part taking up the rest. at is clamped to the rectangle's height
+func (r Rectangle[T]) SplitV(at T) (top, bottom Rectangle[T]) {
+	at = min(max(at, 0), r.wh.Y())
+	top = New(r.xy, vector2.New(r.wh.X(), at))
+	bottom = New(r.xy.AddXY(0, at), vector2.New(r.wh.X(), r.wh.Y()-at))
+	return top, bottom
+}
+
+// divide returns the offset of the i'th of n equal divisions of length.
+// Integer lengths that don't divide evenly spread the remainder across the
+// divisions, so they still cover the length exactly
+func divide[T vector.Number](length T, i, n int) T {
+	return T(float64(length) * float64(i) / float64(n))
+}
+
+// Grid divides the rectangle into rows by cols equally sized cells, returned
+// row by row. The cells tile the rectangle without gaps or overlap
+func (r Rectangle[T]) Grid(rows, cols int) []Rectangle[T] {
+	if rows <= 0 || cols <= 0 {
+		return nil
+	}
+
+	cells := make([]Rectangle[T], 0, rows*cols)
+	for row := 0; row < rows; row++ {
+		y0, y1 := divide(r.wh.Y(), row, rows), divide(r.wh.Y(), row+1, rows)
+		for col := 0; col < cols; col++ {
+			x0, x1 := divide(r.wh.X(), col, cols), divide(r.wh.X(), col+1, cols)
+			cells = append(cells, New(r.xy.AddXY(x0, y0), vector2.New(x1-x0, y1-y0)))
+		}
+	}
+	return cells
+}
+
+// fitEdges shrinks a pair of opposite edges proportionally when together
+// they're longer than length
+func fitEdges[T vector.Number](a, b, length T) (T, T) {
+	if a+b <= length || a+b == 0 {
+		return a, b
+	}
+	a = T(float64(a) * float64(length) / float64(a+b))
+	return a, length - a
+}
+
+// NineSlice divides the rectangle into the nine regions of a 9-slice, or
+// 9-patch, layout: four fixed size corners, four edges that stretch along
+// one axis, and a center that stretches along both. The regions are
+// returned row by row, from the top left corner to the bottom right. When
+// opposite edges don't fit within the rectangle they're shrunk
+// proportionally and the center collapses to nothing. Slicing both a source
+// image and its destination gives the pairs of regions to draw between.
+func (r Rectangle[T]) NineSlice(edges Edges[T]) [9]Rectangle[T] {
+	left, right := fitEdges(edges.Left, edges.Right, r.wh.X())
+	top, bottom := fitEdges(edges.Top, edges.Bottom, r.wh.Y())
+
+	xs := [4]T{r.xy.X(), r.xy.X() + left, r.xy.X() + r.wh.X() - right, r.xy.X() + r.wh.X()}
+	ys := [4]T{r.xy.Y(), r.xy.Y() + top, r.xy.Y() + r.wh.Y() - bottom, r.xy.Y() + r.wh.Y()}
+
+	var slices [9]Rectangle[T]
+	for row := 0; row < 3; row++ {
+		for col := 0; col < 3; col++ {
+			slices[row*3+col] = New(
+				vector2.New(xs[col], ys[row]),
+				vector2.New(xs[col+1]-xs[col], ys[row+1]-ys[row]),
+			)
+		}
+	}
+	return slices
+}
@@ -0,0 +1,82 @@
+package rect2_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector/rect2"
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInset(t *testing.T) {
+	r := rect2.New(vector2.New(0., 0.), vector2.New(10., 20.))
+
+	inset := r.Inset(rect2.Edges[float64]{Left: 1, Top: 2, Right: 3, Bottom: 4})
+	assert.Equal(t, vector2.New(1., 2.), inset.XY())
+	assert.Equal(t, vector2.New(6., 14.), inset.WH())
+
+	outset := r.Inset(rect2.UniformEdges(-1.))
+	assert.Equal(t, vector2.New(-1., -1.), outset.XY())
+	assert.Equal(t, vector2.New(12., 22.), outset.WH())
+}
+
+func TestSplit(t *testing.T) {
+	r := rect2.New(vector2.New(1, 2), vector2.New(10, 20))
+
+	left, right := r.SplitH(4)
+	assert.Equal(t, rect2.New(vector2.New(1, 2), vector2.New(4, 20)), left)
+	assert.Equal(t, rect2.New(vector2.New(5, 2), vector2.New(6, 20)), right)
+
+	top, bottom := r.SplitV(5)
+	assert.Equal(t, rect2.New(vector2.New(1, 2), vector2.New(10, 5)), top)
+	assert.Equal(t, rect2.New(vector2.New(1, 7), vector2.New(10, 15)), bottom)
+
+	left, right = r.SplitH(100)
+	assert.Equal(t, r, left)
+	assert.Equal(t, 0, right.Width())
+
+	top, bottom = r.SplitV(-3)
+	assert.Equal(t, 0, top.Height())
+	assert.Equal(t, r, bottom)
+}
+
+func TestGrid(t *testing.T) {
+	r := rect2.New(vector2.New(0, 0), vector2.New(10, 7))
+
+	cells := r.Grid(2, 3)
+	assert.Len(t, cells, 6)
+
+	// Cells tile the rectangle exactly, even when the size doesn't divide
+	area := 0
+	for i, cell := range cells {
+		area += cell.Width() * cell.Height()
+		if i%3 > 0 {
+			assert.Equal(t, cells[i-1].B().X(), cell.A().X())
+		}
+		if i >= 3 {
+			assert.Equal(t, cells[i-3].B().Y(), cell.A().Y())
+		}
+	}
+	assert.Equal(t, 70, area)
+	assert.Equal(t, r.B(), cells[5].B())
+
+	assert.Nil(t, r.Grid(0, 3))
+}
+
+func TestNineSlice(t *testing.T) {
+	r := rect2.New(vector2.New(0., 0.), vector2.New(100., 50.))
+	slices := r.NineSlice(rect2.Edges[float64]{Left: 10, Top: 5, Right: 20, Bottom: 15})
+
+	assert.Equal(t, rect2.New(vector2.New(0., 0.), vector2.New(10., 5.)), slices[0])
+	assert.Equal(t, rect2.New(vector2.New(10., 0.), vector2.New(70., 5.)), slices[1])
+	assert.Equal(t, rect2.New(vector2.New(80., 0.), vector2.New(20., 5.)), slices[2])
+	assert.Equal(t, rect2.New(vector2.New(10., 5.), vector2.New(70., 30.)), slices[4])
+	assert.Equal(t, rect2.New(vector2.New(80., 35.), vector2.New(20., 15.)), slices[8])
+
+	// Edges wider than the rectangle shrink proportionally
+	small := rect2.New(vector2.New(0., 0.), vector2.New(15., 50.))
+	slices = small.NineSlice(rect2.Edges[float64]{Left: 10, Top: 5, Right: 20, Bottom: 15})
+	assert.InDelta(t, 5., slices[0].Width(), 1e-9)
+	assert.InDelta(t, 0., slices[4].Width(), 1e-9)
+	assert.InDelta(t, 10., slices[2].Width(), 1e-9)
+}
@@ -0,0 +1,170 @@
+// Package sample2 generates well distributed sets of 2D sample points within
+// a rectangle, for uses like Monte Carlo integration in rendering and
+// scattering objects across a level. Compared to independent uniform random
+// points, the samples here avoid clumping and leaving large gaps, which
+// lowers noise for the same number of samples.
+//
+// Every generator draws from the provided random source, so the same seed
+// reproduces the same set of points.
+package sample2
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/EliCDavis/vector/rect2"
+	"github.com/EliCDavis/vector/vector2"
+)
+
+// at maps a point in the unit square onto the rectangle
+func at(bounds rect2.Float64, u, v float64) vector2.Float64 {
+	return vector2.New(bounds.X()+u*bounds.Width(), bounds.Y()+v*bounds.Height())
+}
+
+// Stratified divides the rectangle into a columns by rows grid of cells and
+// places one uniformly random point within each cell, also known as
+// jittered grid sampling. Points are returned row by row
+func Stratified(r *rand.Rand, bounds rect2.Float64, columns, rows int) []vector2.Float64 {
+	samples := make([]vector2.Float64, 0, max(columns*rows, 0))
+	for y := 0; y < rows; y++ {
+		for x := 0; x < columns; x++ {
+			samples = append(samples, at(bounds,
+				(float64(x)+r.Float64())/float64(columns),
+				(float64(y)+r.Float64())/float64(rows),
+			))
+		}
+	}
+	return samples
+}
+
+// NRooks places n points such that, when the rectangle is divided into n
+// columns and n rows, every column and every row holds exactly one point,
+// like n rooks on a chess board that can't take each other. It's the 2D case
+// of Latin hypercube sampling, and keeps the projection of the points onto
+// either axis evenly spread
+func NRooks(r *rand.Rand, bounds rect2.Float64, n int) []vector2.Float64 {
+	if n <= 0 {
+		return nil
+	}
+
+	columns := r.Perm(n)
+	samples := make([]vector2.Float64, n)
+	for i := range samples {
+		samples[i] = at(bounds,
+			(float64(columns[i])+r.Float64())/float64(n),
+			(float64(i)+r.Float64())/float64(n),
+		)
+	}
+	return samples
+}
+
+// PoissonDisk fills the rectangle with points no closer than radius to each
+// other, until no more fit, using Bridson's algorithm. The result is an
+// approximate blue noise distribution, random but evenly spaced. The number
+// of points depends on the radius rather than being chosen up front
+func PoissonDisk(r *rand.Rand, bounds rect2.Float64, radius float64) []vector2.Float64 {
+	// How many candidates are tried around each point before giving up on it
+	const attempts = 30
+
+	w, h := bounds.Width(), bounds.Height()
+	if radius <= 0 || w <= 0 || h <= 0 {
+		return nil
+	}
+
+	// The grid's cells are small enough to each hold at most one point
+	cellSize := radius / math.Sqrt2
+	columns := int(math.Ceil(w / cellSize))
+	rows := int(math.Ceil(h / cellSize))
+	grid := make([]int, columns*rows)
+	for i := range grid {
+		grid[i] = -1
+	}
+	cell := func(p vector2.Float64) (int, int) {
+		return min(int(p.X()/cellSize), columns-1), min(int(p.Y()/cellSize), rows-1)
+	}
+
+	// Points are generated relative to the rectangle's origin
+	var samples []vector2.Float64
+	add := func(p vector2.Float64) {
+		x, y := cell(p)
+		grid[y*columns+x] = len(samples)
+		samples = append(samples, p)
+	}
+	fits := func(p vector2.Float64) bool {
+		if p.X() < 0 || p.X() >= w || p.Y() < 0 || p.Y() >= h {
+			return false
+		}
+		cx, cy := cell(p)
+		for y := max(cy-2, 0); y <= min(cy+2, rows-1); y++ {
+			for x := max(cx-2, 0); x <= min(cx+2, columns-1); x++ {
+				if i := grid[y*columns+x]; i >= 0 && samples[i].DistanceSquared(p) < radius*radius {
+					return false
+				}
+			}
+		}
+		return true
+	}
+
+	add(vector2.New(r.Float64()*w, r.Float64()*h))
+	active := []int{0}
+	for len(active) > 0 {
+		a := r.Intn(len(active))
+		center := samples[active[a]]
+
+		found := false
+		for i := 0; i < attempts; i++ {
+			// Candidates are spread uniformly over the annulus between
+			// radius and twice the radius
+			angle := r.Float64() * 2 * math.Pi
+			dist := radius * math.Sqrt(1+3*r.Float64())
+			candidate := center.Add(vector2.New(math.Cos(angle), math.Sin(angle)).Scale(dist))
+			if fits(candidate) {
+				add(candidate)
+				active = append(active, len(samples)-1)
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			active[a] = active[len(active)-1]
+			active = active[:len(active)-1]
+		}
+	}
+
+	for i, p := range samples {
+		samples[i] = p.Add(bounds.XY())
+	}
+	return samples
+}
+
+// BestCandidate places n points one at a time using Mitchell's best
+// candidate algorithm: each point is the one, out of candidates uniformly
+// random tries, furthest from all points placed so far. It gives an
+// approximate blue noise distribution with an exact number of points, at a
+// cost that grows quadratically with n. Higher candidate counts give more
+// even spacing, with 10 a common choice
+func BestCandidate(r *rand.Rand, bounds rect2.Float64, n, candidates int) []vector2.Float64 {
+	if n <= 0 {
+		return nil
+	}
+	candidates = max(candidates, 1)
+
+	samples := make([]vector2.Float64, 0, n)
+	for len(samples) < n {
+		var best vector2.Float64
+		bestDist := -1.
+		for i := 0; i < candidates; i++ {
+			candidate := at(bounds, r.Float64(), r.Float64())
+			nearest := math.Inf(1)
+			for _, s := range samples {
+				nearest = min(nearest, s.DistanceSquared(candidate))
+			}
+			if nearest > bestDist {
+				best, bestDist = candidate, nearest
+			}
+		}
+		samples = append(samples, best)
+	}
+	return samples
+}
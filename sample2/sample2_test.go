@@ -0,0 +1,99 @@
+package sample2_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/EliCDavis/vector/rect2"
+	"github.com/EliCDavis/vector/sample2"
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/stretchr/testify/assert"
+)
+
+var bounds = rect2.New(vector2.New(-5., 10.), vector2.New(20., 10.))
+
+func assertWithin(t *testing.T, samples []vector2.Float64) {
+	t.Helper()
+	for _, s := range samples {
+		assert.True(t, s.X() >= -5 && s.X() < 15 && s.Y() >= 10 && s.Y() < 20, "%v out of bounds", s)
+	}
+}
+
+func TestStratified(t *testing.T) {
+	samples := sample2.Stratified(rand.New(rand.NewSource(1)), bounds, 4, 5)
+	assert.Len(t, samples, 20)
+	assertWithin(t, samples)
+
+	// One sample per 5x2 cell
+	for i, s := range samples {
+		assert.Equal(t, i%4, int((s.X()+5)/5))
+		assert.Equal(t, i/4, int((s.Y()-10)/2))
+	}
+
+	assert.Empty(t, sample2.Stratified(rand.New(rand.NewSource(1)), bounds, 0, 5))
+}
+
+func TestNRooks(t *testing.T) {
+	n := 16
+	samples := sample2.NRooks(rand.New(rand.NewSource(1)), bounds, n)
+	assert.Len(t, samples, n)
+	assertWithin(t, samples)
+
+	columns := map[int]bool{}
+	rows := map[int]bool{}
+	for _, s := range samples {
+		columns[int((s.X()+5)/20*float64(n))] = true
+		rows[int((s.Y()-10)/10*float64(n))] = true
+	}
+	assert.Len(t, columns, n)
+	assert.Len(t, rows, n)
+
+	assert.Nil(t, sample2.NRooks(rand.New(rand.NewSource(1)), bounds, 0))
+}
+
+func TestPoissonDisk(t *testing.T) {
+	radius := 1.
+	samples := sample2.PoissonDisk(rand.New(rand.NewSource(1)), bounds, radius)
+	assertWithin(t, samples)
+
+	// Bridson's algorithm packs to roughly 0.7 points per radius squared
+	assert.Greater(t, len(samples), 100)
+
+	for i, a := range samples {
+		for _, b := range samples[i+1:] {
+			assert.GreaterOrEqual(t, a.Distance(b), radius)
+		}
+	}
+
+	assert.Equal(t, samples, sample2.PoissonDisk(rand.New(rand.NewSource(1)), bounds, radius))
+	assert.Nil(t, sample2.PoissonDisk(rand.New(rand.NewSource(1)), bounds, 0))
+}
+
+func TestBestCandidate(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	samples := sample2.BestCandidate(r, bounds, 100, 10)
+	assert.Len(t, samples, 100)
+	assertWithin(t, samples)
+
+	nearest := func(samples []vector2.Float64) float64 {
+		total := 0.
+		for i, a := range samples {
+			d := math.Inf(1)
+			for j, b := range samples {
+				if i != j {
+					d = min(d, a.Distance(b))
+				}
+			}
+			total += d
+		}
+		return total / float64(len(samples))
+	}
+
+	// Spaced out more evenly than plain random points
+	uniform := make([]vector2.Float64, 100)
+	for i := range uniform {
+		uniform[i] = vector2.New(r.Float64()*20-5, r.Float64()*10+10)
+	}
+	assert.Greater(t, nearest(samples), 1.5*nearest(uniform))
+}
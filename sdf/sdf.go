@@ -0,0 +1,102 @@
+// Package sdf provides analytic signed distance functions, which return the
+// distance from a point to the surface of a shape: negative inside, zero on
+// the surface, and positive outside. Shapes are centered on the origin, so
+// to place one elsewhere, move the point the opposite way before evaluating
+// it. Distances combine with Union, Intersect, Subtract, and their smooth
+// variants to model complex shapes, which can then be raymarched or meshed
+// on the CPU.
+//
+// Most formulas follow Inigo Quilez's catalog of distance functions.
+package sdf
+
+import (
+	"math"
+
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vector3"
+)
+
+// Func2 is a signed distance function over 2D space
+type Func2 func(p vector2.Float64) float64
+
+// Func3 is a signed distance function over 3D space
+type Func3 func(p vector3.Float64) float64
+
+// Union combines two shapes into the space covered by either
+func Union(a, b float64) float64 {
+	return math.Min(a, b)
+}
+
+// Intersect keeps only the space covered by both shapes
+func Intersect(a, b float64) float64 {
+	return math.Max(a, b)
+}
+
+// Subtract carves shape b out of shape a
+func Subtract(a, b float64) float64 {
+	return math.Max(a, -b)
+}
+
+// SmoothUnion combines two shapes like Union, blending them together with a
+// fillet roughly k wide where they meet. A k of zero is a sharp Union
+func SmoothUnion(a, b, k float64) float64 {
+	if k <= 0 {
+		return Union(a, b)
+	}
+	h := math.Max(k-math.Abs(a-b), 0) / k
+	return math.Min(a, b) - h*h*k/4
+}
+
+// SmoothIntersect keeps the space covered by both shapes like Intersect,
+// rounding the edges where they meet over roughly k
+func SmoothIntersect(a, b, k float64) float64 {
+	return -SmoothUnion(-a, -b, k)
+}
+
+// SmoothSubtract carves shape b out of shape a like Subtract, rounding the
+// edges of the cut over roughly k
+func SmoothSubtract(a, b, k float64) float64 {
+	return SmoothIntersect(a, -b, k)
+}
+
+// Normal2 estimates the outward surface normal of f at p from the gradient of
+// the distance, sampled eps to either side along each axis
+func Normal2(f Func2, p vector2.Float64, eps float64) vector2.Float64 {
+	dx := vector2.New(eps, 0.)
+	dy := vector2.New(0., eps)
+	return vector2.New(
+		f(p.Add(dx))-f(p.Sub(dx)),
+		f(p.Add(dy))-f(p.Sub(dy)),
+	).Normalized()
+}
+
+// Normal3 estimates the outward surface normal of f at p from the gradient of
+// the distance. It samples the four corners of a tetrahedron eps away from p,
+// which needs two fewer evaluations than central differences
+func Normal3(f Func3, p vector3.Float64, eps float64) vector3.Float64 {
+	k1 := vector3.New(1., -1., -1.)
+	k2 := vector3.New(-1., -1., 1.)
+	k3 := vector3.New(-1., 1., -1.)
+	k4 := vector3.New(1., 1., 1.)
+	return k1.Scale(f(p.Add(k1.Scale(eps)))).
+		Add(k2.Scale(f(p.Add(k2.Scale(eps))))).
+		Add(k3.Scale(f(p.Add(k3.Scale(eps))))).
+		Add(k4.Scale(f(p.Add(k4.Scale(eps))))).
+		Normalized()
+}
+
+// Raymarch sphere traces f along the ray from origin in the unit direction
+// dir, stepping by the distance to the nearest surface each time. It returns
+// the distance along the ray of the first point within eps of a surface, and
+// false if none is found within maxDistance or maxSteps steps
+func Raymarch(f Func3, origin, dir vector3.Float64, maxDistance, eps float64, maxSteps int) (float64, bool) {
+	t := 0.
+	for i := 0; i < maxSteps && t <= maxDistance; i++ {
+		d := f(origin.Add(dir.Scale(t)))
+		if d < eps {
+			return t, true
+		}
+		t += d
+	}
+	return t, false
+}
@@ -0,0 +1,98 @@
+package sdf_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/EliCDavis/vector/sdf"
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/EliCDavis/vector/vectortest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShapes2(t *testing.T) {
+	assert.InDelta(t, 3., sdf.Circle(vector2.New(3., 4.), 2), 1e-12)
+	assert.InDelta(t, -2., sdf.Circle(vector2.Zero[float64](), 2), 1e-12)
+
+	half := vector2.New(2., 1.)
+	assert.InDelta(t, 1., sdf.Rect(vector2.New(3., 0.), half), 1e-12)
+	assert.InDelta(t, -0.5, sdf.Rect(vector2.New(0., 0.5), half), 1e-12)
+	assert.InDelta(t, math.Sqrt2, sdf.Rect(vector2.New(3., 2.), half), 1e-12)
+
+	// Rounding keeps the extents but pulls in the corners
+	assert.InDelta(t, 1., sdf.RoundedRect(vector2.New(3., 0.), half, 0.5), 1e-12)
+	assert.InDelta(t, math.Sqrt(4.5)-0.5, sdf.RoundedRect(vector2.New(3., 2.), half, 0.5), 1e-12)
+
+	a, b := vector2.New(-1., 0.), vector2.New(1., 0.)
+	assert.InDelta(t, 1.5, sdf.Capsule2(vector2.New(0., 2.), a, b, 0.5), 1e-12)
+	assert.InDelta(t, 1.5, sdf.Capsule2(vector2.New(3., 0.), a, b, 0.5), 1e-12)
+	assert.InDelta(t, math.Sqrt(5)-0.5, sdf.Capsule2(vector2.New(0., 2.), a, a, 0.5), 1e-12)
+
+	assert.InDelta(t, -1., sdf.Line(vector2.New(5., 1.), vector2.New(0., 1.), 2), 1e-12)
+}
+
+func TestShapes3(t *testing.T) {
+	assert.InDelta(t, 6., sdf.Sphere(vector3.New(2., 3., 6.), 1), 1e-12)
+
+	half := vector3.New(1., 2., 3.)
+	assert.InDelta(t, 1., sdf.Box(vector3.New(0., 0., 4.), half), 1e-12)
+	assert.InDelta(t, -1., sdf.Box(vector3.Zero[float64](), half), 1e-12)
+	assert.InDelta(t, math.Sqrt(3), sdf.Box(vector3.New(2., 3., 4.), half), 1e-12)
+	assert.InDelta(t, math.Sqrt(3)*1.5-0.5, sdf.RoundedBox(vector3.New(2., 3., 4.), half, 0.5), 1e-12)
+
+	a, b := vector3.New(0., -1., 0.), vector3.New(0., 1., 0.)
+	assert.InDelta(t, 1., sdf.Capsule(vector3.New(2., 0.5, 0.), a, b, 1), 1e-12)
+	assert.InDelta(t, 1., sdf.Capsule(vector3.New(0., 3., 0.), a, b, 1), 1e-12)
+
+	assert.InDelta(t, 0., sdf.Torus(vector3.New(0., 0.5, 2.), 2, 0.5), 1e-12)
+	assert.InDelta(t, -0.5, sdf.Torus(vector3.New(-2., 0., 0.), 2, 0.5), 1e-12)
+	assert.InDelta(t, 1.5, sdf.Torus(vector3.Zero[float64](), 2, 0.5), 1e-12)
+
+	assert.InDelta(t, 3., sdf.Plane(vector3.New(1., 5., 1.), vector3.New(0., 1., 0.), 2), 1e-12)
+}
+
+func TestCombinators(t *testing.T) {
+	assert.Equal(t, 1., sdf.Union(1, 2))
+	assert.Equal(t, 2., sdf.Intersect(1, 2))
+	assert.Equal(t, 1., sdf.Subtract(1, 2))
+	assert.Equal(t, 3., sdf.Subtract(-1, -3))
+
+	// Smooth variants match their sharp counterparts away from the seam
+	assert.Equal(t, 1., sdf.SmoothUnion(1, 5, 0.5))
+	assert.Equal(t, 5., sdf.SmoothIntersect(1, 5, 0.5))
+	assert.Equal(t, 1., sdf.SmoothUnion(1, 1, 0))
+
+	// and bulge out where the shapes meet
+	assert.InDelta(t, 0.875, sdf.SmoothUnion(1, 1, 0.5), 1e-12)
+	assert.InDelta(t, 1.125, sdf.SmoothIntersect(1, 1, 0.5), 1e-12)
+	assert.Greater(t, sdf.SmoothSubtract(1, -1, 0.5), sdf.Subtract(1, -1))
+}
+
+func TestNormals(t *testing.T) {
+	sphere := func(p vector3.Float64) float64 { return sdf.Sphere(p, 1) }
+	vectortest.AssertVector3InDelta(t, vector3.New(0., 0.6, 0.8), sdf.Normal3(sphere, vector3.New(0., 0.6, 0.8), 1e-4), 1e-4)
+
+	rect := func(p vector2.Float64) float64 { return sdf.Rect(p, vector2.New(2., 1.)) }
+	vectortest.AssertVector2InDelta(t, vector2.New(0., -1.), sdf.Normal2(rect, vector2.New(0.5, -1.), 1e-4), 1e-6)
+}
+
+func TestRaymarch(t *testing.T) {
+	scene := sdf.Func3(func(p vector3.Float64) float64 {
+		return sdf.Union(
+			sdf.Sphere(p.Sub(vector3.New(0., 0., -5.)), 1),
+			sdf.Plane(p, vector3.New(0., 1., 0.), -2),
+		)
+	})
+
+	hit, ok := sdf.Raymarch(scene, vector3.Zero[float64](), vector3.New(0., 0., -1.), 100, 1e-6, 100)
+	assert.True(t, ok)
+	assert.InDelta(t, 4., hit, 1e-5)
+
+	hit, ok = sdf.Raymarch(scene, vector3.Zero[float64](), vector3.New(0., -1., 0.), 100, 1e-6, 100)
+	assert.True(t, ok)
+	assert.InDelta(t, 2., hit, 1e-5)
+
+	_, ok = sdf.Raymarch(scene, vector3.Zero[float64](), vector3.New(0., 1., 0.), 100, 1e-6, 100)
+	assert.False(t, ok)
+}
@@ -0,0 +1,88 @@
+package sdf
+
+import (
+	"math"
+
+	"github.com/EliCDavis/vector/mathex"
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vector3"
+)
+
+// Circle is the distance from p to a circle of the given radius
+func Circle(p vector2.Float64, radius float64) float64 {
+	return p.Length() - radius
+}
+
+// Rect is the distance from p to an axis aligned rectangle extending
+// halfSize from the origin along each axis
+func Rect(p, halfSize vector2.Float64) float64 {
+	q := p.Abs().Sub(halfSize)
+	return vector2.Max(q, vector2.Zero[float64]()).Length() + math.Min(q.MaxComponent(), 0)
+}
+
+// RoundedRect is the distance from p to a rectangle like Rect with its corners
+// rounded by radius, staying within the same extents
+func RoundedRect(p, halfSize vector2.Float64, radius float64) float64 {
+	return Rect(p, halfSize.Sub(vector2.Fill(radius))) - radius
+}
+
+// Capsule2 is the distance from p to the 2D capsule, or stadium, made of all
+// points within radius of the segment from a to b
+func Capsule2(p, a, b vector2.Float64, radius float64) float64 {
+	pa, ba := p.Sub(a), b.Sub(a)
+	h := 0.
+	if l := ba.Dot(ba); l > 0 {
+		h = mathex.Clamp(pa.Dot(ba)/l, 0, 1)
+	}
+	return pa.Sub(ba.Scale(h)).Length() - radius
+}
+
+// Line is the distance from p to the line through the origin with the given
+// unit normal, offset along it by offset. Points on the side the normal
+// faces are outside
+func Line(p, normal vector2.Float64, offset float64) float64 {
+	return p.Dot(normal) - offset
+}
+
+// Sphere is the distance from p to a sphere of the given radius
+func Sphere(p vector3.Float64, radius float64) float64 {
+	return p.Length() - radius
+}
+
+// Box is the distance from p to an axis aligned box extending halfSize from
+// the origin along each axis
+func Box(p, halfSize vector3.Float64) float64 {
+	q := p.Abs().Sub(halfSize)
+	return vector3.Max(q, vector3.Zero[float64]()).Length() + math.Min(q.MaxComponent(), 0)
+}
+
+// RoundedBox is the distance from p to a box like Box with its edges and
+// corners rounded by radius, staying within the same extents
+func RoundedBox(p, halfSize vector3.Float64, radius float64) float64 {
+	return Box(p, halfSize.Sub(vector3.Fill(radius))) - radius
+}
+
+// Capsule is the distance from p to the capsule made of all points within
+// radius of the segment from a to b
+func Capsule(p, a, b vector3.Float64, radius float64) float64 {
+	pa, ba := p.Sub(a), b.Sub(a)
+	h := 0.
+	if l := ba.Dot(ba); l > 0 {
+		h = mathex.Clamp(pa.Dot(ba)/l, 0, 1)
+	}
+	return pa.Sub(ba.Scale(h)).Length() - radius
+}
+
+// Torus is the distance from p to a torus lying in the XZ plane, whose tube
+// of radius minor circles the Y axis at a distance of major
+func Torus(p vector3.Float64, major, minor float64) float64 {
+	q := vector2.New(p.XZ().Length()-major, p.Y())
+	return q.Length() - minor
+}
+
+// Plane is the distance from p to the plane through the origin with the given
+// unit normal, offset along it by offset. Points on the side the normal
+// faces are outside
+func Plane(p, normal vector3.Float64, offset float64) float64 {
+	return p.Dot(normal) - offset
+}
@@ -0,0 +1,148 @@
+// Package steering implements Craig Reynolds' steering behaviors for
+// autonomous agents, such as seeking a target, arriving at it smoothly, or
+// keeping apart from a crowd. Every behavior returns a steering force,
+// limited to the agent's MaxForce, which is meant to be applied to the
+// agent's velocity by the caller, typically alongside other behaviors:
+//
+//	force := steering.Seek(agent, target).Add(steering.Separation(agent, others, 2))
+//	agent.Velocity = steering.Truncate(agent.Velocity.Add(force.Scale(dt)), agent.MaxSpeed)
+//	agent.Position = agent.Position.Add(agent.Velocity.Scale(dt))
+//
+// Behaviors work with both vector2.Float64 and vector3.Float64.
+package steering
+
+// Vector is satisfied by the floating point vector types the behaviors
+// operate on, such as vector2.Float64 and vector3.Float64
+type Vector[V any] interface {
+	Add(V) V
+	Sub(V) V
+	Scale(float64) V
+	Length() float64
+}
+
+// Agent is the kinematic state of something being steered
+type Agent[V Vector[V]] struct {
+	Position V
+	Velocity V
+
+	// MaxSpeed limits how fast the agent wants to travel
+	MaxSpeed float64
+
+	// MaxForce limits the magnitude of the steering forces returned
+	MaxForce float64
+}
+
+// Truncate shortens v to length max if it's any longer
+func Truncate[V Vector[V]](v V, max float64) V {
+	if l := v.Length(); l > max {
+		return v.Scale(max / l)
+	}
+	return v
+}
+
+// withLength scales v to the given length, leaving zero length vectors as
+// they are
+func withLength[V Vector[V]](v V, length float64) V {
+	l := v.Length()
+	if l == 0 {
+		return v
+	}
+	return v.Scale(length / l)
+}
+
+// steer returns the force turning the agent's velocity towards desired
+func steer[V Vector[V]](agent Agent[V], desired V) V {
+	return Truncate(desired.Sub(agent.Velocity), agent.MaxForce)
+}
+
+// Seek steers the agent towards target at full speed
+func Seek[V Vector[V]](agent Agent[V], target V) V {
+	return steer(agent, withLength(target.Sub(agent.Position), agent.MaxSpeed))
+}
+
+// Flee steers the agent directly away from target at full speed
+func Flee[V Vector[V]](agent Agent[V], target V) V {
+	return steer(agent, withLength(agent.Position.Sub(target), agent.MaxSpeed))
+}
+
+// Arrive steers the agent towards target like Seek, but slows it down once
+// within slowingRadius of the target so it comes to rest on it
+func Arrive[V Vector[V]](agent Agent[V], target V, slowingRadius float64) V {
+	offset := target.Sub(agent.Position)
+	speed := agent.MaxSpeed
+	if distance := offset.Length(); distance < slowingRadius {
+		speed *= distance / slowingRadius
+	}
+	return steer(agent, withLength(offset, speed))
+}
+
+// Pursue steers the agent towards where a moving target will be, predicting
+// its position from its current velocity and how long the agent would take
+// to reach it
+func Pursue[V Vector[V]](agent Agent[V], targetPosition, targetVelocity V) V {
+	return Seek(agent, predict(agent, targetPosition, targetVelocity))
+}
+
+// Evade steers the agent away from where a moving target will be, the
+// opposite of Pursue
+func Evade[V Vector[V]](agent Agent[V], targetPosition, targetVelocity V) V {
+	return Flee(agent, predict(agent, targetPosition, targetVelocity))
+}
+
+func predict[V Vector[V]](agent Agent[V], targetPosition, targetVelocity V) V {
+	if agent.MaxSpeed <= 0 {
+		return targetPosition
+	}
+	lookAhead := targetPosition.Sub(agent.Position).Length() / agent.MaxSpeed
+	return targetPosition.Add(targetVelocity.Scale(lookAhead))
+}
+
+// Separation steers the agent away from the neighbors within radius of it,
+// pushing harder the closer they are. Neighbors sharing the agent's position
+// are ignored, so the agent itself may be included in neighbors
+func Separation[V Vector[V]](agent Agent[V], neighbors []V, radius float64) V {
+	var push V
+	found := false
+	for _, n := range neighbors {
+		away := agent.Position.Sub(n)
+		distance := away.Length()
+		if distance == 0 || distance >= radius {
+			continue
+		}
+		push = push.Add(away.Scale(1 / (distance * distance)))
+		found = true
+	}
+
+	var none V
+	if !found {
+		return none
+	}
+	return steer(agent, withLength(push, agent.MaxSpeed))
+}
+
+// Cohesion steers the agent towards the center of the neighbors within
+// radius of it
+func Cohesion[V Vector[V]](agent Agent[V], neighbors []V, radius float64) V {
+	var center V
+	count := 0
+	for _, n := range neighbors {
+		if n.Sub(agent.Position).Length() < radius {
+			center = center.Add(n)
+			count++
+		}
+	}
+
+	var none V
+	if count == 0 {
+		return none
+	}
+	return Seek(agent, center.Scale(1/float64(count)))
+}
+
+// wander seeks a point on a circle projected distance ahead of the agent,
+// after nudging the agent's position on that circle by displacement
+func wander[V Vector[V]](agent Agent[V], target *V, displacement V, distance, radius float64) V {
+	*target = withLength((*target).Add(displacement), radius)
+	center := agent.Position.Add(withLength(agent.Velocity, distance))
+	return Seek(agent, center.Add(*target))
+}
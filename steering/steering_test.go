@@ -0,0 +1,94 @@
+package steering_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/EliCDavis/vector/steering"
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/EliCDavis/vector/vectortest"
+	"github.com/stretchr/testify/assert"
+)
+
+func agent2(position, velocity vector2.Float64) steering.Agent[vector2.Float64] {
+	return steering.Agent[vector2.Float64]{Position: position, Velocity: velocity, MaxSpeed: 2, MaxForce: 10}
+}
+
+func TestSeekAndFlee(t *testing.T) {
+	a := agent2(vector2.Zero[float64](), vector2.New(0., 1.))
+
+	vectortest.AssertVector2InDelta(t, vector2.New(2., -1.), steering.Seek(a, vector2.New(5., 0.)), 1e-12)
+	vectortest.AssertVector2InDelta(t, vector2.New(-2., -1.), steering.Flee(a, vector2.New(5., 0.)), 1e-12)
+
+	// Already at the target
+	vectortest.AssertVector2InDelta(t, vector2.New(0., -1.), steering.Seek(a, a.Position), 1e-12)
+
+	a.MaxForce = 0.5
+	assert.InDelta(t, 0.5, steering.Seek(a, vector2.New(5., 0.)).Length(), 1e-12)
+}
+
+func TestArrive(t *testing.T) {
+	a := agent2(vector2.Zero[float64](), vector2.Zero[float64]())
+
+	vectortest.AssertVector2InDelta(t, vector2.New(2., 0.), steering.Arrive(a, vector2.New(10., 0.), 4), 1e-12)
+	vectortest.AssertVector2InDelta(t, vector2.New(1., 0.), steering.Arrive(a, vector2.New(2., 0.), 4), 1e-12)
+	vectortest.AssertVector2InDelta(t, vector2.Zero[float64](), steering.Arrive(a, a.Position, 4), 1e-12)
+}
+
+func TestPursueAndEvade(t *testing.T) {
+	a := agent2(vector2.Zero[float64](), vector2.Zero[float64]())
+
+	// The target is 4 units away, 2 seconds at full speed, so it's expected
+	// to have moved up by 2
+	vectortest.AssertVector2InDelta(t, vector2.New(4., 2.).Normalized().Scale(2), steering.Pursue(a, vector2.New(4., 0.), vector2.New(0., 1.)), 1e-12)
+	vectortest.AssertVector2InDelta(t, vector2.New(-4., -2.).Normalized().Scale(2), steering.Evade(a, vector2.New(4., 0.), vector2.New(0., 1.)), 1e-12)
+}
+
+func TestSeparationAndCohesion(t *testing.T) {
+	a := agent2(vector2.Zero[float64](), vector2.Zero[float64]())
+	neighbors := []vector2.Float64{
+		a.Position,
+		vector2.New(1., 0.),
+		vector2.New(0., 3.),
+		vector2.New(100., 100.),
+	}
+
+	// The closer neighbor pushes harder
+	sep := steering.Separation(a, neighbors, 5)
+	assert.InDelta(t, 2., sep.Length(), 1e-12)
+	assert.Less(t, sep.X(), 0.)
+	assert.Less(t, sep.Y(), 0.)
+	assert.Less(t, sep.X(), sep.Y())
+
+	vectortest.AssertVector2InDelta(t, vector2.New(1., 3.).Normalized().Scale(2), steering.Cohesion(a, neighbors, 5), 1e-12)
+
+	vectortest.AssertVector2InDelta(t, vector2.Zero[float64](), steering.Separation(a, neighbors, 0.5), 0)
+	vectortest.AssertVector2InDelta(t, vector2.Zero[float64](), steering.Cohesion(a, nil, 5), 0)
+}
+
+func TestVector3(t *testing.T) {
+	a := steering.Agent[vector3.Float64]{MaxSpeed: 1, MaxForce: 1}
+	vectortest.AssertVector3InDelta(t, vector3.New(0., 0., 1.), steering.Seek(a, vector3.New(0., 0., 9.)), 1e-12)
+	vectortest.AssertVector3InDelta(t, vector3.New(0., 0., 0.5), steering.Arrive(a, vector3.New(0., 0., 1.), 2), 1e-12)
+}
+
+func TestWander(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	a2 := agent2(vector2.Zero[float64](), vector2.New(1., 0.))
+	target2 := vector2.New(0., 1.)
+	for i := 0; i < 50; i++ {
+		force := steering.Wander2(a2, r, &target2, 3, 1, 0.2)
+		assert.LessOrEqual(t, force.Length(), a2.MaxForce+1e-12)
+		assert.InDelta(t, 1., target2.Length(), 1e-12)
+	}
+
+	a3 := steering.Agent[vector3.Float64]{Velocity: vector3.New(1., 0., 0.), MaxSpeed: 1, MaxForce: 1}
+	target3 := vector3.New(0., 2., 0.)
+	for i := 0; i < 50; i++ {
+		force := steering.Wander3(a3, r, &target3, 3, 2, 0.2)
+		assert.LessOrEqual(t, force.Length(), a3.MaxForce+1e-12)
+		assert.InDelta(t, 2., target3.Length(), 1e-12)
+	}
+}
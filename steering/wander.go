@@ -0,0 +1,35 @@
+package steering
+
+import (
+	"math/rand"
+
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vector3"
+)
+
+// Wander2 steers the agent in a smoothly meandering random path. target is
+// the agent's current point on a circle of radius projected distance ahead
+// of it, and is updated in place, so the same variable should be passed in
+// on every call. Each call moves target by up to jitter along each axis
+// before steering towards it
+func Wander2(agent Agent[vector2.Float64], r *rand.Rand, target *vector2.Float64, distance, radius, jitter float64) vector2.Float64 {
+	displacement := vector2.New(
+		(r.Float64()*2-1)*jitter,
+		(r.Float64()*2-1)*jitter,
+	)
+	return wander(agent, target, displacement, distance, radius)
+}
+
+// Wander3 steers the agent in a smoothly meandering random path. target is
+// the agent's current point on a sphere of radius projected distance ahead
+// of it, and is updated in place, so the same variable should be passed in
+// on every call. Each call moves target by up to jitter along each axis
+// before steering towards it
+func Wander3(agent Agent[vector3.Float64], r *rand.Rand, target *vector3.Float64, distance, radius, jitter float64) vector3.Float64 {
+	displacement := vector3.New(
+		(r.Float64()*2-1)*jitter,
+		(r.Float64()*2-1)*jitter,
+		(r.Float64()*2-1)*jitter,
+	)
+	return wander(agent, target, displacement, distance, radius)
+}
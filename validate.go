@@ -0,0 +1,79 @@
+package vector
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// InvalidComponent describes a single component of a vector that failed
+// validation
+type InvalidComponent struct {
+	// Name is the component's name, such as "x"
+	Name string
+
+	// Value is the component's value
+	Value float64
+
+	// Reason says what's wrong with the value, such as "is NaN"
+	Reason string
+}
+
+func (c InvalidComponent) String() string {
+	return c.Name + " " + c.Reason
+}
+
+// ValidationError is returned by the Validate methods of vectors, listing
+// every component that failed validation in order
+type ValidationError struct {
+	Components []InvalidComponent
+}
+
+func (e *ValidationError) Error() string {
+	reasons := make([]string, len(e.Components))
+	for i, c := range e.Components {
+		reasons[i] = c.String()
+	}
+	return "invalid vector: " + strings.Join(reasons, ", ")
+}
+
+var componentNames = [...]string{"x", "y", "z", "w"}
+
+// ValidateComponents checks the components of a vector, given in x, y, z, w
+// order, are neither NaN nor infinite, and when bounded is true, that they're
+// within [min, max]. It returns a *ValidationError naming every component
+// that fails, or nil. It backs the Validate and ValidateRange methods of the
+// vector packages
+func ValidateComponents[T Number](components []T, bounded bool, min, max T) error {
+	var invalid []InvalidComponent
+	for i, c := range components {
+		f := float64(c)
+
+		var reason string
+		switch {
+		case math.IsNaN(f):
+			reason = "is NaN"
+		case math.IsInf(f, 1):
+			reason = "is +Inf"
+		case math.IsInf(f, -1):
+			reason = "is -Inf"
+		case bounded && c < min:
+			reason = fmt.Sprintf("is less than the minimum of %v", min)
+		case bounded && c > max:
+			reason = fmt.Sprintf("is greater than the maximum of %v", max)
+		default:
+			continue
+		}
+
+		name := fmt.Sprintf("component %d", i)
+		if i < len(componentNames) {
+			name = componentNames[i]
+		}
+		invalid = append(invalid, InvalidComponent{Name: name, Value: f, Reason: reason})
+	}
+
+	if len(invalid) == 0 {
+		return nil
+	}
+	return &ValidationError{Components: invalid}
+}
@@ -0,0 +1,37 @@
+package vector_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/EliCDavis/vector"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateComponents(t *testing.T) {
+	assert.NoError(t, vector.ValidateComponents([]float64{1, -2, 3}, false, 0, 0))
+
+	err := vector.ValidateComponents([]float64{math.NaN(), 1, math.Inf(1), math.Inf(-1)}, false, 0, 0)
+	require.Error(t, err)
+	assert.Equal(t, "invalid vector: x is NaN, z is +Inf, w is -Inf", err.Error())
+
+	var validation *vector.ValidationError
+	require.True(t, errors.As(err, &validation))
+	require.Len(t, validation.Components, 3)
+	assert.Equal(t, "z", validation.Components[1].Name)
+	assert.Equal(t, math.Inf(1), validation.Components[1].Value)
+}
+
+func TestValidateComponentsRange(t *testing.T) {
+	assert.NoError(t, vector.ValidateComponents([]int{0, 5, 10}, true, 0, 10))
+
+	err := vector.ValidateComponents([]int{-1, 5, 11}, true, 0, 10)
+	require.Error(t, err)
+	assert.Equal(t, "invalid vector: x is less than the minimum of 0, z is greater than the maximum of 10", err.Error())
+
+	// Non finite values are reported as such, rather than as out of range
+	err = vector.ValidateComponents([]float32{float32(math.Inf(1))}, true, 0, 1)
+	assert.EqualError(t, err, "invalid vector: x is +Inf")
+}
@@ -0,0 +1,36 @@
+package vector2
+
+import (
+	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/mathex"
+)
+
+// SumAccurate adds all vectors together using compensated summation, which
+// avoids the error a naive running total builds up over very large slices,
+// especially of float32 vectors
+func SumAccurate[T vector.Number](vectors []Vector[T]) Vector[T] {
+	var x, y mathex.CompensatedSum
+	for _, v := range vectors {
+		x.Add(float64(v.x))
+		y.Add(float64(v.y))
+	}
+	return Vector[T]{
+		x: T(x.Value()),
+		y: T(y.Value()),
+	}
+}
+
+// AverageAccurate computes the average of all vectors using compensated
+// summation. See SumAccurate
+func AverageAccurate[T vector.Number](vectors []Vector[T]) Vector[T] {
+	var x, y mathex.CompensatedSum
+	for _, v := range vectors {
+		x.Add(float64(v.x))
+		y.Add(float64(v.y))
+	}
+	count := float64(len(vectors))
+	return Vector[T]{
+		x: T(x.Value() / count),
+		y: T(y.Value() / count),
+	}
+}
@@ -0,0 +1,55 @@
+package vector2
+
+import "github.com/EliCDavis/vector"
+
+// Anchors are points within a rectangle given in normalized coordinates,
+// where (0, 0) is the top left corner and (1, 1) the bottom right, matching
+// the Y down coordinates of screens and UI layouts
+var (
+	AnchorTopLeft     = Float64{x: 0, y: 0}
+	AnchorTop         = Float64{x: 0.5, y: 0}
+	AnchorTopRight    = Float64{x: 1, y: 0}
+	AnchorLeft        = Float64{x: 0, y: 0.5}
+	AnchorCenter      = Float64{x: 0.5, y: 0.5}
+	AnchorRight       = Float64{x: 1, y: 0.5}
+	AnchorBottomLeft  = Float64{x: 0, y: 1}
+	AnchorBottom      = Float64{x: 0.5, y: 1}
+	AnchorBottomRight = Float64{x: 1, y: 1}
+)
+
+// AnchorWithin converts the normalized anchor into an offset from the top
+// left of a container of size wh
+func AnchorWithin[T vector.Number](wh Vector[T], anchor Float64) Vector[T] {
+	return Vector[T]{
+		x: T(float64(wh.x) * anchor.x),
+		y: T(float64(wh.y) * anchor.y),
+	}
+}
+
+// AnchorOf converts an offset from the top left of a container of size wh
+// into a normalized anchor, the inverse of AnchorWithin. Axes the container
+// has no size along map to 0
+func AnchorOf[T vector.Number](offset, wh Vector[T]) Float64 {
+	var anchor Float64
+	if wh.x != 0 {
+		anchor.x = float64(offset.x) / float64(wh.x)
+	}
+	if wh.y != 0 {
+		anchor.y = float64(offset.y) / float64(wh.y)
+	}
+	return anchor
+}
+
+// PivotAnchor is Pivot with a normalized anchor, returning the top left
+// corner of a rectangle of size wh whose anchor point sits at v
+func (v Vector[T]) PivotAnchor(anchor Float64, wh Vector[T]) Vector[T] {
+	return v.Sub(AnchorWithin(wh, anchor))
+}
+
+// AlignWithin returns the top left corner of a rectangle of size wh placed
+// within a container at position with size containerWH, so that the
+// anchor points of both line up. AnchorCenter centers the rectangle, while
+// AnchorBottomRight pushes it into the container's bottom right corner
+func AlignWithin[T vector.Number](position, containerWH, wh Vector[T], anchor Float64) Vector[T] {
+	return position.Add(AnchorWithin(containerWH.Sub(wh), anchor))
+}
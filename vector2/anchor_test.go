@@ -0,0 +1,51 @@
+package vector2_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnchorWithin(t *testing.T) {
+	wh := vector2.New(200, 100)
+
+	tests := map[string]struct {
+		anchor vector2.Float64
+		want   vector2.Int
+	}{
+		"top left":     {anchor: vector2.AnchorTopLeft, want: vector2.New(0, 0)},
+		"top":          {anchor: vector2.AnchorTop, want: vector2.New(100, 0)},
+		"center":       {anchor: vector2.AnchorCenter, want: vector2.New(100, 50)},
+		"right":        {anchor: vector2.AnchorRight, want: vector2.New(200, 50)},
+		"bottom left":  {anchor: vector2.AnchorBottomLeft, want: vector2.New(0, 100)},
+		"bottom right": {anchor: vector2.AnchorBottomRight, want: vector2.New(200, 100)},
+		"custom":       {anchor: vector2.New(0.25, 0.75), want: vector2.New(50, 75)},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := vector2.AnchorWithin(wh, tc.anchor)
+			assert.Equal(t, tc.want, got)
+			assert.Equal(t, tc.anchor, vector2.AnchorOf(got, wh))
+		})
+	}
+
+	assert.Equal(t, vector2.New(0., 0.5), vector2.AnchorOf(vector2.New(3., 2.), vector2.New(0., 4.)))
+}
+
+func TestPivotAnchor(t *testing.T) {
+	wh := vector2.New(20., 10.)
+	assert.Equal(t, vector2.New(90., 45.), vector2.New(100., 50.).PivotAnchor(vector2.AnchorCenter, wh))
+	assert.Equal(t, vector2.New(100., 50.).Pivot(vector2.New(1., 1.), wh), vector2.New(100., 50.).PivotAnchor(vector2.AnchorBottomRight, wh))
+}
+
+func TestAlignWithin(t *testing.T) {
+	position := vector2.New(10, 20)
+	container := vector2.New(300, 200)
+	wh := vector2.New(100, 50)
+
+	assert.Equal(t, vector2.New(10, 20), vector2.AlignWithin(position, container, wh, vector2.AnchorTopLeft))
+	assert.Equal(t, vector2.New(110, 95), vector2.AlignWithin(position, container, wh, vector2.AnchorCenter))
+	assert.Equal(t, vector2.New(210, 170), vector2.AlignWithin(position, container, wh, vector2.AnchorBottomRight))
+}
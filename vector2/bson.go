@@ -0,0 +1,52 @@
+package vector2
+
+import (
+	"fmt"
+
+	"github.com/EliCDavis/vector/internal/codec"
+)
+
+// MarshalBSON encodes the vector as a BSON document with the fields
+// x, y, satisfying the MongoDB driver's bson.Marshaler interface
+func (v Vector[T]) MarshalBSON() ([]byte, error) {
+	return codec.AppendBSONDocument(nil, componentKeys, v.x, v.y), nil
+}
+
+// UnmarshalBSON decodes the vector from a BSON document with the fields
+// x, y, satisfying the MongoDB driver's bson.Unmarshaler interface
+func (v *Vector[T]) UnmarshalBSON(data []byte) error {
+	return v.unmarshalBSON(data, false)
+}
+
+// MarshalBSONValue encodes the vector as an embedded BSON document so it can
+// be stored directly as a field of another document, satisfying the
+// bson.ValueMarshaler interface of version 2 of the MongoDB driver
+// (go.mongodb.org/mongo-driver/v2). Version 1 of the driver expects a
+// bsontype.Type rather than a byte, so it falls back to MarshalBSON there
+func (v Vector[T]) MarshalBSONValue() (byte, []byte, error) {
+	data, err := v.MarshalBSON()
+	return codec.BSONDocument, data, err
+}
+
+// UnmarshalBSONValue decodes the vector from an embedded BSON document or
+// array, satisfying the bson.ValueUnmarshaler interface of version 2 of the
+// MongoDB driver
+func (v *Vector[T]) UnmarshalBSONValue(typ byte, data []byte) error {
+	switch typ {
+	case codec.BSONDocument:
+		return v.unmarshalBSON(data, false)
+	case codec.BSONArray:
+		return v.unmarshalBSON(data, true)
+	}
+	return fmt.Errorf("unable to decode bson vector from element type 0x%02x", typ)
+}
+
+func (v *Vector[T]) unmarshalBSON(data []byte, isArray bool) error {
+	var components [componentCount]T
+	if err := codec.DecodeBSONDocument(data, componentKeys, isArray, components[:]); err != nil {
+		return err
+	}
+	v.x = components[0]
+	v.y = components[1]
+	return nil
+}
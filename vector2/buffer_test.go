@@ -0,0 +1,53 @@
+package vector2_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPackFloat32(t *testing.T) {
+	in := []vector2.Float32{
+		vector2.New[float32](1, 2),
+		vector2.New[float32](-1, 0.5),
+	}
+
+	data := vector2.PackFloat32(in)
+	assert.Len(t, data, 2*8)
+
+	back, err := vector2.UnpackFloat32(data)
+	assert.NoError(t, err)
+	assert.Equal(t, in, back)
+}
+
+func TestPackFloat32Strided(t *testing.T) {
+	in := []vector2.Float32{
+		vector2.New[float32](1, 2),
+		vector2.New[float32](-1, 0.5),
+	}
+
+	data := make([]byte, 4+2*8+8)
+	require.NoError(t, vector2.PackFloat32Strided(data, in, 4, 8+4))
+
+	back, err := vector2.UnpackFloat32Strided(data, 2, 4, 8+4)
+	assert.NoError(t, err)
+	assert.Equal(t, in, back)
+
+	assert.Error(t, vector2.PackFloat32Strided(data, in, 4, 8-4))
+}
+
+func TestPackFloat16(t *testing.T) {
+	in := []vector2.Float32{
+		vector2.Fill[float32](0.5),
+		vector2.Fill[float32](-3),
+	}
+
+	data := vector2.PackFloat16(in)
+	assert.Len(t, data, 2*2*2)
+
+	back, err := vector2.UnpackFloat16(data)
+	assert.NoError(t, err)
+	assert.Equal(t, in, back)
+}
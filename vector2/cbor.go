@@ -0,0 +1,19 @@
+package vector2
+
+import "github.com/EliCDavis/vector/internal/codec"
+
+// MarshalCBOR encodes the vector as a CBOR array of 2 components
+func (v Vector[T]) MarshalCBOR() ([]byte, error) {
+	return codec.AppendCBOR(nil, v.x, v.y), nil
+}
+
+// UnmarshalCBOR decodes a vector from a CBOR array of 2 components
+func (v *Vector[T]) UnmarshalCBOR(data []byte) error {
+	var components [componentCount]T
+	if err := codec.DecodeCBOR(data, components[:]); err != nil {
+		return err
+	}
+	v.x = components[0]
+	v.y = components[1]
+	return nil
+}
@@ -0,0 +1,27 @@
+package vector2
+
+import "github.com/EliCDavis/vector"
+
+// ToComplex returns the vector as the complex number x + yi. Multiplying it
+// by a unit complex number rotates it, and rotations compose by multiplying
+// them together, so 2D rotations can be tracked as a single complex128
+// instead of an angle that needs wrapping and trigonometry on every use.
+// cmplx.Rect(1, angle) creates the rotation by angle radians
+func (v Vector[T]) ToComplex() complex128 {
+	return complex(float64(v.x), float64(v.y))
+}
+
+// FromComplex returns the vector (real(c), imag(c))
+func FromComplex[T vector.Number](c complex128) Vector[T] {
+	return Vector[T]{
+		x: T(real(c)),
+		y: T(imag(c)),
+	}
+}
+
+// MulComplex multiplies the vector by c as if they were both complex numbers,
+// rotating it counter-clockwise by c's argument and scaling it by c's
+// magnitude. Unit complex numbers rotate without scaling
+func (v Vector[T]) MulComplex(c complex128) Vector[T] {
+	return FromComplex[T](v.ToComplex() * c)
+}
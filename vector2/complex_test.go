@@ -0,0 +1,36 @@
+package vector2_test
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComplexRoundTrip(t *testing.T) {
+	v := vector2.New(3., -4.)
+	assert.Equal(t, complex(3, -4), v.ToComplex())
+	assert.Equal(t, v, vector2.FromComplex[float64](v.ToComplex()))
+	assert.Equal(t, vector2.New(1, 2), vector2.FromComplex[int](complex(1.9, 2.2)))
+}
+
+func TestMulComplex(t *testing.T) {
+	v := vector2.New(1., 0.)
+
+	quarter := cmplx.Rect(1, math.Pi/2)
+	rotated := v.MulComplex(quarter)
+	assert.InDelta(t, 0., rotated.X(), 1e-12)
+	assert.InDelta(t, 1., rotated.Y(), 1e-12)
+
+	// Rotations compose by multiplying them
+	eighth := cmplx.Rect(1, math.Pi/4)
+	composed := v.MulComplex(eighth * eighth)
+	assert.InDelta(t, 0., composed.Distance(rotated), 1e-12)
+
+	// Non unit complex numbers also scale
+	scaled := vector2.New(1., 1.).MulComplex(complex(0, 2))
+	assert.InDelta(t, -2., scaled.X(), 1e-12)
+	assert.InDelta(t, 2., scaled.Y(), 1e-12)
+}
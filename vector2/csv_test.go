@@ -0,0 +1,26 @@
+package vector2_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCSVRoundTrip(t *testing.T) {
+	in := []vector2.Float64{
+		vector2.New(1.5, -2.),
+		vector2.New(4., 5.),
+	}
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, vector2.WriteCSV(buf, in))
+	assert.Equal(t, "x,y\n1.5,-2\n4,5\n", buf.String())
+
+	out, err := vector2.ReadCSV[float64](buf, vector.CSVOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, in, out)
+}
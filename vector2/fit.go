@@ -0,0 +1,80 @@
+package vector2
+
+import "math"
+
+// LineFit is a line fit through a set of points, along with how well the
+// points follow it
+type LineFit struct {
+	// Point is a point on the line, the centroid of the fitted points
+	Point Float64
+
+	// Direction is the unit length direction of the line. Its sign is
+	// arbitrary
+	Direction Float64
+
+	// RMSResidual is the root mean square perpendicular distance of the
+	// fitted points from the line
+	RMSResidual float64
+
+	// MaxResidual is the largest perpendicular distance of any fitted point
+	// from the line
+	MaxResidual float64
+}
+
+// Normal returns the unit length normal of the line, Direction rotated 90
+// degrees counter clockwise
+func (l LineFit) Normal() Float64 {
+	return New(-l.Direction.y, l.Direction.x)
+}
+
+// Residual returns the signed perpendicular distance of p from the line,
+// positive on the side the normal points towards
+func (l LineFit) Residual(p Float64) float64 {
+	return p.Sub(l.Point).Dot(l.Normal())
+}
+
+// FitLine fits a line through the points using total least squares, which
+// minimizes the perpendicular distance of each point from the line rather
+// than the vertical distance ordinary least squares does. This treats x and
+// y alike, so vertical lines fit as well as horizontal ones. It returns false
+// when there are no points or they're all the same, leaving the direction
+// undefined
+func FitLine(points []Float64) (LineFit, bool) {
+	if len(points) == 0 {
+		return LineFit{}, false
+	}
+
+	var centroid Float64
+	for _, p := range points {
+		centroid = centroid.Add(p)
+	}
+	centroid = centroid.DivByConstant(float64(len(points)))
+
+	var sxx, sxy, syy float64
+	for _, p := range points {
+		d := p.Sub(centroid)
+		sxx += d.x * d.x
+		sxy += d.x * d.y
+		syy += d.y * d.y
+	}
+	if sxx+syy == 0 {
+		return LineFit{}, false
+	}
+
+	// The direction is the covariance matrix's major eigenvector, which
+	// lies at half the angle of (sxx - syy, 2sxy)
+	angle := math.Atan2(2*sxy, sxx-syy) / 2
+	fit := LineFit{
+		Point:     centroid,
+		Direction: New(math.Cos(angle), math.Sin(angle)),
+	}
+
+	var sumSquared float64
+	for _, p := range points {
+		r := math.Abs(fit.Residual(p))
+		sumSquared += r * r
+		fit.MaxResidual = max(fit.MaxResidual, r)
+	}
+	fit.RMSResidual = math.Sqrt(sumSquared / float64(len(points)))
+	return fit, true
+}
@@ -0,0 +1,67 @@
+package vector2_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFitLine(t *testing.T) {
+	points := []vector2.Float64{
+		vector2.New(0., 1.),
+		vector2.New(1., 3.),
+		vector2.New(2., 5.),
+		vector2.New(3., 7.),
+	}
+
+	fit, ok := vector2.FitLine(points)
+	require.True(t, ok)
+	assert.InDelta(t, 1.5, fit.Point.X(), 1e-12)
+	assert.InDelta(t, 4., fit.Point.Y(), 1e-12)
+
+	// y = 2x + 1, up to the direction's sign
+	expected := vector2.New(1., 2.).Normalized()
+	assert.InDelta(t, 1., math.Abs(fit.Direction.Dot(expected)), 1e-12)
+	assert.InDelta(t, 0., fit.RMSResidual, 1e-12)
+	assert.InDelta(t, 0., fit.MaxResidual, 1e-12)
+}
+
+func TestFitLineVertical(t *testing.T) {
+	fit, ok := vector2.FitLine([]vector2.Float64{
+		vector2.New(2., 0.),
+		vector2.New(2., 5.),
+		vector2.New(2., -3.),
+	})
+	require.True(t, ok)
+	assert.InDelta(t, 0., fit.Direction.X(), 1e-12)
+	assert.InDelta(t, 1., math.Abs(fit.Direction.Y()), 1e-12)
+}
+
+func TestFitLineResiduals(t *testing.T) {
+	fit, ok := vector2.FitLine([]vector2.Float64{
+		vector2.New(0., 1.),
+		vector2.New(0., -1.),
+		vector2.New(2., 1.),
+		vector2.New(2., -1.),
+		vector2.New(4., 1.),
+		vector2.New(4., -1.),
+	})
+	require.True(t, ok)
+	assert.InDelta(t, 1., math.Abs(fit.Direction.X()), 1e-12)
+	assert.InDelta(t, 1., fit.RMSResidual, 1e-12)
+	assert.InDelta(t, 1., fit.MaxResidual, 1e-12)
+
+	assert.InDelta(t, 3., math.Abs(fit.Residual(vector2.New(10., 3.))), 1e-12)
+	assert.InDelta(t, 0., fit.Normal().Dot(fit.Direction), 1e-12)
+}
+
+func TestFitLineDegenerate(t *testing.T) {
+	_, ok := vector2.FitLine(nil)
+	assert.False(t, ok)
+
+	_, ok = vector2.FitLine([]vector2.Float64{vector2.New(1., 1.), vector2.New(1., 1.)})
+	assert.False(t, ok)
+}
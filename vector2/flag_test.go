@@ -0,0 +1,34 @@
+package vector2_test
+
+import (
+	"flag"
+	"fmt"
+	"testing"
+
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/stretchr/testify/assert"
+)
+
+var _ flag.Value = (*vector2.Vector[float64])(nil)
+
+func TestFlagString(t *testing.T) {
+	v := vector2.New(1.5, -2.)
+	assert.Equal(t, "1.5,-2", v.String())
+	assert.Equal(t, "1.5,-2", fmt.Sprint(v))
+}
+
+func TestFlagSet(t *testing.T) {
+	var origin vector2.Float64
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.Var(&origin, "origin", "origin of the scene")
+
+	assert.NoError(t, fs.Parse([]string{"--origin=1.5,-2"}))
+	assert.Equal(t, vector2.New(1.5, -2.), origin)
+	assert.Equal(t, "vector2", origin.Type())
+}
+
+func TestFlagSetInvalid(t *testing.T) {
+	var v vector2.Float64
+	assert.Error(t, v.Set("1"))
+	assert.Error(t, v.Set("a,b,c,d"))
+}
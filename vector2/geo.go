@@ -0,0 +1,60 @@
+package vector2
+
+import (
+	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/internal/codec"
+)
+
+// WKT returns the vector as a WKT point, "POINT(x y)"
+func (v Vector[T]) WKT() string {
+	return string(v.AppendWKT(nil))
+}
+
+// AppendWKT appends the vector to b as a WKT point, "POINT(x y)"
+func (v Vector[T]) AppendWKT(b []byte) []byte {
+	return codec.AppendWKTPoint(b, v.x, v.y)
+}
+
+// ParseWKT parses a vector from a WKT or EWKT point, such as "POINT(x y)",
+// "POINT Z (x y)", or "SRID=4326;POINT(x y)"
+func ParseWKT[T vector.Number](s string) (Vector[T], error) {
+	var components [componentCount]T
+	if err := codec.ParseWKTPoint(s, components[:]); err != nil {
+		return Vector[T]{}, err
+	}
+
+	var v Vector[T]
+	v.x = components[0]
+	v.y = components[1]
+	return v, nil
+}
+
+// GeoJSONPoint is a Vector that is encoded to JSON as a GeoJSON Point
+// geometry, {"type": "Point", "coordinates": [x, y]}
+type GeoJSONPoint[T vector.Number] Vector[T]
+
+// GeoJSON returns the vector wrapped so that it is encoded to JSON as a
+// GeoJSON Point geometry
+func (v Vector[T]) GeoJSON() GeoJSONPoint[T] {
+	return GeoJSONPoint[T](v)
+}
+
+// Vector returns the underlying vector
+func (g GeoJSONPoint[T]) Vector() Vector[T] {
+	return Vector[T](g)
+}
+
+func (g GeoJSONPoint[T]) MarshalJSON() ([]byte, error) {
+	return codec.AppendGeoJSONPoint(nil, g.x, g.y)
+}
+
+// UnmarshalJSON decodes a GeoJSON Point geometry. Positions with an altitude are accepted, discarding it.
+func (g *GeoJSONPoint[T]) UnmarshalJSON(data []byte) error {
+	var components [componentCount]T
+	if err := codec.UnmarshalGeoJSONPoint(data, components[:]); err != nil {
+		return err
+	}
+	g.x = components[0]
+	g.y = components[1]
+	return nil
+}
@@ -0,0 +1,49 @@
+package vector2_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWKT(t *testing.T) {
+	in := vector2.New(1.5, -2.)
+	assert.Equal(t, "POINT(1.5 -2)", in.WKT())
+
+	back, err := vector2.ParseWKT[float64]("SRID=4326;POINT(1.5 -2)")
+	assert.NoError(t, err)
+	assert.Equal(t, in, back)
+
+	_, err = vector2.ParseWKT[float64]("POINT(1 2 3)")
+	assert.Error(t, err)
+
+	// Measures are skipped rather than read as coordinates
+	measured, err := vector2.ParseWKT[float64]("POINT M (1 2 99)")
+	assert.NoError(t, err)
+	assert.Equal(t, vector2.New(1., 2.), measured)
+
+	_, err = vector2.ParseWKT[float64]("POINT Z (1 2 3)")
+	assert.Error(t, err)
+}
+
+func TestGeoJSON(t *testing.T) {
+	in := vector2.New(-122.4194, 37.7749)
+
+	data, err := json.Marshal(in.GeoJSON())
+	assert.NoError(t, err)
+	assert.Equal(t, `{"type":"Point","coordinates":[-122.4194,37.7749]}`, string(data))
+
+	var back vector2.GeoJSONPoint[float64]
+	assert.NoError(t, json.Unmarshal(data, &back))
+	assert.Equal(t, in, back.Vector())
+
+	// Altitude is discarded
+	var withAltitude vector2.GeoJSONPoint[float64]
+	assert.NoError(t, json.Unmarshal([]byte(`{"type": "Point", "coordinates": [1, 2, 3]}`), &withAltitude))
+	assert.Equal(t, vector2.New(1., 2.), withAltitude.Vector())
+
+	var invalid vector2.GeoJSONPoint[float64]
+	assert.Error(t, json.Unmarshal([]byte(`{"type": "Point", "coordinates": [1]}`), &invalid))
+}
@@ -0,0 +1,40 @@
+package vector2_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGob(t *testing.T) {
+	type session struct {
+		Position vector2.Float64
+		Cells    []vector2.Int
+		Lookup   map[string]vector2.Float32
+	}
+
+	in := session{
+		Position: vector2.New(1.5, -2.),
+		Cells:    []vector2.Int{vector2.New(1, 2), vector2.Zero[int]()},
+		Lookup:   map[string]vector2.Float32{"spawn": vector2.New[float32](1.5, -2.)},
+	}
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, gob.NewEncoder(buf).Encode(in))
+
+	var out session
+	require.NoError(t, gob.NewDecoder(buf).Decode(&out))
+	assert.Equal(t, in, out)
+}
+
+func TestGobComponentMismatch(t *testing.T) {
+	buf := &bytes.Buffer{}
+	require.NoError(t, gob.NewEncoder(buf).Encode(vector2.New(1.5, -2.)))
+
+	var out vector2.Float32
+	assert.Error(t, gob.NewDecoder(buf).Decode(&out))
+}
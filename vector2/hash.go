@@ -0,0 +1,47 @@
+package vector2
+
+import (
+	"math"
+
+	"github.com/EliCDavis/vector/mathex"
+)
+
+// The functions below derive repeatable randomness from integer grid cells,
+// so procedural generation can ask for the random value of a cell at any
+// time, in any order, without storing generator state. Different seeds give
+// independent sets of values.
+
+// HashFloat returns a uniformly distributed value in [0, 1) for the cell
+func HashFloat(cell Int, seed uint32) float64 {
+	return mathex.HashFloat(mathex.Hash2(cell.x, cell.y, seed))
+}
+
+// HashRand returns a vector with each component uniformly distributed in
+// [0, 1) for the cell, like Rand
+func HashRand(cell Int, seed uint32) Float64 {
+	return Float64{
+		x: HashFloat(cell, seed),
+		y: HashFloat(cell, seed+1),
+	}
+}
+
+// HashUnit returns a unit vector pointing in a uniformly distributed direction
+// for the cell
+func HashUnit(cell Int, seed uint32) Float64 {
+	angle := 2 * math.Pi * HashFloat(cell, seed)
+	return Float64{x: math.Cos(angle), y: math.Sin(angle)}
+}
+
+// gradients are the directions HashGradient picks between: the axes and the
+// diagonals
+var gradients = [8]Float64{
+	{1, 0}, {-1, 0}, {0, 1}, {0, -1},
+	{math.Sqrt2 / 2, math.Sqrt2 / 2}, {-math.Sqrt2 / 2, math.Sqrt2 / 2},
+	{math.Sqrt2 / 2, -math.Sqrt2 / 2}, {-math.Sqrt2 / 2, -math.Sqrt2 / 2},
+}
+
+// HashGradient returns one of eight evenly spaced unit vectors for the cell,
+// as used for the lattice gradients of Perlin style gradient noise
+func HashGradient(cell Int, seed uint32) Float64 {
+	return gradients[mathex.Hash2(cell.x, cell.y, seed)>>29]
+}
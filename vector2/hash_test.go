@@ -0,0 +1,36 @@
+package vector2_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHash(t *testing.T) {
+	cell := vector2.New(3, -7)
+	assert.Equal(t, vector2.HashRand(cell, 1), vector2.HashRand(cell, 1))
+	assert.NotEqual(t, vector2.HashRand(cell, 1), vector2.HashRand(cell, 2))
+	assert.NotEqual(t, vector2.HashFloat(cell, 1), vector2.HashFloat(vector2.New(-7, 3), 1))
+
+	mean := vector2.Zero[float64]()
+	directions := map[vector2.Float64]bool{}
+	for x := -20; x < 20; x++ {
+		for y := -20; y < 20; y++ {
+			cell := vector2.New(x, y)
+
+			r := vector2.HashRand(cell, 9)
+			assert.True(t, r.X() >= 0 && r.X() < 1 && r.Y() >= 0 && r.Y() < 1)
+
+			u := vector2.HashUnit(cell, 9)
+			assert.InDelta(t, 1, u.Length(), 1e-12)
+			mean = mean.Add(u)
+
+			g := vector2.HashGradient(cell, 9)
+			assert.InDelta(t, 1, g.Length(), 1e-12)
+			directions[g] = true
+		}
+	}
+	assert.InDelta(t, 0, mean.Length()/1600, 0.05)
+	assert.Len(t, directions, 8)
+}
@@ -0,0 +1,122 @@
+package vector2
+
+import (
+	"math"
+
+	"github.com/EliCDavis/vector"
+)
+
+// The Into variants below write their results to a caller provided
+// destination rather than allocating a new slice or SoA, letting pipelines of
+// bulk operations reuse the same buffers from step to step. dst is grown only
+// if its capacity is too small, and the resized dst is returned. dst may be
+// the input being operated on.
+
+// resize returns dst with a length of n, reusing its backing array if it has
+// the capacity to do so
+func resize[T any](dst []T, n int) []T {
+	if cap(dst) < n {
+		return make([]T, n)
+	}
+	return dst[:n]
+}
+
+func checkIntoLengths(a, b int) {
+	if a != b {
+		panic("vector2: slices must have the same length")
+	}
+}
+
+// AddInto sets dst[i] to a[i] + b[i], returning dst resized to the length of
+// the inputs. a and b must share a length, and dst may be one of the inputs
+func AddInto[T vector.Number](dst, a, b []Vector[T]) []Vector[T] {
+	checkIntoLengths(len(a), len(b))
+	dst = resize(dst, len(a))
+	for i := range dst {
+		dst[i] = a[i].Add(b[i])
+	}
+	return dst
+}
+
+// SubInto sets dst[i] to a[i] - b[i], returning dst resized to the length of
+// the inputs. a and b must share a length, and dst may be one of the inputs
+func SubInto[T vector.Number](dst, a, b []Vector[T]) []Vector[T] {
+	checkIntoLengths(len(a), len(b))
+	dst = resize(dst, len(a))
+	for i := range dst {
+		dst[i] = a[i].Sub(b[i])
+	}
+	return dst
+}
+
+// ScaleInto sets dst[i] to src[i] scaled by t
+func ScaleInto[T vector.Number](dst, src []Vector[T], t float64) []Vector[T] {
+	dst = resize(dst, len(src))
+	for i := range dst {
+		dst[i] = src[i].Scale(t)
+	}
+	return dst
+}
+
+// NormalizedInto sets dst[i] to src[i] normalized
+func NormalizedInto[T vector.Number](dst, src []Vector[T]) []Vector[T] {
+	dst = resize(dst, len(src))
+	for i := range dst {
+		dst[i] = src[i].Normalized()
+	}
+	return dst
+}
+
+// ModifyInto sets dst[i] to f(src[i])
+func ModifyInto[T vector.Number](dst, src []Vector[T], f func(Vector[T]) Vector[T]) []Vector[T] {
+	dst = resize(dst, len(src))
+	for i := range dst {
+		dst[i] = f(src[i])
+	}
+	return dst
+}
+
+// resized returns dst with room for n vectors, reusing its component slices
+// where they have the capacity to do so
+func (dst SoA[T]) resized(n int) SoA[T] {
+	return SoA[T]{X: resize(dst.X, n), Y: resize(dst.Y, n)}
+}
+
+// TranslateInto adds v to every vector, writing the results to dst
+func (s SoA[T]) TranslateInto(dst SoA[T], v Vector[T]) SoA[T] {
+	x, y := s.components()
+	dst = dst.resized(len(x))
+	dx, dy := dst.components()
+	for i := range x {
+		dx[i] = x[i] + v.x
+		dy[i] = y[i] + v.y
+	}
+	return dst
+}
+
+// ScaleInto multiplies every vector by t, writing the results to dst
+func (s SoA[T]) ScaleInto(dst SoA[T], t float64) SoA[T] {
+	x, y := s.components()
+	dst = dst.resized(len(x))
+	dx, dy := dst.components()
+	for i := range x {
+		dx[i] = T(float64(x[i]) * t)
+		dy[i] = T(float64(y[i]) * t)
+	}
+	return dst
+}
+
+// RotateInto rotates every vector counter-clockwise about the origin by
+// radians, writing the results to dst
+func (s SoA[T]) RotateInto(dst SoA[T], radians float64) SoA[T] {
+	sin, cos := math.Sincos(radians)
+	x, y := s.components()
+	dst = dst.resized(len(x))
+	dx, dy := dst.components()
+	for i := range x {
+		fx, fy := float64(x[i]), float64(y[i])
+		dx[i] = T(fx*cos - fy*sin)
+		dy[i] = T(fx*sin + fy*cos)
+	}
+	return dst
+}
@@ -0,0 +1,53 @@
+package vector2_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIntoReusesDestination(t *testing.T) {
+	src := []vector2.Int{vector2.New(1, 2), vector2.New(3, 4)}
+	dst := make([]vector2.Int, 0, 4)
+
+	out := vector2.AddInto(dst, src, src)
+	assert.Equal(t, []vector2.Int{vector2.New(2, 4), vector2.New(6, 8)}, out)
+	assert.Same(t, &dst[:1][0], &out[0])
+
+	out = vector2.ScaleInto(out, out, 0.5)
+	assert.Equal(t, src, out)
+
+	out = vector2.SubInto(out, out, src)
+	assert.Equal(t, []vector2.Int{vector2.Zero[int](), vector2.Zero[int]()}, out)
+
+	out = vector2.ModifyInto(out, src, func(v vector2.Int) vector2.Int { return v.Scale(2) })
+	assert.Equal(t, []vector2.Int{vector2.New(2, 4), vector2.New(6, 8)}, out)
+
+	// A destination that's too small is replaced
+	normalized := vector2.NormalizedInto(nil, []vector2.Float64{vector2.New(3., 4.)})
+	assert.Len(t, normalized, 1)
+	assert.InDelta(t, 0.6, normalized[0].X(), 1e-9)
+	assert.InDelta(t, 0.8, normalized[0].Y(), 1e-9)
+
+	assert.Panics(t, func() { vector2.AddInto(nil, src, src[:1]) })
+}
+
+func TestSoAInto(t *testing.T) {
+	src := vector2.SoAFrom([]vector2.Float64{vector2.New(1., 0.), vector2.New(0., 2.)})
+	dst := vector2.NewSoA[float64](0)
+
+	dst = src.TranslateInto(dst, vector2.One[float64]())
+	assert.Equal(t, []vector2.Float64{vector2.New(2., 1.), vector2.New(1., 3.)}, dst.Vectors())
+	assert.Equal(t, vector2.New(1., 0.), src.At(0))
+
+	dst = src.ScaleInto(dst, 3)
+	assert.Equal(t, []vector2.Float64{vector2.New(3., 0.), vector2.New(0., 6.)}, dst.Vectors())
+
+	dst = dst.RotateInto(dst, math.Pi/2)
+	assert.InDelta(t, 0., dst.At(0).X(), 1e-9)
+	assert.InDelta(t, 3., dst.At(0).Y(), 1e-9)
+	assert.InDelta(t, -6., dst.At(1).X(), 1e-9)
+	assert.InDelta(t, 0., dst.At(1).Y(), 1e-9)
+}
@@ -0,0 +1,39 @@
+package vector2
+
+import "math"
+
+// ToIsometric projects the vector from grid space into isometric screen
+// space, where tileSize is the width and height in pixels of the diamond
+// each tile is drawn as. Moving along +x in grid space moves down and to the
+// right on screen, while moving along +y moves down and to the left. The
+// tile at grid coordinate (i, j) covers [i, i+1) x [j, j+1), so its top
+// corner lands at ToIsometric of (i, j)
+func (v Vector[T]) ToIsometric(tileSize Float64) Float64 {
+	x, y := float64(v.x), float64(v.y)
+	return Float64{
+		x: (x - y) * tileSize.x / 2,
+		y: (x + y) * tileSize.y / 2,
+	}
+}
+
+// FromIsometric converts a point in isometric screen space back into grid
+// space, undoing ToIsometric
+func FromIsometric(screen, tileSize Float64) Float64 {
+	sx := screen.x / (tileSize.x / 2)
+	sy := screen.y / (tileSize.y / 2)
+	return Float64{
+		x: (sy + sx) / 2,
+		y: (sy - sx) / 2,
+	}
+}
+
+// PickIsometricTile returns the grid coordinate of the tile drawn under the
+// isometric screen point, as used for mouse picking. See ToIsometric for the
+// conventions of the projection
+func PickIsometricTile(screen, tileSize Float64) Int {
+	grid := FromIsometric(screen, tileSize)
+	return Int{
+		x: int(math.Floor(grid.x)),
+		y: int(math.Floor(grid.y)),
+	}
+}
@@ -0,0 +1,39 @@
+package vector2_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsometricProjection(t *testing.T) {
+	tile := vector2.New(64., 32.)
+
+	assert.Equal(t, vector2.New(0., 0.), vector2.New(0, 0).ToIsometric(tile))
+	assert.Equal(t, vector2.New(32., 16.), vector2.New(1, 0).ToIsometric(tile))
+	assert.Equal(t, vector2.New(-32., 16.), vector2.New(0, 1).ToIsometric(tile))
+	assert.Equal(t, vector2.New(0., 32.), vector2.New(1, 1).ToIsometric(tile))
+
+	grid := vector2.New(3.25, -1.5)
+	back := vector2.FromIsometric(grid.ToIsometric(tile), tile)
+	assert.InDelta(t, grid.X(), back.X(), 1e-12)
+	assert.InDelta(t, grid.Y(), back.Y(), 1e-12)
+}
+
+func TestPickIsometricTile(t *testing.T) {
+	tile := vector2.New(64., 32.)
+
+	// The diamond for tile (0, 0) spans from its top corner at the origin
+	// down to (0, 32), and out to ±32 horizontally at its middle
+	assert.Equal(t, vector2.New(0, 0), vector2.PickIsometricTile(vector2.New(0., 16.), tile))
+	assert.Equal(t, vector2.New(0, 0), vector2.PickIsometricTile(vector2.New(30., 16.), tile))
+	assert.Equal(t, vector2.New(1, 0), vector2.PickIsometricTile(vector2.New(34., 17.), tile))
+	assert.Equal(t, vector2.New(0, 1), vector2.PickIsometricTile(vector2.New(-34., 17.), tile))
+	assert.Equal(t, vector2.New(-1, -1), vector2.PickIsometricTile(vector2.New(0., -16.), tile))
+
+	for _, want := range []vector2.Int{vector2.New(5, 2), vector2.New(-3, 7)} {
+		center := want.ToFloat64().Add(vector2.New(0.5, 0.5)).ToIsometric(tile)
+		assert.Equal(t, want, vector2.PickIsometricTile(center, tile))
+	}
+}
@@ -0,0 +1,62 @@
+package vector2
+
+import (
+	"io"
+
+	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/internal/codec"
+)
+
+// ArrayJSON is a Vector that is encoded to JSON as an array of components,
+// [x, y], rather than an object. This is the form used by many interchange
+// formats such as glTF and three.js. Both forms are accepted when decoding.
+type ArrayJSON[T vector.Number] Vector[T]
+
+// ArrayJSON returns the vector wrapped so that it is encoded to JSON as an
+// array of components
+func (v Vector[T]) ArrayJSON() ArrayJSON[T] {
+	return ArrayJSON[T](v)
+}
+
+// Vector returns the underlying vector
+func (a ArrayJSON[T]) Vector() Vector[T] {
+	return Vector[T](a)
+}
+
+func (a ArrayJSON[T]) MarshalJSON() ([]byte, error) {
+	return codec.AppendJSONArray(nil, a.x, a.y)
+}
+
+func (a *ArrayJSON[T]) UnmarshalJSON(data []byte) error {
+	return (*Vector[T])(a).UnmarshalJSON(data)
+}
+
+// AppendJSON appends the MarshalJSON encoding of the vector to b, allowing
+// callers to reuse buffers and avoid allocations
+func (v Vector[T]) AppendJSON(b []byte) ([]byte, error) {
+	return codec.AppendJSONObject(b, componentKeys, v.x, v.y)
+}
+
+// AppendJSONSlice appends the vectors to b as a JSON array, encoding each the
+// same way as MarshalJSON
+func AppendJSONSlice[T vector.Number](b []byte, vectors []Vector[T]) ([]byte, error) {
+	var err error
+	b = append(b, '[')
+	for i, v := range vectors {
+		if i > 0 {
+			b = append(b, ',')
+		}
+		if b, err = v.AppendJSON(b); err != nil {
+			return b, err
+		}
+	}
+	return append(b, ']'), nil
+}
+
+// WriteJSON streams the vectors to w as a JSON array, producing the same
+// output as AppendJSONSlice without holding the entire encoding in memory
+func WriteJSON[T vector.Number](w io.Writer, vectors []Vector[T]) error {
+	return codec.WriteJSONArray(w, len(vectors), func(dst []byte, i int) ([]byte, error) {
+		return vectors[i].AppendJSON(dst)
+	})
+}
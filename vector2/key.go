@@ -0,0 +1,20 @@
+package vector2
+
+import "github.com/EliCDavis/vector"
+
+// PackKey packs the integer vector into a single uint64, with x in the upper
+// 32 bits and y in the lower, so coordinates like chunk or tile positions can
+// be used as compact map keys. Each component is converted to an int32 first,
+// so components of 64 bit types outside its range wrap
+func PackKey[T vector.Integer](v Vector[T]) uint64 {
+	return uint64(uint32(int32(v.x)))<<32 | uint64(uint32(int32(v.y)))
+}
+
+// UnpackKey recovers the vector packed into k by PackKey, restoring the sign
+// of negative components
+func UnpackKey[T vector.Integer](k uint64) Vector[T] {
+	return Vector[T]{
+		x: T(int32(k >> 32)),
+		y: T(int32(k)),
+	}
+}
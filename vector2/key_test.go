@@ -0,0 +1,37 @@
+package vector2_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPackKey(t *testing.T) {
+	for _, v := range []vector2.Int{
+		vector2.New(0, 0),
+		vector2.New(1, -1),
+		vector2.New(-1, 1),
+		vector2.New(math.MaxInt32, math.MinInt32),
+		vector2.New(-12345, 67890),
+	} {
+		assert.Equal(t, v, vector2.UnpackKey[int](vector2.PackKey(v)))
+	}
+
+	assert.Equal(t, uint64(0x00000001ffffffff), vector2.PackKey(vector2.New(1, -1)))
+	assert.NotEqual(t, vector2.PackKey(vector2.New(1, 2)), vector2.PackKey(vector2.New(2, 1)))
+
+	assert.Equal(t, vector2.New[int16](-3, 4), vector2.UnpackKey[int16](vector2.PackKey(vector2.New[int16](-3, 4))))
+	assert.Equal(t, vector2.New[int8](math.MinInt8, math.MaxInt8), vector2.UnpackKey[int8](vector2.PackKey(vector2.New[int8](math.MinInt8, math.MaxInt8))))
+
+	tiles := map[uint64]string{}
+	tiles[vector2.PackKey(vector2.New(-4, 9))] = "grass"
+	assert.Equal(t, "grass", tiles[vector2.PackKey(vector2.New(-4, 9))])
+}
+
+func TestPackKeyWrapsOutOfRange(t *testing.T) {
+	v := vector2.New[int64](math.MaxInt32+1, math.MinInt32-1)
+	assert.Equal(t, vector2.New[int64](math.MinInt32, math.MaxInt32), vector2.UnpackKey[int64](vector2.PackKey(v)))
+	assert.Equal(t, vector2.PackKey(vector2.New[int64](1, 2)), vector2.PackKey(vector2.New[int64](1<<32+1, 1<<32+2)))
+}
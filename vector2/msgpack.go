@@ -0,0 +1,27 @@
+package vector2
+
+import "github.com/EliCDavis/vector/internal/codec"
+
+// MarshalMsg appends the MessagePack encoding of the vector to b as a fixed
+// array of 2 components, following the msgp Marshaler convention
+func (v Vector[T]) MarshalMsg(b []byte) ([]byte, error) {
+	return codec.AppendMsgpack(b, v.x, v.y), nil
+}
+
+// UnmarshalMsg decodes a vector encoded as a MessagePack array of 2
+// components from the start of bts, returning the remaining bytes
+func (v *Vector[T]) UnmarshalMsg(bts []byte) ([]byte, error) {
+	var components [componentCount]T
+	rest, err := codec.ReadMsgpack(bts, components[:])
+	if err != nil {
+		return bts, err
+	}
+	v.x = components[0]
+	v.y = components[1]
+	return rest, nil
+}
+
+// Msgsize returns an upper bound on the number of bytes MarshalMsg appends
+func (v Vector[T]) Msgsize() int {
+	return codec.MsgpackSize[T](componentCount)
+}
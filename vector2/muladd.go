@@ -0,0 +1,34 @@
+package vector2
+
+import "math"
+
+// MulAdd returns v + b*s, computing each component with a single rounding via
+// math.FMA. This is the "accumulate a scaled vector" step found in most
+// integrators and filters, such as position.MulAdd(velocity, dt)
+func (v Vector[T]) MulAdd(b Vector[T], s float64) Vector[T] {
+	return Vector[T]{
+		x: T(math.FMA(float64(b.x), s, float64(v.x))),
+		y: T(math.FMA(float64(b.y), s, float64(v.y))),
+	}
+}
+
+// ScaleAdd returns v*s + b, computing each component with a single rounding
+// via math.FMA
+func (v Vector[T]) ScaleAdd(s float64, b Vector[T]) Vector[T] {
+	return Vector[T]{
+		x: T(math.FMA(float64(v.x), s, float64(b.x))),
+		y: T(math.FMA(float64(v.y), s, float64(b.y))),
+	}
+}
+
+// MulAddAssign sets v to v + b*s, see MulAdd
+func (v *Vector[T]) MulAddAssign(b Vector[T], s float64) {
+	v.x = T(math.FMA(float64(b.x), s, float64(v.x)))
+	v.y = T(math.FMA(float64(b.y), s, float64(v.y)))
+}
+
+// ScaleAddAssign sets v to v*s + b, see ScaleAdd
+func (v *Vector[T]) ScaleAddAssign(s float64, b Vector[T]) {
+	v.x = T(math.FMA(float64(v.x), s, float64(b.x)))
+	v.y = T(math.FMA(float64(v.y), s, float64(b.y)))
+}
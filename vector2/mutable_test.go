@@ -0,0 +1,42 @@
+package vector2_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAssign(t *testing.T) {
+	a := vector2.New(1.5, -2.)
+	b := vector2.New(0.5, 4.)
+
+	tests := map[string]struct {
+		assign func(v *vector2.Float64)
+		want   vector2.Float64
+	}{
+		"add":             {assign: func(v *vector2.Float64) { v.AddAssign(b) }, want: a.Add(b)},
+		"sub":             {assign: func(v *vector2.Float64) { v.SubAssign(b) }, want: a.Sub(b)},
+		"scale":           {assign: func(v *vector2.Float64) { v.ScaleAssign(2.5) }, want: a.Scale(2.5)},
+		"mult by vector":  {assign: func(v *vector2.Float64) { v.MultByVectorAssign(b) }, want: a.MultByVector(b)},
+		"div by constant": {assign: func(v *vector2.Float64) { v.DivByConstantAssign(3) }, want: a.DivByConstant(3)},
+		"normalize":       {assign: func(v *vector2.Float64) { v.NormalizeAssign() }, want: a.Normalized()},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			v := a
+			tc.assign(&v)
+			assert.Equal(t, tc.want, v)
+		})
+	}
+}
+
+func TestAssignInSlice(t *testing.T) {
+	particles := []vector2.Int{vector2.Zero[int](), vector2.One[int]()}
+	for i := range particles {
+		particles[i].AddAssign(vector2.Fill(2))
+		particles[i].ScaleAssign(2)
+	}
+	assert.Equal(t, []vector2.Int{vector2.Fill(4), vector2.Fill(6)}, particles)
+}
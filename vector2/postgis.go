@@ -0,0 +1,40 @@
+package vector2
+
+import (
+	"database/sql/driver"
+
+	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/internal/codec"
+)
+
+// PostGISPoint is a Vector that is stored in SQL databases as a PostGIS
+// geometry point rather than the plain "x,y" text form
+type PostGISPoint[T vector.Number] Vector[T]
+
+// PostGIS returns the vector wrapped so that it's stored in SQL databases as
+// a PostGIS geometry point
+func (v Vector[T]) PostGIS() PostGISPoint[T] {
+	return PostGISPoint[T](v)
+}
+
+// Vector returns the underlying vector
+func (p PostGISPoint[T]) Vector() Vector[T] {
+	return Vector[T](p)
+}
+
+// Value stores the point as WKT, "POINT(x y)", which PostGIS accepts as input
+// for geometry columns
+func (p PostGISPoint[T]) Value() (driver.Value, error) {
+	return string(codec.AppendWKTPoint(nil, p.x, p.y)), nil
+}
+
+// Scan reads the point from hex encoded EWKB, raw WKB, or WKT/EWKT
+func (p *PostGISPoint[T]) Scan(src any) error {
+	var components [componentCount]T
+	if err := codec.ScanPostGIS(src, components[:]); err != nil {
+		return err
+	}
+	p.x = components[0]
+	p.y = components[1]
+	return nil
+}
@@ -0,0 +1,74 @@
+package vector2_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostGISValue(t *testing.T) {
+	value, err := vector2.New(1.5, -2.).PostGIS().Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "POINT(1.5 -2)", value)
+}
+
+func TestPostGISScan(t *testing.T) {
+	tests := map[string]struct {
+		src  any
+		want vector2.Float64
+	}{
+		"wkt":        {src: "POINT(1.5 -2)", want: vector2.New(1.5, -2.)},
+		"wkt spaced": {src: " point ( 1 2 ) ", want: vector2.New(1., 2.)},
+		"ewkt":       {src: "SRID=4326;POINT(3 4)", want: vector2.New(3., 4.)},
+		"wkt bytes":  {src: []byte("POINT(5 6)"), want: vector2.New(5., 6.)},
+		// SELECT 'SRID=4326;POINT(1 2)'::geometry
+		"hex ewkb": {src: "0101000020E6100000000000000000F03F0000000000000040", want: vector2.New(1., 2.)},
+		// SELECT ST_AsBinary('POINT(1 2)'::geometry)
+		"wkb": {
+			src: []byte{
+				0x01, 0x01, 0x00, 0x00, 0x00,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40,
+			},
+			want: vector2.New(1., 2.),
+		},
+		"big endian wkb": {
+			src: []byte{
+				0x00, 0x00, 0x00, 0x00, 0x01,
+				0x3f, 0xf0, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				0x40, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			},
+			want: vector2.New(1., 2.),
+		},
+		// SELECT ST_AsBinary('POINT M (1 2 99)'::geometry)
+		"iso wkb m": {
+			src: []byte{
+				0x01, 0xd1, 0x07, 0x00, 0x00,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0xc0, 0x58, 0x40,
+			},
+			want: vector2.New(1., 2.),
+		},
+		// SELECT 'POINT ZM (1 2 3 99)'::geometry
+		"hex ewkb zm": {src: "01010000C0000000000000F03F000000000000004000000000000008400000000000C05840", want: vector2.New(1., 2.)},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var p vector2.PostGISPoint[float64]
+			assert.NoError(t, p.Scan(tc.src))
+			assert.Equal(t, tc.want, p.Vector())
+		})
+	}
+}
+
+func TestPostGISScanErrors(t *testing.T) {
+	var p vector2.PostGISPoint[float64]
+	assert.EqualError(t, p.Scan(nil), "unable to scan NULL into vector")
+	assert.Error(t, p.Scan("LINESTRING(1 2, 3 4)"))
+	assert.Error(t, p.Scan("POINT(1 2 3)"))
+	assert.Error(t, p.Scan("POINT 1 2"))
+	assert.Error(t, p.Scan([]byte{0x01, 0x02, 0x00, 0x00, 0x00}))
+}
@@ -0,0 +1,58 @@
+package vector2_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResample(t *testing.T) {
+	// An L shape 10 long, with an uneven distribution of points
+	line := []vector2.Float64{
+		vector2.New(0., 0.),
+		vector2.New(0.5, 0.),
+		vector2.New(6., 0.),
+		vector2.New(6., 4.),
+	}
+
+	resampled := vector2.Resample(line, 2)
+	assert.Len(t, resampled, 6)
+	expected := []vector2.Float64{
+		vector2.New(0., 0.),
+		vector2.New(2., 0.),
+		vector2.New(4., 0.),
+		vector2.New(6., 0.),
+		vector2.New(6., 2.),
+		vector2.New(6., 4.),
+	}
+	for i := range expected {
+		assert.InDelta(t, 0., expected[i].Distance(resampled[i]), 1e-12)
+	}
+}
+
+func TestResampleUnevenSpacing(t *testing.T) {
+	line := []vector2.Float64{vector2.New(0., 0.), vector2.New(10., 0.)}
+
+	// 10 / 3 rounds to 3 segments, each 10/3 long
+	resampled := vector2.Resample(line, 3)
+	assert.Len(t, resampled, 4)
+	assert.InDelta(t, 10./3, resampled[1].X(), 1e-12)
+	assert.Equal(t, line[1], resampled[3])
+
+	// A spacing longer than the line keeps just the end points
+	assert.Equal(t, line, vector2.Resample(line, 100))
+}
+
+func TestResampleDegenerate(t *testing.T) {
+	assert.Empty(t, vector2.Resample(nil, 1))
+
+	single := []vector2.Float64{vector2.New(1., 2.)}
+	assert.Equal(t, single, vector2.Resample(single, 1))
+
+	line := []vector2.Float64{vector2.New(1., 2.), vector2.New(3., 4.)}
+	assert.Equal(t, line, vector2.Resample(line, 0))
+
+	point := []vector2.Float64{vector2.New(1., 2.), vector2.New(1., 2.), vector2.New(1., 2.)}
+	assert.Equal(t, []vector2.Float64{point[0], point[2]}, vector2.Resample(point, 1))
+}
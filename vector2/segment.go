@@ -0,0 +1,157 @@
+package vector2
+
+import (
+	"math"
+
+	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/mathex"
+)
+
+// SegmentIntersectionKind describes how two segments intersect
+type SegmentIntersectionKind int
+
+const (
+	// NoIntersection means the segments don't touch
+	NoIntersection SegmentIntersectionKind = iota
+
+	// PointIntersection means the segments cross or touch at a single point
+	PointIntersection
+
+	// OverlapIntersection means the segments are collinear and share a
+	// stretch of non-zero length
+	OverlapIntersection
+)
+
+// SegmentIntersection is the result of intersecting segment a with segment b
+type SegmentIntersection struct {
+	Kind SegmentIntersectionKind
+
+	// Start is the point the segments cross at, or the start of their
+	// overlap, which is the end closest to a's start
+	Start Float64
+
+	// End is the end of the segments' overlap. For point intersections it's
+	// equal to Start
+	End Float64
+
+	// TStart and TEnd are the parameters along segment a, from 0 at its
+	// start to 1 at its end, of Start and End
+	TStart, TEnd float64
+
+	// UStart and UEnd are the parameters along segment b of Start and End
+	UStart, UEnd float64
+}
+
+// segmentEpsilon is the relative tolerance used to decide when segments are
+// parallel, collinear, or touching at their end points
+const segmentEpsilon = 1e-10
+
+func cross(a, b Float64) float64 {
+	return a.x*b.y - a.y*b.x
+}
+
+// IntersectSegments intersects the segment from a0 to a1 with the segment
+// from b0 to b1. Collinear segments that overlap report the shared stretch,
+// and zero length segments are treated as points
+func IntersectSegments[T vector.Number](a0, a1, b0, b1 Vector[T]) SegmentIntersection {
+	p, q := a0.ToFloat64(), b0.ToFloat64()
+	r, s := a1.ToFloat64().Sub(p), b1.ToFloat64().Sub(q)
+	qp := q.Sub(p)
+
+	rr, ss := r.LengthSquared(), s.LengthSquared()
+	switch {
+	case rr == 0 && ss == 0:
+		if qp.LengthSquared() != 0 {
+			return SegmentIntersection{}
+		}
+		return pointIntersection(p, 0, 0)
+	case rr == 0:
+		// Segment a is a single point, find where it lies along b
+		u := p.Sub(q).Dot(s) / ss
+		if !onSegment(p.Sub(q), s, u) {
+			return SegmentIntersection{}
+		}
+		return pointIntersection(p, 0, clamp01(u))
+	case ss == 0:
+		t := qp.Dot(r) / rr
+		if !onSegment(qp, r, t) {
+			return SegmentIntersection{}
+		}
+		return pointIntersection(q, clamp01(t), 0)
+	}
+
+	rxs := cross(r, s)
+	if math.Abs(rxs) <= segmentEpsilon*math.Sqrt(rr*ss) {
+		if math.Abs(cross(qp, r)) > segmentEpsilon*math.Sqrt(rr)*qp.Length() {
+			// Parallel, but not on the same line
+			return SegmentIntersection{}
+		}
+		return collinearIntersection(p, r, qp, s)
+	}
+
+	t := cross(qp, s) / rxs
+	u := cross(qp, r) / rxs
+	if t < -segmentEpsilon || t > 1+segmentEpsilon || u < -segmentEpsilon || u > 1+segmentEpsilon {
+		return SegmentIntersection{}
+	}
+	t, u = clamp01(t), clamp01(u)
+	return pointIntersection(p.Add(r.Scale(t)), t, u)
+}
+
+// collinearIntersection handles segments that lie along the same line by
+// projecting segment b onto segment a
+func collinearIntersection(p, r, qp, s Float64) SegmentIntersection {
+	rr := r.LengthSquared()
+	t0 := qp.Dot(r) / rr
+	t1 := t0 + s.Dot(r)/rr
+
+	lo := math.Max(0, math.Min(t0, t1))
+	hi := math.Min(1, math.Max(t0, t1))
+	if lo > hi+segmentEpsilon {
+		return SegmentIntersection{}
+	}
+
+	// Converts a parameter along a into one along b
+	toU := func(t float64) float64 {
+		return clamp01((t - t0) / (t1 - t0))
+	}
+
+	if mathex.ApproximatelyWithin(hi, lo, segmentEpsilon) {
+		return pointIntersection(p.Add(r.Scale(lo)), lo, toU(lo))
+	}
+	return SegmentIntersection{
+		Kind:   OverlapIntersection,
+		Start:  p.Add(r.Scale(lo)),
+		End:    p.Add(r.Scale(hi)),
+		TStart: lo,
+		TEnd:   hi,
+		UStart: toU(lo),
+		UEnd:   toU(hi),
+	}
+}
+
+// onSegment reports whether the point at offset d from the start of a
+// segment with direction dir, whose projection onto it is at parameter t,
+// lies on the segment
+func onSegment(d, dir Float64, t float64) bool {
+	if t < -segmentEpsilon || t > 1+segmentEpsilon {
+		return false
+	}
+	return math.Abs(cross(d, dir)) <= segmentEpsilon*dir.Length()*math.Max(d.Length(), 1)
+}
+
+func pointIntersection(point Float64, t, u float64) SegmentIntersection {
+	return SegmentIntersection{
+		Kind:   PointIntersection,
+		Start:  point,
+		End:    point,
+		TStart: t,
+		TEnd:   t,
+		UStart: u,
+		UEnd:   u,
+	}
+}
+
+func clamp01(v float64) float64 {
+	return math.Max(0, math.Min(1, v))
+}
@@ -0,0 +1,77 @@
+package vector2_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIntersectSegmentsCrossing(t *testing.T) {
+	hit := vector2.IntersectSegments(vector2.New(0, 0), vector2.New(4, 4), vector2.New(0, 4), vector2.New(4, 0))
+	assert.Equal(t, vector2.PointIntersection, hit.Kind)
+	assert.Equal(t, vector2.New(2., 2.), hit.Start)
+	assert.Equal(t, hit.Start, hit.End)
+	assert.Equal(t, 0.5, hit.TStart)
+	assert.Equal(t, 0.5, hit.UStart)
+
+	hit = vector2.IntersectSegments(vector2.New(0., 0.), vector2.New(4., 0.), vector2.New(1., -1.), vector2.New(1., 3.))
+	assert.Equal(t, vector2.New(1., 0.), hit.Start)
+	assert.Equal(t, 0.25, hit.TStart)
+	assert.Equal(t, 0.25, hit.UStart)
+}
+
+func TestIntersectSegmentsMissing(t *testing.T) {
+	tests := map[string][4]vector2.Float64{
+		"short of each other": {vector2.New(0., 0.), vector2.New(1., 1.), vector2.New(3., 0.), vector2.New(2., 1.)},
+		"parallel":            {vector2.New(0., 0.), vector2.New(4., 0.), vector2.New(0., 1.), vector2.New(4., 1.)},
+		"collinear disjoint":  {vector2.New(0., 0.), vector2.New(1., 0.), vector2.New(2., 0.), vector2.New(3., 0.)},
+		"point off segment":   {vector2.New(0., 0.), vector2.New(4., 0.), vector2.New(2., 1.), vector2.New(2., 1.)},
+		"distinct points":     {vector2.New(0., 0.), vector2.New(0., 0.), vector2.New(1., 0.), vector2.New(1., 0.)},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, vector2.NoIntersection, vector2.IntersectSegments(tc[0], tc[1], tc[2], tc[3]).Kind)
+		})
+	}
+}
+
+func TestIntersectSegmentsTouching(t *testing.T) {
+	// Sharing an end point
+	hit := vector2.IntersectSegments(vector2.New(0., 0.), vector2.New(2., 0.), vector2.New(2., 0.), vector2.New(3., 5.))
+	assert.Equal(t, vector2.PointIntersection, hit.Kind)
+	assert.Equal(t, vector2.New(2., 0.), hit.Start)
+	assert.Equal(t, 1., hit.TStart)
+	assert.Equal(t, 0., hit.UStart)
+
+	// Collinear, meeting end to end
+	hit = vector2.IntersectSegments(vector2.New(0., 0.), vector2.New(2., 0.), vector2.New(2., 0.), vector2.New(5., 0.))
+	assert.Equal(t, vector2.PointIntersection, hit.Kind)
+	assert.Equal(t, vector2.New(2., 0.), hit.Start)
+
+	// A zero length segment lying on the other
+	hit = vector2.IntersectSegments(vector2.New(0., 0.), vector2.New(4., 0.), vector2.New(1., 0.), vector2.New(1., 0.))
+	assert.Equal(t, vector2.PointIntersection, hit.Kind)
+	assert.Equal(t, vector2.New(1., 0.), hit.Start)
+	assert.Equal(t, 0.25, hit.TStart)
+}
+
+func TestIntersectSegmentsOverlap(t *testing.T) {
+	hit := vector2.IntersectSegments(vector2.New(0., 0.), vector2.New(4., 4.), vector2.New(5., 5.), vector2.New(2., 2.))
+	assert.Equal(t, vector2.OverlapIntersection, hit.Kind)
+	assert.Equal(t, vector2.New(2., 2.), hit.Start)
+	assert.Equal(t, vector2.New(4., 4.), hit.End)
+	assert.Equal(t, 0.5, hit.TStart)
+	assert.Equal(t, 1., hit.TEnd)
+	assert.InDelta(t, 1., hit.UStart, 1e-12)
+	assert.InDelta(t, 1./3, hit.UEnd, 1e-12)
+
+	// One segment containing the other
+	hit = vector2.IntersectSegments(vector2.New(1, 0), vector2.New(2, 0), vector2.New(0, 0), vector2.New(10, 0))
+	assert.Equal(t, vector2.OverlapIntersection, hit.Kind)
+	assert.Equal(t, vector2.New(1., 0.), hit.Start)
+	assert.Equal(t, vector2.New(2., 0.), hit.End)
+	assert.Equal(t, 0.1, hit.UStart)
+	assert.Equal(t, 0.2, hit.UEnd)
+}
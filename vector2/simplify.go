@@ -0,0 +1,63 @@
+package vector2
+
+import "github.com/EliCDavis/vector"
+
+// segmentDistanceSquared returns the squared distance from p to the closest
+// point on the segment ab
+func segmentDistanceSquared(p, a, b Vector[float64]) float64 {
+	ab := b.Sub(a)
+	lengthSquared := ab.LengthSquared()
+	if lengthSquared == 0 {
+		return p.DistanceSquared(a)
+	}
+
+	t := p.Sub(a).Dot(ab) / lengthSquared
+	if t < 0 {
+		t = 0
+	} else if t > 1 {
+		t = 1
+	}
+	return p.DistanceSquared(a.Add(ab.Scale(t)))
+}
+
+// Simplify reduces the number of points in a polyline using the
+// Ramer–Douglas–Peucker algorithm, removing points that deviate from the
+// simplified line by no more than tolerance. The first and last points are
+// always kept, and the points kept are returned in their original order
+func Simplify[T vector.Number](points []Vector[T], tolerance float64) []Vector[T] {
+	if len(points) < 3 {
+		return append([]Vector[T](nil), points...)
+	}
+
+	keep := make([]bool, len(points))
+	keep[0] = true
+	keep[len(points)-1] = true
+
+	toleranceSquared := tolerance * tolerance
+	stack := [][2]int{{0, len(points) - 1}}
+	for len(stack) > 0 {
+		span := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		a, b := points[span[0]].ToFloat64(), points[span[1]].ToFloat64()
+		farthest, farthestDistance := -1, toleranceSquared
+		for i := span[0] + 1; i < span[1]; i++ {
+			if d := segmentDistanceSquared(points[i].ToFloat64(), a, b); d > farthestDistance {
+				farthest, farthestDistance = i, d
+			}
+		}
+
+		if farthest != -1 {
+			keep[farthest] = true
+			stack = append(stack, [2]int{span[0], farthest}, [2]int{farthest, span[1]})
+		}
+	}
+
+	out := make([]Vector[T], 0, len(points))
+	for i, p := range points {
+		if keep[i] {
+			out = append(out, p)
+		}
+	}
+	return out
+}
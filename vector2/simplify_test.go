@@ -0,0 +1,42 @@
+package vector2_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSimplify(t *testing.T) {
+	line := []vector2.Float64{
+		vector2.New(0., 0.),
+		vector2.New(1., 0.1),
+		vector2.New(2., -0.1),
+		vector2.New(3., 5.),
+		vector2.New(4., 6.),
+		vector2.New(5., 7.),
+	}
+
+	assert.Equal(t, []vector2.Float64{
+		vector2.New(0., 0.),
+		vector2.New(2., -0.1),
+		vector2.New(3., 5.),
+		vector2.New(5., 7.),
+	}, vector2.Simplify(line, 0.5))
+
+	// Collinear points are dropped even with no tolerance
+	assert.Equal(t, append(line[:4:4], line[5]), vector2.Simplify(line, 0))
+	assert.Equal(t, []vector2.Float64{line[0], line[5]}, vector2.Simplify(line, 100))
+
+	// Closed outlines whose ends meet keep their shape
+	square := []vector2.Int{
+		vector2.New(0, 0), vector2.New(5, 0), vector2.New(10, 0),
+		vector2.New(10, 10), vector2.New(0, 10), vector2.New(0, 0),
+	}
+	assert.Equal(t, []vector2.Int{
+		vector2.New(0, 0), vector2.New(10, 0), vector2.New(10, 10), vector2.New(0, 10), vector2.New(0, 0),
+	}, vector2.Simplify(square, 1))
+
+	short := []vector2.Float64{vector2.New(1., 2.)}
+	assert.Equal(t, short, vector2.Simplify(short, 1))
+}
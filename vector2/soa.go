@@ -0,0 +1,206 @@
+package vector2
+
+import (
+	"math"
+
+	"github.com/EliCDavis/vector"
+)
+
+// SoA stores many vectors as a struct of arrays, with each component held in
+// its own contiguous slice, for particle systems and plotting where the same
+// operation is applied to every point. The batch operations modify the SoA in
+// place and return it to allow chaining.
+type SoA[T vector.Number] struct {
+	X []T
+	Y []T
+}
+
+type (
+	Float64SoA = SoA[float64]
+	Float32SoA = SoA[float32]
+)
+
+// NewSoA creates a SoA holding n zero vectors
+func NewSoA[T vector.Number](n int) SoA[T] {
+	return SoA[T]{
+		X: make([]T, n),
+		Y: make([]T, n),
+	}
+}
+
+// SoAFrom copies the vectors into a new SoA
+func SoAFrom[T vector.Number](vectors []Vector[T]) SoA[T] {
+	s := NewSoA[T](len(vectors))
+	for i, v := range vectors {
+		s.X[i] = v.x
+		s.Y[i] = v.y
+	}
+	return s
+}
+
+// Vectors copies the vectors into a new slice
+func (s SoA[T]) Vectors() []Vector[T] {
+	out := make([]Vector[T], s.Len())
+	x, y := s.components()
+	for i := range out {
+		out[i] = Vector[T]{x[i], y[i]}
+	}
+	return out
+}
+
+// Len is the number of vectors held
+func (s SoA[T]) Len() int {
+	return len(s.X)
+}
+
+// At returns the vector at index i
+func (s SoA[T]) At(i int) Vector[T] {
+	return Vector[T]{s.X[i], s.Y[i]}
+}
+
+// Set replaces the vector at index i
+func (s SoA[T]) Set(i int, v Vector[T]) {
+	s.X[i] = v.x
+	s.Y[i] = v.y
+}
+
+// Append adds the vectors to the end of the SoA, returning the updated SoA
+func (s SoA[T]) Append(vectors ...Vector[T]) SoA[T] {
+	for _, v := range vectors {
+		s.X = append(s.X, v.x)
+		s.Y = append(s.Y, v.y)
+	}
+	return s
+}
+
+// components returns the component slices resliced to the same length, which
+// lets the compiler drop bounds checks from loops over both
+func (s SoA[T]) components() (x, y []T) {
+	n := len(s.X)
+	return s.X[:n], s.Y[:n]
+}
+
+// Translate adds v to every vector
+func (s SoA[T]) Translate(v Vector[T]) SoA[T] {
+	x, y := s.components()
+	for i := range x {
+		x[i] += v.x
+		y[i] += v.y
+	}
+	return s
+}
+
+// Scale multiplies every vector by t
+func (s SoA[T]) Scale(t float64) SoA[T] {
+	x, y := s.components()
+	for i := range x {
+		x[i] = T(float64(x[i]) * t)
+		y[i] = T(float64(y[i]) * t)
+	}
+	return s
+}
+
+// Rotate rotates every vector counter-clockwise about the origin by radians
+func (s SoA[T]) Rotate(radians float64) SoA[T] {
+	sin, cos := math.Sincos(radians)
+	x, y := s.components()
+	for i := range x {
+		fx, fy := float64(x[i]), float64(y[i])
+		x[i] = T(fx*cos - fy*sin)
+		y[i] = T(fx*sin + fy*cos)
+	}
+	return s
+}
+
+// Bounds returns the min and max points of an AABB encompassing all vectors.
+// An empty SoA results in two zero vectors
+func (s SoA[T]) Bounds() (Vector[T], Vector[T]) {
+	if s.Len() == 0 {
+		return Vector[T]{}, Vector[T]{}
+	}
+
+	minX, maxX := minMax(s.X)
+	minY, maxY := minMax(s.Y)
+	return Vector[T]{minX, minY}, Vector[T]{maxX, maxY}
+}
+
+// Centroid returns the average of all vectors. An empty SoA results in a zero
+// vector
+func (s SoA[T]) Centroid() Vector[float64] {
+	if s.Len() == 0 {
+		return Vector[float64]{}
+	}
+
+	x, y := s.components()
+	var sumX, sumY float64
+	for i := range x {
+		sumX += float64(x[i])
+		sumY += float64(y[i])
+	}
+	n := float64(len(x))
+	return Vector[float64]{sumX / n, sumY / n}
+}
+
+// Distances computes the distance from every vector to p, writing the results
+// to dst, which is grown if needed, and returning it
+func (s SoA[T]) Distances(p Vector[T], dst []float64) []float64 {
+	x, y := s.components()
+	if cap(dst) < len(x) {
+		dst = make([]float64, len(x))
+	}
+	dst = dst[:len(x)]
+
+	px, py := float64(p.x), float64(p.y)
+	for i := range x {
+		dx, dy := float64(x[i])-px, float64(y[i])-py
+		dst[i] = math.Sqrt(dx*dx + dy*dy)
+	}
+	return dst
+}
+
+// Nearest returns the index of the vector closest to p along with its
+// distance, sampling the unsigned distance field of the point set at p. An
+// empty SoA returns an index of -1 and an infinite distance
+func (s SoA[T]) Nearest(p Vector[T]) (index int, distance float64) {
+	index = -1
+	best := math.Inf(1)
+
+	x, y := s.components()
+	px, py := float64(p.x), float64(p.y)
+	for i := range x {
+		dx, dy := float64(x[i])-px, float64(y[i])-py
+		if d := dx*dx + dy*dy; d < best {
+			best = d
+			index = i
+		}
+	}
+	return index, math.Sqrt(best)
+}
+
+// Within appends to dst the indices of every vector no further than radius
+// from p, returning the extended slice
+func (s SoA[T]) Within(p Vector[T], radius float64, dst []int) []int {
+	r2 := radius * radius
+	x, y := s.components()
+	px, py := float64(p.x), float64(p.y)
+	for i := range x {
+		dx, dy := float64(x[i])-px, float64(y[i])-py
+		if dx*dx+dy*dy <= r2 {
+			dst = append(dst, i)
+		}
+	}
+	return dst
+}
+
+func minMax[T vector.Number](values []T) (vmin, vmax T) {
+	vmin, vmax = values[0], values[0]
+	for _, v := range values[1:] {
+		if v < vmin {
+			vmin = v
+		}
+		if v > vmax {
+			vmax = v
+		}
+	}
+	return
+}
@@ -0,0 +1,77 @@
+package vector2_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSoAConversion(t *testing.T) {
+	in := []vector2.Float64{vector2.New(1., 2.), vector2.New(3., 4.)}
+
+	s := vector2.SoAFrom(in)
+	assert.Equal(t, 2, s.Len())
+	assert.Equal(t, []float64{1, 3}, s.X)
+	assert.Equal(t, []float64{2, 4}, s.Y)
+	assert.Equal(t, in, s.Vectors())
+
+	s.Set(0, vector2.One[float64]())
+	assert.Equal(t, vector2.One[float64](), s.At(0))
+
+	s = s.Append(vector2.Up[float64]())
+	assert.Equal(t, 3, s.Len())
+	assert.Equal(t, vector2.Up[float64](), s.At(2))
+}
+
+func TestSoATransform(t *testing.T) {
+	s := vector2.SoAFrom([]vector2.Float64{vector2.New(1., 0.), vector2.New(0., 2.)})
+
+	s.Scale(2).Translate(vector2.New(1., 1.))
+	assert.Equal(t, []vector2.Float64{vector2.New(3., 1.), vector2.New(1., 5.)}, s.Vectors())
+
+	s = vector2.SoAFrom([]vector2.Float64{vector2.New(1., 0.)}).Rotate(math.Pi / 2)
+	assert.InDelta(t, 0, s.X[0], 1e-12)
+	assert.InDelta(t, 1, s.Y[0], 1e-12)
+}
+
+func TestSoABoundsAndCentroid(t *testing.T) {
+	s := vector2.SoAFrom([]vector2.Int{vector2.New(1, 5), vector2.New(-3, 2), vector2.New(4, -1)})
+
+	vmin, vmax := s.Bounds()
+	assert.Equal(t, vector2.New(-3, -1), vmin)
+	assert.Equal(t, vector2.New(4, 5), vmax)
+	assert.Equal(t, vector2.New(2./3, 2.), s.Centroid())
+}
+
+func TestSoADistanceQueries(t *testing.T) {
+	s := vector2.SoAFrom([]vector2.Float64{
+		vector2.New(0., 0.),
+		vector2.New(3., 4.),
+		vector2.New(10., 0.),
+	})
+
+	assert.Equal(t, []float64{0, 5, 10}, s.Distances(vector2.Zero[float64](), nil))
+
+	index, distance := s.Nearest(vector2.New(9., 0.))
+	assert.Equal(t, 2, index)
+	assert.Equal(t, 1., distance)
+
+	assert.Equal(t, []int{0, 1}, s.Within(vector2.Zero[float64](), 5, nil))
+	assert.Equal(t, []int{1, 2}, s.Within(vector2.New(6., 2.), 5, nil))
+}
+
+func TestSoAEmpty(t *testing.T) {
+	s := vector2.NewSoA[float64](0)
+
+	vmin, vmax := s.Bounds()
+	assert.Equal(t, vector2.Zero[float64](), vmin)
+	assert.Equal(t, vector2.Zero[float64](), vmax)
+	assert.Equal(t, vector2.Zero[float64](), s.Centroid())
+
+	index, distance := s.Nearest(vector2.Zero[float64]())
+	assert.Equal(t, -1, index)
+	assert.True(t, math.IsInf(distance, 1))
+	assert.Empty(t, s.Within(vector2.Zero[float64](), 1, nil))
+}
@@ -0,0 +1,51 @@
+package vector2_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	_ driver.Valuer = vector2.Vector[float64]{}
+	_ sql.Scanner   = (*vector2.Vector[float64])(nil)
+)
+
+func TestSQLValue(t *testing.T) {
+	value, err := vector2.New(1.5, -2.).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "1.5,-2", value)
+}
+
+func TestSQLScan(t *testing.T) {
+	in := vector2.New(1.5, -2.)
+
+	var fromString vector2.Float64
+	assert.NoError(t, fromString.Scan("1.5,-2"))
+	assert.Equal(t, in, fromString)
+
+	var fromBytes vector2.Float64
+	assert.NoError(t, fromBytes.Scan([]byte("1.5,-2")))
+	assert.Equal(t, in, fromBytes)
+
+	data, err := in.MarshalBinary()
+	assert.NoError(t, err)
+	var fromBinary vector2.Float64
+	assert.NoError(t, fromBinary.Scan(data))
+	assert.Equal(t, in, fromBinary)
+
+	var fromPostgres vector2.Int
+	assert.NoError(t, fromPostgres.Scan("(1,2)"))
+	assert.Equal(t, vector2.New(1, 2), fromPostgres)
+}
+
+func TestSQLScanErrors(t *testing.T) {
+	var v vector2.Float64
+	assert.EqualError(t, v.Scan(nil), "unable to scan NULL into vector")
+	assert.EqualError(t, v.Scan(12), "unable to scan int into vector")
+	assert.Error(t, v.Scan("1"))
+	assert.Error(t, v.Scan("a,b,c,d"))
+}
@@ -0,0 +1,26 @@
+package vector2
+
+import "github.com/EliCDavis/vector/internal/codec"
+
+// MarshalText encodes the vector in the canonical form "x,y"
+func (v Vector[T]) MarshalText() ([]byte, error) {
+	return v.AppendText(nil)
+}
+
+// AppendText appends the components of the vector to b in the form
+// "x,y", allowing callers to reuse buffers and avoid allocations
+func (v Vector[T]) AppendText(b []byte) ([]byte, error) {
+	return codec.AppendText(b, v.x, v.y), nil
+}
+
+// UnmarshalText parses a vector from the form "x,y". Whitespace
+// surrounding each component is ignored
+func (v *Vector[T]) UnmarshalText(text []byte) error {
+	var components [componentCount]T
+	if err := codec.ParseText(text, components[:]); err != nil {
+		return err
+	}
+	v.x = components[0]
+	v.y = components[1]
+	return nil
+}
@@ -0,0 +1,83 @@
+package vector2_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppendText(t *testing.T) {
+	f64, err := vector2.New(1.5, -2.).AppendText(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "1.5,-2", string(f64))
+
+	f32, err := vector2.New[float32](1.5, -2).AppendText(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "1.5,-2", string(f32))
+
+	i, err := vector2.New(1, -2).AppendText([]byte("v="))
+	assert.NoError(t, err)
+	assert.Equal(t, "v=1,-2", string(i))
+}
+
+func TestAppendTextAllocations(t *testing.T) {
+	v := vector2.Fill(1.25)
+	buf := make([]byte, 0, 128)
+	allocs := testing.AllocsPerRun(100, func() {
+		buf, _ = v.AppendText(buf[:0])
+	})
+	assert.Zero(t, allocs)
+}
+
+func TestMarshalText(t *testing.T) {
+	in := vector2.New(1.5, -2.)
+
+	text, err := in.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "1.5,-2", string(text))
+
+	var out vector2.Float64
+	assert.NoError(t, out.UnmarshalText(text))
+	assert.Equal(t, in, out)
+}
+
+func TestUnmarshalTextWhitespace(t *testing.T) {
+	var out vector2.Int
+	assert.NoError(t, out.UnmarshalText([]byte(" 3, 3 ")))
+	assert.Equal(t, vector2.Fill(3), out)
+}
+
+func TestUnmarshalTextErrors(t *testing.T) {
+	tests := map[string]string{
+		"empty":          "",
+		"too few":        "1",
+		"too many":       "1,1,1",
+		"not a number":   "1,a",
+		"trailing comma": "1,1,",
+		"int8 overflow":  "1,300",
+	}
+
+	for name, text := range tests {
+		t.Run(name, func(t *testing.T) {
+			var out vector2.Int8
+			assert.Error(t, out.UnmarshalText([]byte(text)))
+		})
+	}
+}
+
+func TestTextMapKey(t *testing.T) {
+	in := map[vector2.Int]string{
+		vector2.Fill(1): "a",
+		vector2.Fill(2): "b",
+	}
+
+	data, err := json.Marshal(in)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"1,1":"a","2,2":"b"}`, string(data))
+
+	out := map[vector2.Int]string{}
+	assert.NoError(t, json.Unmarshal(data, &out))
+	assert.Equal(t, in, out)
+}
@@ -0,0 +1,300 @@
+package vector2
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/EliCDavis/vector"
+)
+
+// ringNode is a vertex in the circular, doubly linked list of vertices the
+// triangulation clips ears from
+type ringNode struct {
+	p          Float64
+	index      int
+	prev, next int
+}
+
+type triangulator struct {
+	nodes []ringNode
+}
+
+// orient is positive when a, b, c turn counter-clockwise, negative when they
+// turn clockwise, and zero when they're collinear
+func orient(a, b, c Float64) float64 {
+	return (b.x-a.x)*(c.y-a.y) - (b.y-a.y)*(c.x-a.x)
+}
+
+func signedArea[T vector.Number](ring []Vector[T]) float64 {
+	area := 0.
+	for i, v := range ring {
+		n := ring[(i+1)%len(ring)]
+		area += float64(v.x)*float64(n.y) - float64(n.x)*float64(v.y)
+	}
+	return area / 2
+}
+
+// addRing links the ring's vertices into a circular list wound
+// counter-clockwise, or clockwise for holes, returning the node of its
+// rightmost vertex
+func addRing[T vector.Number](t *triangulator, ring []Vector[T], baseIndex int, hole bool) int {
+	order := make([]int, len(ring))
+	for i := range order {
+		order[i] = i
+	}
+	if (signedArea(ring) < 0) != hole {
+		for i, j := 0, len(order)-1; i < j; i, j = i+1, j-1 {
+			order[i], order[j] = order[j], order[i]
+		}
+	}
+
+	start := len(t.nodes)
+	rightmost := start
+	for k, i := range order {
+		id := start + k
+		t.nodes = append(t.nodes, ringNode{
+			p:     ring[i].ToFloat64(),
+			index: baseIndex + i,
+			prev:  start + (k+len(order)-1)%len(order),
+			next:  start + (k+1)%len(order),
+		})
+		if p := t.nodes[id].p; p.x > t.nodes[rightmost].p.x {
+			rightmost = id
+		}
+	}
+	return rightmost
+}
+
+// locallyInside reports whether the direction from node a towards b starts
+// out inside the polygon
+func (t *triangulator) locallyInside(a int, b Float64) bool {
+	n := t.nodes[a]
+	prev, next := t.nodes[n.prev].p, t.nodes[n.next].p
+	if orient(prev, n.p, next) >= 0 {
+		return orient(n.p, next, b) >= 0 && orient(prev, n.p, b) >= 0
+	}
+	return orient(n.p, next, b) >= 0 || orient(prev, n.p, b) >= 0
+}
+
+// blocked reports whether any edge of any ring crosses the segment from a to
+// b, or any vertex other than a and b lies on it. Every ring is checked, both
+// those already spliced together and holes still waiting to be bridged, so
+// bridges never cut across each other or through a hole
+func (t *triangulator) blocked(a, b Float64) bool {
+	for _, n := range t.nodes {
+		p, q := n.p, t.nodes[n.next].p
+		if p == a || p == b {
+			continue
+		}
+		if orient(a, b, p) == 0 && between(a, b, p) {
+			return true
+		}
+		if q != a && q != b {
+			o1, o2 := orient(a, b, p), orient(a, b, q)
+			o3, o4 := orient(p, q, a), orient(p, q, b)
+			if ((o1 > 0 && o2 < 0) || (o1 < 0 && o2 > 0)) && ((o3 > 0 && o4 < 0) || (o3 < 0 && o4 > 0)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// between reports whether p, known to be collinear with a and b, lies
+// between them
+func between(a, b, p Float64) bool {
+	return p.x >= min(a.x, b.x) && p.x <= max(a.x, b.x) &&
+		p.y >= min(a.y, b.y) && p.y <= max(a.y, b.y)
+}
+
+// bridgeHole splices the hole starting at its rightmost node into the outer
+// ring by connecting it to a vertex of the outer ring it can see, turning
+// the polygon with a hole into a single ring that doubles back on itself.
+// It returns false if no vertex can be connected to the hole without the
+// bridge crossing an edge, leaving the polygon untouched
+func (t *triangulator) bridgeHole(outer, hole int) bool {
+	m := t.nodes[hole].p
+
+	var candidates []int
+	id := outer
+	for {
+		if t.nodes[id].p.x >= m.x {
+			candidates = append(candidates, id)
+		}
+		id = t.nodes[id].next
+		if id == outer {
+			break
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return t.nodes[candidates[i]].p.DistanceSquared(m) < t.nodes[candidates[j]].p.DistanceSquared(m)
+	})
+
+	bridge := -1
+	for _, c := range candidates {
+		p := t.nodes[c].p
+		if t.locallyInside(c, m) && t.locallyInside(hole, p) && !t.blocked(m, p) {
+			bridge = c
+			break
+		}
+	}
+	if bridge == -1 {
+		return false
+	}
+
+	// Duplicate both ends of the bridge so the ring can travel into the
+	// hole and back out again
+	b2 := len(t.nodes)
+	t.nodes = append(t.nodes, t.nodes[bridge])
+	h2 := len(t.nodes)
+	t.nodes = append(t.nodes, t.nodes[hole])
+
+	bridgeNext := t.nodes[bridge].next
+	holePrev := t.nodes[hole].prev
+
+	t.nodes[bridge].next = hole
+	t.nodes[hole].prev = bridge
+
+	t.nodes[holePrev].next = h2
+	t.nodes[h2].prev = holePrev
+	t.nodes[h2].next = b2
+	t.nodes[b2].prev = h2
+	t.nodes[b2].next = bridgeNext
+	t.nodes[bridgeNext].prev = b2
+	return true
+}
+
+func pointInTriangle(a, b, c, p Float64) bool {
+	return orient(a, b, p) >= 0 && orient(b, c, p) >= 0 && orient(c, a, p) >= 0
+}
+
+// isEar reports whether the triangle formed by the node and its neighbors
+// can be clipped from the ring
+func (t *triangulator) isEar(id int) bool {
+	n := t.nodes[id]
+	a, b, c := t.nodes[n.prev].p, n.p, t.nodes[n.next].p
+	if orient(a, b, c) <= 0 {
+		return false
+	}
+
+	for other := t.nodes[n.next].next; other != n.prev; other = t.nodes[other].next {
+		p := t.nodes[other].p
+		if p == a || p == b || p == c {
+			continue
+		}
+		if pointInTriangle(a, b, c, p) {
+			return false
+		}
+	}
+	return true
+}
+
+func (t *triangulator) remove(id int) {
+	n := t.nodes[id]
+	t.nodes[n.prev].next = n.next
+	t.nodes[n.next].prev = n.prev
+}
+
+// clip repeatedly clips ears from the ring until only a triangle remains
+func (t *triangulator) clip(start, count int) []int {
+	indices := make([]int, 0, 3*(count-2))
+	emit := func(id int) {
+		n := t.nodes[id]
+		a, b, c := t.nodes[n.prev], n, t.nodes[n.next]
+		if orient(a.p, b.p, c.p) > 0 {
+			indices = append(indices, a.index, b.index, c.index)
+		}
+	}
+
+	cur, stop := start, start
+	for count > 3 {
+		next := t.nodes[cur].next
+		if t.isEar(cur) {
+			emit(cur)
+			t.remove(cur)
+			count--
+			cur, stop = next, next
+			continue
+		}
+
+		cur = next
+		if cur != stop {
+			continue
+		}
+
+		// A full lap without finding an ear. Drop a degenerate vertex if
+		// there is one, and otherwise force progress by clipping anyway,
+		// which only happens for self intersecting input
+		degenerate := -1
+		for id := t.nodes[cur].next; ; id = t.nodes[id].next {
+			n := t.nodes[id]
+			if orient(t.nodes[n.prev].p, n.p, t.nodes[n.next].p) == 0 {
+				degenerate = id
+				break
+			}
+			if id == cur {
+				break
+			}
+		}
+		if degenerate == -1 {
+			degenerate = cur
+			emit(cur)
+		}
+		next = t.nodes[degenerate].next
+		t.remove(degenerate)
+		count--
+		cur, stop = next, next
+	}
+	if count == 3 {
+		emit(cur)
+	}
+	return indices
+}
+
+// Triangulate splits a simple polygon, optionally with holes, into triangles
+// using ear clipping, returning three indices per triangle. Indices refer to
+// the polygon's vertices followed by the vertices of each hole in order, as
+// if they were all concatenated into a single slice. The polygon and holes
+// may be wound in either direction, and the triangles produced wind
+// counter-clockwise in a Y up coordinate system. Holes must lie within the
+// polygon and not overlap each other. An error is returned if a hole can't be
+// connected to the polygon, which happens when it lies outside the polygon or
+// crosses its edges, rather than silently triangulating over it
+func Triangulate[T vector.Number](polygon []Vector[T], holes [][]Vector[T]) ([]int, error) {
+	if len(polygon) < 3 {
+		return nil, nil
+	}
+
+	t := &triangulator{}
+	outer := addRing(t, polygon, 0, false)
+	count := len(polygon)
+
+	type holeStart struct {
+		index, node, length int
+		x                   float64
+	}
+	var starts []holeStart
+	base := len(polygon)
+	for i, hole := range holes {
+		if len(hole) >= 3 {
+			node := addRing(t, hole, base, true)
+			starts = append(starts, holeStart{index: i, node: node, length: len(hole), x: t.nodes[node].p.x})
+		}
+		base += len(hole)
+	}
+
+	// Bridging holes from right to left lets each one connect to the holes
+	// already spliced in to its right, keeping bridges short
+	sort.SliceStable(starts, func(i, j int) bool {
+		return starts[i].x > starts[j].x
+	})
+	for _, h := range starts {
+		if !t.bridgeHole(outer, h.node) {
+			return nil, fmt.Errorf("unable to connect hole %d to the polygon, it must lie within the polygon without crossing its edges", h.index)
+		}
+		// The hole's vertices, plus the duplicated ends of the bridge
+		count += h.length + 2
+	}
+
+	return t.clip(outer, count), nil
+}
@@ -0,0 +1,180 @@
+package vector2_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// triangulatedArea sums the signed area of every triangle, failing if any of
+// them are wound clockwise
+func triangulatedArea(t *testing.T, vertices []vector2.Float64, indices []int) float64 {
+	t.Helper()
+	require.Zero(t, len(indices)%3)
+
+	total := 0.
+	for i := 0; i < len(indices); i += 3 {
+		a, b, c := vertices[indices[i]], vertices[indices[i+1]], vertices[indices[i+2]]
+		area := ((b.X()-a.X())*(c.Y()-a.Y()) - (b.Y()-a.Y())*(c.X()-a.X())) / 2
+		assert.Greater(t, area, 0., "triangle %d is not counter-clockwise", i/3)
+		total += area
+	}
+	return total
+}
+
+func TestTriangulateSimple(t *testing.T) {
+	square := []vector2.Float64{vector2.New(0., 0.), vector2.New(0., 1.), vector2.New(1., 1.), vector2.New(1., 0.)}
+	indices, err := vector2.Triangulate(square, nil)
+	require.NoError(t, err)
+	assert.Len(t, indices, 6)
+	assert.InDelta(t, 1, triangulatedArea(t, square, indices), 1e-12)
+
+	// A concave L shape
+	l := []vector2.Float64{
+		vector2.New(0., 0.), vector2.New(2., 0.), vector2.New(2., 1.),
+		vector2.New(1., 1.), vector2.New(1., 2.), vector2.New(0., 2.),
+	}
+	indices, err = vector2.Triangulate(l, nil)
+	require.NoError(t, err)
+	assert.Len(t, indices, 12)
+	assert.InDelta(t, 3, triangulatedArea(t, l, indices), 1e-12)
+
+	// Collinear vertices along an edge don't produce slivers
+	collinear := []vector2.Int{vector2.New(0, 0), vector2.New(1, 0), vector2.New(2, 0), vector2.New(2, 2), vector2.New(0, 2)}
+	indices, err = vector2.Triangulate(collinear, nil)
+	require.NoError(t, err)
+	floats := make([]vector2.Float64, len(collinear))
+	for i, v := range collinear {
+		floats[i] = v.ToFloat64()
+	}
+	assert.InDelta(t, 4, triangulatedArea(t, floats, indices), 1e-12)
+
+	indices, err = vector2.Triangulate([]vector2.Float64{vector2.New(0., 0.)}, nil)
+	require.NoError(t, err)
+	assert.Nil(t, indices)
+}
+
+func TestTriangulateHoles(t *testing.T) {
+	outer := []vector2.Float64{vector2.New(0., 0.), vector2.New(10., 0.), vector2.New(10., 10.), vector2.New(0., 10.)}
+	holeA := []vector2.Float64{vector2.New(2., 2.), vector2.New(4., 2.), vector2.New(4., 4.), vector2.New(2., 4.)}
+	holeB := []vector2.Float64{vector2.New(6., 6.), vector2.New(8., 7.), vector2.New(6., 8.)}
+
+	vertices := append(append(append([]vector2.Float64{}, outer...), holeA...), holeB...)
+
+	indices, err := vector2.Triangulate(outer, [][]vector2.Float64{holeA})
+	require.NoError(t, err)
+	assert.Len(t, indices, 3*8)
+	assert.InDelta(t, 96, triangulatedArea(t, vertices, indices), 1e-9)
+
+	indices, err = vector2.Triangulate(outer, [][]vector2.Float64{holeA, holeB})
+	require.NoError(t, err)
+	assert.InDelta(t, 94, triangulatedArea(t, vertices, indices), 1e-9)
+	for _, i := range indices {
+		assert.Less(t, i, len(vertices))
+	}
+}
+
+func TestTriangulateCircleWithHole(t *testing.T) {
+	circle := func(radius float64, n int) []vector2.Float64 {
+		out := make([]vector2.Float64, n)
+		for i := range out {
+			angle := 2 * math.Pi * float64(i) / float64(n)
+			out[i] = vector2.New(math.Cos(angle), math.Sin(angle)).Scale(radius)
+		}
+		return out
+	}
+
+	outer := circle(10, 64)
+	hole := circle(5, 32)
+	indices, err := vector2.Triangulate(outer, [][]vector2.Float64{hole})
+	require.NoError(t, err)
+
+	want := polygonArea(outer) - polygonArea(hole)
+	assert.InDelta(t, want, triangulatedArea(t, append(outer, hole...), indices), 1e-9)
+	assert.Len(t, indices, 3*(64+32))
+}
+
+func polygonArea(ring []vector2.Float64) float64 {
+	area := 0.
+	for i, v := range ring {
+		n := ring[(i+1)%len(ring)]
+		area += v.X()*n.Y() - n.X()*v.Y()
+	}
+	return math.Abs(area) / 2
+}
+
+func TestTriangulateStar(t *testing.T) {
+	star := make([]vector2.Float64, 20)
+	for i := range star {
+		radius := 10.
+		if i%2 == 1 {
+			radius = 3
+		}
+		angle := 2 * math.Pi * float64(i) / float64(len(star))
+		star[i] = vector2.New(math.Cos(angle), math.Sin(angle)).Scale(radius)
+	}
+
+	// Wound clockwise to make sure the input winding doesn't matter
+	for i, j := 0, len(star)-1; i < j; i, j = i+1, j-1 {
+		star[i], star[j] = star[j], star[i]
+	}
+
+	indices, err := vector2.Triangulate(star, nil)
+	require.NoError(t, err)
+	assert.Len(t, indices, 3*18)
+	assert.InDelta(t, polygonArea(star), triangulatedArea(t, star, indices), 1e-9)
+}
+
+func square(x, y, size float64) []vector2.Float64 {
+	return []vector2.Float64{
+		vector2.New(x, y), vector2.New(x+size, y), vector2.New(x+size, y+size), vector2.New(x, y+size),
+	}
+}
+
+func TestTriangulateStackedHoles(t *testing.T) {
+	outer := square(-10, -10, 20)
+	holes := [][]vector2.Float64{square(-1, -7, 2), square(-1, -1, 2), square(-1, 5, 2)}
+
+	vertices := append([]vector2.Float64{}, outer...)
+	for _, hole := range holes {
+		vertices = append(vertices, hole...)
+	}
+
+	indices, err := vector2.Triangulate(outer, holes)
+	require.NoError(t, err)
+	assert.Len(t, indices, 3*20)
+	assert.InDelta(t, 388, triangulatedArea(t, vertices, indices), 1e-9)
+}
+
+func TestTriangulateGridOfHoles(t *testing.T) {
+	outer := square(-10, -10, 20)
+	var holes [][]vector2.Float64
+	for x := -1; x <= 1; x++ {
+		for y := -1; y <= 1; y++ {
+			holes = append(holes, square(float64(x)*6-1, float64(y)*6-1, 2))
+		}
+	}
+
+	vertices := append([]vector2.Float64{}, outer...)
+	for _, hole := range holes {
+		vertices = append(vertices, hole...)
+	}
+
+	indices, err := vector2.Triangulate(outer, holes)
+	require.NoError(t, err)
+	assert.Len(t, indices, 3*(4+9*4+2*9-2))
+	assert.InDelta(t, 364, triangulatedArea(t, vertices, indices), 1e-9)
+}
+
+func TestTriangulateUnbridgeableHole(t *testing.T) {
+	outer := []vector2.Float64{vector2.New(0., 0.), vector2.New(10., 0.), vector2.New(10., 10.), vector2.New(0., 10.)}
+	inside := []vector2.Float64{vector2.New(2., 2.), vector2.New(4., 2.), vector2.New(4., 4.), vector2.New(2., 4.)}
+	outside := []vector2.Float64{vector2.New(20., 2.), vector2.New(22., 2.), vector2.New(22., 4.), vector2.New(20., 4.)}
+
+	indices, err := vector2.Triangulate(outer, [][]vector2.Float64{inside, outside})
+	assert.EqualError(t, err, "unable to connect hole 1 to the polygon, it must lie within the polygon without crossing its edges")
+	assert.Nil(t, indices)
+}
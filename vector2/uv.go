@@ -0,0 +1,93 @@
+package vector2
+
+import (
+	"github.com/EliCDavis/vector/mathex"
+)
+
+// WrapMode is how texture coordinates outside of [0, 1] map back onto a
+// texture, matching the address modes of graphics APIs
+type WrapMode int
+
+const (
+	// WrapRepeat tiles the texture, so 1.25 samples the same spot as 0.25
+	WrapRepeat WrapMode = iota
+
+	// WrapMirroredRepeat tiles the texture, flipping every other tile, so
+	// 1.25 samples the same spot as 0.75
+	WrapMirroredRepeat
+
+	// WrapClampToEdge extends the texture's edges outwards, so 1.25 samples
+	// the same spot as 1
+	WrapClampToEdge
+)
+
+// wrapUV wraps a single texture coordinate into [0, 1]
+func wrapUV(c float64, mode WrapMode) float64 {
+	switch mode {
+	case WrapMirroredRepeat:
+		c = wrapFloat(c, 2)
+		if c > 1 {
+			return 2 - c
+		}
+		return c
+	case WrapClampToEdge:
+		return mathex.Clamp(c, 0, 1)
+	}
+	return wrapFloat(c, 1)
+}
+
+// WrapUV maps the texture coordinates into the [0, 1] range of a texture
+// following mode. Repeating wraps into [0, 1), while mirroring and clamping
+// can reach 1 itself
+func (v Vector[T]) WrapUV(mode WrapMode) Vector[T] {
+	return Vector[T]{
+		x: T(wrapUV(float64(v.x), mode)),
+		y: T(wrapUV(float64(v.y), mode)),
+	}
+}
+
+// wrapTexel wraps a single texel index into [0, n)
+func wrapTexel(t, n int, mode WrapMode) int {
+	switch mode {
+	case WrapMirroredRepeat:
+		t = mathex.Mod(t, 2*n)
+		if t >= n {
+			return 2*n - 1 - t
+		}
+		return t
+	case WrapClampToEdge:
+		return mathex.Clamp(t, 0, n-1)
+	}
+	return mathex.Mod(t, n)
+}
+
+// WrapTexel maps a texel index that may lie outside a texture of the given
+// size back onto one of its texels following mode, such as when a software
+// sampler reads the neighbors of an edge texel. Each component of size must
+// be positive
+func WrapTexel(texel, size Int, mode WrapMode) Int {
+	return Int{
+		x: wrapTexel(texel.x, size.x, mode),
+		y: wrapTexel(texel.y, size.y, mode),
+	}
+}
+
+// UVToTexel converts texture coordinates into continuous texel space for a
+// texture of the given size, where whole numbers land on texel centers. The
+// floor of the result is the texel to the top left of the coordinate when
+// interpolating bilinearly, and the fraction is the interpolation weight
+func (v Vector[T]) UVToTexel(size Int) Float64 {
+	return Float64{
+		x: float64(v.x)*float64(size.x) - 0.5,
+		y: float64(v.y)*float64(size.y) - 0.5,
+	}
+}
+
+// TexelCenterUV returns the texture coordinates of the center of a texel in a
+// texture of the given size. It's the inverse of UVToTexel for whole texels
+func TexelCenterUV(texel, size Int) Float64 {
+	return Float64{
+		x: (float64(texel.x) + 0.5) / float64(size.x),
+		y: (float64(texel.y) + 0.5) / float64(size.y),
+	}
+}
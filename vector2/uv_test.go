@@ -0,0 +1,51 @@
+package vector2_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWrapUV(t *testing.T) {
+	tests := map[string]struct {
+		mode vector2.WrapMode
+		in   vector2.Float64
+		want vector2.Float64
+	}{
+		"repeat inside":  {mode: vector2.WrapRepeat, in: vector2.New(0.25, 0.5), want: vector2.New(0.25, 0.5)},
+		"repeat outside": {mode: vector2.WrapRepeat, in: vector2.New(1.25, -0.25), want: vector2.New(0.25, 0.75)},
+		"repeat edge":    {mode: vector2.WrapRepeat, in: vector2.New(1., -2.), want: vector2.New(0., 0.)},
+		"mirror inside":  {mode: vector2.WrapMirroredRepeat, in: vector2.New(0.25, 1.), want: vector2.New(0.25, 1.)},
+		"mirror outside": {mode: vector2.WrapMirroredRepeat, in: vector2.New(1.25, -0.25), want: vector2.New(0.75, 0.25)},
+		"mirror far":     {mode: vector2.WrapMirroredRepeat, in: vector2.New(2.25, -1.25), want: vector2.New(0.25, 0.75)},
+		"clamp inside":   {mode: vector2.WrapClampToEdge, in: vector2.New(0.25, 0.5), want: vector2.New(0.25, 0.5)},
+		"clamp outside":  {mode: vector2.WrapClampToEdge, in: vector2.New(1.25, -0.25), want: vector2.New(1., 0.)},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := tc.in.WrapUV(tc.mode)
+			assert.InDelta(t, tc.want.X(), got.X(), 1e-12)
+			assert.InDelta(t, tc.want.Y(), got.Y(), 1e-12)
+		})
+	}
+}
+
+func TestWrapTexel(t *testing.T) {
+	size := vector2.New(4, 3)
+	assert.Equal(t, vector2.New(1, 2), vector2.WrapTexel(vector2.New(5, -1), size, vector2.WrapRepeat))
+	assert.Equal(t, vector2.New(2, 0), vector2.WrapTexel(vector2.New(5, -1), size, vector2.WrapMirroredRepeat))
+	assert.Equal(t, vector2.New(3, 0), vector2.WrapTexel(vector2.New(5, -1), size, vector2.WrapClampToEdge))
+	assert.Equal(t, vector2.New(0, 2), vector2.WrapTexel(vector2.New(-8, 8), size, vector2.WrapMirroredRepeat))
+}
+
+func TestTexelConversion(t *testing.T) {
+	size := vector2.New(4, 2)
+	center := vector2.TexelCenterUV(vector2.New(1, 0), size)
+	assert.Equal(t, vector2.New(0.375, 0.25), center)
+	assert.Equal(t, vector2.New(1., 0.), center.UVToTexel(size))
+
+	// Halfway between the centers of texels 1 and 2
+	assert.Equal(t, vector2.New(1.5, -0.5), vector2.New(0.5, 0.).UVToTexel(size))
+}
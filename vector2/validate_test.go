@@ -0,0 +1,20 @@
+package vector2_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, vector2.New(1., 2.).Validate())
+	assert.EqualError(t, vector2.New(math.NaN(), 2.).Validate(), "invalid vector: x is NaN")
+}
+
+func TestValidateRange(t *testing.T) {
+	v := vector2.New(1., 2.)
+	assert.NoError(t, v.ValidateRange(0, 5))
+	assert.EqualError(t, v.ValidateRange(0, 1.5), "invalid vector: y is greater than the maximum of 1.5")
+}
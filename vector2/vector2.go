@@ -1,12 +1,15 @@
 package vector2
 
+//go:generate go run ../internal/cmd/swizzlegen -dim 2
+
 import (
-	"encoding/json"
 	"fmt"
 	"math"
 	"math/rand"
 
 	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/internal/codec"
+	"github.com/EliCDavis/vector/internal/fp"
 	"github.com/EliCDavis/vector/mathex"
 )
 
@@ -84,9 +87,16 @@ func One[T vector.Number]() Vector[T] {
 
 // Lerp linearly interpolates between a and b by t
 func Lerp[T vector.Number](a, b Vector[T], t float64) Vector[T] {
+	if mathex.IsFloat[T]() {
+		s := T(t)
+		return Vector[T]{
+			x: fp.MulAdd(b.x-a.x, s, a.x),
+			y: fp.MulAdd(b.y-a.y, s, a.y),
+		}
+	}
 	return Vector[T]{
-		x: T((float64(b.x-a.x) * t) + float64(a.x)),
-		y: T((float64(b.y-a.y) * t) + float64(a.y)),
+		x: T(fp.MulAdd(float64(b.x-a.x), t, float64(a.x))),
+		y: T(fp.MulAdd(float64(b.y-a.y), t, float64(a.y))),
 	}
 }
 
@@ -173,6 +183,15 @@ func Rand(r *rand.Rand) Vector[float64] {
 	}
 }
 
+// RandGaussian returns a vector where each component is sampled from a normal
+// distribution centered on 0 with the given standard deviation
+func RandGaussian(r *rand.Rand, stddev float64) Vector[float64] {
+	return Vector[float64]{
+		x: mathex.RandNormal(r, 0, stddev),
+		y: mathex.RandNormal(r, 0, stddev),
+	}
+}
+
 func (v Vector[T]) MinComponent() T {
 	return min(v.x, v.y)
 }
@@ -182,28 +201,20 @@ func (v Vector[T]) MaxComponent() T {
 }
 
 func (v Vector[T]) MarshalJSON() ([]byte, error) {
-	return json.Marshal(&struct {
-		X float64 `json:"x"`
-		Y float64 `json:"y"`
-	}{
-		X: float64(v.x),
-		Y: float64(v.y),
-	})
+	return v.AppendJSON(nil)
 }
 
 func (v *Vector[T]) UnmarshalJSON(data []byte) error {
-	aux := &struct {
-		X float64 `json:"x"`
-		Y float64 `json:"y"`
-	}{
-		X: 0,
-		Y: 0,
-	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	var components [componentCount]T
+	if codec.IsJSONArray(data) {
+		if err := codec.UnmarshalJSONArray(data, components[:]); err != nil {
+			return err
+		}
+	} else if err := codec.UnmarshalJSONObject(data, componentKeys, components[:]); err != nil {
 		return err
 	}
-	v.x = T(aux.X)
-	v.y = T(aux.Y)
+	v.x = components[0]
+	v.y = components[1]
 	return nil
 }
 
@@ -282,52 +293,9 @@ func (v Vector[T]) ToInt64() Vector[int64] {
 	}
 }
 
-func (v Vector[T]) X() T {
-	return v.x
-}
-
-func (v Vector[T]) SetX(X T) Vector[T] {
-	return Vector[T]{
-		x: X,
-		y: v.y,
-	}
-}
-
-func (v Vector[T]) Dx(dX T) Vector[T] {
-	return Vector[T]{
-		x: v.x + dX,
-		y: v.y,
-	}
-}
-
-func (v Vector[T]) Y() T {
-	return v.y
-}
-
-func (v Vector[T]) SetY(Y T) Vector[T] {
-	return Vector[T]{
-		x: v.x,
-		y: Y,
-	}
-}
-
-func (v Vector[T]) Dy(dY T) Vector[T] {
-	return Vector[T]{
-		x: v.x,
-		y: v.y + dY,
-	}
-}
-
-func (v Vector[T]) YX() Vector[T] {
-	return Vector[T]{
-		x: v.y,
-		y: v.x,
-	}
-}
-
 func (v Vector[T]) Angle(other Vector[T]) float64 {
 	denominator := mathex.Sqrt(float64(v.LengthSquared() * other.LengthSquared()))
-	if denominator < 1e-15 {
+	if mathex.ApproximatelyWithin(denominator, 0, 1e-15) {
 		return 0.
 	}
 	return mathex.Acos(mathex.Clamp(float64(v.Dot(other))/denominator, -1., 1.))
@@ -335,7 +303,7 @@ func (v Vector[T]) Angle(other Vector[T]) float64 {
 
 func (v Vector[T]) AngleF(other Vector[T]) float32 {
 	denominator := mathex.Sqrt(float32(v.LengthSquared() * other.LengthSquared()))
-	if denominator < 1e-15 {
+	if mathex.ApproximatelyWithin(denominator, 0, 1e-15) {
 		return 0.
 	}
 	return mathex.Acos(mathex.Clamp(float32(v.Dot(other))/denominator, -1., 1.))
@@ -347,7 +315,7 @@ func (v Vector[T]) Midpoint(o Vector[T]) Vector[T] {
 }
 
 func (v Vector[T]) Dot(other Vector[T]) T {
-	return v.x*other.x + v.y*other.y
+	return fp.Dot2(v.x, v.y, other.x, other.y)
 }
 
 // Perpendicular creates a vector perpendicular to the one passed in with the
@@ -407,7 +375,7 @@ func (v Vector[T]) Product() T {
 }
 
 func (v Vector[T]) LengthSquared() T {
-	return v.x*v.x + v.y*v.y
+	return fp.Dot2(v.x, v.y, v.x, v.y)
 }
 
 func (v Vector[T]) Length() float64 {
@@ -415,10 +383,24 @@ func (v Vector[T]) Length() float64 {
 }
 
 func (v Vector[T]) Normalized() Vector[T] {
+	if mathex.IsFloat[T]() {
+		inv := 1 / mathex.Sqrt(v.LengthSquared())
+		return Vector[T]{
+			x: fp.Mul(v.x, inv),
+			y: fp.Mul(v.y, inv),
+		}
+	}
 	return v.DivByConstant(v.Length())
 }
 
 func (v Vector[T]) Scale(t float64) Vector[T] {
+	if mathex.IsFloat[T]() {
+		s := T(t)
+		return Vector[T]{
+			x: fp.Mul(v.x, s),
+			y: fp.Mul(v.y, s),
+		}
+	}
 	return Vector[T]{
 		x: T(float64(v.x) * t),
 		y: T(float64(v.y) * t),
@@ -481,7 +463,7 @@ func (v Vector[T]) DivByConstant(t float64) Vector[T] {
 func (v Vector[T]) DistanceSquared(other Vector[T]) T {
 	xDist := other.x - v.x
 	yDist := other.y - v.y
-	return (xDist * xDist) + (yDist * yDist)
+	return fp.Dot2(xDist, yDist, xDist, yDist)
 }
 
 func (v Vector[T]) Project(normal Vector[T]) Vector[T] {
@@ -555,6 +537,24 @@ func (v Vector[T]) FloorToInt() Vector[int] {
 	)
 }
 
+// Snap rounds each component of the vector to the nearest multiple of the
+// corresponding component of step
+func (v Vector[T]) Snap(step Vector[T]) Vector[T] {
+	return Vector[T]{
+		x: mathex.Snap(v.x, step.x),
+		y: mathex.Snap(v.y, step.y),
+	}
+}
+
+// Sign returns a vector where each component is -1, 0, or 1 depending on the
+// sign of the corresponding component of the vector
+func (v Vector[T]) Sign() Vector[T] {
+	return Vector[T]{
+		x: mathex.Sign(v.x),
+		y: mathex.Sign(v.y),
+	}
+}
+
 // Abs applies the Abs math operation to each component of the vector
 func (v Vector[T]) Abs() Vector[T] {
 	return Vector[T]{
@@ -567,6 +567,21 @@ func (v Vector[T]) NearZero() bool {
 	return mathex.NearZero(v.x) && mathex.NearZero(v.y)
 }
 
+// Approximately returns true if each component of the vector is approximately
+// equal to the corresponding component of the other vector
+func (v Vector[T]) Approximately(other Vector[T]) bool {
+	return mathex.Approximately(v.x, other.x) &&
+		mathex.Approximately(v.y, other.y)
+}
+
+// WithinULP returns true if each component of the vector is no more than n
+// units in the last place away from the corresponding component of the other
+// vector
+func (v Vector[T]) WithinULP(other Vector[T], n uint64) bool {
+	return mathex.WithinULP(v.x, other.x, n) &&
+		mathex.WithinULP(v.y, other.y, n)
+}
+
 func (v Vector[T]) ContainsNaN() bool {
 	if math.IsNaN(float64(v.x)) {
 		return true
@@ -586,20 +601,9 @@ func (v Vector[T]) Flip() Vector[T] {
 	}
 }
 
-func (v Vector[T]) FlipX() Vector[T] {
-	return Vector[T]{
-		x: v.x * -1,
-		y: v.y,
-	}
-}
-
-func (v Vector[T]) FlipY() Vector[T] {
-	return Vector[T]{
-		x: v.x,
-		y: v.y * -1,
-	}
-}
-
+// Pivot returns the top left corner of a rectangle of size wh whose anchor
+// point sits at v. Use PivotAnchor for fractional anchors such as
+// AnchorCenter on integer vectors
 func (v Vector[T]) Pivot(anchor Vector[T], wh Vector[T]) Vector[T] {
 	return Vector[T]{
 		x: v.x - wh.x*anchor.x,
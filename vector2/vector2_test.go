@@ -494,3 +494,33 @@ func TestValues(t *testing.T) {
 	assert.Equal(t, x, 1)
 	assert.Equal(t, y, 2)
 }
+
+func TestApproximately(t *testing.T) {
+	v := vector2.Fill(1.)
+	assert.True(t, v.Approximately(vector2.Fill(1.+1e-12)))
+	assert.False(t, v.Approximately(vector2.Fill(1.001)))
+	assert.True(t, v.WithinULP(vector2.Fill(math.Nextafter(1, 2)), 1))
+	assert.False(t, v.WithinULP(vector2.Fill(1.001), 1))
+}
+
+func TestSnap(t *testing.T) {
+	got := vector2.New(1.2, 3.9).Snap(vector2.New(0.5, 2.))
+	assert.True(t, vector2.New(1., 4.).Approximately(got), got.Format("%f"))
+}
+
+func TestSign(t *testing.T) {
+	assert.Equal(t, vector2.New[float64](-1, 0), vector2.New[float64](-1.2, 0).Sign())
+	assert.Equal(t, vector2.New(-1, 0), vector2.New[int](-1, 0).Scale(5).Sign())
+}
+
+func TestRandGaussian(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+
+	sum := vector2.Zero[float64]()
+	n := 10000
+	for i := 0; i < n; i++ {
+		sum = sum.Add(vector2.RandGaussian(r, 2))
+	}
+
+	assert.True(t, sum.DivByConstant(float64(n)).Length() < 0.1)
+}
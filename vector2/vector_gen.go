@@ -0,0 +1,69 @@
+// Code generated by internal/cmd/swizzlegen; DO NOT EDIT.
+
+package vector2
+
+// X returns the x component
+func (v Vector[T]) X() T {
+	return v.x
+}
+
+// SetX returns a copy of the vector with the x component replaced
+func (v Vector[T]) SetX(newX T) Vector[T] {
+	v.x = newX
+	return v
+}
+
+// Dx returns a copy of the vector with dX added to the x component
+func (v Vector[T]) Dx(dX T) Vector[T] {
+	v.x += dX
+	return v
+}
+
+// FlipX returns a copy of the vector with the x component negated
+func (v Vector[T]) FlipX() Vector[T] {
+	v.x *= -1
+	return v
+}
+
+// Y returns the y component
+func (v Vector[T]) Y() T {
+	return v.y
+}
+
+// SetY returns a copy of the vector with the y component replaced
+func (v Vector[T]) SetY(newY T) Vector[T] {
+	v.y = newY
+	return v
+}
+
+// Dy returns a copy of the vector with dY added to the y component
+func (v Vector[T]) Dy(dY T) Vector[T] {
+	v.y += dY
+	return v
+}
+
+// FlipY returns a copy of the vector with the y component negated
+func (v Vector[T]) FlipY() Vector[T] {
+	v.y *= -1
+	return v
+}
+
+// XX returns a vector made up of the x and x components
+func (v Vector[T]) XX() Vector[T] {
+	return Vector[T]{x: v.x, y: v.x}
+}
+
+// XY returns a vector made up of the x and y components
+func (v Vector[T]) XY() Vector[T] {
+	return Vector[T]{x: v.x, y: v.y}
+}
+
+// YX returns a vector made up of the y and x components
+func (v Vector[T]) YX() Vector[T] {
+	return Vector[T]{x: v.y, y: v.x}
+}
+
+// YY returns a vector made up of the y and y components
+func (v Vector[T]) YY() Vector[T] {
+	return Vector[T]{x: v.y, y: v.y}
+}
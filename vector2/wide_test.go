@@ -0,0 +1,20 @@
+package vector2_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWideIntegerMath(t *testing.T) {
+	a := vector2.New[int32](50000, -60000)
+	b := vector2.New[int32](40000, 70000)
+
+	assert.Equal(t, int64(50000*40000-60000*70000), vector2.Dot64(a, b))
+	assert.Equal(t, int64(50000*50000+60000*60000), vector2.LengthSquared64(a))
+	assert.Equal(t, int64(10000*10000+130000*130000), vector2.DistanceSquared64(a, b))
+
+	// The component type overflows where the wide variant doesn't
+	assert.NotEqual(t, vector2.LengthSquared64(a), int64(a.LengthSquared()))
+}
@@ -0,0 +1,77 @@
+package vector2
+
+import (
+	"math"
+
+	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/mathex"
+)
+
+// wrapFloat wraps v into the range [0, n)
+func wrapFloat(v, n float64) float64 {
+	r := math.Mod(v, n)
+	if r < 0 {
+		r += n
+	}
+	if r >= n {
+		// A tiny negative remainder can round up to n itself
+		return 0
+	}
+	return r
+}
+
+// wrapSum wraps a + b into the range [0, n). Integer components are widened
+// to int64 and reduced into [0, n) before they're combined, as the sum of two
+// int64 components could itself overflow
+func wrapSum[T vector.Number](a, b, n T) T {
+	if mathex.IsFloat[T]() {
+		return T(wrapFloat(float64(a)+float64(b), float64(n)))
+	}
+	ra, rb, m := mathex.Mod(int64(a), int64(n)), mathex.Mod(int64(b), int64(n)), int64(n)
+	if ra >= m-rb {
+		return T(ra - (m - rb))
+	}
+	return T(ra + rb)
+}
+
+// wrapDiff wraps a - b into the range [0, n), reducing integer components
+// the same way as wrapSum
+func wrapDiff[T vector.Number](a, b, n T) T {
+	if mathex.IsFloat[T]() {
+		return T(wrapFloat(float64(a)-float64(b), float64(n)))
+	}
+	ra, rb, m := mathex.Mod(int64(a), int64(n)), mathex.Mod(int64(b), int64(n)), int64(n)
+	if ra >= rb {
+		return T(ra - rb)
+	}
+	return T(ra + (m - rb))
+}
+
+// WrapTo wraps each component into the range [0, bounds), treating the space
+// as a torus. Negative components wrap around from the far side, so (-1, 0)
+// wrapped to (10, 10) becomes (9, 0). Each component of bounds must be
+// positive
+func (v Vector[T]) WrapTo(bounds Vector[T]) Vector[T] {
+	return Vector[T]{
+		x: wrapSum(v.x, 0, bounds.x),
+		y: wrapSum(v.y, 0, bounds.y),
+	}
+}
+
+// AddWrapped adds other to the vector, wrapping the result into the range
+// [0, bounds) as described by WrapTo
+func (v Vector[T]) AddWrapped(other, bounds Vector[T]) Vector[T] {
+	return Vector[T]{
+		x: wrapSum(v.x, other.x, bounds.x),
+		y: wrapSum(v.y, other.y, bounds.y),
+	}
+}
+
+// SubWrapped subtracts other from the vector, wrapping the result into the
+// range [0, bounds) as described by WrapTo
+func (v Vector[T]) SubWrapped(other, bounds Vector[T]) Vector[T] {
+	return Vector[T]{
+		x: wrapDiff(v.x, other.x, bounds.x),
+		y: wrapDiff(v.y, other.y, bounds.y),
+	}
+}
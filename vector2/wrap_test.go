@@ -0,0 +1,49 @@
+package vector2_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWrapTo(t *testing.T) {
+	bounds := vector2.New(10, 5)
+
+	tests := map[string]struct {
+		in   vector2.Int
+		want vector2.Int
+	}{
+		"inside":         {in: vector2.New(3, 4), want: vector2.New(3, 4)},
+		"on the bound":   {in: vector2.New(10, 5), want: vector2.New(0, 0)},
+		"past the bound": {in: vector2.New(23, 7), want: vector2.New(3, 2)},
+		"negative":       {in: vector2.New(-1, -6), want: vector2.New(9, 4)},
+		"far negative":   {in: vector2.New(-21, -10), want: vector2.New(9, 0)},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.in.WrapTo(bounds))
+		})
+	}
+}
+
+func TestWrappedArithmetic(t *testing.T) {
+	bounds := vector2.New(10, 10)
+
+	assert.Equal(t, vector2.New(1, 9), vector2.New(8, 0).AddWrapped(vector2.New(3, -1), bounds))
+	assert.Equal(t, vector2.New(9, 2), vector2.New(0, 0).SubWrapped(vector2.New(1, 8), bounds))
+
+	// The sum doesn't overflow the component type before wrapping
+	assert.Equal(t, vector2.New[int8](30, 0), vector2.New[int8](120, 0).AddWrapped(vector2.New[int8](120, 0), vector2.New[int8](105, 1)))
+
+	assert.Equal(t, vector2.New[int8](28, 0), vector2.New[int8](0, 0).SubWrapped(vector2.New[int8](-128, 0), vector2.New[int8](100, 1)))
+
+	// Nor does it overflow int64 when the components are already int64
+	bigBounds := vector2.New[int64](math.MaxInt64, 1)
+	assert.Equal(t, vector2.New[int64](4, 0), vector2.New[int64](math.MaxInt64-1, 0).AddWrapped(vector2.New[int64](5, 0), bigBounds))
+	assert.Equal(t, vector2.New[int64](math.MaxInt64-6, 0), vector2.New[int64](math.MinInt64, 0).SubWrapped(vector2.New[int64](5, 0), bigBounds))
+
+	assert.Equal(t, vector2.New(0.5, 9.75), vector2.New(-9.5, -0.25).WrapTo(vector2.New(10., 10.)))
+}
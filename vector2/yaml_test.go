@@ -0,0 +1,70 @@
+package vector2_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+func TestYAML(t *testing.T) {
+	in := vector2.New(1.5, -2.)
+
+	data, err := yaml.Marshal(in)
+	assert.NoError(t, err)
+	assert.Equal(t, "1.5,-2\n", string(data))
+
+	var out vector2.Float64
+	assert.NoError(t, yaml.Unmarshal(data, &out))
+	assert.Equal(t, in, out)
+}
+
+func TestYAMLForms(t *testing.T) {
+	want := vector2.New(1.5, -2.)
+
+	tests := map[string]string{
+		"flow mapping":  "{x: 1.5, y: -2}",
+		"block mapping": "x: 1.5\ny: -2",
+		"sequence":      "[1.5, -2]",
+		"scalar":        `"1.5,-2"`,
+	}
+
+	for name, text := range tests {
+		t.Run(name, func(t *testing.T) {
+			var out vector2.Float64
+			assert.NoError(t, yaml.Unmarshal([]byte(text), &out))
+			assert.Equal(t, want, out)
+		})
+	}
+}
+
+func TestYAMLInField(t *testing.T) {
+	type config struct {
+		Origin vector2.Int64 `yaml:"origin"`
+	}
+
+	in := config{Origin: vector2.Fill[int64](9007199254740993)}
+	data, err := yaml.Marshal(in)
+	assert.NoError(t, err)
+
+	var out config
+	assert.NoError(t, yaml.Unmarshal(data, &out))
+	assert.Equal(t, in, out)
+}
+
+func TestYAMLErrors(t *testing.T) {
+	tests := map[string]string{
+		"short sequence": "[1.5]",
+		"bad component":  "{x: abc}",
+		"overflow":       "{x: 300}",
+		"bad scalar":     "nope",
+	}
+
+	for name, text := range tests {
+		t.Run(name, func(t *testing.T) {
+			var out vector2.Int8
+			assert.Error(t, yaml.Unmarshal([]byte(text), &out))
+		})
+	}
+}
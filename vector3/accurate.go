@@ -0,0 +1,105 @@
+package vector3
+
+import (
+	"math"
+
+	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/mathex"
+)
+
+// SumAccurate adds all vectors together using compensated summation, which
+// avoids the error a naive running total builds up over very large slices,
+// especially of float32 vectors
+func SumAccurate[T vector.Number](vectors []Vector[T]) Vector[T] {
+	var x, y, z mathex.CompensatedSum
+	for _, v := range vectors {
+		x.Add(float64(v.x))
+		y.Add(float64(v.y))
+		z.Add(float64(v.z))
+	}
+	return Vector[T]{
+		x: T(x.Value()),
+		y: T(y.Value()),
+		z: T(z.Value()),
+	}
+}
+
+// AverageAccurate computes the average of all vectors using compensated
+// summation. See SumAccurate
+func AverageAccurate[T vector.Number](vectors []Vector[T]) Vector[T] {
+	var x, y, z mathex.CompensatedSum
+	for _, v := range vectors {
+		x.Add(float64(v.x))
+		y.Add(float64(v.y))
+		z.Add(float64(v.z))
+	}
+	count := float64(len(vectors))
+	return Vector[T]{
+		x: T(x.Value() / count),
+		y: T(y.Value() / count),
+		z: T(z.Value() / count),
+	}
+}
+
+// LengthAccurate returns the length of the vector like Length, but scales the
+// components by the largest of them first, the same way math.Hypot does. That
+// keeps the squared terms from overflowing to Inf or underflowing to 0 for
+// vectors with extremely large or tiny components, at the cost of a division
+// per component
+func (v Vector[T]) LengthAccurate() float64 {
+	x := math.Abs(float64(v.x))
+	y := math.Abs(float64(v.y))
+	z := math.Abs(float64(v.z))
+
+	m := max(x, y, z)
+	if m == 0 || math.IsInf(m, 1) {
+		return m
+	}
+
+	x, y, z = x/m, y/m, z/m
+	return m * math.Sqrt(x*x+y*y+z*z)
+}
+
+// Accumulator sums float64 vectors in double-double precision, roughly twice
+// that of a float64, for workloads like orbital mechanics where adding up
+// many small steps with a naive running total drifts over time. The zero
+// value is an empty sum ready for use.
+type Accumulator struct {
+	x, y, z mathex.DoubleDouble
+}
+
+// Add adds v to the running total
+func (a *Accumulator) Add(v Float64) {
+	a.x.Add(v.x)
+	a.y.Add(v.y)
+	a.z.Add(v.z)
+}
+
+// Sub subtracts v from the running total
+func (a *Accumulator) Sub(v Float64) {
+	a.x.Sub(v.x)
+	a.y.Sub(v.y)
+	a.z.Sub(v.z)
+}
+
+// AddAccumulator adds another accumulator's total to this one without
+// rounding it to float64 first
+func (a *Accumulator) AddAccumulator(o Accumulator) {
+	a.x.AddDoubleDouble(o.x)
+	a.y.AddDoubleDouble(o.y)
+	a.z.AddDoubleDouble(o.z)
+}
+
+// Value returns the total rounded to the nearest float64 vector
+func (a Accumulator) Value() Float64 {
+	return Float64{x: a.x.Value(), y: a.y.Value(), z: a.z.Value()}
+}
+
+// Parts returns the total as the unevaluated sum hi + lo, so the full
+// precision can be carried into further double-double computations
+func (a Accumulator) Parts() (hi, lo Float64) {
+	hi.x, lo.x = a.x.Parts()
+	hi.y, lo.y = a.y.Parts()
+	hi.z, lo.z = a.z.Parts()
+	return hi, lo
+}
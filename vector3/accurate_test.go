@@ -0,0 +1,88 @@
+package vector3_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccurateReductions(t *testing.T) {
+	vectors := make([]vector3.Float32, 1000000)
+	for i := range vectors {
+		vectors[i] = vector3.New[float32](0.1, 1, -0.3)
+	}
+
+	sum := vector3.SumAccurate(vectors)
+	assert.InDelta(t, 100000, sum.X(), 0.01)
+	assert.Equal(t, float32(1000000), sum.Y())
+	assert.InDelta(t, -300000, sum.Z(), 0.03)
+
+	// A naive float32 running total drifts far from the true value
+	var naive float32
+	for _, v := range vectors {
+		naive += v.X()
+	}
+	assert.Greater(t, float64(naive)-100000, 100.)
+
+	avg := vector3.AverageAccurate(vectors)
+	assert.Equal(t, vector3.New[float32](0.1, 1, -0.3), avg)
+}
+
+func TestLengthAccurate(t *testing.T) {
+	tests := map[string]struct {
+		v    vector3.Float64
+		want float64
+	}{
+		"regular":   {v: vector3.New(2., -3., 6.), want: 7},
+		"huge":      {v: vector3.New(3e200, 4e200, 0.), want: 5e200},
+		"tiny":      {v: vector3.New(0., -3e-200, 4e-200), want: 5e-200},
+		"denormals": {v: vector3.New(3e-320, 4e-320, 0.), want: 5e-320},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.InEpsilon(t, tc.want, tc.v.LengthAccurate(), 1e-12)
+		})
+	}
+
+	// The naive length overflows and underflows for the same vectors
+	assert.True(t, math.IsInf(vector3.New(3e200, 4e200, 0.).Length(), 1))
+	assert.Equal(t, 0., vector3.New(3e-200, 4e-200, 0.).Length())
+
+	assert.Equal(t, 0., vector3.Zero[float64]().LengthAccurate())
+	assert.Equal(t, math.Inf(1), vector3.New(1., math.Inf(-1), 0.).LengthAccurate())
+	assert.True(t, math.IsNaN(vector3.New(1., math.NaN(), 0.).LengthAccurate()))
+	assert.InDelta(t, 5., vector3.New[int](3, 4, 0).LengthAccurate(), 0)
+}
+
+func TestAccumulator(t *testing.T) {
+	start := vector3.New(1.5e11, -2e11, 1e3)
+	step := vector3.New(1e-3, 2e-4, -3e-5)
+
+	var acc vector3.Accumulator
+	acc.Add(start)
+	naive := start
+	for i := 0; i < 1000000; i++ {
+		acc.Add(step)
+		naive = naive.Add(step)
+	}
+
+	// Step by step accumulation loses most of each step to rounding
+	want := vector3.New(1.5e11+1000, -2e11+200, 1e3-30)
+	assert.NotEqual(t, want, naive)
+	assert.Equal(t, want, acc.Value())
+
+	acc.Sub(start)
+	hi, lo := acc.Parts()
+	assert.InDelta(t, 1000., hi.X(), 1e-9)
+	assert.InDelta(t, 200., hi.Y(), 1e-9)
+	assert.InDelta(t, -30., hi.Z(), 1e-9)
+	assert.Equal(t, hi, hi.Add(lo))
+
+	var other vector3.Accumulator
+	other.Sub(vector3.New(1000., 200., -30.))
+	acc.AddAccumulator(other)
+	assert.InDelta(t, 0., acc.Value().Length(), 1e-9)
+}
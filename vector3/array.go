@@ -174,24 +174,19 @@ func (v3a Array[T]) Average(vectors []Vector[T]) Vector[float64] {
 
 // Bounds returns the min and max points of an AABB encompassing
 func (v3a Array[T]) Bounds() (Vector[T], Vector[T]) {
-	vmin := New(math.Inf(1), math.Inf(1), math.Inf(1))
-	vmax := New(math.Inf(-1), math.Inf(-1), math.Inf(-1))
+	if len(v3a) == 0 {
+		return Vector[T]{}, Vector[T]{}
+	}
 
-	for _, v := range v3a {
-		vmin = New(
-			min(float64(v.x), vmin.x),
-			min(float64(v.y), vmin.y),
-			min(float64(v.z), vmin.z),
-		)
+	vmin := v3a[0]
+	vmax := v3a[0]
 
-		vmax = New(
-			max(float64(v.x), vmax.x),
-			max(float64(v.y), vmax.y),
-			max(float64(v.z), vmax.z),
-		)
+	for _, v := range v3a[1:] {
+		vmin = Min(vmin, v)
+		vmax = Max(vmax, v)
 	}
 
-	return New(T(vmin.x), T(vmin.y), T(vmin.z)), New(T(vmax.x), T(vmax.y), T(vmax.z))
+	return vmin, vmax
 }
 
 // StandardDeviation calculates the population standard deviation on each
@@ -272,3 +272,22 @@ func TestArray_Sum(t *testing.T) {
 	assert.Equal(t, 12., sum.Y())
 	assert.Equal(t, 15., sum.Z())
 }
+
+func TestArrayBoundsInt64Precision(t *testing.T) {
+	big := int64(1<<62 + 1)
+	pts := vector3.Int64Array{
+		vector3.New(big, -big, 1),
+		vector3.New(big-1, -big+1, 2),
+	}
+
+	min, max := pts.Bounds()
+
+	assert.Equal(t, vector3.New(big-1, -big, int64(1)), min)
+	assert.Equal(t, vector3.New(big, -big+1, int64(2)), max)
+}
+
+func TestArrayBoundsEmpty(t *testing.T) {
+	min, max := vector3.Float64Array{}.Bounds()
+	assert.Equal(t, vector3.Zero[float64](), min)
+	assert.Equal(t, vector3.Zero[float64](), max)
+}
@@ -0,0 +1,101 @@
+package vector3
+
+import (
+	"strconv"
+
+	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/mathex"
+)
+
+// String returns the axis as its sign followed by its letter, like "-Y"
+func (a Axis) String() string {
+	switch a {
+	case PositiveX:
+		return "+X"
+	case PositiveY:
+		return "+Y"
+	case PositiveZ:
+		return "+Z"
+	case NegativeX:
+		return "-X"
+	case NegativeY:
+		return "-Y"
+	case NegativeZ:
+		return "-Z"
+	}
+	return "Axis(" + strconv.Itoa(int(a)) + ")"
+}
+
+// AxisVector returns the unit vector pointing along a
+func AxisVector[T vector.Number](a Axis) Vector[T] {
+	var v Vector[T]
+	return v.setAxis(a, 1)
+}
+
+// MajorAxis returns the signed axis the vector points most closely along,
+// which is the one of its largest component by magnitude. Ties go to X, then
+// Y. The zero vector has no direction and returns 0, which isn't a valid axis
+func (v Vector[T]) MajorAxis() Axis {
+	x, y, z := mathex.Abs(v.x), mathex.Abs(v.y), mathex.Abs(v.z)
+
+	var a Axis
+	var c T
+	switch {
+	case x == 0 && y == 0 && z == 0:
+		return 0
+	case x >= y && x >= z:
+		a, c = PositiveX, v.x
+	case y >= z:
+		a, c = PositiveY, v.y
+	default:
+		a, c = PositiveZ, v.z
+	}
+
+	if c < 0 {
+		return -a
+	}
+	return a
+}
+
+// SnapToAxis returns the unit vector along the vector's MajorAxis, the
+// closest of the six axis directions to it. The zero vector is returned
+// unchanged
+func (v Vector[T]) SnapToAxis() Vector[T] {
+	a := v.MajorAxis()
+	if a == 0 {
+		return v
+	}
+	return AxisVector[T](a)
+}
+
+// Octant identifies one of the eight regions space is divided into by the
+// coordinate planes. Bit 0 is set when x is positive, bit 1 for y and bit 2
+// for z, so it can be used directly as the index of an octree child
+type Octant uint8
+
+// Octant returns the octant the vector points into. Zero components count as
+// positive
+func (v Vector[T]) Octant() Octant {
+	var o Octant
+	if v.x >= 0 {
+		o |= 1
+	}
+	if v.y >= 0 {
+		o |= 2
+	}
+	if v.z >= 0 {
+		o |= 4
+	}
+	return o
+}
+
+// Signs returns a vector with components of 1 or -1 pointing into the octant
+func (o Octant) Signs() Vector[int] {
+	sign := func(bit Octant) int {
+		if o&bit != 0 {
+			return 1
+		}
+		return -1
+	}
+	return Vector[int]{sign(1), sign(2), sign(4)}
+}
@@ -0,0 +1,53 @@
+package vector3_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMajorAxis(t *testing.T) {
+	tests := map[string]struct {
+		v    vector3.Float64
+		want vector3.Axis
+	}{
+		"x":          {v: vector3.New(3., -2., 1.), want: vector3.PositiveX},
+		"negative y": {v: vector3.New(0.1, -2., 1.), want: vector3.NegativeY},
+		"z":          {v: vector3.New(0.1, -2., 2.5), want: vector3.PositiveZ},
+		"tie":        {v: vector3.New(-1., 1., 1.), want: vector3.NegativeX},
+		"zero":       {v: vector3.Zero[float64](), want: 0},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.v.MajorAxis())
+		})
+	}
+
+	assert.Equal(t, vector3.NegativeZ, vector3.New(1, 2, -3).MajorAxis())
+}
+
+func TestSnapToAxis(t *testing.T) {
+	assert.Equal(t, vector3.New(0., -1., 0.), vector3.New(0.1, -2., 1.).SnapToAxis())
+	assert.Equal(t, vector3.New(0, 0, 1), vector3.New(-2, 1, 7).SnapToAxis())
+	assert.Equal(t, vector3.Zero[float64](), vector3.Zero[float64]().SnapToAxis())
+	assert.Equal(t, vector3.New(-1., 0., 0.), vector3.AxisVector[float64](vector3.NegativeX))
+}
+
+func TestAxisString(t *testing.T) {
+	assert.Equal(t, "+X", vector3.PositiveX.String())
+	assert.Equal(t, "-Z", vector3.NegativeZ.String())
+	assert.Equal(t, "Axis(0)", vector3.Axis(0).String())
+}
+
+func TestOctant(t *testing.T) {
+	assert.Equal(t, vector3.Octant(7), vector3.New(1., 2., 3.).Octant())
+	assert.Equal(t, vector3.Octant(0), vector3.New(-1., -2., -3.).Octant())
+	assert.Equal(t, vector3.Octant(5), vector3.New(0., -2., 3.).Octant())
+
+	for o := vector3.Octant(0); o < 8; o++ {
+		assert.Equal(t, o, o.Signs().Octant())
+	}
+	assert.Equal(t, vector3.New(1, -1, 1), vector3.Octant(5).Signs())
+}
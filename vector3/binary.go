@@ -0,0 +1,28 @@
+package vector3
+
+import "github.com/EliCDavis/vector/internal/codec"
+
+// MarshalBinary encodes the vector as a single byte identifying the component
+// type, followed by each component in little-endian byte order
+func (v Vector[T]) MarshalBinary() ([]byte, error) {
+	return v.AppendBinary(nil)
+}
+
+// AppendBinary appends the MarshalBinary encoding of the vector to b,
+// allowing callers to reuse buffers and avoid allocations
+func (v Vector[T]) AppendBinary(b []byte) ([]byte, error) {
+	return codec.AppendTaggedBinary(b, v.x, v.y, v.z), nil
+}
+
+// UnmarshalBinary decodes a vector previously encoded with MarshalBinary. An
+// error is returned if the data was encoded with a different component type
+func (v *Vector[T]) UnmarshalBinary(data []byte) error {
+	var components [componentCount]T
+	if err := codec.DecodeTaggedBinary(data, components[:]); err != nil {
+		return err
+	}
+	v.x = components[0]
+	v.y = components[1]
+	v.z = components[2]
+	return nil
+}
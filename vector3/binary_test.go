@@ -0,0 +1,89 @@
+package vector3_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+)
+
+type binaryTestCase[T vector.Number] struct {
+	val vector3.Vector[T]
+}
+
+func (tc binaryTestCase[T]) test(t *testing.T) {
+	data, err := tc.val.MarshalBinary()
+	assert.NoError(t, err)
+	assert.Equal(t, 1+vector.ComponentTypeOf[T]().Size()*3, len(data))
+	assert.Equal(t, byte(vector.ComponentTypeOf[T]()), data[0])
+
+	var back vector3.Vector[T]
+	assert.NoError(t, back.UnmarshalBinary(data))
+	assert.Equal(t, tc.val, back)
+}
+
+func TestMarshalBinary(t *testing.T) {
+	tests := map[string]testCaseI{
+		"float64": binaryTestCase[float64]{
+			val: vector3.New[float64](1, 2, 3),
+		},
+		"float32": binaryTestCase[float32]{
+			val: vector3.New[float32](1, 2, 3),
+		},
+		"int8": binaryTestCase[int8]{
+			val: vector3.New[int8](1, 2, 3),
+		},
+		"int16": binaryTestCase[int16]{
+			val: vector3.New[int16](1, 2, 3),
+		},
+		"int32": binaryTestCase[int32]{
+			val: vector3.New[int32](1, 2, 3),
+		},
+		"int64": binaryTestCase[int64]{
+			val: vector3.New[int64](1, 2, 3),
+		},
+		"int": binaryTestCase[int]{
+			val: vector3.New[int](1, 2, 3),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tc.test(t)
+		})
+	}
+}
+
+func TestMarshalBinaryLayout(t *testing.T) {
+	data, err := vector3.Fill[int16](0x0102).MarshalBinary()
+	assert.NoError(t, err)
+	assert.Equal(t, byte(vector.Int16Component), data[0])
+	assert.Equal(t, []byte{0x02, 0x01}, data[1:3])
+}
+
+func TestUnmarshalBinaryErrors(t *testing.T) {
+	data, err := vector3.Fill(1.).MarshalBinary()
+	assert.NoError(t, err)
+
+	var wrongType vector3.Float32
+	assert.Error(t, wrongType.UnmarshalBinary(data))
+
+	var v vector3.Float64
+	assert.Error(t, v.UnmarshalBinary(nil))
+	assert.Error(t, v.UnmarshalBinary(data[:len(data)-1]))
+	assert.Equal(t, vector3.Zero[float64](), v)
+}
+
+func TestAppendBinaryAllocations(t *testing.T) {
+	v := vector3.Fill(1.25)
+	buf := make([]byte, 0, 128)
+	allocs := testing.AllocsPerRun(100, func() {
+		buf, _ = v.AppendBinary(buf[:0])
+	})
+	assert.Zero(t, allocs)
+
+	data, err := v.MarshalBinary()
+	assert.NoError(t, err)
+	assert.Equal(t, data, buf)
+}
@@ -0,0 +1,132 @@
+package vector3_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+)
+
+type bsonTestCase[T vector.Number] struct {
+	val vector3.Vector[T]
+}
+
+func (tc bsonTestCase[T]) test(t *testing.T) {
+	data, err := tc.val.MarshalBSON()
+	assert.NoError(t, err)
+
+	var back vector3.Vector[T]
+	assert.NoError(t, back.UnmarshalBSON(data))
+	assert.Equal(t, tc.val, back)
+
+	typ, data, err := tc.val.MarshalBSONValue()
+	assert.NoError(t, err)
+	assert.Equal(t, byte(0x03), typ)
+
+	var backValue vector3.Vector[T]
+	assert.NoError(t, backValue.UnmarshalBSONValue(typ, data))
+	assert.Equal(t, tc.val, backValue)
+}
+
+func TestBSON(t *testing.T) {
+	tests := map[string]testCaseI{
+		"float64": bsonTestCase[float64]{
+			val: vector3.New[float64](1, -2, 300),
+		},
+		"float32": bsonTestCase[float32]{
+			val: vector3.New[float32](1, -2, 300),
+		},
+		"int8": bsonTestCase[int8]{
+			val: vector3.New[int8](1, -2, 3),
+		},
+		"int16": bsonTestCase[int16]{
+			val: vector3.New[int16](1, -2, 300),
+		},
+		"int32": bsonTestCase[int32]{
+			val: vector3.New[int32](1, -2, 300),
+		},
+		"int64": bsonTestCase[int64]{
+			val: vector3.New[int64](1, -2, 9007199254740993),
+		},
+		"int": bsonTestCase[int]{
+			val: vector3.New[int](1, -2, 300),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tc.test(t)
+		})
+	}
+}
+
+func TestBSONLayout(t *testing.T) {
+	data, err := vector3.New[int32](1, 2, 3).MarshalBSON()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{
+		26, 0x00, 0x00, 0x00,
+		0x10, 'x', 0x00, 1, 0x00, 0x00, 0x00,
+		0x10, 'y', 0x00, 2, 0x00, 0x00, 0x00,
+		0x10, 'z', 0x00, 3, 0x00, 0x00, 0x00,
+		0x00,
+	}, data)
+}
+
+func TestBSONArray(t *testing.T) {
+	var v vector3.Float64
+	assert.NoError(t, v.UnmarshalBSONValue(0x04, []byte{
+		38, 0x00, 0x00, 0x00,
+		0x01, '0', 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf8, 0x3f,
+		0x01, '1', 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf8, 0x3f,
+		0x01, '2', 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf8, 0x3f,
+		0x00,
+	}))
+	assert.Equal(t, vector3.Fill(1.5), v)
+}
+
+func TestBSONErrors(t *testing.T) {
+	floats, err := vector3.Fill(1.5).MarshalBSON()
+	assert.NoError(t, err)
+
+	large, err := vector3.Fill(1000).MarshalBSON()
+	assert.NoError(t, err)
+
+	tests := map[string][]byte{
+		"empty":             nil,
+		"bad length":        large[:len(large)-1],
+		"fraction into int": floats,
+		"overflow":          large,
+	}
+
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			var v vector3.Int8
+			assert.Error(t, v.UnmarshalBSON(data))
+		})
+	}
+
+	var v vector3.Float64
+	assert.Error(t, v.UnmarshalBSONValue(0x02, floats))
+}
+
+// bsonValueMarshaler and bsonValueUnmarshaler mirror the bson.ValueMarshaler
+// and bson.ValueUnmarshaler interfaces of go.mongodb.org/mongo-driver/v2
+type bsonValueMarshaler interface {
+	MarshalBSONValue() (typ byte, data []byte, err error)
+}
+
+type bsonValueUnmarshaler interface {
+	UnmarshalBSONValue(typ byte, data []byte) error
+}
+
+func TestBSONValueDriverInterfaces(t *testing.T) {
+	var marshaler bsonValueMarshaler = vector3.New[float64](1, -2, 300)
+	typ, data, err := marshaler.MarshalBSONValue()
+	assert.NoError(t, err)
+
+	var back vector3.Float64
+	var unmarshaler bsonValueUnmarshaler = &back
+	assert.NoError(t, unmarshaler.UnmarshalBSONValue(typ, data))
+	assert.Equal(t, marshaler, back)
+}
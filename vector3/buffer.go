@@ -0,0 +1,135 @@
+package vector3
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/EliCDavis/vector/internal/codec"
+)
+
+const (
+	// float32Size is the number of bytes a Float32 occupies in a packed buffer
+	float32Size = componentCount * 4
+
+	// float16Size is the number of bytes a Float32 occupies in a packed
+	// buffer of half precision floats
+	float16Size = componentCount * 2
+)
+
+// PackFloat32 packs the vectors into tightly packed little-endian bytes, the
+// layout expected by glTF accessors and GPU vertex buffers
+func PackFloat32(vectors []Float32) []byte {
+	dst := make([]byte, len(vectors)*float32Size)
+	PackFloat32Strided(dst, vectors, 0, 0)
+	return dst
+}
+
+// PackFloat32Strided writes the vectors into dst as little-endian float32s,
+// the first at offset and each following one stride bytes after the last,
+// leaving the bytes in between untouched. This allows writing a single
+// attribute into an interleaved vertex buffer. A stride of 0 means the
+// vectors are tightly packed.
+func PackFloat32Strided(dst []byte, vectors []Float32, offset, stride int) error {
+	stride, err := codec.BufferStride(len(dst), len(vectors), float32Size, offset, stride)
+	if err != nil {
+		return err
+	}
+
+	for i, v := range vectors {
+		o := offset + i*stride
+		binary.LittleEndian.PutUint32(dst[o:], math.Float32bits(v.x))
+		binary.LittleEndian.PutUint32(dst[o+4:], math.Float32bits(v.y))
+		binary.LittleEndian.PutUint32(dst[o+8:], math.Float32bits(v.z))
+	}
+	return nil
+}
+
+// UnpackFloat32 reads vectors from tightly packed little-endian bytes
+func UnpackFloat32(data []byte) ([]Float32, error) {
+	if len(data)%float32Size != 0 {
+		return nil, fmt.Errorf("buffer length %d is not a multiple of %d", len(data), float32Size)
+	}
+	return UnpackFloat32Strided(data, len(data)/float32Size, 0, 0)
+}
+
+// UnpackFloat32Strided reads count vectors stored as little-endian float32s,
+// the first at offset and each following one stride bytes after the last. A
+// stride of 0 means the vectors are tightly packed.
+func UnpackFloat32Strided(data []byte, count, offset, stride int) ([]Float32, error) {
+	stride, err := codec.BufferStride(len(data), count, float32Size, offset, stride)
+	if err != nil {
+		return nil, err
+	}
+
+	vectors := make([]Float32, count)
+	for i := range vectors {
+		o := offset + i*stride
+		vectors[i] = Float32{
+			x: math.Float32frombits(binary.LittleEndian.Uint32(data[o:])),
+			y: math.Float32frombits(binary.LittleEndian.Uint32(data[o+4:])),
+			z: math.Float32frombits(binary.LittleEndian.Uint32(data[o+8:])),
+		}
+	}
+	return vectors, nil
+}
+
+// PackFloat16 packs the vectors into tightly packed little-endian half
+// precision floats, halving the memory of PackFloat32 at the cost of
+// precision. Components are rounded to the nearest representable value,
+// values beyond the range of a half become ±Inf, and NaN and ±Inf are
+// preserved
+func PackFloat16(vectors []Float32) []byte {
+	dst := make([]byte, len(vectors)*float16Size)
+	PackFloat16Strided(dst, vectors, 0, 0)
+	return dst
+}
+
+// PackFloat16Strided writes the vectors into dst as little-endian half
+// precision floats, the first at offset and each following one stride bytes
+// after the last. A stride of 0 means the vectors are tightly packed. See
+// PackFloat16 for how components are converted
+func PackFloat16Strided(dst []byte, vectors []Float32, offset, stride int) error {
+	stride, err := codec.BufferStride(len(dst), len(vectors), float16Size, offset, stride)
+	if err != nil {
+		return err
+	}
+
+	for i, v := range vectors {
+		o := offset + i*stride
+		binary.LittleEndian.PutUint16(dst[o:], codec.Float32ToHalf(v.x))
+		binary.LittleEndian.PutUint16(dst[o+2:], codec.Float32ToHalf(v.y))
+		binary.LittleEndian.PutUint16(dst[o+4:], codec.Float32ToHalf(v.z))
+	}
+	return nil
+}
+
+// UnpackFloat16 reads vectors from tightly packed little-endian half precision
+// floats
+func UnpackFloat16(data []byte) ([]Float32, error) {
+	if len(data)%float16Size != 0 {
+		return nil, fmt.Errorf("buffer length %d is not a multiple of %d", len(data), float16Size)
+	}
+	return UnpackFloat16Strided(data, len(data)/float16Size, 0, 0)
+}
+
+// UnpackFloat16Strided reads count vectors stored as little-endian half
+// precision floats, the first at offset and each following one stride bytes
+// after the last. A stride of 0 means the vectors are tightly packed.
+func UnpackFloat16Strided(data []byte, count, offset, stride int) ([]Float32, error) {
+	stride, err := codec.BufferStride(len(data), count, float16Size, offset, stride)
+	if err != nil {
+		return nil, err
+	}
+
+	vectors := make([]Float32, count)
+	for i := range vectors {
+		o := offset + i*stride
+		vectors[i] = Float32{
+			x: codec.HalfToFloat32(binary.LittleEndian.Uint16(data[o:])),
+			y: codec.HalfToFloat32(binary.LittleEndian.Uint16(data[o+2:])),
+			z: codec.HalfToFloat32(binary.LittleEndian.Uint16(data[o+4:])),
+		}
+	}
+	return vectors, nil
+}
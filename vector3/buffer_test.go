@@ -0,0 +1,135 @@
+package vector3_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPackFloat32(t *testing.T) {
+	data := vector3.PackFloat32([]vector3.Float32{
+		vector3.New[float32](1, 2, 3),
+		vector3.New[float32](-1, 0, 0.5),
+	})
+
+	assert.Equal(t, []byte{
+		0x00, 0x00, 0x80, 0x3f,
+		0x00, 0x00, 0x00, 0x40,
+		0x00, 0x00, 0x40, 0x40,
+		0x00, 0x00, 0x80, 0xbf,
+		0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x3f,
+	}, data)
+
+	back, err := vector3.UnpackFloat32(data)
+	assert.NoError(t, err)
+	assert.Equal(t, []vector3.Float32{
+		vector3.New[float32](1, 2, 3),
+		vector3.New[float32](-1, 0, 0.5),
+	}, back)
+}
+
+func TestPackFloat32Interleaved(t *testing.T) {
+	positions := []vector3.Float32{
+		vector3.New[float32](1, 2, 3),
+		vector3.New[float32](4, 5, 6),
+		vector3.New[float32](7, 8, 9),
+	}
+	normals := []vector3.Float32{
+		vector3.Up[float32](),
+		vector3.Right[float32](),
+		vector3.Forward[float32](),
+	}
+
+	// position followed by normal, 24 bytes per vertex
+	const stride = 24
+	data := make([]byte, len(positions)*stride)
+	require.NoError(t, vector3.PackFloat32Strided(data, positions, 0, stride))
+	require.NoError(t, vector3.PackFloat32Strided(data, normals, 12, stride))
+
+	backPositions, err := vector3.UnpackFloat32Strided(data, 3, 0, stride)
+	assert.NoError(t, err)
+	assert.Equal(t, positions, backPositions)
+
+	backNormals, err := vector3.UnpackFloat32Strided(data, 3, 12, stride)
+	assert.NoError(t, err)
+	assert.Equal(t, normals, backNormals)
+}
+
+func TestPackFloat32Errors(t *testing.T) {
+	vectors := []vector3.Float32{vector3.Zero[float32](), vector3.Zero[float32]()}
+
+	assert.EqualError(t, vector3.PackFloat32Strided(make([]byte, 24), vectors, 0, 8), "stride 8 is smaller than element size 12")
+	assert.EqualError(t, vector3.PackFloat32Strided(make([]byte, 24), vectors, 4, 0), "buffer of 24 bytes too small for 2 elements with offset 4 and stride 12")
+	assert.EqualError(t, vector3.PackFloat32Strided(make([]byte, 24), vectors, -1, 0), "invalid offset -1")
+
+	_, err := vector3.UnpackFloat32(make([]byte, 13))
+	assert.EqualError(t, err, "buffer length 13 is not a multiple of 12")
+
+	_, err = vector3.UnpackFloat32Strided(make([]byte, 40), 2, 0, 32)
+	assert.Error(t, err)
+}
+
+func TestPackFloat16(t *testing.T) {
+	in := []vector3.Float32{
+		vector3.New[float32](1, -2, 0.5),
+		vector3.New[float32](65504, 0.000061035156, -0),
+	}
+
+	data := vector3.PackFloat16(in)
+	assert.Equal(t, []byte{
+		0x00, 0x3c,
+		0x00, 0xc0,
+		0x00, 0x38,
+		0xff, 0x7b,
+		0x00, 0x04,
+		0x00, 0x00,
+	}, data)
+
+	back, err := vector3.UnpackFloat16(data)
+	assert.NoError(t, err)
+	assert.Equal(t, in, back)
+}
+
+func TestPackFloat16Rounding(t *testing.T) {
+	data := vector3.PackFloat16([]vector3.Float32{vector3.New[float32](0.1, 1e6, -1e6)})
+	back, err := vector3.UnpackFloat16(data)
+	assert.NoError(t, err)
+
+	assert.InDelta(t, 0.1, back[0].X(), 1e-4)
+	assert.True(t, math.IsInf(float64(back[0].Y()), 1))
+	assert.True(t, math.IsInf(float64(back[0].Z()), -1))
+}
+
+func TestPackFloat16NaN(t *testing.T) {
+	nan := float32(math.NaN())
+	inf := float32(math.Inf(-1))
+	data := vector3.PackFloat16([]vector3.Float32{vector3.New(nan, inf, 1)})
+
+	back, err := vector3.UnpackFloat16(data)
+	assert.NoError(t, err)
+	assert.True(t, math.IsNaN(float64(back[0].X())))
+	assert.True(t, math.IsInf(float64(back[0].Y()), -1))
+	assert.Equal(t, float32(1), back[0].Z())
+}
+
+func TestPackFloat16Strided(t *testing.T) {
+	in := []vector3.Float32{
+		vector3.New[float32](1, 2, 3),
+		vector3.New[float32](4, 5, 6),
+	}
+
+	// pad each vector to 8 bytes for alignment
+	data := make([]byte, 16)
+	require.NoError(t, vector3.PackFloat16Strided(data, in, 0, 8))
+
+	back, err := vector3.UnpackFloat16Strided(data, 2, 0, 8)
+	assert.NoError(t, err)
+	assert.Equal(t, in, back)
+
+	_, err = vector3.UnpackFloat16(make([]byte, 7))
+	assert.EqualError(t, err, "buffer length 7 is not a multiple of 6")
+}
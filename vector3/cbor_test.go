@@ -0,0 +1,82 @@
+package vector3_test
+
+import (
+	"encoding/hex"
+	"math"
+	"testing"
+
+	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type cborTestCase[T vector.Number] struct {
+	val vector3.Vector[T]
+}
+
+func (tc cborTestCase[T]) test(t *testing.T) {
+	data, err := tc.val.MarshalCBOR()
+	require.NoError(t, err)
+
+	var back vector3.Vector[T]
+	require.NoError(t, back.UnmarshalCBOR(data))
+	assert.Equal(t, tc.val, back)
+}
+
+func TestCBOR(t *testing.T) {
+	tests := map[string]testCaseI{
+		"float64": cborTestCase[float64]{
+			val: vector3.New[float64](1, -2, 3),
+		},
+		"float64 precision": cborTestCase[float64]{
+			val: vector3.Fill(math.Pi),
+		},
+		"float32": cborTestCase[float32]{
+			val: vector3.New[float32](1, -2, 3),
+		},
+		"int8": cborTestCase[int8]{
+			val: vector3.New[int8](1, -2, 3),
+		},
+		"int16": cborTestCase[int16]{
+			val: vector3.New[int16](1, -2, 3),
+		},
+		"int32": cborTestCase[int32]{
+			val: vector3.New[int32](1, -200000, 3),
+		},
+		"int64": cborTestCase[int64]{
+			val: vector3.Fill[int64](math.MinInt64),
+		},
+		"int": cborTestCase[int]{
+			val: vector3.Fill[int](math.MaxInt),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tc.test(t)
+		})
+	}
+}
+
+func TestCBORDecodeForms(t *testing.T) {
+	want := vector3.New[float64](1, 2, 3)
+	for _, encoded := range []string{"83010203", "83f93c00f94000f94200", "9f010203ff"} {
+		data, err := hex.DecodeString(encoded)
+		require.NoError(t, err)
+
+		var v vector3.Float64
+		require.NoError(t, v.UnmarshalCBOR(data), encoded)
+		assert.Equal(t, want, v, encoded)
+	}
+}
+
+func TestCBORInvalid(t *testing.T) {
+	var v vector3.Int
+	assert.Error(t, v.UnmarshalCBOR(nil))
+	assert.Error(t, v.UnmarshalCBOR([]byte{0x81, 0x01}))
+
+	data, err := vector3.New[float64](1, -2, 3).MarshalCBOR()
+	require.NoError(t, err)
+	assert.Error(t, v.UnmarshalCBOR(data))
+}
@@ -0,0 +1,43 @@
+package vector3
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/EliCDavis/vector/internal/colorspace"
+	"github.com/EliCDavis/vector/mathex"
+)
+
+// RGBA interprets the vector as an opaque color with red, green, and blue
+// components in the range [0, 1], satisfying the color.Color interface. Out of
+// range components are clamped. It's the inverse of FromColor for opaque
+// colors
+func (v Vector[T]) RGBA() (r, g, b, a uint32) {
+	return colorChannel(float64(v.x)), colorChannel(float64(v.y)), colorChannel(float64(v.z)), 0xffff
+}
+
+// colorChannel maps c from [0, 1] to [0, 0xffff]. NaN has no meaningful
+// intensity and would make the conversion to uint32 implementation defined,
+// so it's treated as 0
+func colorChannel(c float64) uint32 {
+	if math.IsNaN(c) {
+		return 0
+	}
+	return uint32(mathex.Clamp(c, 0, 1)*0xffff + 0.5)
+}
+
+// FromColorSRGB returns the sRGB encoded red, green, and blue components of
+// c in the range [0, 1]. Unlike FromColor, translucent colors have their
+// alpha divided back out rather than being darkened by it
+func FromColorSRGB(c color.Color) Float64 {
+	r, g, b, _ := colorspace.Straight(c)
+	return New(r, g, b)
+}
+
+// FromColorLinear returns the red, green, and blue components of c decoded
+// from sRGB into linear light, which lighting and blending math should be
+// done in. Translucent colors have their alpha divided back out first
+func FromColorLinear(c color.Color) Float64 {
+	r, g, b, _ := colorspace.Linear(c)
+	return New(r, g, b)
+}
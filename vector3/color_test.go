@@ -0,0 +1,84 @@
+package vector3_test
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"testing"
+
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/EliCDavis/vector/vectortest"
+	"github.com/stretchr/testify/assert"
+)
+
+var _ color.Color = vector3.Float64{}
+
+func TestRGBA(t *testing.T) {
+	tests := map[string]struct {
+		in   vector3.Float64
+		want color.RGBA64
+	}{
+		"black": {in: vector3.New(0., 0., 0.), want: color.RGBA64{0, 0, 0, 0xffff}},
+		"white": {in: vector3.New(1., 1., 1.), want: color.RGBA64{0xffff, 0xffff, 0xffff, 0xffff}},
+		"half":  {in: vector3.New(0.5, 0.25, 1.), want: color.RGBA64{0x8000, 0x4000, 0xffff, 0xffff}},
+		"clamp": {in: vector3.New(-1., 2., 0.), want: color.RGBA64{0, 0xffff, 0, 0xffff}},
+		"nan":   {in: vector3.New(math.NaN(), 1., 0.5), want: color.RGBA64{0, 0xffff, 0x8000, 0xffff}},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			r, g, b, a := tc.in.RGBA()
+			assert.Equal(t, tc.want, color.RGBA64{uint16(r), uint16(g), uint16(b), uint16(a)})
+		})
+	}
+}
+
+func TestColorRoundTrip(t *testing.T) {
+	in := color.RGBA{R: 12, G: 200, B: 99, A: 255}
+	assert.Equal(t, in, color.RGBAModel.Convert(vector3.FromColor(in)))
+
+	img := image.NewRGBA(image.Rect(0, 0, 1, 1))
+	img.Set(0, 0, vector3.New(1., 0., 0.))
+	assert.Equal(t, color.RGBA{R: 255, A: 255}, img.RGBAAt(0, 0))
+}
+
+func TestFromColorSpaces(t *testing.T) {
+	tests := map[string]struct {
+		in         color.Color
+		wantSRGB   vector3.Float64
+		wantLinear vector3.Float64
+	}{
+		"rgba": {
+			in:         color.RGBA{R: 255, G: 128, B: 0, A: 255},
+			wantSRGB:   vector3.New(1., 128./255, 0.),
+			wantLinear: vector3.New(1., 0.2158605, 0.),
+		},
+		"nrgba translucent": {
+			in:         color.NRGBA{R: 255, G: 128, B: 0, A: 64},
+			wantSRGB:   vector3.New(1., 128./255, 0.),
+			wantLinear: vector3.New(1., 0.2158605, 0.),
+		},
+		"premultiplied translucent": {
+			in:         color.RGBA{R: 64, G: 32, B: 0, A: 64},
+			wantSRGB:   vector3.New(1., 0.5, 0.),
+			wantLinear: vector3.New(1., 0.2140411, 0.),
+		},
+		"gray16": {
+			in:         color.Gray16{Y: 0x8000},
+			wantSRGB:   vector3.New(0x8000/65535., 0x8000/65535., 0x8000/65535.),
+			wantLinear: vector3.New(0.2140482, 0.2140482, 0.2140482),
+		},
+		"transparent": {
+			in:         color.RGBA{},
+			wantSRGB:   vector3.Zero[float64](),
+			wantLinear: vector3.Zero[float64](),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			vectortest.AssertVector3InDelta(t, tc.wantSRGB, vector3.FromColorSRGB(tc.in), 1e-6)
+			vectortest.AssertVector3InDelta(t, tc.wantLinear, vector3.FromColorLinear(tc.in), 1e-6)
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package vector3
+
+// Axis identifies a signed coordinate axis
+type Axis int8
+
+const (
+	PositiveX Axis = 1
+	PositiveY Axis = 2
+	PositiveZ Axis = 3
+	NegativeX Axis = -PositiveX
+	NegativeY Axis = -PositiveY
+	NegativeZ Axis = -PositiveZ
+)
+
+// CoordinateSystem describes a convention for which axes point right, up, and
+// forward. Converting between conventions explicitly, rather than swapping
+// components by hand, avoids the sign mistakes that come with importing assets
+// from other engines.
+type CoordinateSystem struct {
+	Right   Axis
+	Up      Axis
+	Forward Axis
+}
+
+var (
+	// OpenGL is right-handed with +Y up, looking down -Z
+	OpenGL = CoordinateSystem{Right: PositiveX, Up: PositiveY, Forward: NegativeZ}
+
+	// GLTF is right-handed with +Y up. It shares OpenGL's axes, so no
+	// conversion happens between the two
+	GLTF = OpenGL
+
+	// Unity is left-handed with +Y up and +Z forward. It's the convention
+	// used by Right, Up, and Forward in this package
+	Unity = CoordinateSystem{Right: PositiveX, Up: PositiveY, Forward: PositiveZ}
+
+	// Unreal is left-handed with +Z up and +X forward
+	Unreal = CoordinateSystem{Right: PositiveY, Up: PositiveZ, Forward: PositiveX}
+
+	// Blender is right-handed with +Z up and +Y forward
+	Blender = CoordinateSystem{Right: PositiveX, Up: PositiveZ, Forward: PositiveY}
+)
+
+// LeftHanded returns true when right × up = forward
+func (c CoordinateSystem) LeftHanded() bool {
+	right := AxisVector[int](c.Right)
+	up := AxisVector[int](c.Up)
+	return right.Cross(up) == AxisVector[int](c.Forward)
+}
+
+// ConvertCoordinates converts the vector from one coordinate system to
+// another. Positions, directions, and normals all convert the same way. When
+// the conversion changes handedness, triangle winding order and the sign of
+// cross products flip, which the caller needs to account for.
+func (v Vector[T]) ConvertCoordinates(from, to CoordinateSystem) Vector[T] {
+	var out Vector[T]
+	out = out.setAxis(to.Right, v.axis(from.Right))
+	out = out.setAxis(to.Up, v.axis(from.Up))
+	return out.setAxis(to.Forward, v.axis(from.Forward))
+}
+
+// ConvertCoordinates converts every vector in the array from one coordinate
+// system to another, see Vector.ConvertCoordinates
+func (v3a Array[T]) ConvertCoordinates(from, to CoordinateSystem) (out Array[T]) {
+	out = make(Array[T], len(v3a))
+	for i, v := range v3a {
+		out[i] = v.ConvertCoordinates(from, to)
+	}
+	return
+}
+
+// axis returns the component of v along a
+func (v Vector[T]) axis(a Axis) T {
+	var c T
+	switch a {
+	case PositiveX, NegativeX:
+		c = v.x
+	case PositiveY, NegativeY:
+		c = v.y
+	case PositiveZ, NegativeZ:
+		c = v.z
+	default:
+		panic("vector3: invalid axis")
+	}
+	if a < 0 {
+		return -c
+	}
+	return c
+}
+
+// setAxis returns v with its component along a set to c
+func (v Vector[T]) setAxis(a Axis, c T) Vector[T] {
+	if a < 0 {
+		c = -c
+	}
+	switch a {
+	case PositiveX, NegativeX:
+		v.x = c
+	case PositiveY, NegativeY:
+		v.y = c
+	case PositiveZ, NegativeZ:
+		v.z = c
+	default:
+		panic("vector3: invalid axis")
+	}
+	return v
+}
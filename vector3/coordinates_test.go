@@ -0,0 +1,63 @@
+package vector3_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCoordinateSystemHandedness(t *testing.T) {
+	assert.False(t, vector3.OpenGL.LeftHanded())
+	assert.False(t, vector3.GLTF.LeftHanded())
+	assert.False(t, vector3.Blender.LeftHanded())
+	assert.True(t, vector3.Unity.LeftHanded())
+	assert.True(t, vector3.Unreal.LeftHanded())
+}
+
+func TestConvertCoordinates(t *testing.T) {
+	v := vector3.New(1., 2., 3.)
+
+	tests := map[string]struct {
+		from, to vector3.CoordinateSystem
+		want     vector3.Float64
+	}{
+		"identity":        {from: vector3.Unity, to: vector3.Unity, want: vector3.New(1., 2., 3.)},
+		"gltf to opengl":  {from: vector3.GLTF, to: vector3.OpenGL, want: vector3.New(1., 2., 3.)},
+		"opengl to unity": {from: vector3.OpenGL, to: vector3.Unity, want: vector3.New(1., 2., -3.)},
+		"unity to unreal": {from: vector3.Unity, to: vector3.Unreal, want: vector3.New(3., 1., 2.)},
+		"unreal to unity": {from: vector3.Unreal, to: vector3.Unity, want: vector3.New(2., 3., 1.)},
+		"blender to gltf": {from: vector3.Blender, to: vector3.GLTF, want: vector3.New(1., 3., -2.)},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := v.ConvertCoordinates(tc.from, tc.to)
+			assert.Equal(t, tc.want, got)
+			assert.Equal(t, v, got.ConvertCoordinates(tc.to, tc.from))
+		})
+	}
+}
+
+func TestConvertCoordinatesSemanticAxes(t *testing.T) {
+	systems := []vector3.CoordinateSystem{vector3.OpenGL, vector3.Unity, vector3.Unreal, vector3.Blender}
+
+	// Up in one system is up in every other
+	for _, from := range systems {
+		for _, to := range systems {
+			up := vector3.Up[int]().ConvertCoordinates(vector3.Unity, from)
+			assert.Equal(t, vector3.Up[int]().ConvertCoordinates(vector3.Unity, to), up.ConvertCoordinates(from, to))
+		}
+	}
+}
+
+func TestArrayConvertCoordinates(t *testing.T) {
+	in := vector3.Array[int]{vector3.New(1, 2, 3), vector3.New(4, 5, 6)}
+	assert.Equal(t, vector3.Array[int]{vector3.New(1, 3, 2), vector3.New(4, 6, 5)}, in.ConvertCoordinates(vector3.Unity, vector3.Blender))
+}
+
+func TestConvertCoordinatesInvalidAxis(t *testing.T) {
+	assert.PanicsWithValue(t, "vector3: invalid axis", func() {
+		vector3.One[int]().ConvertCoordinates(vector3.CoordinateSystem{}, vector3.Unity)
+	})
+}
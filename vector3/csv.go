@@ -0,0 +1,56 @@
+package vector3
+
+import (
+	"errors"
+	"io"
+
+	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/internal/codec"
+)
+
+// WriteCSV writes the vectors to w as CSV, one vector per record, preceded by
+// the header "x,y,z"
+func WriteCSV[T vector.Number](w io.Writer, vectors []Vector[T]) error {
+	writer, err := codec.NewCSVWriter[T](w, componentKeys)
+	if err != nil {
+		return err
+	}
+	for _, v := range vectors {
+		if err := writer.Write(v.x, v.y, v.z); err != nil {
+			return err
+		}
+	}
+	return writer.Flush()
+}
+
+// ReadCSV reads vectors from CSV data, one vector per record. By default the
+// first record is treated as a header and the columns named x,y,z are used,
+// see vector.CSVOptions for reading headerless data or selecting other
+// columns
+func ReadCSV[T vector.Number](r io.Reader, opts vector.CSVOptions) ([]Vector[T], error) {
+	reader, err := codec.NewCSVReader[T](r, componentKeys, opts)
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var vectors []Vector[T]
+	var components [componentCount]T
+	for {
+		err := reader.Read(components[:])
+		if errors.Is(err, io.EOF) {
+			return vectors, nil
+		}
+		if err != nil {
+			return vectors, err
+		}
+
+		var v Vector[T]
+		v.x = components[0]
+		v.y = components[1]
+		v.z = components[2]
+		vectors = append(vectors, v)
+	}
+}
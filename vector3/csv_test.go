@@ -0,0 +1,121 @@
+package vector3_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteCSV(t *testing.T) {
+	buf := &bytes.Buffer{}
+	err := vector3.WriteCSV(buf, []vector3.Float64{
+		vector3.New(1.5, -2., 0.1),
+		vector3.New(4., 5., 6.),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "x,y,z\n1.5,-2,0.1\n4,5,6\n", buf.String())
+}
+
+func TestCSVRoundTrip(t *testing.T) {
+	in := []vector3.Float32{
+		vector3.New[float32](1.5, -2, 0.1),
+		vector3.New[float32](4, 5, 6),
+	}
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, vector3.WriteCSV(buf, in))
+
+	out, err := vector3.ReadCSV[float32](buf, vector.CSVOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, in, out)
+}
+
+func TestReadCSV(t *testing.T) {
+	tests := map[string]struct {
+		data string
+		opts vector.CSVOptions
+		want []vector3.Int
+	}{
+		"empty": {
+			data: "",
+		},
+		"header only": {
+			data: "x,y,z\n",
+		},
+		"reordered header": {
+			data: "time, Z, Y, X\n0, 3, 2, 1\n1, 6, 5, 4\n",
+			want: []vector3.Int{vector3.New(1, 2, 3), vector3.New(4, 5, 6)},
+		},
+		"unnamed header": {
+			data: "a,b,c\n1,2,3\n",
+			want: []vector3.Int{vector3.New(1, 2, 3)},
+		},
+		"no header": {
+			data: "1,2,3\n4,5,6\n",
+			opts: vector.CSVOptions{NoHeader: true},
+			want: []vector3.Int{vector3.New(1, 2, 3), vector3.New(4, 5, 6)},
+		},
+		"named columns": {
+			data: "px,py,pz,vx,vy,vz\n1,2,3,4,5,6\n",
+			opts: vector.CSVOptions{Columns: []string{"vx", "vy", "vz"}},
+			want: []vector3.Int{vector3.New(4, 5, 6)},
+		},
+		"column indices": {
+			data: "1,2,3,4,5,6\n",
+			opts: vector.CSVOptions{NoHeader: true, ColumnIndices: []int{5, 3, 1}},
+			want: []vector3.Int{vector3.New(6, 4, 2)},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			out, err := vector3.ReadCSV[int](strings.NewReader(tc.data), tc.opts)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, out)
+		})
+	}
+}
+
+func TestReadCSVErrors(t *testing.T) {
+	tests := map[string]struct {
+		data string
+		opts vector.CSVOptions
+		err  string
+	}{
+		"bad component": {
+			data: "x,y,z\n1,2,3\n1,a,3\n",
+			err:  `line 3: strconv.ParseInt: parsing "a": invalid syntax`,
+		},
+		"missing column": {
+			data: "x,y,z\n1,2\n",
+			err:  "line 2: missing column 2",
+		},
+		"unknown column": {
+			data: "x,y,z\n1,2,3\n",
+			opts: vector.CSVOptions{Columns: []string{"a", "b", "c"}},
+			err:  `column "a" not found in header`,
+		},
+		"columns without header": {
+			data: "1,2,3\n",
+			opts: vector.CSVOptions{NoHeader: true, Columns: []string{"x", "y", "z"}},
+			err:  "selecting columns by name requires a header",
+		},
+		"wrong index count": {
+			data: "1,2,3\n",
+			opts: vector.CSVOptions{NoHeader: true, ColumnIndices: []int{0}},
+			err:  "expected 3 column indices, got 1",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := vector3.ReadCSV[int](strings.NewReader(tc.data), tc.opts)
+			assert.EqualError(t, err, tc.err)
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package vector3
+
+import (
+	"github.com/EliCDavis/vector/internal/fp"
+	"github.com/EliCDavis/vector/mathex"
+)
+
+// normalizedTolerance is how far the squared length of a vector may stray
+// from 1 before NormalizedFast bothers rescaling it
+const normalizedTolerance = 1e-6
+
+// NormalizedFast is a faster, slightly less precise alternative to
+// Normalized intended for normal and particle heavy workloads. Vectors that
+// are already unit length within tolerance are returned unchanged, and
+// float32 vectors are scaled by an approximate reciprocal square root rather
+// than dividing by their length. Other component types fall back to
+// Normalized
+func (v Vector[T]) NormalizedFast() Vector[T] {
+	if !mathex.IsFloat[T]() {
+		return v.Normalized()
+	}
+
+	lengthSquared := fp.Dot3(v.x, v.y, v.z, v.x, v.y, v.z)
+	if mathex.ApproximatelyWithin(float64(lengthSquared), 1, normalizedTolerance) {
+		return v
+	}
+
+	if f, ok := any(lengthSquared).(float32); ok {
+		inv := T(mathex.InvSqrtFast(f))
+		return Vector[T]{
+			x: v.x * inv,
+			y: v.y * inv,
+			z: v.z * inv,
+		}
+	}
+	return v.Normalized()
+}
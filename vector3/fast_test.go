@@ -0,0 +1,32 @@
+package vector3_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizedFast(t *testing.T) {
+	v := vector3.New[float32](3, -4, 12)
+	want := v.Normalized()
+	got := v.NormalizedFast()
+	assert.InDelta(t, want.X(), got.X(), 1e-5)
+	assert.InDelta(t, want.Y(), got.Y(), 1e-5)
+	assert.InDelta(t, want.Z(), got.Z(), 1e-5)
+
+	unit := vector3.New[float32](0.6, 0.8, 0)
+	assert.Equal(t, unit, unit.NormalizedFast())
+
+	assert.Equal(t, vector3.New(3., 4., 12.).Normalized(), vector3.New(3., 4., 12.).NormalizedFast())
+	assert.Equal(t, vector3.New(0, 1, 0), vector3.New(0, 5, 0).NormalizedFast())
+}
+
+func BenchmarkNormalizedFast(b *testing.B) {
+	v := vector3.New[float32](1, 2, 3)
+	var out vector3.Float32
+	for i := 0; i < b.N; i++ {
+		out = v.NormalizedFast()
+	}
+	_ = out
+}
@@ -0,0 +1,19 @@
+package vector3
+
+// String returns the vector in the form "x,y,z", matching MarshalText. Together
+// with Set this satisfies the flag.Value interface
+func (v Vector[T]) String() string {
+	text, _ := v.AppendText(nil)
+	return string(text)
+}
+
+// Set parses the vector from the form "x,y,z", allowing a *Vector to be used
+// directly with flag.Var
+func (v *Vector[T]) Set(value string) error {
+	return v.UnmarshalText([]byte(value))
+}
+
+// Type returns the name of the value's type, as required by pflag.Value
+func (v *Vector[T]) Type() string {
+	return "vector3"
+}
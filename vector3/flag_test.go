@@ -0,0 +1,34 @@
+package vector3_test
+
+import (
+	"flag"
+	"fmt"
+	"testing"
+
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+)
+
+var _ flag.Value = (*vector3.Vector[float64])(nil)
+
+func TestFlagString(t *testing.T) {
+	v := vector3.New(1.5, -2., 0.1)
+	assert.Equal(t, "1.5,-2,0.1", v.String())
+	assert.Equal(t, "1.5,-2,0.1", fmt.Sprint(v))
+}
+
+func TestFlagSet(t *testing.T) {
+	var origin vector3.Float64
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.Var(&origin, "origin", "origin of the scene")
+
+	assert.NoError(t, fs.Parse([]string{"--origin=1.5,-2,0.1"}))
+	assert.Equal(t, vector3.New(1.5, -2., 0.1), origin)
+	assert.Equal(t, "vector3", origin.Type())
+}
+
+func TestFlagSetInvalid(t *testing.T) {
+	var v vector3.Float64
+	assert.Error(t, v.Set("1"))
+	assert.Error(t, v.Set("a,b,c,d"))
+}
@@ -0,0 +1,77 @@
+package vector3_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWKT(t *testing.T) {
+	in := vector3.New(1.5, -2., 300.)
+	assert.Equal(t, "POINT(1.5 -2 300)", in.WKT())
+	assert.Equal(t, "p=POINT(1 2 3)", string(vector3.New(1, 2, 3).AppendWKT([]byte("p="))))
+
+	back, err := vector3.ParseWKT[float64](in.WKT())
+	assert.NoError(t, err)
+	assert.Equal(t, in, back)
+}
+
+func TestParseWKT(t *testing.T) {
+	tests := map[string]vector3.Float64{
+		"POINT(1 2 3)":            vector3.New(1., 2., 3.),
+		"POINT Z (1 2 3)":         vector3.New(1., 2., 3.),
+		"point z(1.5 2 -3)":       vector3.New(1.5, 2., -3.),
+		"SRID=4326;POINT(1 2 3)":  vector3.New(1., 2., 3.),
+		"  POINT ( 1   2   3 )  ": vector3.New(1., 2., 3.),
+		"POINT ZM (1 2 3 99)":     vector3.New(1., 2., 3.),
+	}
+
+	for in, want := range tests {
+		t.Run(in, func(t *testing.T) {
+			got, err := vector3.ParseWKT[float64](in)
+			assert.NoError(t, err)
+			assert.Equal(t, want, got)
+		})
+	}
+}
+
+func TestParseWKTErrors(t *testing.T) {
+	for _, in := range []string{
+		"",
+		"POINT(1 2)",
+		"POINT(1 2 3 4)",
+		"POINT(1 2 a)",
+		"POINT 1 2 3",
+		"POINT Q(1 2 3)",
+		"LINESTRING(1 2 3, 4 5 6)",
+		"SRID=4326 POINT(1 2 3)",
+		"POINT M (1 2 99)",
+		"POINT ZM (1 2 3)",
+	} {
+		t.Run(in, func(t *testing.T) {
+			_, err := vector3.ParseWKT[float64](in)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestGeoJSON(t *testing.T) {
+	in := vector3.New(1.5, -2., 300.)
+
+	data, err := json.Marshal(in.GeoJSON())
+	assert.NoError(t, err)
+	assert.Equal(t, `{"type":"Point","coordinates":[1.5,-2,300]}`, string(data))
+
+	var back vector3.GeoJSONPoint[float64]
+	assert.NoError(t, json.Unmarshal(data, &back))
+	assert.Equal(t, in, back.Vector())
+}
+
+func TestGeoJSONErrors(t *testing.T) {
+	var g vector3.GeoJSONPoint[float64]
+	assert.Error(t, json.Unmarshal([]byte(`{"type":"LineString","coordinates":[[1,2],[3,4]]}`), &g))
+	assert.EqualError(t, json.Unmarshal([]byte(`{"type":"Polygon","coordinates":[]}`), &g), `unable to decode GeoJSON point: unexpected geometry type "Polygon"`)
+	assert.EqualError(t, json.Unmarshal([]byte(`{"type":"Point","coordinates":[1,2]}`), &g), "unable to decode GeoJSON point: expected 3 coordinates, got 2")
+}
@@ -0,0 +1,12 @@
+package vector3
+
+// GobEncode encodes the vector for encoding/gob using the MarshalBinary
+// format. Without it gob would skip the vector's unexported fields entirely
+func (v Vector[T]) GobEncode() ([]byte, error) {
+	return v.MarshalBinary()
+}
+
+// GobDecode decodes a vector encoded with GobEncode
+func (v *Vector[T]) GobDecode(data []byte) error {
+	return v.UnmarshalBinary(data)
+}
@@ -0,0 +1,40 @@
+package vector3_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGob(t *testing.T) {
+	type session struct {
+		Position vector3.Float64
+		Cells    []vector3.Int
+		Lookup   map[string]vector3.Float32
+	}
+
+	in := session{
+		Position: vector3.New(1.5, -2., 0.1),
+		Cells:    []vector3.Int{vector3.New(1, 2, 3), vector3.Zero[int]()},
+		Lookup:   map[string]vector3.Float32{"spawn": vector3.New[float32](1.5, -2., 0.1)},
+	}
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, gob.NewEncoder(buf).Encode(in))
+
+	var out session
+	require.NoError(t, gob.NewDecoder(buf).Decode(&out))
+	assert.Equal(t, in, out)
+}
+
+func TestGobComponentMismatch(t *testing.T) {
+	buf := &bytes.Buffer{}
+	require.NoError(t, gob.NewEncoder(buf).Encode(vector3.New(1.5, -2., 0.1)))
+
+	var out vector3.Float32
+	assert.Error(t, gob.NewDecoder(buf).Decode(&out))
+}
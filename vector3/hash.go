@@ -0,0 +1,58 @@
+package vector3
+
+import (
+	"math"
+
+	"github.com/EliCDavis/vector/mathex"
+)
+
+// The functions below derive repeatable randomness from integer grid cells,
+// so procedural generation can ask for the random value of a cell at any
+// time, in any order, without storing generator state. Different seeds give
+// independent sets of values.
+
+// HashFloat returns a uniformly distributed value in [0, 1) for the cell
+func HashFloat(cell Int, seed uint32) float64 {
+	return mathex.HashFloat(mathex.Hash3(cell.x, cell.y, cell.z, seed))
+}
+
+// HashRand returns a vector with each component uniformly distributed in
+// [0, 1) for the cell, like Rand
+func HashRand(cell Int, seed uint32) Float64 {
+	return Float64{
+		x: HashFloat(cell, seed),
+		y: HashFloat(cell, seed+1),
+		z: HashFloat(cell, seed+2),
+	}
+}
+
+// HashUnit returns a unit vector pointing in a uniformly distributed direction
+// for the cell
+func HashUnit(cell Int, seed uint32) Float64 {
+	z := 2*HashFloat(cell, seed) - 1
+	angle := 2 * math.Pi * HashFloat(cell, seed+1)
+	r := math.Sqrt(1 - z*z)
+	return Float64{x: r * math.Cos(angle), y: r * math.Sin(angle), z: z}
+}
+
+// gradients are the directions HashGradient picks between: towards the
+// middle of each of a cube's 12 edges, as in Perlin's improved noise, with
+// four repeated so there's a power of two of them
+var gradients = func() [16]Float64 {
+	edges := [16]Float64{
+		{1, 1, 0}, {-1, 1, 0}, {1, -1, 0}, {-1, -1, 0},
+		{1, 0, 1}, {-1, 0, 1}, {1, 0, -1}, {-1, 0, -1},
+		{0, 1, 1}, {0, -1, 1}, {0, 1, -1}, {0, -1, -1},
+		{1, 1, 0}, {-1, 1, 0}, {0, -1, 1}, {0, -1, -1},
+	}
+	for i, e := range edges {
+		edges[i] = e.Normalized()
+	}
+	return edges
+}()
+
+// HashGradient returns one of twelve unit vectors for the cell, as used for
+// the lattice gradients of Perlin style gradient noise
+func HashGradient(cell Int, seed uint32) Float64 {
+	return gradients[mathex.Hash3(cell.x, cell.y, cell.z, seed)>>28]
+}
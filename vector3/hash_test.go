@@ -0,0 +1,38 @@
+package vector3_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHash(t *testing.T) {
+	cell := vector3.New(3, -7, 12)
+	assert.Equal(t, vector3.HashRand(cell, 1), vector3.HashRand(cell, 1))
+	assert.NotEqual(t, vector3.HashRand(cell, 1), vector3.HashRand(cell, 2))
+	assert.NotEqual(t, vector3.HashFloat(cell, 1), vector3.HashFloat(vector3.New(12, -7, 3), 1))
+
+	mean := vector3.Zero[float64]()
+	directions := map[vector3.Float64]bool{}
+	for x := -10; x < 10; x++ {
+		for y := -10; y < 10; y++ {
+			for z := -10; z < 10; z++ {
+				cell := vector3.New(x, y, z)
+
+				r := vector3.HashRand(cell, 9)
+				assert.True(t, r.MinComponent() >= 0 && r.MaxComponent() < 1)
+
+				u := vector3.HashUnit(cell, 9)
+				assert.InDelta(t, 1, u.Length(), 1e-12)
+				mean = mean.Add(u)
+
+				g := vector3.HashGradient(cell, 9)
+				assert.InDelta(t, 1, g.Length(), 1e-12)
+				directions[g] = true
+			}
+		}
+	}
+	assert.InDelta(t, 0, mean.Length()/8000, 0.05)
+	assert.Len(t, directions, 12)
+}
@@ -0,0 +1,165 @@
+package vector3
+
+import (
+	"math"
+
+	"github.com/EliCDavis/vector"
+)
+
+// The Into variants below write their results to a caller provided
+// destination rather than allocating a new array, letting pipelines of bulk
+// operations reuse the same buffers from step to step. dst is grown only if
+// its capacity is too small, and the resized dst is returned. dst may be the
+// array being operated on.
+
+// resize returns dst with a length of n, reusing its backing array if it has
+// the capacity to do so
+func resize[T vector.Number](dst Array[T], n int) Array[T] {
+	if cap(dst) < n {
+		return make(Array[T], n)
+	}
+	return dst[:n]
+}
+
+// AddInto sets dst[i] to a[i] + b[i] for vectors of any component type,
+// returning dst resized to the length of the inputs. a and b must share a
+// length, and dst may be one of the inputs
+func AddInto[T vector.Number](dst Array[T], a, b []Vector[T]) Array[T] {
+	checkSliceLengths(len(a), len(a), len(b))
+	dst = resize(dst, len(a))
+	for i := range dst {
+		dst[i] = a[i].Add(b[i])
+	}
+	return dst
+}
+
+// SubInto sets dst[i] to a[i] - b[i] for vectors of any component type,
+// returning dst resized to the length of the inputs. a and b must share a
+// length, and dst may be one of the inputs
+func SubInto[T vector.Number](dst Array[T], a, b []Vector[T]) Array[T] {
+	checkSliceLengths(len(a), len(a), len(b))
+	dst = resize(dst, len(a))
+	for i := range dst {
+		dst[i] = a[i].Sub(b[i])
+	}
+	return dst
+}
+
+// AddInto adds other to every vector, writing the results to dst
+func (v3a Array[T]) AddInto(dst Array[T], other Vector[T]) Array[T] {
+	dst = resize(dst, len(v3a))
+	for i, v := range v3a {
+		dst[i] = v.Add(other)
+	}
+	return dst
+}
+
+// SubInto subtracts other from every vector, writing the results to dst
+func (v3a Array[T]) SubInto(dst Array[T], other Vector[T]) Array[T] {
+	dst = resize(dst, len(v3a))
+	for i, v := range v3a {
+		dst[i] = v.Sub(other)
+	}
+	return dst
+}
+
+// ScaleInto scales every vector by t, writing the results to dst
+func (v3a Array[T]) ScaleInto(dst Array[T], t float64) Array[T] {
+	dst = resize(dst, len(v3a))
+	for i, v := range v3a {
+		dst[i] = v.Scale(t)
+	}
+	return dst
+}
+
+// DivByConstantInto divides every vector by t, writing the results to dst
+func (v3a Array[T]) DivByConstantInto(dst Array[T], t float64) Array[T] {
+	dst = resize(dst, len(v3a))
+	for i, v := range v3a {
+		dst[i] = v.DivByConstant(t)
+	}
+	return dst
+}
+
+// NormalizedInto normalizes every vector, writing the results to dst
+func (v3a Array[T]) NormalizedInto(dst Array[T]) Array[T] {
+	dst = resize(dst, len(v3a))
+	for i, v := range v3a {
+		dst[i] = v.Normalized()
+	}
+	return dst
+}
+
+// ModifyInto applies f to every vector, writing the results to dst
+func (v3a Array[T]) ModifyInto(dst Array[T], f func(Vector[T]) Vector[T]) Array[T] {
+	dst = resize(dst, len(v3a))
+	for i, v := range v3a {
+		dst[i] = f(v)
+	}
+	return dst
+}
+
+// resizeComponents returns dst with a length of n, reusing its backing array
+// if it has the capacity to do so
+func resizeComponents[T vector.Number](dst []T, n int) []T {
+	if cap(dst) < n {
+		return make([]T, n)
+	}
+	return dst[:n]
+}
+
+// resized returns dst with room for n vectors, reusing its component slices
+// where they have the capacity to do so
+func (dst SoA[T]) resized(n int) SoA[T] {
+	return SoA[T]{
+		X: resizeComponents(dst.X, n),
+		Y: resizeComponents(dst.Y, n),
+		Z: resizeComponents(dst.Z, n),
+	}
+}
+
+// TranslateInto adds v to every vector, writing the results to dst
+func (s SoA[T]) TranslateInto(dst SoA[T], v Vector[T]) SoA[T] {
+	x, y, z := s.components()
+	dst = dst.resized(len(x))
+	dx, dy, dz := dst.components()
+	for i := range x {
+		dx[i] = x[i] + v.x
+		dy[i] = y[i] + v.y
+		dz[i] = z[i] + v.z
+	}
+	return dst
+}
+
+// ScaleInto multiplies every vector by t, writing the results to dst
+func (s SoA[T]) ScaleInto(dst SoA[T], t float64) SoA[T] {
+	x, y, z := s.components()
+	dst = dst.resized(len(x))
+	dx, dy, dz := dst.components()
+	for i := range x {
+		dx[i] = T(float64(x[i]) * t)
+		dy[i] = T(float64(y[i]) * t)
+		dz[i] = T(float64(z[i]) * t)
+	}
+	return dst
+}
+
+// NormalizeInto scales every vector to a length of 1, writing the results to
+// dst. Zero length vectors are copied unchanged rather than becoming NaN
+func (s SoA[T]) NormalizeInto(dst SoA[T]) SoA[T] {
+	x, y, z := s.components()
+	dst = dst.resized(len(x))
+	dx, dy, dz := dst.components()
+	for i := range x {
+		fx, fy, fz := float64(x[i]), float64(y[i]), float64(z[i])
+		l := math.Sqrt(fx*fx + fy*fy + fz*fz)
+		if l == 0 {
+			dx[i], dy[i], dz[i] = x[i], y[i], z[i]
+			continue
+		}
+		dx[i] = T(fx / l)
+		dy[i] = T(fy / l)
+		dz[i] = T(fz / l)
+	}
+	return dst
+}
@@ -0,0 +1,80 @@
+package vector3_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIntoReusesDestination(t *testing.T) {
+	src := vector3.Array[int]{vector3.New(1, 2, 3), vector3.New(4, 5, 6)}
+	dst := make(vector3.Array[int], 0, 4)
+
+	out := src.AddInto(dst, vector3.One[int]())
+	assert.Equal(t, vector3.Array[int]{vector3.New(2, 3, 4), vector3.New(5, 6, 7)}, out)
+	assert.Same(t, &dst[:1][0], &out[0])
+
+	out = out.ScaleInto(out, 2)
+	assert.Equal(t, vector3.Array[int]{vector3.New(4, 6, 8), vector3.New(10, 12, 14)}, out)
+
+	out = vector3.SubInto(out, out, src)
+	assert.Equal(t, vector3.Array[int]{vector3.New(3, 4, 5), vector3.New(6, 7, 8)}, out)
+
+	// A destination that's too small is replaced
+	small := src.AddInto(nil, vector3.Zero[int]())
+	assert.Equal(t, src, small)
+
+	assert.Panics(t, func() { vector3.AddInto(nil, src, src[:1]) })
+}
+
+func TestArrayPool(t *testing.T) {
+	var pool vector3.ArrayPool[float64]
+	src := vector3.Array[float64]{vector3.New(3., 0., 4.), vector3.New(0., 2., 0.)}
+
+	scratch := pool.Get(len(src))
+	assert.Len(t, scratch, 2)
+
+	out := src.NormalizedInto(scratch)
+	assert.Equal(t, vector3.Array[float64]{vector3.New(0.6, 0., 0.8), vector3.Up[float64]()}, out)
+	pool.Put(out)
+
+	assert.Len(t, pool.Get(1), 1)
+}
+
+func TestArrayPoolDoesNotAllocate(t *testing.T) {
+	var pool vector3.ArrayPool[float64]
+	pool.Put(pool.Get(16))
+
+	allocs := testing.AllocsPerRun(100, func() {
+		pool.Put(pool.Get(16))
+	})
+	assert.Equal(t, 0., allocs)
+}
+
+func BenchmarkIntoPipeline(b *testing.B) {
+	src := make(vector3.Float64Array, 1024)
+	var pool vector3.ArrayPool[float64]
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		scratch := pool.Get(len(src))
+		scratch = src.AddInto(scratch, vector3.One[float64]())
+		scratch = scratch.ScaleInto(scratch, 2)
+		pool.Put(scratch)
+	}
+}
+
+func TestSoAInto(t *testing.T) {
+	src := vector3.Array[float64]{vector3.New(3., 0., 4.), vector3.Zero[float64]()}.SoA()
+	dst := vector3.NewSoA[float64](0)
+
+	dst = src.TranslateInto(dst, vector3.One[float64]())
+	assert.Equal(t, vector3.Array[float64]{vector3.New(4., 1., 5.), vector3.One[float64]()}, dst.Array())
+	assert.Equal(t, vector3.New(3., 0., 4.), src.At(0))
+
+	dst = src.NormalizeInto(dst)
+	assert.Equal(t, vector3.Array[float64]{vector3.New(0.6, 0., 0.8), vector3.Zero[float64]()}, dst.Array())
+
+	dst = dst.ScaleInto(dst, 10)
+	assert.Equal(t, vector3.Array[float64]{vector3.New(6., 0., 8.), vector3.Zero[float64]()}, dst.Array())
+}
@@ -0,0 +1,138 @@
+package vector3_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnmarshalJSONArray(t *testing.T) {
+	var out vector3.Float64
+	assert.NoError(t, json.Unmarshal([]byte(" [1.5,-2.5,3.25]"), &out))
+	assert.Equal(t, vector3.New(1.5, -2.5, 3.25), out)
+}
+
+func TestUnmarshalJSONArrayWrongLength(t *testing.T) {
+	var out vector3.Float64
+	assert.Error(t, json.Unmarshal([]byte("[1.5,-2.5]"), &out))
+	assert.Error(t, json.Unmarshal([]byte("[1.5,-2.5,3.25,1]"), &out))
+	assert.Error(t, json.Unmarshal([]byte("[\"a\"]"), &out))
+	assert.Equal(t, vector3.Zero[float64](), out)
+}
+
+func TestArrayJSON(t *testing.T) {
+	type mesh struct {
+		Position vector3.ArrayJSON[float64] `json:"position"`
+	}
+
+	in := mesh{Position: vector3.New(1.5, -2.5, 3.25).ArrayJSON()}
+
+	data, err := json.Marshal(in)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"position":[1.5,-2.5,3.25]}`, string(data))
+
+	var out mesh
+	assert.NoError(t, json.Unmarshal(data, &out))
+	assert.Equal(t, in.Position.Vector(), out.Position.Vector())
+
+	var fromObject vector3.ArrayJSON[float64]
+	objectData, err := json.Marshal(in.Position.Vector())
+	assert.NoError(t, err)
+	assert.NoError(t, json.Unmarshal(objectData, &fromObject))
+	assert.Equal(t, in.Position, fromObject)
+}
+
+func TestJSONInt64Precision(t *testing.T) {
+	in := vector3.Fill[int64](9007199254740993)
+
+	data, err := json.Marshal(in)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"x":9007199254740993,"y":9007199254740993,"z":9007199254740993}`, string(data))
+
+	var out vector3.Int64
+	assert.NoError(t, json.Unmarshal(data, &out))
+	assert.Equal(t, in, out)
+
+	var fromArray vector3.Int64
+	assert.NoError(t, json.Unmarshal([]byte("[9007199254740993,9007199254740993,9007199254740993]"), &fromArray))
+	assert.Equal(t, in, fromArray)
+}
+
+func TestJSONIntLenientDecoding(t *testing.T) {
+	var out vector3.Int
+	assert.NoError(t, json.Unmarshal([]byte(`{"x":1.7,"Y":2e2}`), &out))
+	assert.Equal(t, vector3.Zero[int]().SetX(1).SetY(200), out)
+
+	var overflow vector3.Int8
+	assert.Error(t, json.Unmarshal([]byte(`{"x":300}`), &overflow))
+}
+
+func TestJSONFloatFormatting(t *testing.T) {
+	var v vector3.Float64
+	assert.NoError(t, json.Unmarshal([]byte(`{"x":1e-7,"y":1e+21,"z":0.5}`), &v))
+
+	data, err := json.Marshal(v)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"x":1e-7,"y":1e+21,"z":0.5}`, string(data))
+}
+
+func TestJSONNaN(t *testing.T) {
+	_, err := json.Marshal(vector3.Fill(math.NaN()))
+	assert.Error(t, err)
+}
+
+func TestAppendJSON(t *testing.T) {
+	v := vector3.New(1.5, -2., 3.)
+
+	data, err := v.AppendJSON([]byte("v="))
+	assert.NoError(t, err)
+	assert.Equal(t, `v={"x":1.5,"y":-2,"z":3}`, string(data))
+
+	buf := make([]byte, 0, 128)
+	allocs := testing.AllocsPerRun(100, func() {
+		buf, _ = v.AppendJSON(buf[:0])
+	})
+	assert.Zero(t, allocs)
+}
+
+func TestAppendJSONSlice(t *testing.T) {
+	vectors := []vector3.Float64{vector3.New(1.5, -2., 3.), vector3.Zero[float64]()}
+
+	want, err := json.Marshal(vectors)
+	assert.NoError(t, err)
+
+	got, err := vector3.AppendJSONSlice(nil, vectors)
+	assert.NoError(t, err)
+	assert.Equal(t, string(want), string(got))
+
+	empty, err := vector3.AppendJSONSlice[float64](nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "[]", string(empty))
+}
+
+func TestWriteJSON(t *testing.T) {
+	vectors := make([]vector3.Float64, 5000)
+	for i := range vectors {
+		vectors[i] = vector3.Fill(float64(i) / 3)
+	}
+
+	want, err := vector3.AppendJSONSlice(nil, vectors)
+	assert.NoError(t, err)
+
+	buf := &bytes.Buffer{}
+	assert.NoError(t, vector3.WriteJSON(buf, vectors))
+	assert.Equal(t, string(want), buf.String())
+
+	var back []vector3.Float64
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &back))
+	assert.Equal(t, vectors, back)
+}
+
+func TestWriteJSONNaN(t *testing.T) {
+	err := vector3.WriteJSON(&bytes.Buffer{}, []vector3.Float64{vector3.Fill(math.NaN())})
+	assert.Error(t, err)
+}
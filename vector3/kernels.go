@@ -0,0 +1,105 @@
+package vector3
+
+import (
+	"unsafe"
+
+	"github.com/EliCDavis/vector/internal/simd"
+)
+
+// The slice kernels below operate on whole slices of floating point vectors
+// at once, treating them as flat runs of components. They use AVX2 on amd64
+// machines that support it and NEON on arm64.
+
+// flatten views the vectors as a flat slice of their components. Vector is
+// made up of three fields of the same type, so there's no padding between or
+// after them
+func flatten[T float32 | float64](v []Vector[T]) []T {
+	if len(v) == 0 {
+		return nil
+	}
+	return unsafe.Slice(&v[0].x, len(v)*componentCount)
+}
+
+type elementKernels struct {
+	f64 func(dst, a, b []float64)
+	f32 func(dst, a, b []float32)
+}
+
+func (k elementKernels) run(dst, a, b any) {
+	switch d := dst.(type) {
+	case []Vector[float64]:
+		k.f64(flatten(d), flatten(a.([]Vector[float64])), flatten(b.([]Vector[float64])))
+	case []Vector[float32]:
+		k.f32(flatten(d), flatten(a.([]Vector[float32])), flatten(b.([]Vector[float32])))
+	}
+}
+
+func checkSliceLengths(dst, a, b int) {
+	if dst != a || dst != b {
+		panic("vector3: slices must have the same length")
+	}
+}
+
+// AddSlices sets dst[i] to a[i] + b[i]. The slices must share a length, and
+// dst may be one of the inputs
+func AddSlices[T float32 | float64](dst, a, b []Vector[T]) {
+	checkSliceLengths(len(dst), len(a), len(b))
+	elementKernels{simd.AddFloat64, simd.AddFloat32}.run(dst, a, b)
+}
+
+// SubSlices sets dst[i] to a[i] - b[i]. The slices must share a length, and
+// dst may be one of the inputs
+func SubSlices[T float32 | float64](dst, a, b []Vector[T]) {
+	checkSliceLengths(len(dst), len(a), len(b))
+	elementKernels{simd.SubFloat64, simd.SubFloat32}.run(dst, a, b)
+}
+
+// MulSlices sets dst[i] to the component-wise product of a[i] and b[i]. The
+// slices must share a length, and dst may be one of the inputs
+func MulSlices[T float32 | float64](dst, a, b []Vector[T]) {
+	checkSliceLengths(len(dst), len(a), len(b))
+	elementKernels{simd.MulFloat64, simd.MulFloat32}.run(dst, a, b)
+}
+
+// DotSlices sets dst[i] to the dot product of a[i] and b[i], computed in the
+// native precision of T. The slices must share a length
+func DotSlices[T float32 | float64](dst []T, a, b []Vector[T]) {
+	checkSliceLengths(len(dst), len(a), len(b))
+	switch d := any(dst).(type) {
+	case []float64:
+		simd.Dot3Float64(d, any(flatten(a)).([]float64), any(flatten(b)).([]float64))
+	case []float32:
+		simd.Dot3Float32(d, any(flatten(a)).([]float32), any(flatten(b)).([]float32))
+	}
+}
+
+// NormalizeSlice sets dst[i] to src[i] scaled to a length of 1. Zero length
+// vectors are left as zero rather than becoming NaN. The slices must share a
+// length, and dst may be src
+func NormalizeSlice[T float32 | float64](dst, src []Vector[T]) {
+	checkSliceLengths(len(dst), len(src), len(src))
+	switch d := any(flatten(dst)).(type) {
+	case []float64:
+		simd.Normalize3Float64(d, any(flatten(src)).([]float64))
+	case []float32:
+		simd.Normalize3Float32(d, any(flatten(src)).([]float32))
+	}
+}
+
+// MinMaxSlice returns the component-wise minimum and maximum of the vectors.
+// An empty slice results in two zero vectors
+func MinMaxSlice[T float32 | float64](src []Vector[T]) (vmin, vmax Vector[T]) {
+	if len(src) == 0 {
+		return
+	}
+
+	var lo, hi any
+	switch s := any(flatten(src)).(type) {
+	case []float64:
+		lo, hi = simd.MinMax3Float64(s)
+	case []float32:
+		lo, hi = simd.MinMax3Float32(s)
+	}
+	l, h := lo.([3]T), hi.([3]T)
+	return Vector[T]{l[0], l[1], l[2]}, Vector[T]{h[0], h[1], h[2]}
+}
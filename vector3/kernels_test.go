@@ -0,0 +1,99 @@
+package vector3_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+)
+
+func randomSlice(r *rand.Rand, n int) []vector3.Float64 {
+	out := make([]vector3.Float64, n)
+	for i := range out {
+		out[i] = vector3.RandRange(r, -10., 10.)
+	}
+	return out
+}
+
+func TestElementKernels(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	a, b := randomSlice(r, 13), randomSlice(r, 13)
+
+	sum := make([]vector3.Float64, len(a))
+	vector3.AddSlices(sum, a, b)
+
+	diff := make([]vector3.Float64, len(a))
+	vector3.SubSlices(diff, a, b)
+
+	prod := make([]vector3.Float64, len(a))
+	vector3.MulSlices(prod, a, b)
+
+	for i := range a {
+		assert.Equal(t, a[i].Add(b[i]), sum[i])
+		assert.Equal(t, a[i].Sub(b[i]), diff[i])
+		assert.Equal(t, a[i].MultByVector(b[i]), prod[i])
+	}
+}
+
+func TestElementKernelsFloat32(t *testing.T) {
+	a := []vector3.Float32{vector3.New[float32](1, 2, 3), vector3.New[float32](4, 5, 6), vector3.New[float32](7, 8, 9)}
+	b := []vector3.Float32{vector3.Fill[float32](1), vector3.Fill[float32](2), vector3.Fill[float32](3)}
+
+	vector3.AddSlices(a, a, b)
+	assert.Equal(t, []vector3.Float32{vector3.New[float32](2, 3, 4), vector3.New[float32](6, 7, 8), vector3.New[float32](10, 11, 12)}, a)
+
+	vector3.MulSlices(a, a, b)
+	assert.Equal(t, []vector3.Float32{vector3.New[float32](2, 3, 4), vector3.New[float32](12, 14, 16), vector3.New[float32](30, 33, 36)}, a)
+}
+
+func TestDotSlices(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	a, b := randomSlice(r, 10), randomSlice(r, 10)
+
+	dots := make([]float64, len(a))
+	vector3.DotSlices(dots, a, b)
+	for i := range a {
+		assert.InDelta(t, a[i].Dot(b[i]), dots[i], 1e-12)
+	}
+}
+
+func TestNormalizeSlice(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	src := append(randomSlice(r, 10), vector3.Zero[float64]())
+
+	dst := make([]vector3.Float64, len(src))
+	vector3.NormalizeSlice(dst, src)
+	for i, v := range src[:10] {
+		assert.InDelta(t, 0, v.Normalized().Distance(dst[i]), 1e-12)
+	}
+	assert.Equal(t, vector3.Zero[float64](), dst[10])
+}
+
+func TestMinMaxSlice(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	src := randomSlice(r, 50)
+
+	wantMin, wantMax := vector3.Array[float64](src).Bounds()
+	gotMin, gotMax := vector3.MinMaxSlice(src)
+	assert.Equal(t, wantMin, gotMin)
+	assert.Equal(t, wantMax, gotMax)
+
+	emptyMin, emptyMax := vector3.MinMaxSlice[float32](nil)
+	assert.Equal(t, vector3.Zero[float32](), emptyMin)
+	assert.Equal(t, vector3.Zero[float32](), emptyMax)
+}
+
+func TestKernelsLengthMismatch(t *testing.T) {
+	assert.PanicsWithValue(t, "vector3: slices must have the same length", func() {
+		vector3.AddSlices(make([]vector3.Float64, 2), make([]vector3.Float64, 3), make([]vector3.Float64, 2))
+	})
+}
+
+func BenchmarkAddSlices(b *testing.B) {
+	a := make([]vector3.Float32, 100_000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		vector3.AddSlices(a, a, a)
+	}
+}
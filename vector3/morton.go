@@ -0,0 +1,133 @@
+package vector3
+
+import (
+	"sort"
+
+	"github.com/EliCDavis/vector"
+)
+
+// mortonBits is the number of bits each axis is quantized to, allowing all
+// three to be interleaved into a single 63 bit code
+const mortonBits = 21
+
+// spreadBits inserts two zero bits between each of the lower 21 bits of v
+func spreadBits(v uint64) uint64 {
+	v &= 0x1fffff
+	v = (v | v<<32) & 0x1f00000000ffff
+	v = (v | v<<16) & 0x1f0000ff0000ff
+	v = (v | v<<8) & 0x100f00f00f00f00f
+	v = (v | v<<4) & 0x10c30c30c30c30c3
+	v = (v | v<<2) & 0x1249249249249249
+	return v
+}
+
+// compactBits reverses spreadBits, gathering every third bit of v into the
+// lower 21 bits of the result
+func compactBits(v uint64) uint64 {
+	v &= 0x1249249249249249
+	v = (v | v>>2) & 0x10c30c30c30c30c3
+	v = (v | v>>4) & 0x100f00f00f00f00f
+	v = (v | v>>8) & 0x1f0000ff0000ff
+	v = (v | v>>16) & 0x1f00000000ffff
+	v = (v | v>>32) & 0x1fffff
+	return v
+}
+
+// quantize maps v from the range [vmin, vmax] to an integer in the range
+// [0, 2^mortonBits), clamping values that fall outside it
+func quantize(v, vmin, vmax float64) uint64 {
+	if vmax <= vmin {
+		return 0
+	}
+	const cells = 1 << mortonBits
+	t := (v - vmin) / (vmax - vmin) * cells
+	if !(t > 0) {
+		return 0
+	}
+	if t >= cells {
+		return cells - 1
+	}
+	return uint64(t)
+}
+
+// MortonCode computes the Morton (Z-order) code of v within the bounds
+// [vmin, vmax]. Points that are near each other in space tend to have codes
+// that are near each other, making the code useful for ordering points to
+// improve locality. Points outside the bounds are clamped to them
+func MortonCode[T vector.Number](v, vmin, vmax Vector[T]) uint64 {
+	x := quantize(float64(v.x), float64(vmin.x), float64(vmax.x))
+	y := quantize(float64(v.y), float64(vmin.y), float64(vmax.y))
+	z := quantize(float64(v.z), float64(vmin.z), float64(vmax.z))
+	return spreadBits(x) | spreadBits(y)<<1 | spreadBits(z)<<2
+}
+
+// MortonOrder returns the permutation of indices that orders the vectors by
+// their Morton code within the bounds [vmin, vmax], leaving the vectors
+// themselves untouched. Vectors with the same code keep their relative order
+func MortonOrder[T vector.Number](vectors []Vector[T], vmin, vmax Vector[T]) []int {
+	codes := make([]uint64, len(vectors))
+	order := make([]int, len(vectors))
+	for i, v := range vectors {
+		codes[i] = MortonCode(v, vmin, vmax)
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return codes[order[i]] < codes[order[j]]
+	})
+	return order
+}
+
+// MortonSort sorts the vectors in place by their Morton code within their own
+// bounds, improving cache coherency for neighbor queries and BVH builds
+// performed over them afterwards
+func MortonSort[T vector.Number](vectors []Vector[T]) {
+	vmin, vmax := Array[T](vectors).Bounds()
+	order := MortonOrder(vectors, vmin, vmax)
+
+	sorted := make([]Vector[T], len(vectors))
+	for i, idx := range order {
+		sorted[i] = vectors[idx]
+	}
+	copy(vectors, sorted)
+}
+
+// MortonEncode interleaves the bits of the voxel coordinate into a Morton
+// (Z-order) code suitable for use as an octree key. Only the lower 21 bits of
+// each component are used, so components should fall within [0, 2^21),
+// offsetting negative coordinates beforehand if needed
+func MortonEncode[T vector.Integer](v Vector[T]) uint64 {
+	return spreadBits(uint64(v.x)) | spreadBits(uint64(v.y))<<1 | spreadBits(uint64(v.z))<<2
+}
+
+// MortonDecode recovers the voxel coordinate encoded by MortonEncode
+func MortonDecode[T vector.Integer](code uint64) Vector[T] {
+	return Vector[T]{
+		x: T(compactBits(code)),
+		y: T(compactBits(code >> 1)),
+		z: T(compactBits(code >> 2)),
+	}
+}
+
+// MortonVoxelOrder returns the permutation of indices that orders the voxels
+// by their Morton code, leaving the voxels themselves untouched. Voxels with
+// the same code keep their relative order
+func MortonVoxelOrder[T vector.Integer](voxels []Vector[T]) []int {
+	codes := make([]uint64, len(voxels))
+	order := make([]int, len(voxels))
+	for i, v := range voxels {
+		codes[i] = MortonEncode(v)
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return codes[order[i]] < codes[order[j]]
+	})
+	return order
+}
+
+// MortonSortVoxels sorts the voxels in place by their Morton code, giving a
+// cache friendly Z-order layout
+func MortonSortVoxels[T vector.Integer](voxels []Vector[T]) {
+	sort.SliceStable(voxels, func(i, j int) bool {
+		return MortonEncode(voxels[i]) < MortonEncode(voxels[j])
+	})
+}
@@ -0,0 +1,77 @@
+package vector3_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMortonCode(t *testing.T) {
+	vmin, vmax := vector3.Zero[float64](), vector3.One[float64]()
+
+	assert.Equal(t, uint64(0), vector3.MortonCode(vmin, vmin, vmax))
+	assert.Equal(t, uint64(1<<63-1), vector3.MortonCode(vmax, vmin, vmax))
+
+	// The highest bit of each axis lands in the top three bits, x first
+	assert.Equal(t, uint64(1)<<60, vector3.MortonCode(vector3.New(0.5, 0., 0.), vmin, vmax))
+	assert.Equal(t, uint64(1)<<61, vector3.MortonCode(vector3.New(0., 0.5, 0.), vmin, vmax))
+	assert.Equal(t, uint64(1)<<62, vector3.MortonCode(vector3.New(0., 0., 0.5), vmin, vmax))
+
+	// Out of bounds points are clamped
+	assert.Equal(t, uint64(0), vector3.MortonCode(vector3.Fill(-2.), vmin, vmax))
+
+	// Degenerate bounds collapse the axis
+	assert.Equal(t, uint64(0), vector3.MortonCode(vector3.Fill(0.3), vmax, vmax))
+}
+
+func TestMortonSort(t *testing.T) {
+	points := []vector3.Float64{
+		vector3.New(1., 1., 1.),
+		vector3.New(0., 0., 0.),
+		vector3.New(1., 0., 0.),
+		vector3.New(0., 1., 0.),
+	}
+
+	assert.Equal(t, []int{1, 2, 3, 0}, vector3.MortonOrder(points, vector3.Zero[float64](), vector3.One[float64]()))
+
+	vector3.MortonSort(points)
+	assert.Equal(t, []vector3.Float64{
+		vector3.New(0., 0., 0.),
+		vector3.New(1., 0., 0.),
+		vector3.New(0., 1., 0.),
+		vector3.New(1., 1., 1.),
+	}, points)
+}
+
+func TestMortonEncodeDecode(t *testing.T) {
+	assert.Equal(t, uint64(0b111), vector3.MortonEncode(vector3.One[int]()))
+	assert.Equal(t, uint64(0b100_010_001_000), vector3.MortonEncode(vector3.New(2, 4, 8)))
+
+	for _, v := range []vector3.Int{
+		vector3.Zero[int](),
+		vector3.New(1, 2, 3),
+		vector3.New(1<<21-1, 0, 12345),
+		vector3.Fill(1<<21 - 1),
+	} {
+		assert.Equal(t, v, vector3.MortonDecode[int](vector3.MortonEncode(v)))
+	}
+}
+
+func TestMortonSortVoxels(t *testing.T) {
+	voxels := []vector3.Int{
+		vector3.New(1, 1, 1),
+		vector3.New(0, 0, 1),
+		vector3.New(1, 0, 0),
+		vector3.New(0, 0, 0),
+	}
+	assert.Equal(t, []int{3, 2, 1, 0}, vector3.MortonVoxelOrder(voxels))
+
+	vector3.MortonSortVoxels(voxels)
+	assert.Equal(t, []vector3.Int{
+		vector3.New(0, 0, 0),
+		vector3.New(1, 0, 0),
+		vector3.New(0, 0, 1),
+		vector3.New(1, 1, 1),
+	}, voxels)
+}
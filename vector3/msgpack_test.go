@@ -0,0 +1,118 @@
+package vector3_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+)
+
+type msgpackTestCase[T vector.Number] struct {
+	val vector3.Vector[T]
+}
+
+func (tc msgpackTestCase[T]) test(t *testing.T) {
+	data, err := tc.val.MarshalMsg(nil)
+	assert.NoError(t, err)
+	assert.LessOrEqual(t, len(data), tc.val.Msgsize())
+
+	var back vector3.Vector[T]
+	rest, err := back.UnmarshalMsg(data)
+	assert.NoError(t, err)
+	assert.Empty(t, rest)
+	assert.Equal(t, tc.val, back)
+}
+
+func TestMsgpack(t *testing.T) {
+	tests := map[string]testCaseI{
+		"float64": msgpackTestCase[float64]{
+			val: vector3.New[float64](1, -2, 300),
+		},
+		"float32": msgpackTestCase[float32]{
+			val: vector3.New[float32](1, -2, 300),
+		},
+		"int8": msgpackTestCase[int8]{
+			val: vector3.New[int8](1, -2, 3),
+		},
+		"int16": msgpackTestCase[int16]{
+			val: vector3.New[int16](1, -2, 300),
+		},
+		"int32": msgpackTestCase[int32]{
+			val: vector3.New[int32](1, -2, 300),
+		},
+		"int64": msgpackTestCase[int64]{
+			val: vector3.New[int64](1, -2, 300),
+		},
+		"int": msgpackTestCase[int]{
+			val: vector3.New[int](1, -2, 300),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tc.test(t)
+		})
+	}
+}
+
+func TestMsgpackLayout(t *testing.T) {
+	data, err := vector3.New(1, -128, 300).MarshalMsg(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x93, 0x01, 0xd0, 0x80, 0xd1, 0x01, 0x2c}, data)
+}
+
+func TestMsgpackStream(t *testing.T) {
+	a := vector3.Fill(1.5)
+	b := vector3.Fill(-2.5)
+
+	data, err := a.MarshalMsg(nil)
+	assert.NoError(t, err)
+	data, err = b.MarshalMsg(data)
+	assert.NoError(t, err)
+
+	var gotA, gotB vector3.Float64
+	data, err = gotA.UnmarshalMsg(data)
+	assert.NoError(t, err)
+	data, err = gotB.UnmarshalMsg(data)
+	assert.NoError(t, err)
+
+	assert.Empty(t, data)
+	assert.Equal(t, a, gotA)
+	assert.Equal(t, b, gotB)
+}
+
+func TestMsgpackIntoFloat(t *testing.T) {
+	data, err := vector3.New(1, -128, 300).MarshalMsg(nil)
+	assert.NoError(t, err)
+
+	var back vector3.Float32
+	_, err = back.UnmarshalMsg(data)
+	assert.NoError(t, err)
+	assert.Equal(t, vector3.New[float32](1, -128, 300), back)
+}
+
+func TestMsgpackErrors(t *testing.T) {
+	floats, err := vector3.Fill(1.5).MarshalMsg(nil)
+	assert.NoError(t, err)
+
+	large, err := vector3.Fill(1000).MarshalMsg(nil)
+	assert.NoError(t, err)
+
+	tests := map[string][]byte{
+		"empty":          nil,
+		"not an array":   {0x01},
+		"wrong length":   {0x91, 0x01},
+		"truncated":      large[:len(large)-1],
+		"float into int": floats,
+		"overflow":       large,
+	}
+
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			var v vector3.Int8
+			_, err := v.UnmarshalMsg(data)
+			assert.Error(t, err)
+		})
+	}
+}
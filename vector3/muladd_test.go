@@ -0,0 +1,32 @@
+package vector3_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMulAdd(t *testing.T) {
+	a := vector3.New(1., 2., 3.)
+	b := vector3.New(0.5, -1., 2.)
+
+	assert.Equal(t, vector3.New(2., 0., 7.), a.MulAdd(b, 2))
+	assert.Equal(t, vector3.New(3., 4., 9.5), a.ScaleAdd(2.5, b))
+
+	v := a
+	v.MulAddAssign(b, 2)
+	assert.Equal(t, a.MulAdd(b, 2), v)
+
+	v = a
+	v.ScaleAddAssign(2.5, b)
+	assert.Equal(t, a.ScaleAdd(2.5, b), v)
+}
+
+func TestMulAddSingleRounding(t *testing.T) {
+	// -1 + (1+2^-30)(1-2^-30) is lost entirely when the product is rounded
+	// before the addition
+	e := 1. / (1 << 30)
+	got := vector3.Fill(-1.).MulAdd(vector3.Fill(1+e), 1-e)
+	assert.Equal(t, vector3.Fill(-e*e), got)
+}
@@ -0,0 +1,78 @@
+package vector3
+
+// neighborOffsets lists the offsets to every cell surrounding a voxel,
+// ordered so that the 6 face neighbors come first, followed by the 12 edge
+// neighbors, and then the 8 corner neighbors. Taking a prefix of the table
+// gives the 6, 18, or 26 connected neighborhood
+var neighborOffsets = func() (offsets [26][3]int8) {
+	i := 0
+	for nonZero := 1; nonZero <= 3; nonZero++ {
+		for z := int8(-1); z <= 1; z++ {
+			for y := int8(-1); y <= 1; y++ {
+				for x := int8(-1); x <= 1; x++ {
+					if x*x+y*y+z*z == int8(nonZero) {
+						offsets[i] = [3]int8{x, y, z}
+						i++
+					}
+				}
+			}
+		}
+	}
+	return
+}()
+
+func (v Vector[T]) neighbor(i int) Vector[T] {
+	o := neighborOffsets[i]
+	return Vector[T]{
+		x: v.x + T(o[0]),
+		y: v.y + T(o[1]),
+		z: v.z + T(o[2]),
+	}
+}
+
+// Neighbors6 returns the 6 voxels that share a face with this one
+func (v Vector[T]) Neighbors6() (out [6]Vector[T]) {
+	for i := range out {
+		out[i] = v.neighbor(i)
+	}
+	return
+}
+
+// Neighbors18 returns the 18 voxels that share a face or an edge with this
+// one. The first 6 are the face neighbors returned by Neighbors6
+func (v Vector[T]) Neighbors18() (out [18]Vector[T]) {
+	for i := range out {
+		out[i] = v.neighbor(i)
+	}
+	return
+}
+
+// Neighbors26 returns all 26 voxels surrounding this one. The first 18 are
+// the neighbors returned by Neighbors18
+func (v Vector[T]) Neighbors26() (out [26]Vector[T]) {
+	for i := range out {
+		out[i] = v.neighbor(i)
+	}
+	return
+}
+
+// VisitNeighbors calls visit for each of the 6, 18, or 26 connected
+// neighbors of the voxel that fall within the grid spanning [vmin, vmax),
+// stopping early if visit returns false. It panics if connectivity isn't 6,
+// 18, or 26
+func (v Vector[T]) VisitNeighbors(connectivity int, vmin, vmax Vector[T], visit func(Vector[T]) bool) {
+	if connectivity != 6 && connectivity != 18 && connectivity != 26 {
+		panic("vector3: connectivity must be 6, 18, or 26")
+	}
+
+	for i := 0; i < connectivity; i++ {
+		n := v.neighbor(i)
+		if n.x < vmin.x || n.y < vmin.y || n.z < vmin.z ||
+			n.x >= vmax.x || n.y >= vmax.y || n.z >= vmax.z {
+			continue
+		}
+		if !visit(n) {
+			return
+		}
+	}
+}
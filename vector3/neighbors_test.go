@@ -0,0 +1,55 @@
+package vector3_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNeighbors(t *testing.T) {
+	v := vector3.New(5, 5, 5)
+
+	six := v.Neighbors6()
+	assert.Contains(t, six, vector3.New(4, 5, 5))
+	assert.Contains(t, six, vector3.New(5, 5, 6))
+	for _, n := range six {
+		assert.Equal(t, 1, n.Sub(v).LengthSquared())
+	}
+
+	eighteen := v.Neighbors18()
+	assert.Equal(t, six[:], eighteen[:6])
+	for _, n := range eighteen[6:] {
+		assert.Equal(t, 2, n.Sub(v).LengthSquared())
+	}
+
+	all := v.Neighbors26()
+	assert.Equal(t, eighteen[:], all[:18])
+	seen := map[vector3.Int]bool{}
+	for _, n := range all {
+		assert.NotEqual(t, v, n)
+		assert.Equal(t, 1, n.Sub(v).Abs().MaxComponent())
+		seen[n] = true
+	}
+	assert.Len(t, seen, 26)
+}
+
+func TestVisitNeighbors(t *testing.T) {
+	var visited []vector3.Int
+	vector3.Zero[int]().VisitNeighbors(26, vector3.Zero[int](), vector3.Fill(10), func(n vector3.Int) bool {
+		visited = append(visited, n)
+		return true
+	})
+	assert.Len(t, visited, 7)
+
+	count := 0
+	vector3.Fill(5).VisitNeighbors(6, vector3.Zero[int](), vector3.Fill(10), func(n vector3.Int) bool {
+		count++
+		return count < 3
+	})
+	assert.Equal(t, 3, count)
+
+	assert.Panics(t, func() {
+		vector3.Zero[int]().VisitNeighbors(8, vector3.Zero[int](), vector3.One[int](), nil)
+	})
+}
@@ -0,0 +1,155 @@
+package vector3
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/EliCDavis/vector"
+)
+
+// NormalWeighting controls how much each triangle contributes to the normals
+// of the vertices it shares
+type NormalWeighting int
+
+const (
+	// AreaWeighted weights each triangle by its area, so large triangles
+	// dominate the normals of the vertices they share
+	AreaWeighted NormalWeighting = iota
+
+	// AngleWeighted weights each triangle by the angle of its corner at the
+	// vertex, making the result independent of how the surface around the
+	// vertex happens to be tessellated
+	AngleWeighted
+)
+
+// checkTriangleIndices validates that indices describe whole triangles
+// referencing vertices within range
+func checkTriangleIndices(indices []int, vertices int) error {
+	if len(indices)%3 != 0 {
+		return fmt.Errorf("vector3: %d indices do not describe whole triangles", len(indices))
+	}
+	for _, i := range indices {
+		if i < 0 || i >= vertices {
+			return fmt.Errorf("vector3: index %d out of range for %d vertices", i, vertices)
+		}
+	}
+	return nil
+}
+
+// cornerWeights returns how much a triangle contributes to the normal of each
+// of its corners, on top of the area weighting already present in its
+// unnormalized face normal
+func cornerWeights(a, b, c Vector[float64], weighting NormalWeighting) [3]float64 {
+	if weighting == AreaWeighted {
+		return [3]float64{1, 1, 1}
+	}
+	return [3]float64{
+		b.Sub(a).Angle(c.Sub(a)),
+		c.Sub(b).Angle(a.Sub(b)),
+		a.Sub(c).Angle(b.Sub(c)),
+	}
+}
+
+// VertexNormals computes a smooth unit normal for every vertex by averaging
+// the normals of the triangles that use it, weighted as requested. Triangles
+// are described by indices, three per triangle, into positions. Vertices not
+// used by any non-degenerate triangle are given a zero normal
+func VertexNormals[T vector.Number](positions []Vector[T], indices []int, weighting NormalWeighting) ([]Vector[float64], error) {
+	if err := checkTriangleIndices(indices, len(positions)); err != nil {
+		return nil, err
+	}
+
+	normals := make([]Vector[float64], len(positions))
+	for tri := 0; tri < len(indices); tri += 3 {
+		corners := [3]int{indices[tri], indices[tri+1], indices[tri+2]}
+		a, b, c := positions[corners[0]].ToFloat64(), positions[corners[1]].ToFloat64(), positions[corners[2]].ToFloat64()
+
+		// The unnormalized cross product is already proportional to area
+		n := b.Sub(a).Cross(c.Sub(a))
+		if n.LengthSquared() == 0 {
+			continue
+		}
+		if weighting == AngleWeighted {
+			n = n.Normalized()
+		}
+
+		weights := cornerWeights(a, b, c, weighting)
+		for i, corner := range corners {
+			normals[corner] = normals[corner].Add(n.Scale(weights[i]))
+		}
+	}
+
+	for i := range normals {
+		normals[i] = normalizeOrZero(normals[i])
+	}
+	return normals, nil
+}
+
+// CornerNormals computes a normal for every corner of every triangle,
+// returning one normal per index. Each corner averages the normals of the
+// triangles sharing its vertex, but only those whose face normal is within
+// hardAngle radians of the corner's own triangle, so that edges sharper than
+// hardAngle stay hard while shallower ones are smoothed. Corners of
+// degenerate triangles are given a zero normal
+func CornerNormals[T vector.Number](positions []Vector[T], indices []int, weighting NormalWeighting, hardAngle float64) ([]Vector[float64], error) {
+	if err := checkTriangleIndices(indices, len(positions)); err != nil {
+		return nil, err
+	}
+
+	triangles := len(indices) / 3
+	faceNormals := make([]Vector[float64], triangles)
+	weights := make([][3]float64, triangles)
+	vertexTriangles := make([][]int, len(positions))
+	for tri := 0; tri < triangles; tri++ {
+		a := positions[indices[tri*3]].ToFloat64()
+		b := positions[indices[tri*3+1]].ToFloat64()
+		c := positions[indices[tri*3+2]].ToFloat64()
+
+		n := b.Sub(a).Cross(c.Sub(a))
+		if n.LengthSquared() == 0 {
+			continue
+		}
+		faceNormals[tri] = n
+		weights[tri] = cornerWeights(a, b, c, weighting)
+		if weighting == AngleWeighted {
+			faceNormals[tri] = n.Normalized()
+		}
+
+		for corner := 0; corner < 3; corner++ {
+			v := indices[tri*3+corner]
+			vertexTriangles[v] = append(vertexTriangles[v], tri)
+		}
+	}
+
+	minCos := math.Cos(hardAngle)
+	out := make([]Vector[float64], len(indices))
+	for i, v := range indices {
+		tri := i / 3
+		own := faceNormals[tri]
+		if own.LengthSquared() == 0 {
+			continue
+		}
+		ownDir := own.Normalized()
+
+		var sum Vector[float64]
+		for _, other := range vertexTriangles[v] {
+			n := faceNormals[other]
+			if other != tri && ownDir.Dot(n.Normalized()) < minCos {
+				continue
+			}
+			sum = sum.Add(n.Scale(weights[other][cornerOf(indices, other, v)]))
+		}
+		out[i] = normalizeOrZero(sum)
+	}
+	return out, nil
+}
+
+// cornerOf returns which corner of the triangle references vertex v
+func cornerOf(indices []int, tri, v int) int {
+	for corner := 0; corner < 3; corner++ {
+		if indices[tri*3+corner] == v {
+			return corner
+		}
+	}
+	return 0
+}
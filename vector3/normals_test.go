@@ -0,0 +1,97 @@
+package vector3_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/EliCDavis/vector/vectortest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// A roof made of two quads meeting at a 90° ridge along the z axis, with the
+// left quad split into two triangles of very different sizes
+var (
+	roofPositions = []vector3.Float64{
+		vector3.New(-1., 0., 0.),  // 0
+		vector3.New(0., 1., 0.),   // 1 ridge
+		vector3.New(0., 1., 1.),   // 2 ridge
+		vector3.New(-1., 0., 1.),  // 3
+		vector3.New(1., 0., 0.),   // 4
+		vector3.New(1., 0., 1.),   // 5
+		vector3.New(-0.1, 0.9, 1), // 6
+	}
+	roofIndices = []int{
+		0, 2, 1,
+		0, 3, 2,
+		4, 1, 2,
+		4, 2, 5,
+	}
+)
+
+func TestVertexNormals(t *testing.T) {
+	normals, err := vector3.VertexNormals(roofPositions, roofIndices, vector3.AreaWeighted)
+	require.NoError(t, err)
+
+	left := vector3.New(-1., 1., 0.).Normalized()
+	right := vector3.New(1., 1., 0.).Normalized()
+
+	vectortest.AssertVector3InDelta(t, left, normals[0], 1e-12)
+	vectortest.AssertVector3InDelta(t, right, normals[5], 1e-12)
+	vectortest.AssertVector3InDelta(t, vector3.Up[float64](), normals[1], 1e-12)
+	assert.Equal(t, vector3.Zero[float64](), normals[6])
+
+	normals, err = vector3.VertexNormals(roofPositions, roofIndices, vector3.AngleWeighted)
+	require.NoError(t, err)
+	vectortest.AssertVector3InDelta(t, left, normals[3], 1e-12)
+
+	_, err = vector3.VertexNormals(roofPositions, []int{0, 1}, vector3.AreaWeighted)
+	assert.Error(t, err)
+}
+
+func TestAngleWeightingIgnoresTessellation(t *testing.T) {
+	// Two faces meet at the apex with a 90° corner each, so angle weighting
+	// gives them an equal say even though one is split into four slivers
+	positions := []vector3.Float64{vector3.New(0., 0., 0.)}
+	var indices []int
+
+	// Face one lies in the XZ plane, split into four slivers
+	for i := 0; i <= 4; i++ {
+		angle := float64(i) / 4 * math.Pi / 2
+		positions = append(positions, vector3.New(math.Cos(angle), 0, math.Sin(angle)))
+	}
+	for i := 1; i <= 4; i++ {
+		indices = append(indices, 0, i+1, i)
+	}
+
+	// Face two lies in the YZ plane as a single triangle
+	positions = append(positions, vector3.New(0., 1., 0.))
+	indices = append(indices, 0, len(positions)-1, 5)
+
+	normals, err := vector3.VertexNormals(positions, indices, vector3.AngleWeighted)
+	require.NoError(t, err)
+	vectortest.AssertVector3InDelta(t, vector3.New(1., 1., 0.).Normalized(), normals[0], 1e-9)
+}
+
+func TestCornerNormals(t *testing.T) {
+	left := vector3.New(-1., 1., 0.).Normalized()
+	right := vector3.New(1., 1., 0.).Normalized()
+
+	// The ridge is a 90° edge, so a 45° threshold keeps it hard
+	hard, err := vector3.CornerNormals(roofPositions, roofIndices, vector3.AreaWeighted, math.Pi/4)
+	require.NoError(t, err)
+	require.Len(t, hard, len(roofIndices))
+	for i := 0; i < 6; i++ {
+		vectortest.AssertVector3InDelta(t, left, hard[i], 1e-12)
+	}
+	for i := 6; i < 12; i++ {
+		vectortest.AssertVector3InDelta(t, right, hard[i], 1e-12)
+	}
+
+	// While a 100° threshold smooths it
+	smooth, err := vector3.CornerNormals(roofPositions, roofIndices, vector3.AreaWeighted, math.Pi*100/180)
+	require.NoError(t, err)
+	vectortest.AssertVector3InDelta(t, vector3.Up[float64](), smooth[1], 1e-12)
+	vectortest.AssertVector3InDelta(t, left, smooth[0], 1e-12)
+}
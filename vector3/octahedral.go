@@ -0,0 +1,59 @@
+package vector3
+
+import (
+	"math"
+
+	"github.com/EliCDavis/vector/internal/codec"
+	"github.com/EliCDavis/vector/vector2"
+)
+
+// EncodeOctahedral maps the direction of the vector onto the unit octahedron
+// and unfolds it into a square, returning coordinates in [-1, 1]. This
+// represents unit vectors, such as normals, with two components and an even
+// distribution of precision. The zero vector encodes as (0, 0).
+func (v Vector[T]) EncodeOctahedral() vector2.Float64 {
+	x, y, z := float64(v.x), float64(v.y), float64(v.z)
+	l1 := math.Abs(x) + math.Abs(y) + math.Abs(z)
+	if l1 == 0 {
+		return vector2.Zero[float64]()
+	}
+
+	x /= l1
+	y /= l1
+	if z < 0 {
+		x, y = (1-math.Abs(y))*signNotZero(x), (1-math.Abs(x))*signNotZero(y)
+	}
+	return vector2.New(x, y)
+}
+
+// DecodeOctahedral converts coordinates produced by EncodeOctahedral back into
+// a unit vector
+func DecodeOctahedral(e vector2.Float64) Float64 {
+	x, y := e.X(), e.Y()
+	z := 1 - math.Abs(x) - math.Abs(y)
+	if t := math.Max(-z, 0); t > 0 {
+		x -= math.Copysign(t, x)
+		y -= math.Copysign(t, y)
+	}
+	return New(x, y, z).Normalized()
+}
+
+// PackOctahedral encodes the direction of the vector with EncodeOctahedral
+// and quantizes each coordinate to a 16 bit signed normalized integer, x in
+// the low bits
+func (v Vector[T]) PackOctahedral() uint32 {
+	e := v.EncodeOctahedral()
+	return codec.PackSnorm(e.X(), 16) | codec.PackSnorm(e.Y(), 16)<<16
+}
+
+// UnpackOctahedral decodes a unit vector packed with PackOctahedral
+func UnpackOctahedral(p uint32) Float64 {
+	return DecodeOctahedral(vector2.New(codec.UnpackSnorm(p, 16), codec.UnpackSnorm(p>>16, 16)))
+}
+
+func signNotZero(f float64) float64 {
+	if f < 0 {
+		return -1
+	}
+	return 1
+}
@@ -0,0 +1,53 @@
+package vector3_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOctahedralAxes(t *testing.T) {
+	tests := map[string]struct {
+		in   vector3.Float64
+		want vector2.Float64
+	}{
+		"right":    {in: vector3.Right[float64](), want: vector2.New(1., 0.)},
+		"left":     {in: vector3.Left[float64](), want: vector2.New(-1., 0.)},
+		"up":       {in: vector3.Up[float64](), want: vector2.New(0., 1.)},
+		"forward":  {in: vector3.Forward[float64](), want: vector2.New(0., 0.)},
+		"backward": {in: vector3.Backwards[float64](), want: vector2.New(1., 1.)},
+		"zero":     {in: vector3.Zero[float64](), want: vector2.New(0., 0.)},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.in.EncodeOctahedral())
+		})
+	}
+}
+
+func TestOctahedralRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for i := 0; i < 1000; i++ {
+		n := vector3.RandNormal(r)
+
+		e := n.EncodeOctahedral()
+		assert.LessOrEqual(t, e.X(), 1.)
+		assert.GreaterOrEqual(t, e.X(), -1.)
+		assert.LessOrEqual(t, e.Y(), 1.)
+		assert.GreaterOrEqual(t, e.Y(), -1.)
+		assert.InDelta(t, 0, vector3.DecodeOctahedral(e).Distance(n), 1e-12)
+
+		// 16 bits per coordinate is accurate to well under a hundredth of a degree
+		assert.InDelta(t, 0, vector3.UnpackOctahedral(n.PackOctahedral()).Distance(n), 1e-4)
+	}
+}
+
+func TestPackOctahedralAxes(t *testing.T) {
+	assert.Equal(t, uint32(0x00007fff), vector3.Right[float64]().PackOctahedral())
+	assert.Equal(t, vector3.Up[float64](), vector3.UnpackOctahedral(vector3.Up[float64]().PackOctahedral()))
+	assert.Equal(t, vector3.Backwards[float64](), vector3.UnpackOctahedral(vector3.Backwards[float64]().PackOctahedral()))
+}
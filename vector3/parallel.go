@@ -0,0 +1,78 @@
+package vector3
+
+import (
+	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/internal/parallel"
+)
+
+// The Parallel functions split work over large slices across goroutines. The
+// workers argument bounds the number of goroutines used, with values <= 0
+// using GOMAXPROCS, and small slices are processed on the calling goroutine.
+// Each goroutine handles a contiguous chunk of the slice whose boundaries
+// depend only on the slice length and workers, and partial results are
+// merged in chunk order, so the same inputs always produce the same output.
+
+// ParallelAverage returns the average of the vectors, see Array.Average. An
+// empty slice results in NaN components
+func ParallelAverage[T vector.Number](vectors []Vector[T], workers int) Vector[float64] {
+	sums := make([]Vector[float64], parallel.Chunks(len(vectors), workers))
+	parallel.For(len(vectors), workers, func(chunk, start, end int) {
+		var x, y, z float64
+		for _, v := range vectors[start:end] {
+			x += float64(v.x)
+			y += float64(v.y)
+			z += float64(v.z)
+		}
+		sums[chunk] = Vector[float64]{x, y, z}
+	})
+
+	var total Vector[float64]
+	for _, s := range sums {
+		total = total.Add(s)
+	}
+	return total.DivByConstant(float64(len(vectors)))
+}
+
+// ParallelBounds returns the min and max points of an AABB encompassing all
+// vectors. An empty slice results in two zero vectors
+func ParallelBounds[T vector.Number](vectors []Vector[T], workers int) (Vector[T], Vector[T]) {
+	if len(vectors) == 0 {
+		return Vector[T]{}, Vector[T]{}
+	}
+
+	chunks := parallel.Chunks(len(vectors), workers)
+	mins := make([]Vector[T], chunks)
+	maxs := make([]Vector[T], chunks)
+	parallel.For(len(vectors), workers, func(chunk, start, end int) {
+		mins[chunk], maxs[chunk] = Array[T](vectors[start:end]).Bounds()
+	})
+
+	vmin, vmax := mins[0], maxs[0]
+	for i := 1; i < chunks; i++ {
+		vmin = Min(vmin, mins[i])
+		vmax = Max(vmax, maxs[i])
+	}
+	return vmin, vmax
+}
+
+// ParallelNormalize sets dst[i] to src[i].Normalized(). The slices must share
+// a length, and dst may be src
+func ParallelNormalize[T vector.Number](dst, src []Vector[T], workers int) {
+	ParallelModify(dst, src, workers, Vector[T].Normalized)
+}
+
+// ParallelModify sets dst[i] to f(src[i]), for applying transforms and other
+// per vector operations. The slices must share a length, and dst may be src.
+// f is called concurrently and must be safe to do so
+func ParallelModify[T vector.Number](dst, src []Vector[T], workers int, f func(Vector[T]) Vector[T]) {
+	if len(dst) != len(src) {
+		panic("vector3: dst and src must have the same length")
+	}
+
+	parallel.For(len(src), workers, func(_, start, end int) {
+		out := dst[start:end]
+		for i, v := range src[start:end] {
+			out[i] = f(v)
+		}
+	})
+}
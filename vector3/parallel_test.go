@@ -0,0 +1,64 @@
+package vector3_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+)
+
+func largeRandomSlice(n int) []vector3.Float64 {
+	r := rand.New(rand.NewSource(42))
+	out := make([]vector3.Float64, n)
+	for i := range out {
+		out[i] = vector3.RandRange(r, -100., 100.)
+	}
+	return out
+}
+
+func TestParallelAverage(t *testing.T) {
+	vectors := largeRandomSlice(100_000)
+
+	want := vector3.Array[float64](vectors).Average(vectors)
+	got := vector3.ParallelAverage(vectors, 4)
+	assert.InDelta(t, 0, want.Distance(got), 1e-9)
+
+	// Deterministic for a given worker count
+	assert.Equal(t, got, vector3.ParallelAverage(vectors, 4))
+}
+
+func TestParallelBounds(t *testing.T) {
+	vectors := largeRandomSlice(100_000)
+
+	wantMin, wantMax := vector3.Array[float64](vectors).Bounds()
+	for _, workers := range []int{0, 1, 3, 16} {
+		gotMin, gotMax := vector3.ParallelBounds(vectors, workers)
+		assert.Equal(t, wantMin, gotMin)
+		assert.Equal(t, wantMax, gotMax)
+	}
+
+	emptyMin, emptyMax := vector3.ParallelBounds[float64](nil, 4)
+	assert.Equal(t, vector3.Zero[float64](), emptyMin)
+	assert.Equal(t, vector3.Zero[float64](), emptyMax)
+}
+
+func TestParallelNormalize(t *testing.T) {
+	vectors := largeRandomSlice(50_000)
+
+	dst := make([]vector3.Float64, len(vectors))
+	vector3.ParallelNormalize(dst, vectors, 4)
+	assert.Equal(t, []vector3.Float64(vector3.Array[float64](vectors).Normalized()), dst)
+}
+
+func TestParallelModify(t *testing.T) {
+	vectors := largeRandomSlice(50_000)
+	want := vector3.Array[float64](vectors).Modify(vector3.Float64.Flip)
+
+	vector3.ParallelModify(vectors, vectors, 0, vector3.Float64.Flip)
+	assert.Equal(t, []vector3.Float64(want), vectors)
+
+	assert.PanicsWithValue(t, "vector3: dst and src must have the same length", func() {
+		vector3.ParallelModify(make([]vector3.Float64, 1), vectors, 0, vector3.Float64.Flip)
+	})
+}
@@ -0,0 +1,50 @@
+package vector3
+
+import (
+	"sync"
+
+	"github.com/EliCDavis/vector"
+)
+
+// ArrayPool hands out scratch arrays for use as the destination of the Into
+// family of operations, recycling their backing memory between uses so that
+// repeated bulk operations don't allocate a new array each time. The zero
+// value is ready for use, and an ArrayPool is safe for concurrent use.
+type ArrayPool[T vector.Number] struct {
+	pool sync.Pool
+
+	// boxes holds the empty pointers arrays are stored in, so that putting an
+	// array back reuses one instead of allocating a new pointer every time
+	boxes sync.Pool
+}
+
+// Get returns an array of length n. Its contents are unspecified and should
+// be overwritten before being read
+func (p *ArrayPool[T]) Get(n int) Array[T] {
+	box, ok := p.pool.Get().(*Array[T])
+	if !ok {
+		return make(Array[T], n)
+	}
+	arr := *box
+	*box = nil
+	p.boxes.Put(box)
+
+	if cap(arr) < n {
+		return make(Array[T], n)
+	}
+	return arr[:n]
+}
+
+// Put returns an array to the pool for later reuse. The array must not be
+// used after being put back
+func (p *ArrayPool[T]) Put(arr Array[T]) {
+	if cap(arr) == 0 {
+		return
+	}
+	box, ok := p.boxes.Get().(*Array[T])
+	if !ok {
+		box = new(Array[T])
+	}
+	*box = arr
+	p.pool.Put(box)
+}
@@ -0,0 +1,159 @@
+package vector3
+
+import (
+	"fmt"
+	"math"
+)
+
+// Quantization maps positions within a bounding box onto a grid of Bits bit
+// unsigned integers per axis, the way Draco and meshoptimizer compress vertex
+// positions. Each axis is divided into 2^Bits-1 equal steps between Min and
+// Max, so the precision adapts to the extent of the data along it.
+type Quantization struct {
+	Min  Float32
+	Max  Float32
+	Bits int
+}
+
+// NewQuantization creates a quantization spanning the bounds of the vectors
+// with the given number of bits per component, which must be between 1 and 31
+func NewQuantization(vectors []Float32, bits int) Quantization {
+	vmin, vmax := MinMaxSlice(vectors)
+	q := Quantization{Min: vmin, Max: vmax, Bits: bits}
+	q.checkBits()
+	return q
+}
+
+func (q Quantization) checkBits() {
+	if q.Bits < 1 || q.Bits > 31 {
+		panic("vector3: quantization bits must be between 1 and 31")
+	}
+}
+
+func (q Quantization) levels() float64 {
+	return float64(uint32(1)<<q.Bits - 1)
+}
+
+// Step returns the distance between neighboring quantized values along each
+// axis
+func (q Quantization) Step() Float64 {
+	q.checkBits()
+	return q.Max.ToFloat64().Sub(q.Min.ToFloat64()).DivByConstant(q.levels())
+}
+
+// MaxError returns the largest difference along each axis between a vector
+// within the bounds and its dequantized value, not counting the rounding of
+// the result to float32. It's half a step
+func (q Quantization) MaxError() Float64 {
+	return q.Step().Scale(0.5)
+}
+
+// Quantize maps the vector onto the grid. Vectors outside the bounds are
+// clamped to them
+func (q Quantization) Quantize(v Float32) Vector[int32] {
+	q.checkBits()
+	levels := q.levels()
+	component := func(c, vmin, vmax float32) int32 {
+		if vmax <= vmin {
+			return 0
+		}
+		t := (float64(c) - float64(vmin)) / (float64(vmax) - float64(vmin))
+		return int32(math.Round(math.Max(0, math.Min(1, t)) * levels))
+	}
+	return Vector[int32]{
+		component(v.x, q.Min.x, q.Max.x),
+		component(v.y, q.Min.y, q.Max.y),
+		component(v.z, q.Min.z, q.Max.z),
+	}
+}
+
+// Dequantize maps a quantized value back into the bounds
+func (q Quantization) Dequantize(v Vector[int32]) Float32 {
+	q.checkBits()
+	levels := q.levels()
+	component := func(c int32, vmin, vmax float32) float32 {
+		return float32(float64(vmin) + float64(c)/levels*(float64(vmax)-float64(vmin)))
+	}
+	return Float32{
+		component(v.x, q.Min.x, q.Max.x),
+		component(v.y, q.Min.y, q.Max.y),
+		component(v.z, q.Min.z, q.Max.z),
+	}
+}
+
+// QuantizeSlice quantizes every vector. See Quantize
+func (q Quantization) QuantizeSlice(vectors []Float32) []Vector[int32] {
+	out := make([]Vector[int32], len(vectors))
+	for i, v := range vectors {
+		out[i] = q.Quantize(v)
+	}
+	return out
+}
+
+// DequantizeSlice dequantizes every value. See Dequantize
+func (q Quantization) DequantizeSlice(values []Vector[int32]) []Float32 {
+	out := make([]Float32, len(values))
+	for i, v := range values {
+		out[i] = q.Dequantize(v)
+	}
+	return out
+}
+
+// Pack writes the quantized values as a tightly packed stream of Bits bit
+// components, least significant bit first, taking 3*Bits bits per vector
+// rather than the 96 of a Float32. The bounds and bit count aren't included
+func (q Quantization) Pack(values []Vector[int32]) []byte {
+	q.checkBits()
+	out := make([]byte, 0, (len(values)*componentCount*q.Bits+7)/8)
+
+	var acc uint64
+	var n int
+	push := func(c int32) {
+		acc |= uint64(uint32(c)&(1<<q.Bits-1)) << n
+		n += q.Bits
+		for n >= 8 {
+			out = append(out, byte(acc))
+			acc >>= 8
+			n -= 8
+		}
+	}
+	for _, v := range values {
+		push(v.x)
+		push(v.y)
+		push(v.z)
+	}
+	if n > 0 {
+		out = append(out, byte(acc))
+	}
+	return out
+}
+
+// Unpack reads count quantized values written by Pack
+func (q Quantization) Unpack(data []byte, count int) ([]Vector[int32], error) {
+	q.checkBits()
+	if need := (count*componentCount*q.Bits + 7) / 8; len(data) < need {
+		return nil, fmt.Errorf("packed data is %d bytes, need %d for %d vectors", len(data), need, count)
+	}
+
+	var acc uint64
+	var n int
+	pull := func() int32 {
+		for n < q.Bits {
+			acc |= uint64(data[0]) << n
+			data = data[1:]
+			n += 8
+		}
+		c := int32(acc & (1<<q.Bits - 1))
+		acc >>= q.Bits
+		n -= q.Bits
+		return c
+	}
+
+	out := make([]Vector[int32], count)
+	for i := range out {
+		out[i].x = pull()
+		out[i].y = pull()
+		out[i].z = pull()
+	}
+	return out, nil
+}
@@ -0,0 +1,54 @@
+package vector3_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuantization(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	vectors := make([]vector3.Float32, 1000)
+	for i := range vectors {
+		vectors[i] = vector3.New(r.Float32()*100-50, r.Float32()*2, r.Float32()*-1000)
+	}
+
+	for _, bits := range []int{1, 8, 11, 16, 31} {
+		q := vector3.NewQuantization(vectors, bits)
+		quantized := q.QuantizeSlice(vectors)
+
+		packed := q.Pack(quantized)
+		assert.Len(t, packed, (len(vectors)*3*bits+7)/8)
+		unpacked, err := q.Unpack(packed, len(vectors))
+		require.NoError(t, err)
+		assert.Equal(t, quantized, unpacked)
+
+		maxErr := q.MaxError()
+		for i, v := range q.DequantizeSlice(quantized) {
+			diff := v.Sub(vectors[i]).Abs()
+			assert.LessOrEqual(t, float64(diff.X()), maxErr.X()*(1+1e-6)+1e-5)
+			assert.LessOrEqual(t, float64(diff.Y()), maxErr.Y()*(1+1e-6)+1e-6)
+			assert.LessOrEqual(t, float64(diff.Z()), maxErr.Z()*(1+1e-6)+1e-4)
+		}
+	}
+}
+
+func TestQuantizationBounds(t *testing.T) {
+	q := vector3.Quantization{Min: vector3.New[float32](0, 0, 5), Max: vector3.New[float32](10, 1, 5), Bits: 8}
+	assert.Equal(t, vector3.New(10./255, 1./255, 0.), q.Step())
+
+	// Out of bounds values clamp, and flat axes collapse to their minimum
+	assert.Equal(t, vector3.New[int32](0, 255, 0), q.Quantize(vector3.New[float32](-3, 7, 9)))
+	assert.Equal(t, vector3.New[float32](0, 1, 5), q.Dequantize(vector3.New[int32](0, 255, 0)))
+
+	_, err := q.Unpack([]byte{1, 2}, 1)
+	assert.EqualError(t, err, "packed data is 2 bytes, need 3 for 1 vectors")
+
+	assert.PanicsWithValue(t, "vector3: quantization bits must be between 1 and 31", func() {
+		vector3.NewQuantization(nil, 32)
+	})
+	assert.Equal(t, vector3.Zero[float64](), vector3.Quantization{Bits: 4}.Step())
+}
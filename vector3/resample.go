@@ -0,0 +1,44 @@
+package vector3
+
+import "math"
+
+// Resample returns points spaced evenly along the polyline, as measured by
+// arc length, keeping its first and last points. The spacing used is the
+// closest to the requested spacing that divides the polyline's length
+// evenly. Polylines with fewer than two points, or a non-positive spacing,
+// are returned as a copy
+func Resample(points []Float64, spacing float64) []Float64 {
+	if len(points) < 2 || spacing <= 0 {
+		return append([]Float64(nil), points...)
+	}
+
+	var length float64
+	for i := 1; i < len(points); i++ {
+		length += points[i-1].Distance(points[i])
+	}
+
+	segments := max(int(math.Round(length/spacing)), 1)
+	step := length / float64(segments)
+
+	resampled := make([]Float64, 0, segments+1)
+	resampled = append(resampled, points[0])
+
+	// start is the arc length at the start of segment i of the polyline
+	i, start := 1, 0.
+	for k := 1; k < segments; k++ {
+		target := step * float64(k)
+		segment := points[i-1].Distance(points[i])
+		for start+segment < target && i < len(points)-1 {
+			start += segment
+			i++
+			segment = points[i-1].Distance(points[i])
+		}
+
+		t := 0.
+		if segment > 0 {
+			t = min((target-start)/segment, 1)
+		}
+		resampled = append(resampled, points[i-1].Add(points[i].Sub(points[i-1]).Scale(t)))
+	}
+	return append(resampled, points[len(points)-1])
+}
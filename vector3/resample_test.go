@@ -0,0 +1,30 @@
+package vector3_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResample(t *testing.T) {
+	// A path 12 long with a zero length segment partway along
+	line := []vector3.Float64{
+		vector3.New(0., 0., 0.),
+		vector3.New(0., 0., 3.),
+		vector3.New(0., 0., 3.),
+		vector3.New(0., 9., 3.),
+	}
+
+	resampled := vector3.Resample(line, 4)
+	expected := []vector3.Float64{
+		vector3.New(0., 0., 0.),
+		vector3.New(0., 1., 3.),
+		vector3.New(0., 5., 3.),
+		vector3.New(0., 9., 3.),
+	}
+	assert.Len(t, resampled, len(expected))
+	for i := range expected {
+		assert.InDelta(t, 0., expected[i].Distance(resampled[i]), 1e-12)
+	}
+}
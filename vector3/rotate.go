@@ -0,0 +1,18 @@
+package vector3
+
+import "github.com/EliCDavis/vector"
+
+// Rotator rotates vectors. It's satisfied by quaternion.Quaternion, which
+// can't be referenced directly here because the quaternion package is built
+// on top of this one.
+type Rotator[T vector.Number] interface {
+	Rotate(v Vector[T]) Vector[T]
+}
+
+// Rotated returns the vector rotated by r, usually a quaternion, keeping call
+// sites fluent:
+//
+//	velocity := forward.Rotated(orientation).Scale(speed)
+func (v Vector[T]) Rotated(r Rotator[T]) Vector[T] {
+	return r.Rotate(v)
+}
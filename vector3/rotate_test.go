@@ -0,0 +1,22 @@
+package vector3_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/EliCDavis/vector/quaternion"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/EliCDavis/vector/vectortest"
+	"github.com/stretchr/testify/assert"
+)
+
+var _ vector3.Rotator[float32] = quaternion.Float32{}
+
+func TestRotated(t *testing.T) {
+	q := quaternion.FromAxisAngle(vector3.New(0., 1., 0.), math.Pi/2)
+	dir := vector3.New(1., 0., 0.)
+
+	assert.Equal(t, q.Rotate(dir), dir.Rotated(q))
+	vectortest.AssertVector3InDelta(t, vector3.New(0., 0., -3.), dir.Rotated(q).Scale(3), 1e-12)
+	vectortest.AssertVector3InDelta(t, dir.Scale(-1), dir.Rotated(q).Rotated(q), 1e-12)
+}
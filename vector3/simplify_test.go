@@ -0,0 +1,20 @@
+package vector3_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSimplify(t *testing.T) {
+	line := []vector3.Float64{
+		vector3.New(0., 0., 0.),
+		vector3.New(1., 0.01, 1.),
+		vector3.New(2., 0., 2.),
+		vector3.New(2., 3., 2.),
+	}
+
+	assert.Equal(t, []vector3.Float64{line[0], line[2], line[3]}, vector3.Simplify(line, 0.1))
+	assert.Equal(t, line, vector3.Simplify(line, 0.001))
+}
@@ -0,0 +1,192 @@
+package vector3
+
+import (
+	"math"
+
+	"github.com/EliCDavis/vector"
+)
+
+// SoA stores many vectors as a struct of arrays, with each component held in
+// its own contiguous slice. The batch operations work over the component
+// slices in tight loops, free of bounds checks and of the copies and
+// allocations that come with the per vector API, which matters for point sets
+// with millions of entries. They modify the SoA in place and return it to
+// allow chaining.
+type SoA[T vector.Number] struct {
+	X []T
+	Y []T
+	Z []T
+}
+
+type (
+	Float64SoA = SoA[float64]
+	Float32SoA = SoA[float32]
+)
+
+// NewSoA creates a SoA holding n zero vectors
+func NewSoA[T vector.Number](n int) SoA[T] {
+	return SoA[T]{
+		X: make([]T, n),
+		Y: make([]T, n),
+		Z: make([]T, n),
+	}
+}
+
+// SoA copies the vectors into a new SoA
+func (v3a Array[T]) SoA() SoA[T] {
+	s := NewSoA[T](len(v3a))
+	for i, v := range v3a {
+		s.X[i] = v.x
+		s.Y[i] = v.y
+		s.Z[i] = v.z
+	}
+	return s
+}
+
+// Array copies the vectors into a new Array
+func (s SoA[T]) Array() Array[T] {
+	out := make(Array[T], s.Len())
+	x, y, z := s.components()
+	for i := range out {
+		out[i] = Vector[T]{x[i], y[i], z[i]}
+	}
+	return out
+}
+
+// Len is the number of vectors held
+func (s SoA[T]) Len() int {
+	return len(s.X)
+}
+
+// At returns the vector at index i
+func (s SoA[T]) At(i int) Vector[T] {
+	return Vector[T]{s.X[i], s.Y[i], s.Z[i]}
+}
+
+// Set replaces the vector at index i
+func (s SoA[T]) Set(i int, v Vector[T]) {
+	s.X[i] = v.x
+	s.Y[i] = v.y
+	s.Z[i] = v.z
+}
+
+// Append adds the vectors to the end of the SoA, returning the updated SoA
+func (s SoA[T]) Append(vectors ...Vector[T]) SoA[T] {
+	for _, v := range vectors {
+		s.X = append(s.X, v.x)
+		s.Y = append(s.Y, v.y)
+		s.Z = append(s.Z, v.z)
+	}
+	return s
+}
+
+// components returns the component slices resliced to the same length, which
+// lets the compiler drop bounds checks from loops over all three
+func (s SoA[T]) components() (x, y, z []T) {
+	n := len(s.X)
+	return s.X[:n], s.Y[:n], s.Z[:n]
+}
+
+// AddScalar adds c to every component of every vector
+func (s SoA[T]) AddScalar(c T) SoA[T] {
+	x, y, z := s.components()
+	for i := range x {
+		x[i] += c
+		y[i] += c
+		z[i] += c
+	}
+	return s
+}
+
+// Translate adds v to every vector
+func (s SoA[T]) Translate(v Vector[T]) SoA[T] {
+	x, y, z := s.components()
+	for i := range x {
+		x[i] += v.x
+		y[i] += v.y
+		z[i] += v.z
+	}
+	return s
+}
+
+// Scale multiplies every vector by t
+func (s SoA[T]) Scale(t float64) SoA[T] {
+	x, y, z := s.components()
+	for i := range x {
+		x[i] = T(float64(x[i]) * t)
+		y[i] = T(float64(y[i]) * t)
+		z[i] = T(float64(z[i]) * t)
+	}
+	return s
+}
+
+// Normalize scales every vector to a length of 1. Zero length vectors are
+// left untouched rather than becoming NaN
+func (s SoA[T]) Normalize() SoA[T] {
+	x, y, z := s.components()
+	for i := range x {
+		fx, fy, fz := float64(x[i]), float64(y[i]), float64(z[i])
+		l := math.Sqrt(fx*fx + fy*fy + fz*fz)
+		if l == 0 {
+			continue
+		}
+		x[i] = T(fx / l)
+		y[i] = T(fy / l)
+		z[i] = T(fz / l)
+	}
+	return s
+}
+
+// Dot computes the dot product of every vector with v, writing the results to
+// dst, which is grown if needed, and returning it
+func (s SoA[T]) Dot(v Vector[T], dst []float64) []float64 {
+	x, y, z := s.components()
+	if cap(dst) < len(x) {
+		dst = make([]float64, len(x))
+	}
+	dst = dst[:len(x)]
+
+	vx, vy, vz := float64(v.x), float64(v.y), float64(v.z)
+	for i := range x {
+		dst[i] = float64(x[i])*vx + float64(y[i])*vy + float64(z[i])*vz
+	}
+	return dst
+}
+
+// Sum adds all vectors together
+func (s SoA[T]) Sum() Vector[T] {
+	return Vector[T]{sum(s.X), sum(s.Y), sum(s.Z)}
+}
+
+// Bounds returns the min and max points of an AABB encompassing all vectors.
+// An empty SoA results in two zero vectors
+func (s SoA[T]) Bounds() (Vector[T], Vector[T]) {
+	if s.Len() == 0 {
+		return Vector[T]{}, Vector[T]{}
+	}
+
+	minX, maxX := minMax(s.X)
+	minY, maxY := minMax(s.Y)
+	minZ, maxZ := minMax(s.Z)
+	return Vector[T]{minX, minY, minZ}, Vector[T]{maxX, maxY, maxZ}
+}
+
+func sum[T vector.Number](values []T) (total T) {
+	for _, v := range values {
+		total += v
+	}
+	return
+}
+
+func minMax[T vector.Number](values []T) (vmin, vmax T) {
+	vmin, vmax = values[0], values[0]
+	for _, v := range values[1:] {
+		if v < vmin {
+			vmin = v
+		}
+		if v > vmax {
+			vmax = v
+		}
+	}
+	return
+}
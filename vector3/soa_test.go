@@ -0,0 +1,85 @@
+package vector3_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSoAConversion(t *testing.T) {
+	in := vector3.Array[float64]{vector3.New(1., 2., 3.), vector3.New(4., 5., 6.)}
+
+	s := in.SoA()
+	assert.Equal(t, 2, s.Len())
+	assert.Equal(t, []float64{1, 4}, s.X)
+	assert.Equal(t, []float64{2, 5}, s.Y)
+	assert.Equal(t, []float64{3, 6}, s.Z)
+	assert.Equal(t, in, s.Array())
+
+	assert.Equal(t, vector3.New(4., 5., 6.), s.At(1))
+	s.Set(1, vector3.One[float64]())
+	assert.Equal(t, vector3.One[float64](), s.At(1))
+
+	s = s.Append(vector3.Zero[float64](), vector3.Up[float64]())
+	assert.Equal(t, 4, s.Len())
+	assert.Equal(t, vector3.Up[float64](), s.At(3))
+}
+
+func TestSoABatchOperations(t *testing.T) {
+	s := vector3.Array[float64]{vector3.New(1., 2., 3.), vector3.New(-4., 5., 0.)}.SoA()
+
+	s.AddScalar(1).Translate(vector3.New(1., 0., -1.)).Scale(2)
+	assert.Equal(t, vector3.Array[float64]{vector3.New(6., 6., 6.), vector3.New(-4., 12., 0.)}, s.Array())
+
+	assert.Equal(t, vector3.New(2., 18., 6.), s.Sum())
+
+	vmin, vmax := s.Bounds()
+	assert.Equal(t, vector3.New(-4., 6., 0.), vmin)
+	assert.Equal(t, vector3.New(6., 12., 6.), vmax)
+
+	dots := s.Dot(vector3.New(1., 0., 1.), nil)
+	assert.Equal(t, []float64{12, -4}, dots)
+}
+
+func TestSoANormalize(t *testing.T) {
+	s := vector3.Array[float64]{vector3.New(3., 0., 4.), vector3.Zero[float64]()}.SoA().Normalize()
+	assert.InDelta(t, 0.6, s.X[0], 1e-12)
+	assert.InDelta(t, 0.8, s.Z[0], 1e-12)
+	assert.Equal(t, vector3.Zero[float64](), s.At(1))
+}
+
+func TestSoAMatchesArray(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	arr := make(vector3.Array[float64], 100)
+	for i := range arr {
+		arr[i] = vector3.RandRange(r, -10., 10.)
+	}
+
+	s := arr.SoA()
+	wantMin, wantMax := arr.Bounds()
+	gotMin, gotMax := s.Bounds()
+	assert.Equal(t, wantMin, gotMin)
+	assert.Equal(t, wantMax, gotMax)
+	assert.InDelta(t, 0, arr.Sum().Distance(s.Sum()), 1e-9)
+	assert.Equal(t, arr.Normalized(), s.Normalize().Array())
+}
+
+func TestSoAEmpty(t *testing.T) {
+	s := vector3.NewSoA[int](0)
+	vmin, vmax := s.Bounds()
+	assert.Equal(t, vector3.Zero[int](), vmin)
+	assert.Equal(t, vector3.Zero[int](), vmax)
+	assert.Equal(t, vector3.Zero[int](), s.Sum())
+	assert.Empty(t, s.Dot(vector3.One[int](), nil))
+}
+
+func BenchmarkSoATranslate(b *testing.B) {
+	s := vector3.NewSoA[float32](100_000)
+	offset := vector3.New[float32](1, 2, 3)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.Translate(offset)
+	}
+}
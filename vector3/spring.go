@@ -0,0 +1,17 @@
+package vector3
+
+import "github.com/EliCDavis/vector"
+
+// Spring moves current towards target as if attached to it by a damped
+// spring, returning the new position. velocity holds the current rate of
+// change and is updated in place, so the same variable should be passed in
+// on every call. dt is the time elapsed since the previous call.
+//
+// Damping of mathex.CriticalDamping(stiffness) settles on target as fast as
+// possible without overshooting, making it suitable for following cameras
+// and UI elements. See mathex.Spring for details
+func Spring[T vector.Number](current Vector[T], velocity *Vector[T], target Vector[T], stiffness, damping, dt float64) Vector[T] {
+	pull := current.Sub(target).Scale(dt * stiffness)
+	*velocity = velocity.Sub(pull).Scale(1 / (1 + dt*damping + dt*dt*stiffness))
+	return current.Add(velocity.Scale(dt))
+}
@@ -0,0 +1,41 @@
+package vector3_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector/mathex"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/EliCDavis/vector/vectortest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpring(t *testing.T) {
+	target := vector3.New(10., -4., 2.)
+	current := vector3.Zero[float64]()
+	velocity := vector3.Zero[float64]()
+
+	stiffness := 64.
+	damping := mathex.CriticalDamping(stiffness)
+
+	previous := current.Distance(target)
+	for i := 0; i < 180; i++ {
+		current = vector3.Spring(current, &velocity, target, stiffness, damping, 1./60.)
+		distance := current.Distance(target)
+		assert.LessOrEqual(t, distance, previous)
+		previous = distance
+	}
+
+	vectortest.AssertVector3InDelta(t, target, current, 0.01)
+	vectortest.AssertVector3InDelta(t, vector3.Zero[float64](), velocity, 0.01)
+}
+
+func TestSpringMatchesScalar(t *testing.T) {
+	current := vector3.New(1., 2., 3.)
+	velocity := vector3.New(0.5, 0., -1.)
+	x, vx := current.X(), velocity.X()
+
+	current = vector3.Spring(current, &velocity, vector3.New(4., 4., 4.), 30, 3, 0.1)
+	x = mathex.Spring(x, &vx, 4., 30, 3, 0.1)
+	assert.InDelta(t, x, current.X(), 1e-12)
+	assert.InDelta(t, vx, velocity.X(), 1e-12)
+}
@@ -0,0 +1,27 @@
+package vector3
+
+import (
+	"database/sql/driver"
+
+	"github.com/EliCDavis/vector/internal/codec"
+)
+
+// Value stores the vector in a database using the text form "x,y,z",
+// satisfying the driver.Valuer interface
+func (v Vector[T]) Value() (driver.Value, error) {
+	text, err := v.MarshalText()
+	return string(text), err
+}
+
+// Scan reads the vector from a database value, satisfying the sql.Scanner
+// interface. Both the text form and the MarshalBinary form are accepted
+func (v *Vector[T]) Scan(src any) error {
+	var components [componentCount]T
+	if err := codec.ScanSQL(src, components[:]); err != nil {
+		return err
+	}
+	v.x = components[0]
+	v.y = components[1]
+	v.z = components[2]
+	return nil
+}
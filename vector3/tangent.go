@@ -0,0 +1,79 @@
+package vector3
+
+import (
+	"fmt"
+
+	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/vector2"
+)
+
+// TriangleTangents computes the tangent and bitangent of a triangle from the
+// positions and texture coordinates of its three vertices, as needed for
+// normal mapping. The tangent points in the direction of increasing U and
+// the bitangent in the direction of increasing V. The results aren't
+// normalized, leaving them weighted by the triangle's size so that they can
+// be accumulated across the triangles sharing a vertex. Triangles with
+// degenerate texture coordinates result in zero vectors
+func TriangleTangents[T vector.Number](p0, p1, p2 Vector[T], uv0, uv1, uv2 vector2.Vector[T]) (tangent, bitangent Vector[float64]) {
+	e1 := p1.ToFloat64().Sub(p0.ToFloat64())
+	e2 := p2.ToFloat64().Sub(p0.ToFloat64())
+	d1 := uv1.ToFloat64().Sub(uv0.ToFloat64())
+	d2 := uv2.ToFloat64().Sub(uv0.ToFloat64())
+
+	det := d1.X()*d2.Y() - d2.X()*d1.Y()
+	if det == 0 {
+		return Vector[float64]{}, Vector[float64]{}
+	}
+
+	r := 1 / det
+	tangent = e1.Scale(d2.Y()).Sub(e2.Scale(d1.Y())).Scale(r)
+	bitangent = e2.Scale(d1.X()).Sub(e1.Scale(d2.X())).Scale(r)
+	return
+}
+
+// VertexTangents computes a unit tangent and bitangent for every vertex by
+// accumulating the tangents of each triangle that uses it. Triangles are
+// described by indices, three per triangle, into positions and uvs. If
+// indices is nil, every three consecutive vertices form a triangle. Vertices
+// not used by any triangle with valid texture coordinates are given zero
+// vectors. An error is returned if positions and uvs differ in length, or an
+// index is out of range
+func VertexTangents[T vector.Number](positions []Vector[T], uvs []vector2.Vector[T], indices []int) (tangents, bitangents []Vector[float64], err error) {
+	if len(positions) != len(uvs) {
+		return nil, nil, fmt.Errorf("vector3: %d positions but %d uvs", len(positions), len(uvs))
+	}
+
+	if indices == nil {
+		indices = make([]int, len(positions)-len(positions)%3)
+		for i := range indices {
+			indices[i] = i
+		}
+	}
+	if err := checkTriangleIndices(indices, len(positions)); err != nil {
+		return nil, nil, err
+	}
+
+	tangents = make([]Vector[float64], len(positions))
+	bitangents = make([]Vector[float64], len(positions))
+	for tri := 0; tri < len(indices); tri += 3 {
+		a, b, c := indices[tri], indices[tri+1], indices[tri+2]
+		t, bt := TriangleTangents(positions[a], positions[b], positions[c], uvs[a], uvs[b], uvs[c])
+		for _, i := range [3]int{a, b, c} {
+			tangents[i] = tangents[i].Add(t)
+			bitangents[i] = bitangents[i].Add(bt)
+		}
+	}
+
+	for i := range tangents {
+		tangents[i] = normalizeOrZero(tangents[i])
+		bitangents[i] = normalizeOrZero(bitangents[i])
+	}
+	return tangents, bitangents, nil
+}
+
+func normalizeOrZero(v Vector[float64]) Vector[float64] {
+	if v.LengthSquared() == 0 {
+		return v
+	}
+	return v.Normalized()
+}
@@ -0,0 +1,63 @@
+package vector3_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTriangleTangents(t *testing.T) {
+	tangent, bitangent := vector3.TriangleTangents(
+		vector3.New(0., 0., 0.), vector3.New(2., 0., 0.), vector3.New(0., 0., -2.),
+		vector2.New(0., 0.), vector2.New(1., 0.), vector2.New(0., 1.),
+	)
+	assert.Equal(t, vector3.New(2., 0., 0.), tangent)
+	assert.Equal(t, vector3.New(0., 0., -2.), bitangent)
+
+	tangent, bitangent = vector3.TriangleTangents(
+		vector3.New(0., 0., 0.), vector3.New(1., 0., 0.), vector3.New(0., 1., 0.),
+		vector2.New(0., 0.), vector2.New(1., 1.), vector2.New(2., 2.),
+	)
+	assert.Equal(t, vector3.Zero[float64](), tangent)
+	assert.Equal(t, vector3.Zero[float64](), bitangent)
+}
+
+func TestVertexTangents(t *testing.T) {
+	// A unit quad in the XY plane, with the UVs flipped horizontally
+	positions := []vector3.Float64{
+		vector3.New(0., 0., 0.),
+		vector3.New(1., 0., 0.),
+		vector3.New(1., 1., 0.),
+		vector3.New(0., 1., 0.),
+		vector3.New(5., 5., 5.),
+	}
+	uvs := []vector2.Float64{
+		vector2.New(1., 0.),
+		vector2.New(0., 0.),
+		vector2.New(0., 1.),
+		vector2.New(1., 1.),
+		vector2.New(0., 0.),
+	}
+
+	tangents, bitangents, err := vector3.VertexTangents(positions, uvs, []int{0, 1, 2, 0, 2, 3})
+	require.NoError(t, err)
+	for i := 0; i < 4; i++ {
+		assert.Equal(t, vector3.New(-1., 0., 0.), tangents[i])
+		assert.Equal(t, vector3.New(0., 1., 0.), bitangents[i])
+	}
+	assert.Equal(t, vector3.Zero[float64](), tangents[4])
+
+	tangents, _, err = vector3.VertexTangents(positions[:3], uvs[:3], nil)
+	require.NoError(t, err)
+	assert.Equal(t, vector3.New(-1., 0., 0.), tangents[0])
+
+	_, _, err = vector3.VertexTangents(positions, uvs[:2], nil)
+	assert.Error(t, err)
+	_, _, err = vector3.VertexTangents(positions, uvs, []int{0, 1, 9})
+	assert.Error(t, err)
+	_, _, err = vector3.VertexTangents(positions, uvs, []int{0, 1})
+	assert.Error(t, err)
+}
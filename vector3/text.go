@@ -0,0 +1,27 @@
+package vector3
+
+import "github.com/EliCDavis/vector/internal/codec"
+
+// MarshalText encodes the vector in the canonical form "x,y,z"
+func (v Vector[T]) MarshalText() ([]byte, error) {
+	return v.AppendText(nil)
+}
+
+// AppendText appends the components of the vector to b in the form
+// "x,y,z", allowing callers to reuse buffers and avoid allocations
+func (v Vector[T]) AppendText(b []byte) ([]byte, error) {
+	return codec.AppendText(b, v.x, v.y, v.z), nil
+}
+
+// UnmarshalText parses a vector from the form "x,y,z". Whitespace
+// surrounding each component is ignored
+func (v *Vector[T]) UnmarshalText(text []byte) error {
+	var components [componentCount]T
+	if err := codec.ParseText(text, components[:]); err != nil {
+		return err
+	}
+	v.x = components[0]
+	v.y = components[1]
+	v.z = components[2]
+	return nil
+}
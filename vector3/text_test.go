@@ -0,0 +1,83 @@
+package vector3_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppendText(t *testing.T) {
+	f64, err := vector3.New(1.5, -2., 0.1).AppendText(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "1.5,-2,0.1", string(f64))
+
+	f32, err := vector3.New[float32](1.5, -2, 0.1).AppendText(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "1.5,-2,0.1", string(f32))
+
+	i, err := vector3.New(1, -2, 300).AppendText([]byte("v="))
+	assert.NoError(t, err)
+	assert.Equal(t, "v=1,-2,300", string(i))
+}
+
+func TestAppendTextAllocations(t *testing.T) {
+	v := vector3.Fill(1.25)
+	buf := make([]byte, 0, 128)
+	allocs := testing.AllocsPerRun(100, func() {
+		buf, _ = v.AppendText(buf[:0])
+	})
+	assert.Zero(t, allocs)
+}
+
+func TestMarshalText(t *testing.T) {
+	in := vector3.New(1.5, -2., 0.1)
+
+	text, err := in.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "1.5,-2,0.1", string(text))
+
+	var out vector3.Float64
+	assert.NoError(t, out.UnmarshalText(text))
+	assert.Equal(t, in, out)
+}
+
+func TestUnmarshalTextWhitespace(t *testing.T) {
+	var out vector3.Int
+	assert.NoError(t, out.UnmarshalText([]byte(" 3, 3, 3 ")))
+	assert.Equal(t, vector3.Fill(3), out)
+}
+
+func TestUnmarshalTextErrors(t *testing.T) {
+	tests := map[string]string{
+		"empty":          "",
+		"too few":        "1,1",
+		"too many":       "1,1,1,1",
+		"not a number":   "1,1,a",
+		"trailing comma": "1,1,1,",
+		"int8 overflow":  "1,1,300",
+	}
+
+	for name, text := range tests {
+		t.Run(name, func(t *testing.T) {
+			var out vector3.Int8
+			assert.Error(t, out.UnmarshalText([]byte(text)))
+		})
+	}
+}
+
+func TestTextMapKey(t *testing.T) {
+	in := map[vector3.Int]string{
+		vector3.Fill(1): "a",
+		vector3.Fill(2): "b",
+	}
+
+	data, err := json.Marshal(in)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"1,1,1":"a","2,2,2":"b"}`, string(data))
+
+	out := map[vector3.Int]string{}
+	assert.NoError(t, json.Unmarshal(data, &out))
+	assert.Equal(t, in, out)
+}
@@ -0,0 +1,40 @@
+package vector3
+
+import "github.com/EliCDavis/vector"
+
+// TriangleNormal returns the unit normal of the triangle abc. Counter
+// clockwise winding, when viewed from the side the normal points towards,
+// follows the right hand rule. Degenerate triangles result in NaN components
+func TriangleNormal[T vector.Number](a, b, c Vector[T]) Vector[T] {
+	return b.Sub(a).Cross(c.Sub(a)).Normalized()
+}
+
+// TriangleArea returns the area of the triangle abc
+func TriangleArea[T vector.Number](a, b, c Vector[T]) float64 {
+	ab := b.ToFloat64().Sub(a.ToFloat64())
+	ac := c.ToFloat64().Sub(a.ToFloat64())
+	return ab.Cross(ac).Length() / 2
+}
+
+// Barycentric computes the barycentric coordinates (u, v, w) of p with
+// respect to the triangle abc, such that p = u*a + v*b + w*c when p lies in
+// the triangle's plane. Points off the plane are projected onto it first.
+// Degenerate triangles result in NaN coordinates
+func Barycentric[T vector.Number](p, a, b, c Vector[T]) (u, v, w float64) {
+	fa := a.ToFloat64()
+	v0 := b.ToFloat64().Sub(fa)
+	v1 := c.ToFloat64().Sub(fa)
+	v2 := p.ToFloat64().Sub(fa)
+
+	d00 := v0.Dot(v0)
+	d01 := v0.Dot(v1)
+	d11 := v1.Dot(v1)
+	d20 := v2.Dot(v0)
+	d21 := v2.Dot(v1)
+	denom := d00*d11 - d01*d01
+
+	v = (d11*d20 - d01*d21) / denom
+	w = (d00*d21 - d01*d20) / denom
+	u = 1 - v - w
+	return
+}
@@ -0,0 +1,47 @@
+package vector3_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTriangleNormal(t *testing.T) {
+	a, b, c := vector3.New(0., 0., 0.), vector3.New(2., 0., 0.), vector3.New(0., 3., 0.)
+	assert.Equal(t, vector3.Forward[float64](), vector3.TriangleNormal(a, b, c))
+	assert.Equal(t, vector3.Backwards[float64](), vector3.TriangleNormal(a, c, b))
+	assert.True(t, vector3.TriangleNormal(a, a, b).ContainsNaN())
+}
+
+func TestTriangleArea(t *testing.T) {
+	assert.Equal(t, 3., vector3.TriangleArea(vector3.New(0, 0, 0), vector3.New(2, 0, 0), vector3.New(0, 3, 0)))
+	assert.InDelta(t, math.Sqrt(3)/2, vector3.TriangleArea(vector3.New(1., 0., 0.), vector3.New(0., 1., 0.), vector3.New(0., 0., 1.)), 1e-12)
+	assert.Equal(t, 0., vector3.TriangleArea(vector3.New(0, 0, 0), vector3.New(1, 1, 1), vector3.New(2, 2, 2)))
+}
+
+func TestBarycentric(t *testing.T) {
+	a, b, c := vector3.New(0., 0., 0.), vector3.New(4., 0., 0.), vector3.New(0., 4., 0.)
+
+	tests := map[string]struct {
+		p       vector3.Float64
+		u, v, w float64
+	}{
+		"a":         {p: a, u: 1},
+		"b":         {p: b, v: 1},
+		"c":         {p: c, w: 1},
+		"midpoint":  {p: vector3.New(2., 2., 0.), v: 0.5, w: 0.5},
+		"outside":   {p: vector3.New(-4., 0., 0.), u: 2, v: -1},
+		"projected": {p: vector3.New(1., 1., 5.), u: 0.5, v: 0.25, w: 0.25},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			u, v, w := vector3.Barycentric(tc.p, a, b, c)
+			assert.InDelta(t, tc.u, u, 1e-12)
+			assert.InDelta(t, tc.v, v, 1e-12)
+			assert.InDelta(t, tc.w, w, 1e-12)
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package vector3
+
+import "github.com/EliCDavis/vector"
+
+// Validate returns a *vector.ValidationError naming each component that's NaN
+// or infinite, or nil when they're all finite. APIs accepting vectors can use
+// it to reject bad input with an actionable error, rather than letting NaNs
+// silently spread through later calculations
+func (v Vector[T]) Validate() error {
+	return vector.ValidateComponents([]T{v.x, v.y, v.z}, false, 0, 0)
+}
+
+// ValidateRange is Validate but also rejects components outside [min, max]
+func (v Vector[T]) ValidateRange(min, max T) error {
+	return vector.ValidateComponents([]T{v.x, v.y, v.z}, true, min, max)
+}
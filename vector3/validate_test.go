@@ -0,0 +1,20 @@
+package vector3_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, vector3.New(1., 2., 3.).Validate())
+	assert.EqualError(t, vector3.New(1., math.Inf(-1), math.NaN()).Validate(), "invalid vector: y is -Inf, z is NaN")
+}
+
+func TestValidateRange(t *testing.T) {
+	v := vector3.New(1., 2., 3.)
+	assert.NoError(t, v.ValidateRange(0, 5))
+	assert.EqualError(t, v.ValidateRange(0, 2.5), "invalid vector: z is greater than the maximum of 2.5")
+}
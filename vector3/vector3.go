@@ -1,15 +1,17 @@
 package vector3
 
+//go:generate go run ../internal/cmd/swizzlegen -dim 3
+
 import (
-	"encoding/json"
 	"fmt"
 	"image/color"
 	"math"
 	"math/rand"
 
 	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/internal/codec"
+	"github.com/EliCDavis/vector/internal/fp"
 	"github.com/EliCDavis/vector/mathex"
-	"github.com/EliCDavis/vector/vector2"
 )
 
 // Vector contains 3 components
@@ -87,6 +89,10 @@ func One[T vector.Number]() Vector[T] {
 	return New[T](1, 1, 1)
 }
 
+// FromColor returns the red, green, and blue components of c as reported by its
+// RGBA method, scaled into the range [0, 1]. The values are alpha
+// premultiplied and left in whatever color space c was encoded in, typically
+// sRGB. Use FromColorLinear for colors that lighting math will be done on
 func FromColor(c color.Color) Float64 {
 	r, g, b, _ := c.RGBA()
 	return New(float64(r)/0xffff, float64(g)/0xffff, float64(b)/0xffff)
@@ -104,10 +110,18 @@ func Average[T vector.Number](vectors []Vector[T]) Vector[T] {
 
 // Lerp linearly interpolates between a and b by t
 func Lerp[T vector.Number](a, b Vector[T], t float64) Vector[T] {
+	if mathex.IsFloat[T]() {
+		s := T(t)
+		return Vector[T]{
+			x: fp.MulAdd(b.x-a.x, s, a.x),
+			y: fp.MulAdd(b.y-a.y, s, a.y),
+			z: fp.MulAdd(b.z-a.z, s, a.z),
+		}
+	}
 	return Vector[T]{
-		x: T((float64(b.x-a.x) * t) + float64(a.x)),
-		y: T((float64(b.y-a.y) * t) + float64(a.y)),
-		z: T((float64(b.z-a.z) * t) + float64(a.z)),
+		x: T(fp.MulAdd(float64(b.x-a.x), t, float64(a.x))),
+		y: T(fp.MulAdd(float64(b.y-a.y), t, float64(a.y))),
+		z: T(fp.MulAdd(float64(b.z-a.z), t, float64(a.z))),
 	}
 }
 
@@ -191,33 +205,21 @@ func (v Vector[T]) ToArr() []T {
 }
 
 func (v Vector[T]) MarshalJSON() ([]byte, error) {
-	return json.Marshal(&struct {
-		X float64 `json:"x"`
-		Y float64 `json:"y"`
-		Z float64 `json:"z"`
-	}{
-		X: float64(v.x),
-		Y: float64(v.y),
-		Z: float64(v.z),
-	})
+	return v.AppendJSON(nil)
 }
 
 func (v *Vector[T]) UnmarshalJSON(data []byte) error {
-	aux := &struct {
-		X float64 `json:"x"`
-		Y float64 `json:"y"`
-		Z float64 `json:"z"`
-	}{
-		X: 0,
-		Y: 0,
-		Z: 0,
-	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	var components [componentCount]T
+	if codec.IsJSONArray(data) {
+		if err := codec.UnmarshalJSONArray(data, components[:]); err != nil {
+			return err
+		}
+	} else if err := codec.UnmarshalJSONObject(data, componentKeys, components[:]); err != nil {
 		return err
 	}
-	v.x = T(aux.X)
-	v.y = T(aux.Y)
-	v.z = T(aux.Z)
+	v.x = components[0]
+	v.y = components[1]
+	v.z = components[2]
 	return nil
 }
 
@@ -281,142 +283,6 @@ func (v Vector[T]) ToInt64() Vector[int64] {
 	}
 }
 
-// X returns the x component
-func (v Vector[T]) X() T {
-	return v.x
-}
-
-// SetX changes the x component of the vector
-func (v Vector[T]) SetX(newX T) Vector[T] {
-	return Vector[T]{
-		x: newX,
-		y: v.y,
-		z: v.z,
-	}
-}
-
-func (v Vector[T]) Dx(dX T) Vector[T] {
-	return Vector[T]{
-		x: v.x + dX,
-		y: v.y,
-		z: v.z,
-	}
-}
-
-// Y returns the y component
-func (v Vector[T]) Y() T {
-	return v.y
-}
-
-// SetY changes the y component of the vector
-func (v Vector[T]) SetY(newY T) Vector[T] {
-	return Vector[T]{
-		x: v.x,
-		y: newY,
-		z: v.z,
-	}
-}
-
-func (v Vector[T]) Dy(dY T) Vector[T] {
-	return Vector[T]{
-		x: v.x,
-		y: v.y + dY,
-		z: v.z,
-	}
-}
-
-// Z returns the z component
-func (v Vector[T]) Z() T {
-	return v.z
-}
-
-// SetZ changes the z component of the vector
-func (v Vector[T]) SetZ(newZ T) Vector[T] {
-	return Vector[T]{
-		x: v.x,
-		y: v.y,
-		z: newZ,
-	}
-}
-
-func (v Vector[T]) Dz(dZ T) Vector[T] {
-	return Vector[T]{
-		x: v.x,
-		y: v.y,
-		z: v.z + dZ,
-	}
-}
-
-func (v Vector[T]) XZY() Vector[T] {
-	return Vector[T]{
-		x: v.x,
-		y: v.z,
-		z: v.y,
-	}
-}
-
-func (v Vector[T]) ZXY() Vector[T] {
-	return Vector[T]{
-		x: v.z,
-		y: v.x,
-		z: v.y,
-	}
-}
-
-func (v Vector[T]) ZYX() Vector[T] {
-	return Vector[T]{
-		x: v.z,
-		y: v.y,
-		z: v.x,
-	}
-}
-
-func (v Vector[T]) YXZ() Vector[T] {
-	return Vector[T]{
-		x: v.y,
-		y: v.x,
-		z: v.z,
-	}
-}
-
-func (v Vector[T]) YZX() Vector[T] {
-	return Vector[T]{
-		x: v.y,
-		y: v.z,
-		z: v.x,
-	}
-}
-
-// XY returns vector2 with the x and y components
-func (v Vector[T]) XY() vector2.Vector[T] {
-	return vector2.New(v.x, v.y)
-}
-
-// XZ returns vector2 with the x and z components
-func (v Vector[T]) XZ() vector2.Vector[T] {
-	return vector2.New(v.x, v.z)
-}
-
-// YZ returns vector2 with the y and z components
-func (v Vector[T]) YZ() vector2.Vector[T] {
-	return vector2.New(v.y, v.z)
-}
-
-// YX returns vector2 with the y and x components
-func (v Vector[T]) YX() vector2.Vector[T] {
-	return vector2.New(v.y, v.x)
-}
-
-// ZX returns vector2 with the z and x components
-func (v Vector[T]) ZX() vector2.Vector[T] {
-	return vector2.New(v.z, v.x)
-}
-
-// ZY returns vector2 with the z and y components
-func (v Vector[T]) ZY() vector2.Vector[T] {
-	return vector2.New(v.z, v.y)
-}
-
 // Midpoint returns the midpoint between this vector and the vector passed in.
 func (v Vector[T]) Midpoint(o Vector[T]) Vector[T] {
 	return Vector[T]{
@@ -505,6 +371,26 @@ func (v Vector[T]) Sqrt() Vector[T] {
 	)
 }
 
+// Snap rounds each component of the vector to the nearest multiple of the
+// corresponding component of step
+func (v Vector[T]) Snap(step Vector[T]) Vector[T] {
+	return Vector[T]{
+		x: mathex.Snap(v.x, step.x),
+		y: mathex.Snap(v.y, step.y),
+		z: mathex.Snap(v.z, step.z),
+	}
+}
+
+// Sign returns a vector where each component is -1, 0, or 1 depending on the
+// sign of the corresponding component of the vector
+func (v Vector[T]) Sign() Vector[T] {
+	return Vector[T]{
+		x: mathex.Sign(v.x),
+		y: mathex.Sign(v.y),
+		z: mathex.Sign(v.z),
+	}
+}
+
 // Abs applies the Abs math operation to each component of the vector
 func (v Vector[T]) Abs() Vector[T] {
 	return New(
@@ -561,18 +447,26 @@ func (v Vector[T]) Product() T {
 }
 
 func (v Vector[T]) Dot(other Vector[T]) T {
-	return (v.x * other.x) + (v.y * other.y) + (v.z * other.z)
+	return fp.Dot3(v.x, v.y, v.z, other.x, other.y, other.z)
 }
 
 func (v Vector[T]) Cross(other Vector[T]) Vector[T] {
 	return Vector[T]{
-		x: (v.y * other.z) - (v.z * other.y),
-		y: (v.z * other.x) - (v.x * other.z),
-		z: (v.x * other.y) - (v.y * other.x),
+		x: fp.DiffOfProducts(v.y, other.z, v.z, other.y),
+		y: fp.DiffOfProducts(v.z, other.x, v.x, other.z),
+		z: fp.DiffOfProducts(v.x, other.y, v.y, other.x),
 	}
 }
 
 func (v Vector[T]) Normalized() Vector[T] {
+	if mathex.IsFloat[T]() {
+		l := mathex.Sqrt(v.LengthSquared())
+		return Vector[T]{
+			x: v.x / l,
+			y: v.y / l,
+			z: v.z / l,
+		}
+	}
 	return v.DivByConstant(v.Length())
 }
 
@@ -615,7 +509,25 @@ func RandNormal(r *rand.Rand) Vector[float64] {
 	}.Normalized()
 }
 
+// RandGaussian returns a vector where each component is sampled from a normal
+// distribution centered on 0 with the given standard deviation
+func RandGaussian(r *rand.Rand, stddev float64) Vector[float64] {
+	return Vector[float64]{
+		x: mathex.RandNormal(r, 0, stddev),
+		y: mathex.RandNormal(r, 0, stddev),
+		z: mathex.RandNormal(r, 0, stddev),
+	}
+}
+
 func (v Vector[T]) Scale(t float64) Vector[T] {
+	if mathex.IsFloat[T]() {
+		s := T(t)
+		return Vector[T]{
+			x: fp.Mul(v.x, s),
+			y: fp.Mul(v.y, s),
+			z: fp.Mul(v.z, s),
+		}
+	}
 	return Vector[T]{
 		x: T(float64(v.x) * t),
 		y: T(float64(v.y) * t),
@@ -663,6 +575,14 @@ func (v Vector[T]) MultByVector(o Vector[T]) Vector[T] {
 }
 
 func (v Vector[T]) DivByConstant(t float64) Vector[T] {
+	if mathex.IsFloat[T]() {
+		s := T(t)
+		return Vector[T]{
+			x: v.x / s,
+			y: v.y / s,
+			z: v.z / s,
+		}
+	}
 	return Vector[T]{
 		x: T(float64(v.x) / t),
 		y: T(float64(v.y) / t),
@@ -675,14 +595,14 @@ func (v Vector[T]) Length() float64 {
 }
 
 func (v Vector[T]) LengthSquared() T {
-	return (v.x * v.x) + (v.y * v.y) + (v.z * v.z)
+	return fp.Dot3(v.x, v.y, v.z, v.x, v.y, v.z)
 }
 
 func (v Vector[T]) DistanceSquared(other Vector[T]) T {
 	xDist := other.x - v.x
 	yDist := other.y - v.y
 	zDist := other.z - v.z
-	return T((xDist * xDist) + (yDist * yDist) + (zDist * zDist))
+	return fp.Dot3(xDist, yDist, zDist, xDist, yDist, zDist)
 }
 
 func (v Vector[T]) Distance(other Vector[T]) float64 {
@@ -691,7 +611,7 @@ func (v Vector[T]) Distance(other Vector[T]) float64 {
 
 func (v Vector[T]) Angle(other Vector[T]) float64 {
 	denominator := mathex.Sqrt(float64(v.LengthSquared() * other.LengthSquared()))
-	if denominator < 1e-15 {
+	if mathex.ApproximatelyWithin(denominator, 0, 1e-15) {
 		return 0.
 	}
 	return mathex.Acos(mathex.Clamp(float64(v.Dot(other))/denominator, -1., 1.))
@@ -699,7 +619,7 @@ func (v Vector[T]) Angle(other Vector[T]) float64 {
 
 func (v Vector[T]) AngleF(other Vector[T]) float32 {
 	denominator := mathex.Sqrt(float32(v.LengthSquared() * other.LengthSquared()))
-	if denominator < 1e-15 {
+	if mathex.ApproximatelyWithin(denominator, 0, 1e-15) {
 		return 0.
 	}
 	return mathex.Acos(mathex.Clamp(float32(v.Dot(other))/denominator, -1., 1.))
@@ -709,34 +629,27 @@ func (v Vector[T]) NearZero() bool {
 	return mathex.NearZero(v.x) && mathex.NearZero(v.y) && mathex.NearZero(v.z)
 }
 
-func (v Vector[T]) Flip() Vector[T] {
-	return Vector[T]{
-		x: v.x * -1,
-		y: v.y * -1,
-		z: v.z * -1,
-	}
+// Approximately returns true if each component of the vector is approximately
+// equal to the corresponding component of the other vector
+func (v Vector[T]) Approximately(other Vector[T]) bool {
+	return mathex.Approximately(v.x, other.x) &&
+		mathex.Approximately(v.y, other.y) &&
+		mathex.Approximately(v.z, other.z)
 }
 
-func (v Vector[T]) FlipX() Vector[T] {
-	return Vector[T]{
-		x: v.x * -1,
-		y: v.y,
-		z: v.z,
-	}
+// WithinULP returns true if each component of the vector is no more than n
+// units in the last place away from the corresponding component of the other
+// vector
+func (v Vector[T]) WithinULP(other Vector[T], n uint64) bool {
+	return mathex.WithinULP(v.x, other.x, n) &&
+		mathex.WithinULP(v.y, other.y, n) &&
+		mathex.WithinULP(v.z, other.z, n)
 }
 
-func (v Vector[T]) FlipY() Vector[T] {
+func (v Vector[T]) Flip() Vector[T] {
 	return Vector[T]{
-		x: v.x,
+		x: v.x * -1,
 		y: v.y * -1,
-		z: v.z,
-	}
-}
-
-func (v Vector[T]) FlipZ() Vector[T] {
-	return Vector[T]{
-		x: v.x,
-		y: v.y,
 		z: v.z * -1,
 	}
 }
@@ -601,3 +601,86 @@ func TestValues(t *testing.T) {
 	assert.Equal(t, y, 2)
 	assert.Equal(t, z, 3)
 }
+
+func TestApproximately(t *testing.T) {
+	v := vector3.Fill(1.)
+	assert.True(t, v.Approximately(vector3.Fill(1.+1e-12)))
+	assert.False(t, v.Approximately(vector3.Fill(1.001)))
+	assert.True(t, v.WithinULP(vector3.Fill(math.Nextafter(1, 2)), 1))
+	assert.False(t, v.WithinULP(vector3.Fill(1.001), 1))
+}
+
+func TestSnap(t *testing.T) {
+	got := vector3.New(1.2, 3.9, -0.4).Snap(vector3.New(0.5, 2., 1.))
+	assert.True(t, vector3.New(1., 4., 0.).Approximately(got), got.Format("%f"))
+}
+
+func TestSign(t *testing.T) {
+	assert.Equal(t, vector3.New[float64](-1, 0, 1), vector3.New[float64](-1.2, 0, 4).Sign())
+	assert.Equal(t, vector3.New(-1, 0, 1), vector3.New[int](-1, 0, 1).Scale(5).Sign())
+}
+
+func TestRandGaussian(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+
+	sum := vector3.Zero[float64]()
+	n := 10000
+	for i := 0; i < n; i++ {
+		sum = sum.Add(vector3.RandGaussian(r, 2))
+	}
+
+	assert.True(t, sum.DivByConstant(float64(n)).Length() < 0.1)
+}
+
+func TestFloat32NativePrecision(t *testing.T) {
+	v := vector3.New[float32](0.1, 0.2, 0.3)
+	s := float32(0.7)
+
+	// Results should match arithmetic done entirely in float32
+	assert.Equal(t, vector3.New(0.1*s, 0.2*s, 0.3*s), v.Scale(float64(s)))
+	assert.Equal(t, vector3.New(v.X()/s, v.Y()/s, v.Z()/s), v.DivByConstant(float64(s)))
+
+	b := vector3.New[float32](1.1, -2.3, 5.7)
+	assert.Equal(t, vector3.New(
+		(b.X()-v.X())*s+v.X(),
+		(b.Y()-v.Y())*s+v.Y(),
+		(b.Z()-v.Z())*s+v.Z(),
+	), vector3.Lerp(v, b, float64(s)))
+
+	l := float32(math.Sqrt(float64(v.X()*v.X() + v.Y()*v.Y() + v.Z()*v.Z())))
+	assert.Equal(t, vector3.New(v.X()/l, v.Y()/l, v.Z()/l), v.Normalized())
+
+	// Integer vectors keep truncating through float64
+	assert.Equal(t, vector3.New(1, 2, 3), vector3.New(2, 5, 7).Scale(0.5))
+}
+
+func BenchmarkFloat32Normalized(b *testing.B) {
+	v := vector3.New[float32](1, 2, 3)
+	for i := 0; i < b.N; i++ {
+		v = v.Normalized().Scale(2)
+	}
+	_ = v
+}
+
+func TestInt64Precision(t *testing.T) {
+	big := int64(1<<60 + 1)
+	v := vector3.New(-big, big, -3)
+
+	assert.Equal(t, vector3.New(big, big, 3), v.Abs())
+	assert.Equal(t, v, v.Round())
+	assert.Equal(t, v, v.Floor())
+	assert.Equal(t, v, v.Ceil())
+	assert.Equal(t, vector3.New(-big, 0, -3), vector3.Min(v, vector3.Zero[int64]()))
+	assert.Equal(t, big, v.MaxComponent())
+}
+
+func TestMinMaxNaNIsSymmetric(t *testing.T) {
+	nan := vector3.New(math.NaN(), 1, 1)
+	zero := vector3.Zero[float64]()
+
+	for _, v := range []vector3.Float64{vector3.Min(nan, zero), vector3.Min(zero, nan), vector3.Max(nan, zero), vector3.Max(zero, nan)} {
+		assert.True(t, math.IsNaN(v.X()))
+	}
+	assert.Equal(t, 0., vector3.Min(nan, zero).Y())
+	assert.Equal(t, 1., vector3.Max(zero, nan).Y())
+}
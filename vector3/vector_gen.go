@@ -0,0 +1,256 @@
+// Code generated by internal/cmd/swizzlegen; DO NOT EDIT.
+
+package vector3
+
+import (
+	"github.com/EliCDavis/vector/vector2"
+)
+
+// X returns the x component
+func (v Vector[T]) X() T {
+	return v.x
+}
+
+// SetX returns a copy of the vector with the x component replaced
+func (v Vector[T]) SetX(newX T) Vector[T] {
+	v.x = newX
+	return v
+}
+
+// Dx returns a copy of the vector with dX added to the x component
+func (v Vector[T]) Dx(dX T) Vector[T] {
+	v.x += dX
+	return v
+}
+
+// FlipX returns a copy of the vector with the x component negated
+func (v Vector[T]) FlipX() Vector[T] {
+	v.x *= -1
+	return v
+}
+
+// Y returns the y component
+func (v Vector[T]) Y() T {
+	return v.y
+}
+
+// SetY returns a copy of the vector with the y component replaced
+func (v Vector[T]) SetY(newY T) Vector[T] {
+	v.y = newY
+	return v
+}
+
+// Dy returns a copy of the vector with dY added to the y component
+func (v Vector[T]) Dy(dY T) Vector[T] {
+	v.y += dY
+	return v
+}
+
+// FlipY returns a copy of the vector with the y component negated
+func (v Vector[T]) FlipY() Vector[T] {
+	v.y *= -1
+	return v
+}
+
+// Z returns the z component
+func (v Vector[T]) Z() T {
+	return v.z
+}
+
+// SetZ returns a copy of the vector with the z component replaced
+func (v Vector[T]) SetZ(newZ T) Vector[T] {
+	v.z = newZ
+	return v
+}
+
+// Dz returns a copy of the vector with dZ added to the z component
+func (v Vector[T]) Dz(dZ T) Vector[T] {
+	v.z += dZ
+	return v
+}
+
+// FlipZ returns a copy of the vector with the z component negated
+func (v Vector[T]) FlipZ() Vector[T] {
+	v.z *= -1
+	return v
+}
+
+// XX returns a vector made up of the x and x components
+func (v Vector[T]) XX() vector2.Vector[T] {
+	return vector2.New(v.x, v.x)
+}
+
+// XY returns a vector made up of the x and y components
+func (v Vector[T]) XY() vector2.Vector[T] {
+	return vector2.New(v.x, v.y)
+}
+
+// XZ returns a vector made up of the x and z components
+func (v Vector[T]) XZ() vector2.Vector[T] {
+	return vector2.New(v.x, v.z)
+}
+
+// YX returns a vector made up of the y and x components
+func (v Vector[T]) YX() vector2.Vector[T] {
+	return vector2.New(v.y, v.x)
+}
+
+// YY returns a vector made up of the y and y components
+func (v Vector[T]) YY() vector2.Vector[T] {
+	return vector2.New(v.y, v.y)
+}
+
+// YZ returns a vector made up of the y and z components
+func (v Vector[T]) YZ() vector2.Vector[T] {
+	return vector2.New(v.y, v.z)
+}
+
+// ZX returns a vector made up of the z and x components
+func (v Vector[T]) ZX() vector2.Vector[T] {
+	return vector2.New(v.z, v.x)
+}
+
+// ZY returns a vector made up of the z and y components
+func (v Vector[T]) ZY() vector2.Vector[T] {
+	return vector2.New(v.z, v.y)
+}
+
+// ZZ returns a vector made up of the z and z components
+func (v Vector[T]) ZZ() vector2.Vector[T] {
+	return vector2.New(v.z, v.z)
+}
+
+// XXX returns a vector made up of the x, x and x components
+func (v Vector[T]) XXX() Vector[T] {
+	return Vector[T]{x: v.x, y: v.x, z: v.x}
+}
+
+// XXY returns a vector made up of the x, x and y components
+func (v Vector[T]) XXY() Vector[T] {
+	return Vector[T]{x: v.x, y: v.x, z: v.y}
+}
+
+// XXZ returns a vector made up of the x, x and z components
+func (v Vector[T]) XXZ() Vector[T] {
+	return Vector[T]{x: v.x, y: v.x, z: v.z}
+}
+
+// XYX returns a vector made up of the x, y and x components
+func (v Vector[T]) XYX() Vector[T] {
+	return Vector[T]{x: v.x, y: v.y, z: v.x}
+}
+
+// XYY returns a vector made up of the x, y and y components
+func (v Vector[T]) XYY() Vector[T] {
+	return Vector[T]{x: v.x, y: v.y, z: v.y}
+}
+
+// XYZ returns a vector made up of the x, y and z components
+func (v Vector[T]) XYZ() Vector[T] {
+	return Vector[T]{x: v.x, y: v.y, z: v.z}
+}
+
+// XZX returns a vector made up of the x, z and x components
+func (v Vector[T]) XZX() Vector[T] {
+	return Vector[T]{x: v.x, y: v.z, z: v.x}
+}
+
+// XZY returns a vector made up of the x, z and y components
+func (v Vector[T]) XZY() Vector[T] {
+	return Vector[T]{x: v.x, y: v.z, z: v.y}
+}
+
+// XZZ returns a vector made up of the x, z and z components
+func (v Vector[T]) XZZ() Vector[T] {
+	return Vector[T]{x: v.x, y: v.z, z: v.z}
+}
+
+// YXX returns a vector made up of the y, x and x components
+func (v Vector[T]) YXX() Vector[T] {
+	return Vector[T]{x: v.y, y: v.x, z: v.x}
+}
+
+// YXY returns a vector made up of the y, x and y components
+func (v Vector[T]) YXY() Vector[T] {
+	return Vector[T]{x: v.y, y: v.x, z: v.y}
+}
+
+// YXZ returns a vector made up of the y, x and z components
+func (v Vector[T]) YXZ() Vector[T] {
+	return Vector[T]{x: v.y, y: v.x, z: v.z}
+}
+
+// YYX returns a vector made up of the y, y and x components
+func (v Vector[T]) YYX() Vector[T] {
+	return Vector[T]{x: v.y, y: v.y, z: v.x}
+}
+
+// YYY returns a vector made up of the y, y and y components
+func (v Vector[T]) YYY() Vector[T] {
+	return Vector[T]{x: v.y, y: v.y, z: v.y}
+}
+
+// YYZ returns a vector made up of the y, y and z components
+func (v Vector[T]) YYZ() Vector[T] {
+	return Vector[T]{x: v.y, y: v.y, z: v.z}
+}
+
+// YZX returns a vector made up of the y, z and x components
+func (v Vector[T]) YZX() Vector[T] {
+	return Vector[T]{x: v.y, y: v.z, z: v.x}
+}
+
+// YZY returns a vector made up of the y, z and y components
+func (v Vector[T]) YZY() Vector[T] {
+	return Vector[T]{x: v.y, y: v.z, z: v.y}
+}
+
+// YZZ returns a vector made up of the y, z and z components
+func (v Vector[T]) YZZ() Vector[T] {
+	return Vector[T]{x: v.y, y: v.z, z: v.z}
+}
+
+// ZXX returns a vector made up of the z, x and x components
+func (v Vector[T]) ZXX() Vector[T] {
+	return Vector[T]{x: v.z, y: v.x, z: v.x}
+}
+
+// ZXY returns a vector made up of the z, x and y components
+func (v Vector[T]) ZXY() Vector[T] {
+	return Vector[T]{x: v.z, y: v.x, z: v.y}
+}
+
+// ZXZ returns a vector made up of the z, x and z components
+func (v Vector[T]) ZXZ() Vector[T] {
+	return Vector[T]{x: v.z, y: v.x, z: v.z}
+}
+
+// ZYX returns a vector made up of the z, y and x components
+func (v Vector[T]) ZYX() Vector[T] {
+	return Vector[T]{x: v.z, y: v.y, z: v.x}
+}
+
+// ZYY returns a vector made up of the z, y and y components
+func (v Vector[T]) ZYY() Vector[T] {
+	return Vector[T]{x: v.z, y: v.y, z: v.y}
+}
+
+// ZYZ returns a vector made up of the z, y and z components
+func (v Vector[T]) ZYZ() Vector[T] {
+	return Vector[T]{x: v.z, y: v.y, z: v.z}
+}
+
+// ZZX returns a vector made up of the z, z and x components
+func (v Vector[T]) ZZX() Vector[T] {
+	return Vector[T]{x: v.z, y: v.z, z: v.x}
+}
+
+// ZZY returns a vector made up of the z, z and y components
+func (v Vector[T]) ZZY() Vector[T] {
+	return Vector[T]{x: v.z, y: v.z, z: v.y}
+}
+
+// ZZZ returns a vector made up of the z, z and z components
+func (v Vector[T]) ZZZ() Vector[T] {
+	return Vector[T]{x: v.z, y: v.z, z: v.z}
+}
@@ -0,0 +1,71 @@
+package vector3
+
+import "math"
+
+// TraverseVoxels walks a ray through a grid of unit sized voxels using the
+// Amanatides–Woo algorithm, calling visit for every voxel the ray passes
+// through in the order it enters them. Along with each voxel, visit is given
+// the distance along the ray at which it was entered, which is 0 for the
+// voxel containing the origin. Traversal stops once the ray has travelled
+// maxDistance, or visit returns false.
+//
+// The direction doesn't need to be normalized. Grids with voxels of another
+// size can be traversed by dividing the origin and maxDistance by the voxel
+// size beforehand, and multiplying the distances visited by it afterwards.
+func TraverseVoxels(origin, direction Float64, maxDistance float64, visit func(voxel Int, distance float64) bool) {
+	cell := [3]int{
+		int(math.Floor(origin.x)),
+		int(math.Floor(origin.y)),
+		int(math.Floor(origin.z)),
+	}
+	if !visit(New(cell[0], cell[1], cell[2]), 0) {
+		return
+	}
+
+	length := direction.Length()
+	if length == 0 || math.IsNaN(length) {
+		return
+	}
+
+	o := [3]float64{origin.x, origin.y, origin.z}
+	d := [3]float64{direction.x / length, direction.y / length, direction.z / length}
+
+	var step [3]int
+	var tMax, tDelta [3]float64
+	for i := range d {
+		switch {
+		case d[i] > 0:
+			step[i] = 1
+			tMax[i] = (float64(cell[i]+1) - o[i]) / d[i]
+			tDelta[i] = 1 / d[i]
+		case d[i] < 0:
+			step[i] = -1
+			tMax[i] = (o[i] - float64(cell[i])) / -d[i]
+			tDelta[i] = -1 / d[i]
+		default:
+			tMax[i] = math.Inf(1)
+			tDelta[i] = math.Inf(1)
+		}
+	}
+
+	for {
+		axis := 0
+		if tMax[1] < tMax[axis] {
+			axis = 1
+		}
+		if tMax[2] < tMax[axis] {
+			axis = 2
+		}
+
+		t := tMax[axis]
+		if t > maxDistance {
+			return
+		}
+
+		cell[axis] += step[axis]
+		tMax[axis] += tDelta[axis]
+		if !visit(New(cell[0], cell[1], cell[2]), t) {
+			return
+		}
+	}
+}
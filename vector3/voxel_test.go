@@ -0,0 +1,61 @@
+package vector3_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+)
+
+type visitedVoxel struct {
+	voxel    vector3.Int
+	distance float64
+}
+
+func traverse(origin, direction vector3.Float64, maxDistance float64) (out []visitedVoxel) {
+	vector3.TraverseVoxels(origin, direction, maxDistance, func(v vector3.Int, d float64) bool {
+		out = append(out, visitedVoxel{v, d})
+		return true
+	})
+	return
+}
+
+func TestTraverseVoxelsAxisAligned(t *testing.T) {
+	assert.Equal(t, []visitedVoxel{
+		{vector3.New(0, 0, 0), 0},
+		{vector3.New(1, 0, 0), 0.5},
+		{vector3.New(2, 0, 0), 1.5},
+		{vector3.New(3, 0, 0), 2.5},
+	}, traverse(vector3.New(0.5, 0.5, 0.5), vector3.New(2., 0., 0.), 3))
+
+	assert.Equal(t, []visitedVoxel{
+		{vector3.New(0, 0, 0), 0},
+		{vector3.New(0, 0, -1), 0.25},
+		{vector3.New(0, 0, -2), 1.25},
+	}, traverse(vector3.New(0.5, 0.5, 0.25), vector3.New(0., 0., -1.), 2))
+}
+
+func TestTraverseVoxelsDiagonal(t *testing.T) {
+	visited := traverse(vector3.New(0.5, 0.25, -0.5), vector3.New(1., 1., 0.), 2)
+
+	// Every step moves to a voxel sharing a face with the previous one, in
+	// order of increasing distance
+	for i := 1; i < len(visited); i++ {
+		assert.Equal(t, 1, visited[i].voxel.Sub(visited[i-1].voxel).LengthSquared())
+		assert.GreaterOrEqual(t, visited[i].distance, visited[i-1].distance)
+	}
+	assert.Equal(t, vector3.New(0, 0, -1), visited[0].voxel)
+	assert.Equal(t, vector3.New(1, 0, -1), visited[1].voxel)
+	assert.Equal(t, vector3.New(1, 1, -1), visited[2].voxel)
+}
+
+func TestTraverseVoxelsStops(t *testing.T) {
+	count := 0
+	vector3.TraverseVoxels(vector3.Zero[float64](), vector3.One[float64](), 100, func(vector3.Int, float64) bool {
+		count++
+		return count < 5
+	})
+	assert.Equal(t, 5, count)
+
+	assert.Len(t, traverse(vector3.New(0.5, 0.5, 0.5), vector3.Zero[float64](), 10), 1)
+}
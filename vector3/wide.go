@@ -0,0 +1,24 @@
+package vector3
+
+import "github.com/EliCDavis/vector"
+
+// Dot64 computes the dot product of two integer vectors, accumulating in
+// int64 so that moderately sized int32 or int components don't overflow
+func Dot64[T vector.Integer](a, b Vector[T]) int64 {
+	return int64(a.x)*int64(b.x) + int64(a.y)*int64(b.y) + int64(a.z)*int64(b.z)
+}
+
+// LengthSquared64 computes the squared length of an integer vector,
+// accumulating in int64 to avoid overflowing the component type
+func LengthSquared64[T vector.Integer](v Vector[T]) int64 {
+	return int64(v.x)*int64(v.x) + int64(v.y)*int64(v.y) + int64(v.z)*int64(v.z)
+}
+
+// DistanceSquared64 computes the squared distance between two integer
+// vectors, accumulating in int64 to avoid overflowing the component type
+func DistanceSquared64[T vector.Integer](a, b Vector[T]) int64 {
+	dx := int64(b.x) - int64(a.x)
+	dy := int64(b.y) - int64(a.y)
+	dz := int64(b.z) - int64(a.z)
+	return dx*dx + dy*dy + dz*dz
+}
@@ -0,0 +1,20 @@
+package vector3_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWideIntegerMath(t *testing.T) {
+	a := vector3.New[int32](50000, -60000, 70000)
+	b := vector3.New[int32](40000, 70000, -80000)
+
+	assert.Equal(t, int64(50000*40000-60000*70000-70000*80000), vector3.Dot64(a, b))
+	assert.Equal(t, int64(50000*50000+60000*60000+70000*70000), vector3.LengthSquared64(a))
+	assert.Equal(t, int64(10000*10000+130000*130000+150000*150000), vector3.DistanceSquared64(a, b))
+
+	// The component type overflows where the wide variant doesn't
+	assert.NotEqual(t, vector3.LengthSquared64(a), int64(a.LengthSquared()))
+}
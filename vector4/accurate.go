@@ -0,0 +1,64 @@
+package vector4
+
+import (
+	"math"
+
+	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/mathex"
+)
+
+// SumAccurate adds all vectors together using compensated summation, which
+// avoids the error a naive running total builds up over very large slices,
+// especially of float32 vectors
+func SumAccurate[T vector.Number](vectors []Vector[T]) Vector[T] {
+	var x, y, z, w mathex.CompensatedSum
+	for _, v := range vectors {
+		x.Add(float64(v.x))
+		y.Add(float64(v.y))
+		z.Add(float64(v.z))
+		w.Add(float64(v.w))
+	}
+	return Vector[T]{
+		x: T(x.Value()),
+		y: T(y.Value()),
+		z: T(z.Value()),
+		w: T(w.Value()),
+	}
+}
+
+// AverageAccurate computes the average of all vectors using compensated
+// summation. See SumAccurate
+func AverageAccurate[T vector.Number](vectors []Vector[T]) Vector[T] {
+	var x, y, z, w mathex.CompensatedSum
+	for _, v := range vectors {
+		x.Add(float64(v.x))
+		y.Add(float64(v.y))
+		z.Add(float64(v.z))
+		w.Add(float64(v.w))
+	}
+	count := float64(len(vectors))
+	return Vector[T]{
+		x: T(x.Value() / count),
+		y: T(y.Value() / count),
+		z: T(z.Value() / count),
+		w: T(w.Value() / count),
+	}
+}
+
+// LengthAccurate returns the length of the vector like Length, but scales the
+// components by the largest of them first, the same way math.Hypot does, so
+// the squared terms can't overflow to Inf or underflow to 0
+func (v Vector[T]) LengthAccurate() float64 {
+	x := math.Abs(float64(v.x))
+	y := math.Abs(float64(v.y))
+	z := math.Abs(float64(v.z))
+	w := math.Abs(float64(v.w))
+
+	m := max(x, y, z, w)
+	if m == 0 || math.IsInf(m, 1) {
+		return m
+	}
+
+	x, y, z, w = x/m, y/m, z/m, w/m
+	return m * math.Sqrt(x*x+y*y+z*z+w*w)
+}
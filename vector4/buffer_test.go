@@ -0,0 +1,53 @@
+package vector4_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector/vector4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPackFloat32(t *testing.T) {
+	in := []vector4.Float32{
+		vector4.New[float32](1, 2, 3, 4),
+		vector4.New[float32](-1, 0.5, 0, 8),
+	}
+
+	data := vector4.PackFloat32(in)
+	assert.Len(t, data, 2*16)
+
+	back, err := vector4.UnpackFloat32(data)
+	assert.NoError(t, err)
+	assert.Equal(t, in, back)
+}
+
+func TestPackFloat32Strided(t *testing.T) {
+	in := []vector4.Float32{
+		vector4.New[float32](1, 2, 3, 4),
+		vector4.New[float32](-1, 0.5, 0, 8),
+	}
+
+	data := make([]byte, 4+2*16+8)
+	require.NoError(t, vector4.PackFloat32Strided(data, in, 4, 16+4))
+
+	back, err := vector4.UnpackFloat32Strided(data, 2, 4, 16+4)
+	assert.NoError(t, err)
+	assert.Equal(t, in, back)
+
+	assert.Error(t, vector4.PackFloat32Strided(data, in, 4, 16-4))
+}
+
+func TestPackFloat16(t *testing.T) {
+	in := []vector4.Float32{
+		vector4.Fill[float32](0.5),
+		vector4.Fill[float32](-3),
+	}
+
+	data := vector4.PackFloat16(in)
+	assert.Len(t, data, 2*4*2)
+
+	back, err := vector4.UnpackFloat16(data)
+	assert.NoError(t, err)
+	assert.Equal(t, in, back)
+}
@@ -0,0 +1,21 @@
+package vector4
+
+import "github.com/EliCDavis/vector/internal/codec"
+
+// MarshalCBOR encodes the vector as a CBOR array of 4 components
+func (v Vector[T]) MarshalCBOR() ([]byte, error) {
+	return codec.AppendCBOR(nil, v.x, v.y, v.z, v.w), nil
+}
+
+// UnmarshalCBOR decodes a vector from a CBOR array of 4 components
+func (v *Vector[T]) UnmarshalCBOR(data []byte) error {
+	var components [componentCount]T
+	if err := codec.DecodeCBOR(data, components[:]); err != nil {
+		return err
+	}
+	v.x = components[0]
+	v.y = components[1]
+	v.z = components[2]
+	v.w = components[3]
+	return nil
+}
@@ -0,0 +1,82 @@
+package vector4_test
+
+import (
+	"encoding/hex"
+	"math"
+	"testing"
+
+	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/vector4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type cborTestCase[T vector.Number] struct {
+	val vector4.Vector[T]
+}
+
+func (tc cborTestCase[T]) test(t *testing.T) {
+	data, err := tc.val.MarshalCBOR()
+	require.NoError(t, err)
+
+	var back vector4.Vector[T]
+	require.NoError(t, back.UnmarshalCBOR(data))
+	assert.Equal(t, tc.val, back)
+}
+
+func TestCBOR(t *testing.T) {
+	tests := map[string]testCaseI{
+		"float64": cborTestCase[float64]{
+			val: vector4.New[float64](1, -2, 3, 4),
+		},
+		"float64 precision": cborTestCase[float64]{
+			val: vector4.Fill(math.Pi),
+		},
+		"float32": cborTestCase[float32]{
+			val: vector4.New[float32](1, -2, 3, 4),
+		},
+		"int8": cborTestCase[int8]{
+			val: vector4.New[int8](1, -2, 3, 4),
+		},
+		"int16": cborTestCase[int16]{
+			val: vector4.New[int16](1, -2, 3, 4),
+		},
+		"int32": cborTestCase[int32]{
+			val: vector4.New[int32](1, -200000, 3, 4),
+		},
+		"int64": cborTestCase[int64]{
+			val: vector4.Fill[int64](math.MinInt64),
+		},
+		"int": cborTestCase[int]{
+			val: vector4.Fill[int](math.MaxInt),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tc.test(t)
+		})
+	}
+}
+
+func TestCBORDecodeForms(t *testing.T) {
+	want := vector4.New[float64](1, 2, 3, 4)
+	for _, encoded := range []string{"8401020304", "84f93c00f94000f94200f94400", "9f01020304ff"} {
+		data, err := hex.DecodeString(encoded)
+		require.NoError(t, err)
+
+		var v vector4.Float64
+		require.NoError(t, v.UnmarshalCBOR(data), encoded)
+		assert.Equal(t, want, v, encoded)
+	}
+}
+
+func TestCBORInvalid(t *testing.T) {
+	var v vector4.Int
+	assert.Error(t, v.UnmarshalCBOR(nil))
+	assert.Error(t, v.UnmarshalCBOR([]byte{0x81, 0x01}))
+
+	data, err := vector4.New[float64](1, -2, 3, 4).MarshalCBOR()
+	require.NoError(t, err)
+	assert.Error(t, v.UnmarshalCBOR(data))
+}
@@ -0,0 +1,47 @@
+package vector4
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/EliCDavis/vector/internal/colorspace"
+	"github.com/EliCDavis/vector/mathex"
+)
+
+// RGBA interprets the vector as an alpha-premultiplied color with red, green,
+// blue, and alpha components in the range [0, 1], satisfying the color.Color
+// interface. It's the inverse of FromColor. Out of range components are
+// clamped, and the color components are additionally clamped to alpha, as
+// premultiplied colors can't exceed it
+func (v Vector[T]) RGBA() (r, g, b, a uint32) {
+	a = colorChannel(float64(v.w), 1)
+	alpha := float64(a) / 0xffff
+	return colorChannel(float64(v.x), alpha), colorChannel(float64(v.y), alpha), colorChannel(float64(v.z), alpha), a
+}
+
+// colorChannel maps c from [0, limit] to [0, 0xffff]. NaN has no meaningful
+// intensity and would make the conversion to uint32 implementation defined,
+// so it's treated as 0
+func colorChannel(c, limit float64) uint32 {
+	if math.IsNaN(c) {
+		return 0
+	}
+	return uint32(mathex.Clamp(c, 0, limit)*0xffff + 0.5)
+}
+
+// FromColorSRGB returns the sRGB encoded red, green, and blue components of
+// c along with its alpha, all in the range [0, 1]. Unlike FromColor, the
+// color components are straight rather than premultiplied by alpha, as the
+// sRGB transfer function applies to straight color
+func FromColorSRGB(c color.Color) Float64 {
+	return New(colorspace.Straight(c))
+}
+
+// FromColorLinear returns the red, green, and blue components of c decoded
+// from sRGB into linear light, along with its alpha. Lighting and blending
+// math should be done in linear light. The color components are straight
+// rather than premultiplied, and can be premultiplied by scaling them by
+// alpha once in linear space
+func FromColorLinear(c color.Color) Float64 {
+	return New(colorspace.Linear(c))
+}
@@ -0,0 +1,62 @@
+package vector4_test
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"testing"
+
+	"github.com/EliCDavis/vector/vector4"
+	"github.com/EliCDavis/vector/vectortest"
+	"github.com/stretchr/testify/assert"
+)
+
+var _ color.Color = vector4.Float64{}
+
+func TestRGBA(t *testing.T) {
+	tests := map[string]struct {
+		in   vector4.Float64
+		want color.RGBA64
+	}{
+		"transparent": {in: vector4.New(0., 0., 0., 0.), want: color.RGBA64{0, 0, 0, 0}},
+		"white":       {in: vector4.New(1., 1., 1., 1.), want: color.RGBA64{0xffff, 0xffff, 0xffff, 0xffff}},
+		"half alpha":  {in: vector4.New(0.5, 0.25, 0., 0.5), want: color.RGBA64{0x8000, 0x4000, 0, 0x8000}},
+		"clamp":       {in: vector4.New(-1., 2., 0., 3.), want: color.RGBA64{0, 0xffff, 0, 0xffff}},
+		"over alpha":  {in: vector4.New(1., 0.1, 0., 0.5), want: color.RGBA64{0x8000, 0x199a, 0, 0x8000}},
+		"nan color":   {in: vector4.New(math.NaN(), 1., 0., 1.), want: color.RGBA64{0, 0xffff, 0, 0xffff}},
+		"nan alpha":   {in: vector4.New(1., 1., 0., math.NaN()), want: color.RGBA64{0, 0, 0, 0}},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			r, g, b, a := tc.in.RGBA()
+			assert.Equal(t, tc.want, color.RGBA64{uint16(r), uint16(g), uint16(b), uint16(a)})
+		})
+	}
+}
+
+func TestColorRoundTrip(t *testing.T) {
+	in := color.NRGBA{R: 12, G: 200, B: 99, A: 128}
+	assert.Equal(t, in, color.NRGBAModel.Convert(vector4.FromColor(in)))
+
+	img := image.NewNRGBA(image.Rect(0, 0, 1, 1))
+	img.Set(0, 0, vector4.New(0.5, 0., 0., 0.5))
+	assert.Equal(t, color.NRGBA{R: 255, A: 128}, img.NRGBAAt(0, 0))
+}
+
+func TestFromColorSpaces(t *testing.T) {
+	straight := color.NRGBA{R: 255, G: 128, B: 0, A: 64}
+	vectortest.AssertVector4InDelta(t, vector4.New(1., 128./255, 0., 64./255), vector4.FromColorSRGB(straight), 1e-9)
+	vectortest.AssertVector4InDelta(t, vector4.New(1., 0.2158605, 0., 64./255), vector4.FromColorLinear(straight), 1e-6)
+
+	// The same color premultiplied through the generic RGBA path, which
+	// loses a little precision to 16-bit rounding
+	premultiplied := color.RGBA64Model.Convert(straight)
+	vectortest.AssertVector4InDelta(t, vector4.FromColorSRGB(straight), vector4.FromColorSRGB(premultiplied), 1e-4)
+	vectortest.AssertVector4InDelta(t, vector4.FromColorLinear(straight), vector4.FromColorLinear(premultiplied), 1e-4)
+
+	opaque := color.RGBA{R: 12, G: 200, B: 99, A: 255}
+	vectortest.AssertVector4InDelta(t, vector4.FromColor(opaque), vector4.FromColorSRGB(opaque), 1e-9)
+
+	assert.Equal(t, vector4.Zero[float64](), vector4.FromColorLinear(color.Transparent))
+}
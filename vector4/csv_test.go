@@ -0,0 +1,26 @@
+package vector4_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/vector4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCSVRoundTrip(t *testing.T) {
+	in := []vector4.Float64{
+		vector4.New(1.5, -2., 0.1, 7.),
+		vector4.New(4., 5., 6., 8.),
+	}
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, vector4.WriteCSV(buf, in))
+	assert.Equal(t, "x,y,z,w\n1.5,-2,0.1,7\n4,5,6,8\n", buf.String())
+
+	out, err := vector4.ReadCSV[float64](buf, vector.CSVOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, in, out)
+}
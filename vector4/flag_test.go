@@ -0,0 +1,34 @@
+package vector4_test
+
+import (
+	"flag"
+	"fmt"
+	"testing"
+
+	"github.com/EliCDavis/vector/vector4"
+	"github.com/stretchr/testify/assert"
+)
+
+var _ flag.Value = (*vector4.Vector[float64])(nil)
+
+func TestFlagString(t *testing.T) {
+	v := vector4.New(1.5, -2., 0.1, 4.)
+	assert.Equal(t, "1.5,-2,0.1,4", v.String())
+	assert.Equal(t, "1.5,-2,0.1,4", fmt.Sprint(v))
+}
+
+func TestFlagSet(t *testing.T) {
+	var origin vector4.Float64
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.Var(&origin, "origin", "origin of the scene")
+
+	assert.NoError(t, fs.Parse([]string{"--origin=1.5,-2,0.1,4"}))
+	assert.Equal(t, vector4.New(1.5, -2., 0.1, 4.), origin)
+	assert.Equal(t, "vector4", origin.Type())
+}
+
+func TestFlagSetInvalid(t *testing.T) {
+	var v vector4.Float64
+	assert.Error(t, v.Set("1"))
+	assert.Error(t, v.Set("a,b,c,d"))
+}
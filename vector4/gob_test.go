@@ -0,0 +1,40 @@
+package vector4_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+
+	"github.com/EliCDavis/vector/vector4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGob(t *testing.T) {
+	type session struct {
+		Position vector4.Float64
+		Cells    []vector4.Int
+		Lookup   map[string]vector4.Float32
+	}
+
+	in := session{
+		Position: vector4.New(1.5, -2., 0.1, 4.),
+		Cells:    []vector4.Int{vector4.New(1, 2, 3, 4), vector4.Zero[int]()},
+		Lookup:   map[string]vector4.Float32{"spawn": vector4.New[float32](1.5, -2., 0.1, 4.)},
+	}
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, gob.NewEncoder(buf).Encode(in))
+
+	var out session
+	require.NoError(t, gob.NewDecoder(buf).Decode(&out))
+	assert.Equal(t, in, out)
+}
+
+func TestGobComponentMismatch(t *testing.T) {
+	buf := &bytes.Buffer{}
+	require.NoError(t, gob.NewEncoder(buf).Encode(vector4.New(1.5, -2., 0.1, 4.)))
+
+	var out vector4.Float32
+	assert.Error(t, gob.NewDecoder(buf).Decode(&out))
+}
@@ -0,0 +1,118 @@
+package vector4_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/vector4"
+	"github.com/stretchr/testify/assert"
+)
+
+type msgpackTestCase[T vector.Number] struct {
+	val vector4.Vector[T]
+}
+
+func (tc msgpackTestCase[T]) test(t *testing.T) {
+	data, err := tc.val.MarshalMsg(nil)
+	assert.NoError(t, err)
+	assert.LessOrEqual(t, len(data), tc.val.Msgsize())
+
+	var back vector4.Vector[T]
+	rest, err := back.UnmarshalMsg(data)
+	assert.NoError(t, err)
+	assert.Empty(t, rest)
+	assert.Equal(t, tc.val, back)
+}
+
+func TestMsgpack(t *testing.T) {
+	tests := map[string]testCaseI{
+		"float64": msgpackTestCase[float64]{
+			val: vector4.New[float64](1, -2, 300, -70000),
+		},
+		"float32": msgpackTestCase[float32]{
+			val: vector4.New[float32](1, -2, 300, -70000),
+		},
+		"int8": msgpackTestCase[int8]{
+			val: vector4.New[int8](1, -2, 3, -4),
+		},
+		"int16": msgpackTestCase[int16]{
+			val: vector4.New[int16](1, -2, 300, -7000),
+		},
+		"int32": msgpackTestCase[int32]{
+			val: vector4.New[int32](1, -2, 300, -70000),
+		},
+		"int64": msgpackTestCase[int64]{
+			val: vector4.New[int64](1, -2, 300, -70000),
+		},
+		"int": msgpackTestCase[int]{
+			val: vector4.New[int](1, -2, 300, -70000),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tc.test(t)
+		})
+	}
+}
+
+func TestMsgpackLayout(t *testing.T) {
+	data, err := vector4.New(1, -128, 300, -16).MarshalMsg(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x94, 0x01, 0xd0, 0x80, 0xd1, 0x01, 0x2c, 0xf0}, data)
+}
+
+func TestMsgpackStream(t *testing.T) {
+	a := vector4.Fill(1.5)
+	b := vector4.Fill(-2.5)
+
+	data, err := a.MarshalMsg(nil)
+	assert.NoError(t, err)
+	data, err = b.MarshalMsg(data)
+	assert.NoError(t, err)
+
+	var gotA, gotB vector4.Float64
+	data, err = gotA.UnmarshalMsg(data)
+	assert.NoError(t, err)
+	data, err = gotB.UnmarshalMsg(data)
+	assert.NoError(t, err)
+
+	assert.Empty(t, data)
+	assert.Equal(t, a, gotA)
+	assert.Equal(t, b, gotB)
+}
+
+func TestMsgpackIntoFloat(t *testing.T) {
+	data, err := vector4.New(1, -128, 300, -16).MarshalMsg(nil)
+	assert.NoError(t, err)
+
+	var back vector4.Float32
+	_, err = back.UnmarshalMsg(data)
+	assert.NoError(t, err)
+	assert.Equal(t, vector4.New[float32](1, -128, 300, -16), back)
+}
+
+func TestMsgpackErrors(t *testing.T) {
+	floats, err := vector4.Fill(1.5).MarshalMsg(nil)
+	assert.NoError(t, err)
+
+	large, err := vector4.Fill(1000).MarshalMsg(nil)
+	assert.NoError(t, err)
+
+	tests := map[string][]byte{
+		"empty":          nil,
+		"not an array":   {0x01},
+		"wrong length":   {0x91, 0x01},
+		"truncated":      large[:len(large)-1],
+		"float into int": floats,
+		"overflow":       large,
+	}
+
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			var v vector4.Int8
+			_, err := v.UnmarshalMsg(data)
+			assert.Error(t, err)
+		})
+	}
+}
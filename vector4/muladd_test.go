@@ -0,0 +1,32 @@
+package vector4_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector/vector4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMulAdd(t *testing.T) {
+	a := vector4.New(1., 2., 3., 4.)
+	b := vector4.New(0.5, -1., 2., -4.)
+
+	assert.Equal(t, vector4.New(2., 0., 7., -4.), a.MulAdd(b, 2))
+	assert.Equal(t, vector4.New(3., 4., 9.5, 6.), a.ScaleAdd(2.5, b))
+
+	v := a
+	v.MulAddAssign(b, 2)
+	assert.Equal(t, a.MulAdd(b, 2), v)
+
+	v = a
+	v.ScaleAddAssign(2.5, b)
+	assert.Equal(t, a.ScaleAdd(2.5, b), v)
+}
+
+func TestMulAddSingleRounding(t *testing.T) {
+	// -1 + (1+2^-30)(1-2^-30) is lost entirely when the product is rounded
+	// before the addition
+	e := 1. / (1 << 30)
+	got := vector4.Fill(-1.).MulAdd(vector4.Fill(1+e), 1-e)
+	assert.Equal(t, vector4.Fill(-e*e), got)
+}
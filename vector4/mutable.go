@@ -0,0 +1,54 @@
+package vector4
+
+// The Assign methods modify the vector in place rather than returning a new
+// one, avoiding value copies in hot loops over large slices:
+//
+//	for i := range particles {
+//		particles[i].AddAssign(velocity)
+//	}
+
+// AddAssign adds other to v
+func (v *Vector[T]) AddAssign(other Vector[T]) {
+	v.x += other.x
+	v.y += other.y
+	v.z += other.z
+	v.w += other.w
+}
+
+// SubAssign subtracts other from v
+func (v *Vector[T]) SubAssign(other Vector[T]) {
+	v.x -= other.x
+	v.y -= other.y
+	v.z -= other.z
+	v.w -= other.w
+}
+
+// ScaleAssign multiplies v by t
+func (v *Vector[T]) ScaleAssign(t float64) {
+	v.x = T(float64(v.x) * t)
+	v.y = T(float64(v.y) * t)
+	v.z = T(float64(v.z) * t)
+	v.w = T(float64(v.w) * t)
+}
+
+// MultByVectorAssign multiplies each component of v by the matching
+// component of o
+func (v *Vector[T]) MultByVectorAssign(o Vector[T]) {
+	v.x *= o.x
+	v.y *= o.y
+	v.z *= o.z
+	v.w *= o.w
+}
+
+// DivByConstantAssign divides v by t
+func (v *Vector[T]) DivByConstantAssign(t float64) {
+	v.x = T(float64(v.x) / t)
+	v.y = T(float64(v.y) / t)
+	v.z = T(float64(v.z) / t)
+	v.w = T(float64(v.w) / t)
+}
+
+// NormalizeAssign scales v to a length of 1, matching Normalized
+func (v *Vector[T]) NormalizeAssign() {
+	v.DivByConstantAssign(v.Length())
+}
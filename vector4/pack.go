@@ -0,0 +1,85 @@
+package vector4
+
+import "github.com/EliCDavis/vector/internal/codec"
+
+// PackUnorm4x8 quantizes each component, clamped to [0, 1], into 8 bits, x in
+// the low byte. This matches the memory layout of an RGBA8 pixel on little
+// endian machines
+func (v Vector[T]) PackUnorm4x8() uint32 {
+	return codec.PackUnorm(float64(v.x), 8) |
+		codec.PackUnorm(float64(v.y), 8)<<8 |
+		codec.PackUnorm(float64(v.z), 8)<<16 |
+		codec.PackUnorm(float64(v.w), 8)<<24
+}
+
+// UnpackUnorm4x8 converts a value packed with PackUnorm4x8 back into a vector
+// with components in [0, 1]
+func UnpackUnorm4x8(p uint32) Float64 {
+	return New(
+		codec.UnpackUnorm(p, 8),
+		codec.UnpackUnorm(p>>8, 8),
+		codec.UnpackUnorm(p>>16, 8),
+		codec.UnpackUnorm(p>>24, 8),
+	)
+}
+
+// PackSnorm4x8 quantizes each component, clamped to [-1, 1], into a signed 8
+// bit integer, x in the low byte
+func (v Vector[T]) PackSnorm4x8() uint32 {
+	return codec.PackSnorm(float64(v.x), 8) |
+		codec.PackSnorm(float64(v.y), 8)<<8 |
+		codec.PackSnorm(float64(v.z), 8)<<16 |
+		codec.PackSnorm(float64(v.w), 8)<<24
+}
+
+// UnpackSnorm4x8 converts a value packed with PackSnorm4x8 back into a vector
+// with components in [-1, 1]
+func UnpackSnorm4x8(p uint32) Float64 {
+	return New(
+		codec.UnpackSnorm(p, 8),
+		codec.UnpackSnorm(p>>8, 8),
+		codec.UnpackSnorm(p>>16, 8),
+		codec.UnpackSnorm(p>>24, 8),
+	)
+}
+
+// PackUnorm1010102 quantizes x, y, and z into 10 bits and w into 2 bits, each
+// clamped to [0, 1], x in the low bits. This is the RGB10A2 layout
+func (v Vector[T]) PackUnorm1010102() uint32 {
+	return codec.PackUnorm(float64(v.x), 10) |
+		codec.PackUnorm(float64(v.y), 10)<<10 |
+		codec.PackUnorm(float64(v.z), 10)<<20 |
+		codec.PackUnorm(float64(v.w), 2)<<30
+}
+
+// UnpackUnorm1010102 converts a value packed with PackUnorm1010102 back into a
+// vector with components in [0, 1]
+func UnpackUnorm1010102(p uint32) Float64 {
+	return New(
+		codec.UnpackUnorm(p, 10),
+		codec.UnpackUnorm(p>>10, 10),
+		codec.UnpackUnorm(p>>20, 10),
+		codec.UnpackUnorm(p>>30, 2),
+	)
+}
+
+// PackSnorm1010102 quantizes x, y, and z into signed 10 bit integers and w
+// into a signed 2 bit integer, each clamped to [-1, 1], x in the low bits.
+// This is the layout commonly used for compact normals and tangents
+func (v Vector[T]) PackSnorm1010102() uint32 {
+	return codec.PackSnorm(float64(v.x), 10) |
+		codec.PackSnorm(float64(v.y), 10)<<10 |
+		codec.PackSnorm(float64(v.z), 10)<<20 |
+		codec.PackSnorm(float64(v.w), 2)<<30
+}
+
+// UnpackSnorm1010102 converts a value packed with PackSnorm1010102 back into a
+// vector with components in [-1, 1]
+func UnpackSnorm1010102(p uint32) Float64 {
+	return New(
+		codec.UnpackSnorm(p, 10),
+		codec.UnpackSnorm(p>>10, 10),
+		codec.UnpackSnorm(p>>20, 10),
+		codec.UnpackSnorm(p>>30, 2),
+	)
+}
@@ -0,0 +1,45 @@
+package vector4_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector/vector4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPackUnorm4x8(t *testing.T) {
+	assert.Equal(t, uint32(0xff0080ff), vector4.New(1., 0.5, 0., 1.).PackUnorm4x8())
+	assert.Equal(t, uint32(0xff00ff00), vector4.New(-1., 2., 0., 1.).PackUnorm4x8())
+
+	assert.Equal(t, vector4.New(1., 128./255, 0., 1.), vector4.UnpackUnorm4x8(0xff0080ff))
+}
+
+func TestPackSnorm4x8(t *testing.T) {
+	assert.Equal(t, uint32(0x7f0081c0), vector4.New(-0.5, -1., 0., 1.).PackSnorm4x8())
+	assert.Equal(t, vector4.New(-64./127, -1., 0., 1.), vector4.UnpackSnorm4x8(0x7f0081c0))
+
+	// -128 and -127 both decode to -1
+	assert.Equal(t, -1., vector4.UnpackSnorm4x8(0x80).X())
+}
+
+func TestPackUnorm1010102(t *testing.T) {
+	assert.Equal(t, uint32(0xc00ffc00|0x3ff), vector4.New(1., 1., 0., 1.).PackUnorm1010102())
+
+	in := vector4.New(0.25, 0.5, 0.75, 1./3)
+	back := vector4.UnpackUnorm1010102(in.PackUnorm1010102())
+	assert.InDelta(t, 0.25, back.X(), 1./1023)
+	assert.InDelta(t, 0.5, back.Y(), 1./1023)
+	assert.InDelta(t, 0.75, back.Z(), 1./1023)
+	assert.Equal(t, 1./3, back.W())
+}
+
+func TestPackSnorm1010102(t *testing.T) {
+	in := vector4.New(0.6, -0.8, 0., -1.)
+	back := vector4.UnpackSnorm1010102(in.PackSnorm1010102())
+	assert.InDelta(t, 0.6, back.X(), 1./511)
+	assert.InDelta(t, -0.8, back.Y(), 1./511)
+	assert.Equal(t, 0., back.Z())
+	assert.Equal(t, -1., back.W())
+
+	assert.Equal(t, uint32(0x400001ff), vector4.New(1., 0., 0., 1.).PackSnorm1010102())
+}
@@ -0,0 +1,20 @@
+package vector4
+
+import (
+	"math/rand"
+	"reflect"
+
+	"github.com/EliCDavis/vector/mathex"
+)
+
+// Generate implements quick.Generator, allowing vectors to be used as
+// arguments of property based tests run with testing/quick. Each component
+// falls within [-size, size]
+func (Vector[T]) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(Vector[T]{
+		x: mathex.RandSized[T](r, size),
+		y: mathex.RandSized[T](r, size),
+		z: mathex.RandSized[T](r, size),
+		w: mathex.RandSized[T](r, size),
+	})
+}
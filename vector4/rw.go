@@ -11,6 +11,8 @@ import (
 
 const componentCount = 4
 
+var componentKeys = []string{"x", "y", "z", "w"}
+
 func (v Vector[T]) Write(out io.Writer, endian binary.ByteOrder) (err error) {
 	switch vv := any(v).(type) {
 	case Float64:
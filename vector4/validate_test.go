@@ -0,0 +1,20 @@
+package vector4_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/EliCDavis/vector/vector4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, vector4.New(1., 2., 3., 4.).Validate())
+	assert.EqualError(t, vector4.New(1., 2., 3., math.Inf(1)).Validate(), "invalid vector: w is +Inf")
+}
+
+func TestValidateRange(t *testing.T) {
+	v := vector4.New(1., 2., 3., 4.)
+	assert.NoError(t, v.ValidateRange(0, 5))
+	assert.EqualError(t, v.ValidateRange(0, 3.5), "invalid vector: w is greater than the maximum of 3.5")
+}
@@ -1,15 +1,16 @@
 package vector4
 
+//go:generate go run ../internal/cmd/swizzlegen -dim 4
+
 import (
-	"encoding/json"
 	"fmt"
 	"image/color"
 	"math"
 
 	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/internal/codec"
+	"github.com/EliCDavis/vector/internal/fp"
 	"github.com/EliCDavis/vector/mathex"
-	"github.com/EliCDavis/vector/vector2"
-	"github.com/EliCDavis/vector/vector3"
 )
 
 // Vector contains 4 components
@@ -50,6 +51,10 @@ func Fill[T vector.Number](v T) Vector[T] {
 	}
 }
 
+// FromColor returns the red, green, blue, and alpha components of c as reported by its
+// RGBA method, scaled into the range [0, 1]. The values are alpha
+// premultiplied and left in whatever color space c was encoded in, typically
+// sRGB. Use FromColorLinear for colors that lighting math will be done on
 func FromColor(c color.Color) Float64 {
 	r, g, b, a := c.RGBA()
 	return New(float64(r)/0xffff, float64(g)/0xffff, float64(b)/0xffff, float64(a)/0xffff)
@@ -92,17 +97,35 @@ func Average[T vector.Number](vectors []Vector[T]) Vector[T] {
 
 // Lerp linearly interpolates between a and b by t
 func Lerp[T vector.Number](a, b Vector[T], t float64) Vector[T] {
+	if mathex.IsFloat[T]() {
+		s := T(t)
+		return Vector[T]{
+			x: fp.MulAdd(b.x-a.x, s, a.x),
+			y: fp.MulAdd(b.y-a.y, s, a.y),
+			z: fp.MulAdd(b.z-a.z, s, a.z),
+			w: fp.MulAdd(b.w-a.w, s, a.w),
+		}
+	}
 
 	// return b.Sub(a).Scale(t).Add(a)
 	return Vector[T]{
-		x: T((float64(b.x-a.x) * t) + float64(a.x)),
-		y: T((float64(b.y-a.y) * t) + float64(a.y)),
-		z: T((float64(b.z-a.z) * t) + float64(a.z)),
-		w: T((float64(b.w-a.w) * t) + float64(a.w)),
+		x: T(fp.MulAdd(float64(b.x-a.x), t, float64(a.x))),
+		y: T(fp.MulAdd(float64(b.y-a.y), t, float64(a.y))),
+		z: T(fp.MulAdd(float64(b.z-a.z), t, float64(a.z))),
+		w: T(fp.MulAdd(float64(b.w-a.w), t, float64(a.w))),
 	}
 }
 
 func (v Vector[T]) Scale(t float64) Vector[T] {
+	if mathex.IsFloat[T]() {
+		s := T(t)
+		return Vector[T]{
+			x: fp.Mul(v.x, s),
+			y: fp.Mul(v.y, s),
+			z: fp.Mul(v.z, s),
+			w: fp.Mul(v.w, s),
+		}
+	}
 	return Vector[T]{
 		x: T(float64(v.x) * t),
 		y: T(float64(v.y) * t),
@@ -111,7 +134,25 @@ func (v Vector[T]) Scale(t float64) Vector[T] {
 	}
 }
 
+func (v Vector[T]) ScaleF(t float32) Vector[T] {
+	return Vector[T]{
+		x: T(float32(v.x) * t),
+		y: T(float32(v.y) * t),
+		z: T(float32(v.z) * t),
+		w: T(float32(v.w) * t),
+	}
+}
+
 func (v Vector[T]) DivByConstant(t float64) Vector[T] {
+	if mathex.IsFloat[T]() {
+		s := T(t)
+		return Vector[T]{
+			x: v.x / s,
+			y: v.y / s,
+			z: v.z / s,
+			w: v.w / s,
+		}
+	}
 	return Vector[T]{
 		x: T(float64(v.x) / t),
 		y: T(float64(v.y) / t),
@@ -183,6 +224,11 @@ func Midpoint[T vector.Number](a, b Vector[T]) Vector[T] {
 	}
 }
 
+// Midpoint finds the mid point between this vector and another
+func (v Vector[T]) Midpoint(o Vector[T]) Vector[T] {
+	return Midpoint(v, o)
+}
+
 // Builds a vector from the data found from the passed in array to the best of
 // it's ability. If the length of the array is smaller than the vector itself,
 // only those values will be used to build the vector, and the remaining vector
@@ -211,38 +257,22 @@ func FromArray[T vector.Number](data []T) Vector[T] {
 }
 
 func (v Vector[T]) MarshalJSON() ([]byte, error) {
-	return json.Marshal(&struct {
-		X float64 `json:"x"`
-		Y float64 `json:"y"`
-		Z float64 `json:"z"`
-		W float64 `json:"w"`
-	}{
-		X: float64(v.x),
-		Y: float64(v.y),
-		Z: float64(v.z),
-		W: float64(v.w),
-	})
+	return v.AppendJSON(nil)
 }
 
 func (v *Vector[T]) UnmarshalJSON(data []byte) error {
-	aux := &struct {
-		X float64 `json:"x"`
-		Y float64 `json:"y"`
-		Z float64 `json:"z"`
-		W float64 `json:"w"`
-	}{
-		X: 0,
-		Y: 0,
-		Z: 0,
-		W: 0,
-	}
-	if err := json.Unmarshal(data, &aux); err != nil {
+	var components [componentCount]T
+	if codec.IsJSONArray(data) {
+		if err := codec.UnmarshalJSONArray(data, components[:]); err != nil {
+			return err
+		}
+	} else if err := codec.UnmarshalJSONObject(data, componentKeys, components[:]); err != nil {
 		return err
 	}
-	v.x = T(aux.X)
-	v.y = T(aux.Y)
-	v.z = T(aux.Z)
-	v.w = T(aux.W)
+	v.x = components[0]
+	v.y = components[1]
+	v.z = components[2]
+	v.w = components[3]
 	return nil
 }
 
@@ -294,102 +324,6 @@ func (v Vector[T]) ToInt64() Vector[int64] {
 	}
 }
 
-// X returns the x component
-func (v Vector[T]) X() T {
-	return v.x
-}
-
-// SetX changes the x component of the vector
-func (v Vector[T]) SetX(newX T) Vector[T] {
-	return Vector[T]{
-		x: newX,
-		y: v.y,
-		z: v.z,
-		w: v.w,
-	}
-}
-
-func (v Vector[T]) Dx(dX T) Vector[T] {
-	return Vector[T]{
-		x: v.x + dX,
-		y: v.y,
-		z: v.z,
-		w: v.w,
-	}
-}
-
-// Y returns the y component
-func (v Vector[T]) Y() T {
-	return v.y
-}
-
-// SetY changes the y component of the vector
-func (v Vector[T]) SetY(newY T) Vector[T] {
-	return Vector[T]{
-		x: v.x,
-		y: newY,
-		z: v.z,
-		w: v.w,
-	}
-}
-
-func (v Vector[T]) Dy(dY T) Vector[T] {
-	return Vector[T]{
-		x: v.x,
-		y: v.y + dY,
-		z: v.z,
-		w: v.w,
-	}
-}
-
-// Z returns the z component
-func (v Vector[T]) Z() T {
-	return v.z
-}
-
-// SetZ changes the z component of the vector
-func (v Vector[T]) SetZ(newZ T) Vector[T] {
-	return Vector[T]{
-		x: v.x,
-		y: v.y,
-		z: newZ,
-		w: v.w,
-	}
-}
-
-func (v Vector[T]) Dz(dZ T) Vector[T] {
-	return Vector[T]{
-		x: v.x,
-		y: v.y,
-		z: v.z + dZ,
-		w: v.w,
-	}
-}
-
-// W returns the w component
-func (v Vector[T]) W() T {
-	return v.w
-}
-
-// SetW changes the w component of the vector
-func (v Vector[T]) SetW(newW T) Vector[T] {
-	return Vector[T]{
-		x: v.x,
-		y: v.y,
-		z: v.z,
-		w: newW,
-	}
-}
-
-func (v Vector[T]) Dw(dW T) Vector[T] {
-	return Vector[T]{
-		x: v.x,
-		y: v.y,
-		z: v.z,
-		w: v.w + dW,
-	}
-}
-
 // Add takes each component of our vector and adds them to the vector passed
 // in, returning a resulting vector
 func (v Vector[T]) Add(other Vector[T]) Vector[T] {
@@ -433,10 +367,76 @@ func (v Vector[T]) Product() T {
 }
 
 func (v Vector[T]) Dot(other Vector[T]) float64 {
-	return float64((v.x * other.x) + (v.y * other.y) + (v.z * other.z) + (v.w * other.w))
+	return float64(fp.Dot4(v.x, v.y, v.z, v.w, other.x, other.y, other.z, other.w))
+}
+
+// Project returns the component of v that lies along normal
+func (v Vector[T]) Project(normal Vector[T]) Vector[T] {
+	return normal.Scale(v.Dot(normal) / normal.Dot(normal))
+}
+
+// Reject returns the component of v perpendicular to normal, such that
+// v.Project(normal) + v.Reject(normal) = v
+func (v Vector[T]) Reject(normal Vector[T]) Vector[T] {
+	return v.Sub(v.Project(normal))
+}
+
+// Reflect mirrors v about the hyperplane with the given unit normal
+func (v Vector[T]) Reflect(normal Vector[T]) Vector[T] {
+	return v.Sub(normal.Scale(2. * v.Dot(normal)))
+}
+
+// Refract bends the unit vector v as it passes through a surface with the
+// given unit normal, where etaiOverEtat is the ratio of the refractive
+// indices on either side of it
+func (v Vector[T]) Refract(normal Vector[T], etaiOverEtat float64) Vector[T] {
+	cosTheta := min(v.Scale(-1).Dot(normal), 1.0)
+	perpendicular := v.Add(normal.Scale(cosTheta)).Scale(etaiOverEtat)
+	parallel := normal.Scale(-math.Sqrt(math.Abs(1.0 - perpendicular.LengthSquared())))
+	return perpendicular.Add(parallel)
+}
+
+func (v Vector[T]) DistanceSquared(other Vector[T]) float64 {
+	xDist := other.x - v.x
+	yDist := other.y - v.y
+	zDist := other.z - v.z
+	wDist := other.w - v.w
+	return float64(fp.Dot4(xDist, yDist, zDist, wDist, xDist, yDist, zDist, wDist))
+}
+
+func (v Vector[T]) Distance(other Vector[T]) float64 {
+	return math.Sqrt(v.DistanceSquared(other))
+}
+
+// Angle returns the angle in radians between the two vectors, or 0 if
+// either has no length
+func (v Vector[T]) Angle(other Vector[T]) float64 {
+	denominator := mathex.Sqrt(v.LengthSquared() * other.LengthSquared())
+	if mathex.ApproximatelyWithin(denominator, 0, 1e-15) {
+		return 0.
+	}
+	return mathex.Acos(mathex.Clamp(v.Dot(other)/denominator, -1., 1.))
+}
+
+// AngleF is Angle computed in float32
+func (v Vector[T]) AngleF(other Vector[T]) float32 {
+	denominator := mathex.Sqrt(float32(v.LengthSquared()) * float32(other.LengthSquared()))
+	if mathex.ApproximatelyWithin(denominator, 0, 1e-15) {
+		return 0.
+	}
+	return mathex.Acos(mathex.Clamp(float32(v.Dot(other))/denominator, -1., 1.))
 }
 
 func (v Vector[T]) Normalized() Vector[T] {
+	if mathex.IsFloat[T]() {
+		l := mathex.Sqrt(fp.Dot4(v.x, v.y, v.z, v.w, v.x, v.y, v.z, v.w))
+		return Vector[T]{
+			x: v.x / l,
+			y: v.y / l,
+			z: v.z / l,
+			w: v.w / l,
+		}
+	}
 	return v.DivByConstant(v.Length())
 }
 
@@ -445,7 +445,7 @@ func (v Vector[T]) Length() float64 {
 }
 
 func (v Vector[T]) LengthSquared() float64 {
-	return float64((v.x * v.x) + (v.y * v.y) + (v.z * v.z) + (v.w * v.w))
+	return float64(fp.Dot4(v.x, v.y, v.z, v.w, v.x, v.y, v.z, v.w))
 }
 
 // Sqrt applies the math.Sqrt to each component of the vector
@@ -458,6 +458,28 @@ func (v Vector[T]) Sqrt() Vector[T] {
 	)
 }
 
+// Snap rounds each component of the vector to the nearest multiple of the
+// corresponding component of step
+func (v Vector[T]) Snap(step Vector[T]) Vector[T] {
+	return Vector[T]{
+		x: mathex.Snap(v.x, step.x),
+		y: mathex.Snap(v.y, step.y),
+		z: mathex.Snap(v.z, step.z),
+		w: mathex.Snap(v.w, step.w),
+	}
+}
+
+// Sign returns a vector where each component is -1, 0, or 1 depending on the
+// sign of the corresponding component of the vector
+func (v Vector[T]) Sign() Vector[T] {
+	return Vector[T]{
+		x: mathex.Sign(v.x),
+		y: mathex.Sign(v.y),
+		z: mathex.Sign(v.z),
+		w: mathex.Sign(v.w),
+	}
+}
+
 // Abs applies the Abs math operation to each component of the vector
 func (v Vector[T]) Abs() Vector[T] {
 	return New(
@@ -575,85 +597,34 @@ func (v Vector[T]) NearZero() bool {
 	return mathex.NearZero(v.x) && mathex.NearZero(v.y) && mathex.NearZero(v.z) && mathex.NearZero(v.w)
 }
 
-func (v Vector[T]) Flip() Vector[T] {
-	return Vector[T]{
-		x: v.x * -1,
-		y: v.y * -1,
-		z: v.z * -1,
-		w: v.w * -1,
-	}
+// Approximately returns true if each component of the vector is approximately
+// equal to the corresponding component of the other vector
+func (v Vector[T]) Approximately(other Vector[T]) bool {
+	return mathex.Approximately(v.x, other.x) &&
+		mathex.Approximately(v.y, other.y) &&
+		mathex.Approximately(v.z, other.z) &&
+		mathex.Approximately(v.w, other.w)
 }
 
-func (v Vector[T]) FlipX() Vector[T] {
-	return Vector[T]{
-		x: v.x * -1,
-		y: v.y,
-		z: v.z,
-		w: v.w,
-	}
+// WithinULP returns true if each component of the vector is no more than n
+// units in the last place away from the corresponding component of the other
+// vector
+func (v Vector[T]) WithinULP(other Vector[T], n uint64) bool {
+	return mathex.WithinULP(v.x, other.x, n) &&
+		mathex.WithinULP(v.y, other.y, n) &&
+		mathex.WithinULP(v.z, other.z, n) &&
+		mathex.WithinULP(v.w, other.w, n)
 }
 
-func (v Vector[T]) FlipY() Vector[T] {
+func (v Vector[T]) Flip() Vector[T] {
 	return Vector[T]{
-		x: v.x,
+		x: v.x * -1,
 		y: v.y * -1,
-		z: v.z,
-		w: v.w,
-	}
-}
-
-func (v Vector[T]) FlipZ() Vector[T] {
-	return Vector[T]{
-		x: v.x,
-		y: v.y,
 		z: v.z * -1,
-		w: v.w,
-	}
-}
-
-func (v Vector[T]) FlipW() Vector[T] {
-	return Vector[T]{
-		x: v.x,
-		y: v.y,
-		z: v.z,
 		w: v.w * -1,
 	}
 }
 
-func (v Vector[T]) XYZ() vector3.Vector[T] {
-	return vector3.New[T](v.x, v.y, v.z)
-}
-
-// XY returns vector2 with the x and y components
-func (v Vector[T]) XY() vector2.Vector[T] {
-	return vector2.New(v.x, v.y)
-}
-
-// XZ returns vector2 with the x and z components
-func (v Vector[T]) XZ() vector2.Vector[T] {
-	return vector2.New(v.x, v.z)
-}
-
-// YZ returns vector2 with the y and z components
-func (v Vector[T]) YZ() vector2.Vector[T] {
-	return vector2.New(v.y, v.z)
-}
-
-// YX returns vector2 with the y and x components
-func (v Vector[T]) YX() vector2.Vector[T] {
-	return vector2.New(v.y, v.x)
-}
-
-// ZX returns vector2 with the z and x components
-func (v Vector[T]) ZX() vector2.Vector[T] {
-	return vector2.New(v.z, v.x)
-}
-
-// ZY returns vector2 with the z and y components
-func (v Vector[T]) ZY() vector2.Vector[T] {
-	return vector2.New(v.z, v.y)
-}
-
 // Log returns the natural logarithm for each component
 func (v Vector[T]) Log() Vector[T] {
 	return Vector[T]{
@@ -717,3 +688,7 @@ func (v Vector[T]) Expm1() Vector[T] {
 func (v Vector[T]) Values() (T, T, T, T) {
 	return v.x, v.y, v.z, v.w
 }
+
+func (v Vector[T]) ToArr() []T {
+	return []T{v.x, v.y, v.z, v.w}
+}
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"image/color"
 	"math"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/EliCDavis/vector/vector2"
@@ -139,6 +141,8 @@ func TestSwizzle_Vector3(t *testing.T) {
 		got      vector3.Float64
 	}{
 		"XYZ": {expected: vector3.New(1., 2., 3.), got: in.XYZ()},
+		"WZY": {expected: vector3.New(4., 3., 2.), got: in.WZY()},
+		"XWW": {expected: vector3.New(1., 4., 4.), got: in.XWW()},
 	}
 
 	for name, tc := range tests {
@@ -150,6 +154,13 @@ func TestSwizzle_Vector3(t *testing.T) {
 	}
 }
 
+func TestSwizzle_Vector4(t *testing.T) {
+	in := vector4.New(1., 2., 3., 4.)
+	assert.Equal(t, vector4.New(4., 3., 2., 1.), in.WZYX())
+	assert.Equal(t, vector4.New(1., 1., 2., 2.), in.XXYY())
+	assert.Equal(t, in, in.XYZW())
+}
+
 func TestSwizzle_Vector2(t *testing.T) {
 	start := vector4.New(1.2, -2.4, 3.7, 12.)
 
@@ -163,6 +174,8 @@ func TestSwizzle_Vector2(t *testing.T) {
 		"yx": {got: start.YX(), want: vector2.New(-2.4, 1.2)},
 		"zy": {got: start.ZY(), want: vector2.New(3.7, -2.4)},
 		"zx": {got: start.ZX(), want: vector2.New(3.7, 1.2)},
+		"xw": {got: start.XW(), want: vector2.New(1.2, 12.)},
+		"ww": {got: start.WW(), want: vector2.New(12., 12.)},
 	}
 
 	for name, tc := range tests {
@@ -481,3 +494,139 @@ func BenchmarkLerp(b *testing.B) {
 	}
 	result = r
 }
+
+func TestApproximately(t *testing.T) {
+	v := vector4.Fill(1.)
+	assert.True(t, v.Approximately(vector4.Fill(1.+1e-12)))
+	assert.False(t, v.Approximately(vector4.Fill(1.001)))
+	assert.True(t, v.WithinULP(vector4.Fill(math.Nextafter(1, 2)), 1))
+	assert.False(t, v.WithinULP(vector4.Fill(1.001), 1))
+}
+
+func TestSnap(t *testing.T) {
+	got := vector4.New(1.2, 3.9, -0.4, 7.).Snap(vector4.New(0.5, 2., 1., 5.))
+	assert.True(t, vector4.New(1., 4., 0., 5.).Approximately(got), got.Format("%f"))
+}
+
+func TestSign(t *testing.T) {
+	assert.Equal(t, vector4.New[float64](-1, 0, 1, -1), vector4.New[float64](-1.2, 0, 4, -8).Sign())
+	assert.Equal(t, vector4.New(-1, 0, 1, -1), vector4.New[int](-1, 0, 1, -1).Scale(5).Sign())
+}
+
+func TestProjectReject(t *testing.T) {
+	v := vector4.New(1., 2., 3., 4.)
+	normal := vector4.New(0., 0., 0., 2.)
+
+	assert.Equal(t, vector4.New(0., 0., 0., 4.), v.Project(normal))
+	assert.Equal(t, vector4.New(1., 2., 3., 0.), v.Reject(normal))
+	assert.Equal(t, v, v.Project(normal).Add(v.Reject(normal)))
+
+	diagonal := vector4.One[float64]()
+	assert.InDelta(t, 0., v.Reject(diagonal).Dot(diagonal), 1e-12)
+}
+
+func TestReflectRefract(t *testing.T) {
+	v := vector4.New(1., -1., 2., -3.)
+	normal := vector4.New(0., 1., 0., 0.)
+
+	assert.Equal(t, vector4.New(1., 1., 2., -3.), v.Reflect(normal))
+	assert.Equal(t, v, v.Reflect(normal).Reflect(normal))
+
+	in := vector4.New(1., -1., 0., 0.).Normalized()
+	assert.InDelta(t, 0., in.Refract(normal, 1).Sub(in).Length(), 1e-12)
+}
+
+func TestDistanceMidpoint(t *testing.T) {
+	a := vector4.New(1., 2., 3., 4.)
+	b := vector4.New(2., 4., 5., 8.)
+
+	assert.Equal(t, 25., a.DistanceSquared(b))
+	assert.Equal(t, 5., a.Distance(b))
+	assert.Equal(t, vector4.New(1.5, 3., 4., 6.), a.Midpoint(b))
+	assert.Equal(t, vector4.Midpoint(a, b), a.Midpoint(b))
+	assert.Equal(t, 25., vector4.New[int](1, 2, 3, 4).DistanceSquared(vector4.New[int](2, 4, 5, 8)))
+}
+
+func TestLengthAccurate(t *testing.T) {
+	assert.Equal(t, 2., vector4.One[float64]().LengthAccurate())
+	assert.Equal(t, 2e300, vector4.Fill(1e300).LengthAccurate())
+	assert.Equal(t, 0., vector4.Zero[float64]().LengthAccurate())
+}
+
+func TestScaleFToArr(t *testing.T) {
+	v := vector4.New(1., -2., 3., -4.)
+	assert.Equal(t, vector4.New(2., -4., 6., -8.), v.ScaleF(2))
+	assert.Equal(t, []float64{1, -2, 3, -4}, v.ToArr())
+}
+
+// TestMethodParity keeps vector4 from falling behind vector3. Every method on
+// vector3 needs a vector4 counterpart unless it only makes sense in three
+// dimensions
+func TestMethodParity(t *testing.T) {
+	threeDimensional := map[string]bool{
+		"Cross":              true,
+		"Perpendicular":      true,
+		"Rotated":            true,
+		"ConvertCoordinates": true,
+		"MajorAxis":          true,
+		"SnapToAxis":         true,
+		"Octant":             true,
+		"EncodeOctahedral":   true,
+		"PackOctahedral":     true,
+		"Neighbors6":         true,
+		"Neighbors18":        true,
+		"Neighbors26":        true,
+		"VisitNeighbors":     true,
+		"GeoJSON":            true,
+		"WKT":                true,
+		"AppendWKT":          true,
+	}
+
+	have := map[string]bool{}
+	for _, typ := range []reflect.Type{reflect.TypeOf(vector4.Float64{}), reflect.TypeOf(&vector4.Float64{})} {
+		for i := 0; i < typ.NumMethod(); i++ {
+			have[typ.Method(i).Name] = true
+		}
+	}
+
+	for _, typ := range []reflect.Type{reflect.TypeOf(vector3.Float64{}), reflect.TypeOf(&vector3.Float64{})} {
+		for i := 0; i < typ.NumMethod(); i++ {
+			name := typ.Method(i).Name
+			if threeDimensional[name] || isSwizzle(name) {
+				continue
+			}
+			assert.True(t, have[name], "vector4 is missing %s", name)
+		}
+	}
+}
+
+// isSwizzle reports whether name is a generated swizzle accessor, which
+// differ between dimensions by design
+func isSwizzle(name string) bool {
+	for _, r := range name {
+		if !strings.ContainsRune("XYZW01", r) {
+			return false
+		}
+	}
+	return len(name) > 1
+}
+
+func TestAngle(t *testing.T) {
+	tests := map[string]struct {
+		a, b vector4.Float64
+		want float64
+	}{
+		"same":          {a: vector4.New(1., 2., 3., 4.), b: vector4.New(2., 4., 6., 8.), want: 0},
+		"opposite":      {a: vector4.New(1., 0., 0., 0.), b: vector4.New(-3., 0., 0., 0.), want: math.Pi},
+		"perpendicular": {a: vector4.New(0., 0., 0., 1.), b: vector4.New(0., 5., 0., 0.), want: math.Pi / 2},
+		"diagonal":      {a: vector4.New(1., 1., 1., 1.), b: vector4.New(1., 0., 0., 0.), want: math.Pi / 3},
+		"zero":          {a: vector4.Zero[float64](), b: vector4.New(1., 0., 0., 0.), want: 0},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.InDelta(t, tc.want, tc.a.Angle(tc.b), 1e-7)
+			assert.InDelta(t, tc.want, tc.a.AngleF(tc.b), 1e-6)
+		})
+	}
+}
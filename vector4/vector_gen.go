@@ -0,0 +1,1780 @@
+// Code generated by internal/cmd/swizzlegen; DO NOT EDIT.
+
+package vector4
+
+import (
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vector3"
+)
+
+// X returns the x component
+func (v Vector[T]) X() T {
+	return v.x
+}
+
+// SetX returns a copy of the vector with the x component replaced
+func (v Vector[T]) SetX(newX T) Vector[T] {
+	v.x = newX
+	return v
+}
+
+// Dx returns a copy of the vector with dX added to the x component
+func (v Vector[T]) Dx(dX T) Vector[T] {
+	v.x += dX
+	return v
+}
+
+// FlipX returns a copy of the vector with the x component negated
+func (v Vector[T]) FlipX() Vector[T] {
+	v.x *= -1
+	return v
+}
+
+// Y returns the y component
+func (v Vector[T]) Y() T {
+	return v.y
+}
+
+// SetY returns a copy of the vector with the y component replaced
+func (v Vector[T]) SetY(newY T) Vector[T] {
+	v.y = newY
+	return v
+}
+
+// Dy returns a copy of the vector with dY added to the y component
+func (v Vector[T]) Dy(dY T) Vector[T] {
+	v.y += dY
+	return v
+}
+
+// FlipY returns a copy of the vector with the y component negated
+func (v Vector[T]) FlipY() Vector[T] {
+	v.y *= -1
+	return v
+}
+
+// Z returns the z component
+func (v Vector[T]) Z() T {
+	return v.z
+}
+
+// SetZ returns a copy of the vector with the z component replaced
+func (v Vector[T]) SetZ(newZ T) Vector[T] {
+	v.z = newZ
+	return v
+}
+
+// Dz returns a copy of the vector with dZ added to the z component
+func (v Vector[T]) Dz(dZ T) Vector[T] {
+	v.z += dZ
+	return v
+}
+
+// FlipZ returns a copy of the vector with the z component negated
+func (v Vector[T]) FlipZ() Vector[T] {
+	v.z *= -1
+	return v
+}
+
+// W returns the w component
+func (v Vector[T]) W() T {
+	return v.w
+}
+
+// SetW returns a copy of the vector with the w component replaced
+func (v Vector[T]) SetW(newW T) Vector[T] {
+	v.w = newW
+	return v
+}
+
+// Dw returns a copy of the vector with dW added to the w component
+func (v Vector[T]) Dw(dW T) Vector[T] {
+	v.w += dW
+	return v
+}
+
+// FlipW returns a copy of the vector with the w component negated
+func (v Vector[T]) FlipW() Vector[T] {
+	v.w *= -1
+	return v
+}
+
+// XX returns a vector made up of the x and x components
+func (v Vector[T]) XX() vector2.Vector[T] {
+	return vector2.New(v.x, v.x)
+}
+
+// XY returns a vector made up of the x and y components
+func (v Vector[T]) XY() vector2.Vector[T] {
+	return vector2.New(v.x, v.y)
+}
+
+// XZ returns a vector made up of the x and z components
+func (v Vector[T]) XZ() vector2.Vector[T] {
+	return vector2.New(v.x, v.z)
+}
+
+// XW returns a vector made up of the x and w components
+func (v Vector[T]) XW() vector2.Vector[T] {
+	return vector2.New(v.x, v.w)
+}
+
+// YX returns a vector made up of the y and x components
+func (v Vector[T]) YX() vector2.Vector[T] {
+	return vector2.New(v.y, v.x)
+}
+
+// YY returns a vector made up of the y and y components
+func (v Vector[T]) YY() vector2.Vector[T] {
+	return vector2.New(v.y, v.y)
+}
+
+// YZ returns a vector made up of the y and z components
+func (v Vector[T]) YZ() vector2.Vector[T] {
+	return vector2.New(v.y, v.z)
+}
+
+// YW returns a vector made up of the y and w components
+func (v Vector[T]) YW() vector2.Vector[T] {
+	return vector2.New(v.y, v.w)
+}
+
+// ZX returns a vector made up of the z and x components
+func (v Vector[T]) ZX() vector2.Vector[T] {
+	return vector2.New(v.z, v.x)
+}
+
+// ZY returns a vector made up of the z and y components
+func (v Vector[T]) ZY() vector2.Vector[T] {
+	return vector2.New(v.z, v.y)
+}
+
+// ZZ returns a vector made up of the z and z components
+func (v Vector[T]) ZZ() vector2.Vector[T] {
+	return vector2.New(v.z, v.z)
+}
+
+// ZW returns a vector made up of the z and w components
+func (v Vector[T]) ZW() vector2.Vector[T] {
+	return vector2.New(v.z, v.w)
+}
+
+// WX returns a vector made up of the w and x components
+func (v Vector[T]) WX() vector2.Vector[T] {
+	return vector2.New(v.w, v.x)
+}
+
+// WY returns a vector made up of the w and y components
+func (v Vector[T]) WY() vector2.Vector[T] {
+	return vector2.New(v.w, v.y)
+}
+
+// WZ returns a vector made up of the w and z components
+func (v Vector[T]) WZ() vector2.Vector[T] {
+	return vector2.New(v.w, v.z)
+}
+
+// WW returns a vector made up of the w and w components
+func (v Vector[T]) WW() vector2.Vector[T] {
+	return vector2.New(v.w, v.w)
+}
+
+// XXX returns a vector made up of the x, x and x components
+func (v Vector[T]) XXX() vector3.Vector[T] {
+	return vector3.New(v.x, v.x, v.x)
+}
+
+// XXY returns a vector made up of the x, x and y components
+func (v Vector[T]) XXY() vector3.Vector[T] {
+	return vector3.New(v.x, v.x, v.y)
+}
+
+// XXZ returns a vector made up of the x, x and z components
+func (v Vector[T]) XXZ() vector3.Vector[T] {
+	return vector3.New(v.x, v.x, v.z)
+}
+
+// XXW returns a vector made up of the x, x and w components
+func (v Vector[T]) XXW() vector3.Vector[T] {
+	return vector3.New(v.x, v.x, v.w)
+}
+
+// XYX returns a vector made up of the x, y and x components
+func (v Vector[T]) XYX() vector3.Vector[T] {
+	return vector3.New(v.x, v.y, v.x)
+}
+
+// XYY returns a vector made up of the x, y and y components
+func (v Vector[T]) XYY() vector3.Vector[T] {
+	return vector3.New(v.x, v.y, v.y)
+}
+
+// XYZ returns a vector made up of the x, y and z components
+func (v Vector[T]) XYZ() vector3.Vector[T] {
+	return vector3.New(v.x, v.y, v.z)
+}
+
+// XYW returns a vector made up of the x, y and w components
+func (v Vector[T]) XYW() vector3.Vector[T] {
+	return vector3.New(v.x, v.y, v.w)
+}
+
+// XZX returns a vector made up of the x, z and x components
+func (v Vector[T]) XZX() vector3.Vector[T] {
+	return vector3.New(v.x, v.z, v.x)
+}
+
+// XZY returns a vector made up of the x, z and y components
+func (v Vector[T]) XZY() vector3.Vector[T] {
+	return vector3.New(v.x, v.z, v.y)
+}
+
+// XZZ returns a vector made up of the x, z and z components
+func (v Vector[T]) XZZ() vector3.Vector[T] {
+	return vector3.New(v.x, v.z, v.z)
+}
+
+// XZW returns a vector made up of the x, z and w components
+func (v Vector[T]) XZW() vector3.Vector[T] {
+	return vector3.New(v.x, v.z, v.w)
+}
+
+// XWX returns a vector made up of the x, w and x components
+func (v Vector[T]) XWX() vector3.Vector[T] {
+	return vector3.New(v.x, v.w, v.x)
+}
+
+// XWY returns a vector made up of the x, w and y components
+func (v Vector[T]) XWY() vector3.Vector[T] {
+	return vector3.New(v.x, v.w, v.y)
+}
+
+// XWZ returns a vector made up of the x, w and z components
+func (v Vector[T]) XWZ() vector3.Vector[T] {
+	return vector3.New(v.x, v.w, v.z)
+}
+
+// XWW returns a vector made up of the x, w and w components
+func (v Vector[T]) XWW() vector3.Vector[T] {
+	return vector3.New(v.x, v.w, v.w)
+}
+
+// YXX returns a vector made up of the y, x and x components
+func (v Vector[T]) YXX() vector3.Vector[T] {
+	return vector3.New(v.y, v.x, v.x)
+}
+
+// YXY returns a vector made up of the y, x and y components
+func (v Vector[T]) YXY() vector3.Vector[T] {
+	return vector3.New(v.y, v.x, v.y)
+}
+
+// YXZ returns a vector made up of the y, x and z components
+func (v Vector[T]) YXZ() vector3.Vector[T] {
+	return vector3.New(v.y, v.x, v.z)
+}
+
+// YXW returns a vector made up of the y, x and w components
+func (v Vector[T]) YXW() vector3.Vector[T] {
+	return vector3.New(v.y, v.x, v.w)
+}
+
+// YYX returns a vector made up of the y, y and x components
+func (v Vector[T]) YYX() vector3.Vector[T] {
+	return vector3.New(v.y, v.y, v.x)
+}
+
+// YYY returns a vector made up of the y, y and y components
+func (v Vector[T]) YYY() vector3.Vector[T] {
+	return vector3.New(v.y, v.y, v.y)
+}
+
+// YYZ returns a vector made up of the y, y and z components
+func (v Vector[T]) YYZ() vector3.Vector[T] {
+	return vector3.New(v.y, v.y, v.z)
+}
+
+// YYW returns a vector made up of the y, y and w components
+func (v Vector[T]) YYW() vector3.Vector[T] {
+	return vector3.New(v.y, v.y, v.w)
+}
+
+// YZX returns a vector made up of the y, z and x components
+func (v Vector[T]) YZX() vector3.Vector[T] {
+	return vector3.New(v.y, v.z, v.x)
+}
+
+// YZY returns a vector made up of the y, z and y components
+func (v Vector[T]) YZY() vector3.Vector[T] {
+	return vector3.New(v.y, v.z, v.y)
+}
+
+// YZZ returns a vector made up of the y, z and z components
+func (v Vector[T]) YZZ() vector3.Vector[T] {
+	return vector3.New(v.y, v.z, v.z)
+}
+
+// YZW returns a vector made up of the y, z and w components
+func (v Vector[T]) YZW() vector3.Vector[T] {
+	return vector3.New(v.y, v.z, v.w)
+}
+
+// YWX returns a vector made up of the y, w and x components
+func (v Vector[T]) YWX() vector3.Vector[T] {
+	return vector3.New(v.y, v.w, v.x)
+}
+
+// YWY returns a vector made up of the y, w and y components
+func (v Vector[T]) YWY() vector3.Vector[T] {
+	return vector3.New(v.y, v.w, v.y)
+}
+
+// YWZ returns a vector made up of the y, w and z components
+func (v Vector[T]) YWZ() vector3.Vector[T] {
+	return vector3.New(v.y, v.w, v.z)
+}
+
+// YWW returns a vector made up of the y, w and w components
+func (v Vector[T]) YWW() vector3.Vector[T] {
+	return vector3.New(v.y, v.w, v.w)
+}
+
+// ZXX returns a vector made up of the z, x and x components
+func (v Vector[T]) ZXX() vector3.Vector[T] {
+	return vector3.New(v.z, v.x, v.x)
+}
+
+// ZXY returns a vector made up of the z, x and y components
+func (v Vector[T]) ZXY() vector3.Vector[T] {
+	return vector3.New(v.z, v.x, v.y)
+}
+
+// ZXZ returns a vector made up of the z, x and z components
+func (v Vector[T]) ZXZ() vector3.Vector[T] {
+	return vector3.New(v.z, v.x, v.z)
+}
+
+// ZXW returns a vector made up of the z, x and w components
+func (v Vector[T]) ZXW() vector3.Vector[T] {
+	return vector3.New(v.z, v.x, v.w)
+}
+
+// ZYX returns a vector made up of the z, y and x components
+func (v Vector[T]) ZYX() vector3.Vector[T] {
+	return vector3.New(v.z, v.y, v.x)
+}
+
+// ZYY returns a vector made up of the z, y and y components
+func (v Vector[T]) ZYY() vector3.Vector[T] {
+	return vector3.New(v.z, v.y, v.y)
+}
+
+// ZYZ returns a vector made up of the z, y and z components
+func (v Vector[T]) ZYZ() vector3.Vector[T] {
+	return vector3.New(v.z, v.y, v.z)
+}
+
+// ZYW returns a vector made up of the z, y and w components
+func (v Vector[T]) ZYW() vector3.Vector[T] {
+	return vector3.New(v.z, v.y, v.w)
+}
+
+// ZZX returns a vector made up of the z, z and x components
+func (v Vector[T]) ZZX() vector3.Vector[T] {
+	return vector3.New(v.z, v.z, v.x)
+}
+
+// ZZY returns a vector made up of the z, z and y components
+func (v Vector[T]) ZZY() vector3.Vector[T] {
+	return vector3.New(v.z, v.z, v.y)
+}
+
+// ZZZ returns a vector made up of the z, z and z components
+func (v Vector[T]) ZZZ() vector3.Vector[T] {
+	return vector3.New(v.z, v.z, v.z)
+}
+
+// ZZW returns a vector made up of the z, z and w components
+func (v Vector[T]) ZZW() vector3.Vector[T] {
+	return vector3.New(v.z, v.z, v.w)
+}
+
+// ZWX returns a vector made up of the z, w and x components
+func (v Vector[T]) ZWX() vector3.Vector[T] {
+	return vector3.New(v.z, v.w, v.x)
+}
+
+// ZWY returns a vector made up of the z, w and y components
+func (v Vector[T]) ZWY() vector3.Vector[T] {
+	return vector3.New(v.z, v.w, v.y)
+}
+
+// ZWZ returns a vector made up of the z, w and z components
+func (v Vector[T]) ZWZ() vector3.Vector[T] {
+	return vector3.New(v.z, v.w, v.z)
+}
+
+// ZWW returns a vector made up of the z, w and w components
+func (v Vector[T]) ZWW() vector3.Vector[T] {
+	return vector3.New(v.z, v.w, v.w)
+}
+
+// WXX returns a vector made up of the w, x and x components
+func (v Vector[T]) WXX() vector3.Vector[T] {
+	return vector3.New(v.w, v.x, v.x)
+}
+
+// WXY returns a vector made up of the w, x and y components
+func (v Vector[T]) WXY() vector3.Vector[T] {
+	return vector3.New(v.w, v.x, v.y)
+}
+
+// WXZ returns a vector made up of the w, x and z components
+func (v Vector[T]) WXZ() vector3.Vector[T] {
+	return vector3.New(v.w, v.x, v.z)
+}
+
+// WXW returns a vector made up of the w, x and w components
+func (v Vector[T]) WXW() vector3.Vector[T] {
+	return vector3.New(v.w, v.x, v.w)
+}
+
+// WYX returns a vector made up of the w, y and x components
+func (v Vector[T]) WYX() vector3.Vector[T] {
+	return vector3.New(v.w, v.y, v.x)
+}
+
+// WYY returns a vector made up of the w, y and y components
+func (v Vector[T]) WYY() vector3.Vector[T] {
+	return vector3.New(v.w, v.y, v.y)
+}
+
+// WYZ returns a vector made up of the w, y and z components
+func (v Vector[T]) WYZ() vector3.Vector[T] {
+	return vector3.New(v.w, v.y, v.z)
+}
+
+// WYW returns a vector made up of the w, y and w components
+func (v Vector[T]) WYW() vector3.Vector[T] {
+	return vector3.New(v.w, v.y, v.w)
+}
+
+// WZX returns a vector made up of the w, z and x components
+func (v Vector[T]) WZX() vector3.Vector[T] {
+	return vector3.New(v.w, v.z, v.x)
+}
+
+// WZY returns a vector made up of the w, z and y components
+func (v Vector[T]) WZY() vector3.Vector[T] {
+	return vector3.New(v.w, v.z, v.y)
+}
+
+// WZZ returns a vector made up of the w, z and z components
+func (v Vector[T]) WZZ() vector3.Vector[T] {
+	return vector3.New(v.w, v.z, v.z)
+}
+
+// WZW returns a vector made up of the w, z and w components
+func (v Vector[T]) WZW() vector3.Vector[T] {
+	return vector3.New(v.w, v.z, v.w)
+}
+
+// WWX returns a vector made up of the w, w and x components
+func (v Vector[T]) WWX() vector3.Vector[T] {
+	return vector3.New(v.w, v.w, v.x)
+}
+
+// WWY returns a vector made up of the w, w and y components
+func (v Vector[T]) WWY() vector3.Vector[T] {
+	return vector3.New(v.w, v.w, v.y)
+}
+
+// WWZ returns a vector made up of the w, w and z components
+func (v Vector[T]) WWZ() vector3.Vector[T] {
+	return vector3.New(v.w, v.w, v.z)
+}
+
+// WWW returns a vector made up of the w, w and w components
+func (v Vector[T]) WWW() vector3.Vector[T] {
+	return vector3.New(v.w, v.w, v.w)
+}
+
+// XXXX returns a vector made up of the x, x, x and x components
+func (v Vector[T]) XXXX() Vector[T] {
+	return Vector[T]{x: v.x, y: v.x, z: v.x, w: v.x}
+}
+
+// XXXY returns a vector made up of the x, x, x and y components
+func (v Vector[T]) XXXY() Vector[T] {
+	return Vector[T]{x: v.x, y: v.x, z: v.x, w: v.y}
+}
+
+// XXXZ returns a vector made up of the x, x, x and z components
+func (v Vector[T]) XXXZ() Vector[T] {
+	return Vector[T]{x: v.x, y: v.x, z: v.x, w: v.z}
+}
+
+// XXXW returns a vector made up of the x, x, x and w components
+func (v Vector[T]) XXXW() Vector[T] {
+	return Vector[T]{x: v.x, y: v.x, z: v.x, w: v.w}
+}
+
+// XXYX returns a vector made up of the x, x, y and x components
+func (v Vector[T]) XXYX() Vector[T] {
+	return Vector[T]{x: v.x, y: v.x, z: v.y, w: v.x}
+}
+
+// XXYY returns a vector made up of the x, x, y and y components
+func (v Vector[T]) XXYY() Vector[T] {
+	return Vector[T]{x: v.x, y: v.x, z: v.y, w: v.y}
+}
+
+// XXYZ returns a vector made up of the x, x, y and z components
+func (v Vector[T]) XXYZ() Vector[T] {
+	return Vector[T]{x: v.x, y: v.x, z: v.y, w: v.z}
+}
+
+// XXYW returns a vector made up of the x, x, y and w components
+func (v Vector[T]) XXYW() Vector[T] {
+	return Vector[T]{x: v.x, y: v.x, z: v.y, w: v.w}
+}
+
+// XXZX returns a vector made up of the x, x, z and x components
+func (v Vector[T]) XXZX() Vector[T] {
+	return Vector[T]{x: v.x, y: v.x, z: v.z, w: v.x}
+}
+
+// XXZY returns a vector made up of the x, x, z and y components
+func (v Vector[T]) XXZY() Vector[T] {
+	return Vector[T]{x: v.x, y: v.x, z: v.z, w: v.y}
+}
+
+// XXZZ returns a vector made up of the x, x, z and z components
+func (v Vector[T]) XXZZ() Vector[T] {
+	return Vector[T]{x: v.x, y: v.x, z: v.z, w: v.z}
+}
+
+// XXZW returns a vector made up of the x, x, z and w components
+func (v Vector[T]) XXZW() Vector[T] {
+	return Vector[T]{x: v.x, y: v.x, z: v.z, w: v.w}
+}
+
+// XXWX returns a vector made up of the x, x, w and x components
+func (v Vector[T]) XXWX() Vector[T] {
+	return Vector[T]{x: v.x, y: v.x, z: v.w, w: v.x}
+}
+
+// XXWY returns a vector made up of the x, x, w and y components
+func (v Vector[T]) XXWY() Vector[T] {
+	return Vector[T]{x: v.x, y: v.x, z: v.w, w: v.y}
+}
+
+// XXWZ returns a vector made up of the x, x, w and z components
+func (v Vector[T]) XXWZ() Vector[T] {
+	return Vector[T]{x: v.x, y: v.x, z: v.w, w: v.z}
+}
+
+// XXWW returns a vector made up of the x, x, w and w components
+func (v Vector[T]) XXWW() Vector[T] {
+	return Vector[T]{x: v.x, y: v.x, z: v.w, w: v.w}
+}
+
+// XYXX returns a vector made up of the x, y, x and x components
+func (v Vector[T]) XYXX() Vector[T] {
+	return Vector[T]{x: v.x, y: v.y, z: v.x, w: v.x}
+}
+
+// XYXY returns a vector made up of the x, y, x and y components
+func (v Vector[T]) XYXY() Vector[T] {
+	return Vector[T]{x: v.x, y: v.y, z: v.x, w: v.y}
+}
+
+// XYXZ returns a vector made up of the x, y, x and z components
+func (v Vector[T]) XYXZ() Vector[T] {
+	return Vector[T]{x: v.x, y: v.y, z: v.x, w: v.z}
+}
+
+// XYXW returns a vector made up of the x, y, x and w components
+func (v Vector[T]) XYXW() Vector[T] {
+	return Vector[T]{x: v.x, y: v.y, z: v.x, w: v.w}
+}
+
+// XYYX returns a vector made up of the x, y, y and x components
+func (v Vector[T]) XYYX() Vector[T] {
+	return Vector[T]{x: v.x, y: v.y, z: v.y, w: v.x}
+}
+
+// XYYY returns a vector made up of the x, y, y and y components
+func (v Vector[T]) XYYY() Vector[T] {
+	return Vector[T]{x: v.x, y: v.y, z: v.y, w: v.y}
+}
+
+// XYYZ returns a vector made up of the x, y, y and z components
+func (v Vector[T]) XYYZ() Vector[T] {
+	return Vector[T]{x: v.x, y: v.y, z: v.y, w: v.z}
+}
+
+// XYYW returns a vector made up of the x, y, y and w components
+func (v Vector[T]) XYYW() Vector[T] {
+	return Vector[T]{x: v.x, y: v.y, z: v.y, w: v.w}
+}
+
+// XYZX returns a vector made up of the x, y, z and x components
+func (v Vector[T]) XYZX() Vector[T] {
+	return Vector[T]{x: v.x, y: v.y, z: v.z, w: v.x}
+}
+
+// XYZY returns a vector made up of the x, y, z and y components
+func (v Vector[T]) XYZY() Vector[T] {
+	return Vector[T]{x: v.x, y: v.y, z: v.z, w: v.y}
+}
+
+// XYZZ returns a vector made up of the x, y, z and z components
+func (v Vector[T]) XYZZ() Vector[T] {
+	return Vector[T]{x: v.x, y: v.y, z: v.z, w: v.z}
+}
+
+// XYZW returns a vector made up of the x, y, z and w components
+func (v Vector[T]) XYZW() Vector[T] {
+	return Vector[T]{x: v.x, y: v.y, z: v.z, w: v.w}
+}
+
+// XYWX returns a vector made up of the x, y, w and x components
+func (v Vector[T]) XYWX() Vector[T] {
+	return Vector[T]{x: v.x, y: v.y, z: v.w, w: v.x}
+}
+
+// XYWY returns a vector made up of the x, y, w and y components
+func (v Vector[T]) XYWY() Vector[T] {
+	return Vector[T]{x: v.x, y: v.y, z: v.w, w: v.y}
+}
+
+// XYWZ returns a vector made up of the x, y, w and z components
+func (v Vector[T]) XYWZ() Vector[T] {
+	return Vector[T]{x: v.x, y: v.y, z: v.w, w: v.z}
+}
+
+// XYWW returns a vector made up of the x, y, w and w components
+func (v Vector[T]) XYWW() Vector[T] {
+	return Vector[T]{x: v.x, y: v.y, z: v.w, w: v.w}
+}
+
+// XZXX returns a vector made up of the x, z, x and x components
+func (v Vector[T]) XZXX() Vector[T] {
+	return Vector[T]{x: v.x, y: v.z, z: v.x, w: v.x}
+}
+
+// XZXY returns a vector made up of the x, z, x and y components
+func (v Vector[T]) XZXY() Vector[T] {
+	return Vector[T]{x: v.x, y: v.z, z: v.x, w: v.y}
+}
+
+// XZXZ returns a vector made up of the x, z, x and z components
+func (v Vector[T]) XZXZ() Vector[T] {
+	return Vector[T]{x: v.x, y: v.z, z: v.x, w: v.z}
+}
+
+// XZXW returns a vector made up of the x, z, x and w components
+func (v Vector[T]) XZXW() Vector[T] {
+	return Vector[T]{x: v.x, y: v.z, z: v.x, w: v.w}
+}
+
+// XZYX returns a vector made up of the x, z, y and x components
+func (v Vector[T]) XZYX() Vector[T] {
+	return Vector[T]{x: v.x, y: v.z, z: v.y, w: v.x}
+}
+
+// XZYY returns a vector made up of the x, z, y and y components
+func (v Vector[T]) XZYY() Vector[T] {
+	return Vector[T]{x: v.x, y: v.z, z: v.y, w: v.y}
+}
+
+// XZYZ returns a vector made up of the x, z, y and z components
+func (v Vector[T]) XZYZ() Vector[T] {
+	return Vector[T]{x: v.x, y: v.z, z: v.y, w: v.z}
+}
+
+// XZYW returns a vector made up of the x, z, y and w components
+func (v Vector[T]) XZYW() Vector[T] {
+	return Vector[T]{x: v.x, y: v.z, z: v.y, w: v.w}
+}
+
+// XZZX returns a vector made up of the x, z, z and x components
+func (v Vector[T]) XZZX() Vector[T] {
+	return Vector[T]{x: v.x, y: v.z, z: v.z, w: v.x}
+}
+
+// XZZY returns a vector made up of the x, z, z and y components
+func (v Vector[T]) XZZY() Vector[T] {
+	return Vector[T]{x: v.x, y: v.z, z: v.z, w: v.y}
+}
+
+// XZZZ returns a vector made up of the x, z, z and z components
+func (v Vector[T]) XZZZ() Vector[T] {
+	return Vector[T]{x: v.x, y: v.z, z: v.z, w: v.z}
+}
+
+// XZZW returns a vector made up of the x, z, z and w components
+func (v Vector[T]) XZZW() Vector[T] {
+	return Vector[T]{x: v.x, y: v.z, z: v.z, w: v.w}
+}
+
+// XZWX returns a vector made up of the x, z, w and x components
+func (v Vector[T]) XZWX() Vector[T] {
+	return Vector[T]{x: v.x, y: v.z, z: v.w, w: v.x}
+}
+
+// XZWY returns a vector made up of the x, z, w and y components
+func (v Vector[T]) XZWY() Vector[T] {
+	return Vector[T]{x: v.x, y: v.z, z: v.w, w: v.y}
+}
+
+// XZWZ returns a vector made up of the x, z, w and z components
+func (v Vector[T]) XZWZ() Vector[T] {
+	return Vector[T]{x: v.x, y: v.z, z: v.w, w: v.z}
+}
+
+// XZWW returns a vector made up of the x, z, w and w components
+func (v Vector[T]) XZWW() Vector[T] {
+	return Vector[T]{x: v.x, y: v.z, z: v.w, w: v.w}
+}
+
+// XWXX returns a vector made up of the x, w, x and x components
+func (v Vector[T]) XWXX() Vector[T] {
+	return Vector[T]{x: v.x, y: v.w, z: v.x, w: v.x}
+}
+
+// XWXY returns a vector made up of the x, w, x and y components
+func (v Vector[T]) XWXY() Vector[T] {
+	return Vector[T]{x: v.x, y: v.w, z: v.x, w: v.y}
+}
+
+// XWXZ returns a vector made up of the x, w, x and z components
+func (v Vector[T]) XWXZ() Vector[T] {
+	return Vector[T]{x: v.x, y: v.w, z: v.x, w: v.z}
+}
+
+// XWXW returns a vector made up of the x, w, x and w components
+func (v Vector[T]) XWXW() Vector[T] {
+	return Vector[T]{x: v.x, y: v.w, z: v.x, w: v.w}
+}
+
+// XWYX returns a vector made up of the x, w, y and x components
+func (v Vector[T]) XWYX() Vector[T] {
+	return Vector[T]{x: v.x, y: v.w, z: v.y, w: v.x}
+}
+
+// XWYY returns a vector made up of the x, w, y and y components
+func (v Vector[T]) XWYY() Vector[T] {
+	return Vector[T]{x: v.x, y: v.w, z: v.y, w: v.y}
+}
+
+// XWYZ returns a vector made up of the x, w, y and z components
+func (v Vector[T]) XWYZ() Vector[T] {
+	return Vector[T]{x: v.x, y: v.w, z: v.y, w: v.z}
+}
+
+// XWYW returns a vector made up of the x, w, y and w components
+func (v Vector[T]) XWYW() Vector[T] {
+	return Vector[T]{x: v.x, y: v.w, z: v.y, w: v.w}
+}
+
+// XWZX returns a vector made up of the x, w, z and x components
+func (v Vector[T]) XWZX() Vector[T] {
+	return Vector[T]{x: v.x, y: v.w, z: v.z, w: v.x}
+}
+
+// XWZY returns a vector made up of the x, w, z and y components
+func (v Vector[T]) XWZY() Vector[T] {
+	return Vector[T]{x: v.x, y: v.w, z: v.z, w: v.y}
+}
+
+// XWZZ returns a vector made up of the x, w, z and z components
+func (v Vector[T]) XWZZ() Vector[T] {
+	return Vector[T]{x: v.x, y: v.w, z: v.z, w: v.z}
+}
+
+// XWZW returns a vector made up of the x, w, z and w components
+func (v Vector[T]) XWZW() Vector[T] {
+	return Vector[T]{x: v.x, y: v.w, z: v.z, w: v.w}
+}
+
+// XWWX returns a vector made up of the x, w, w and x components
+func (v Vector[T]) XWWX() Vector[T] {
+	return Vector[T]{x: v.x, y: v.w, z: v.w, w: v.x}
+}
+
+// XWWY returns a vector made up of the x, w, w and y components
+func (v Vector[T]) XWWY() Vector[T] {
+	return Vector[T]{x: v.x, y: v.w, z: v.w, w: v.y}
+}
+
+// XWWZ returns a vector made up of the x, w, w and z components
+func (v Vector[T]) XWWZ() Vector[T] {
+	return Vector[T]{x: v.x, y: v.w, z: v.w, w: v.z}
+}
+
+// XWWW returns a vector made up of the x, w, w and w components
+func (v Vector[T]) XWWW() Vector[T] {
+	return Vector[T]{x: v.x, y: v.w, z: v.w, w: v.w}
+}
+
+// YXXX returns a vector made up of the y, x, x and x components
+func (v Vector[T]) YXXX() Vector[T] {
+	return Vector[T]{x: v.y, y: v.x, z: v.x, w: v.x}
+}
+
+// YXXY returns a vector made up of the y, x, x and y components
+func (v Vector[T]) YXXY() Vector[T] {
+	return Vector[T]{x: v.y, y: v.x, z: v.x, w: v.y}
+}
+
+// YXXZ returns a vector made up of the y, x, x and z components
+func (v Vector[T]) YXXZ() Vector[T] {
+	return Vector[T]{x: v.y, y: v.x, z: v.x, w: v.z}
+}
+
+// YXXW returns a vector made up of the y, x, x and w components
+func (v Vector[T]) YXXW() Vector[T] {
+	return Vector[T]{x: v.y, y: v.x, z: v.x, w: v.w}
+}
+
+// YXYX returns a vector made up of the y, x, y and x components
+func (v Vector[T]) YXYX() Vector[T] {
+	return Vector[T]{x: v.y, y: v.x, z: v.y, w: v.x}
+}
+
+// YXYY returns a vector made up of the y, x, y and y components
+func (v Vector[T]) YXYY() Vector[T] {
+	return Vector[T]{x: v.y, y: v.x, z: v.y, w: v.y}
+}
+
+// YXYZ returns a vector made up of the y, x, y and z components
+func (v Vector[T]) YXYZ() Vector[T] {
+	return Vector[T]{x: v.y, y: v.x, z: v.y, w: v.z}
+}
+
+// YXYW returns a vector made up of the y, x, y and w components
+func (v Vector[T]) YXYW() Vector[T] {
+	return Vector[T]{x: v.y, y: v.x, z: v.y, w: v.w}
+}
+
+// YXZX returns a vector made up of the y, x, z and x components
+func (v Vector[T]) YXZX() Vector[T] {
+	return Vector[T]{x: v.y, y: v.x, z: v.z, w: v.x}
+}
+
+// YXZY returns a vector made up of the y, x, z and y components
+func (v Vector[T]) YXZY() Vector[T] {
+	return Vector[T]{x: v.y, y: v.x, z: v.z, w: v.y}
+}
+
+// YXZZ returns a vector made up of the y, x, z and z components
+func (v Vector[T]) YXZZ() Vector[T] {
+	return Vector[T]{x: v.y, y: v.x, z: v.z, w: v.z}
+}
+
+// YXZW returns a vector made up of the y, x, z and w components
+func (v Vector[T]) YXZW() Vector[T] {
+	return Vector[T]{x: v.y, y: v.x, z: v.z, w: v.w}
+}
+
+// YXWX returns a vector made up of the y, x, w and x components
+func (v Vector[T]) YXWX() Vector[T] {
+	return Vector[T]{x: v.y, y: v.x, z: v.w, w: v.x}
+}
+
+// YXWY returns a vector made up of the y, x, w and y components
+func (v Vector[T]) YXWY() Vector[T] {
+	return Vector[T]{x: v.y, y: v.x, z: v.w, w: v.y}
+}
+
+// YXWZ returns a vector made up of the y, x, w and z components
+func (v Vector[T]) YXWZ() Vector[T] {
+	return Vector[T]{x: v.y, y: v.x, z: v.w, w: v.z}
+}
+
+// YXWW returns a vector made up of the y, x, w and w components
+func (v Vector[T]) YXWW() Vector[T] {
+	return Vector[T]{x: v.y, y: v.x, z: v.w, w: v.w}
+}
+
+// YYXX returns a vector made up of the y, y, x and x components
+func (v Vector[T]) YYXX() Vector[T] {
+	return Vector[T]{x: v.y, y: v.y, z: v.x, w: v.x}
+}
+
+// YYXY returns a vector made up of the y, y, x and y components
+func (v Vector[T]) YYXY() Vector[T] {
+	return Vector[T]{x: v.y, y: v.y, z: v.x, w: v.y}
+}
+
+// YYXZ returns a vector made up of the y, y, x and z components
+func (v Vector[T]) YYXZ() Vector[T] {
+	return Vector[T]{x: v.y, y: v.y, z: v.x, w: v.z}
+}
+
+// YYXW returns a vector made up of the y, y, x and w components
+func (v Vector[T]) YYXW() Vector[T] {
+	return Vector[T]{x: v.y, y: v.y, z: v.x, w: v.w}
+}
+
+// YYYX returns a vector made up of the y, y, y and x components
+func (v Vector[T]) YYYX() Vector[T] {
+	return Vector[T]{x: v.y, y: v.y, z: v.y, w: v.x}
+}
+
+// YYYY returns a vector made up of the y, y, y and y components
+func (v Vector[T]) YYYY() Vector[T] {
+	return Vector[T]{x: v.y, y: v.y, z: v.y, w: v.y}
+}
+
+// YYYZ returns a vector made up of the y, y, y and z components
+func (v Vector[T]) YYYZ() Vector[T] {
+	return Vector[T]{x: v.y, y: v.y, z: v.y, w: v.z}
+}
+
+// YYYW returns a vector made up of the y, y, y and w components
+func (v Vector[T]) YYYW() Vector[T] {
+	return Vector[T]{x: v.y, y: v.y, z: v.y, w: v.w}
+}
+
+// YYZX returns a vector made up of the y, y, z and x components
+func (v Vector[T]) YYZX() Vector[T] {
+	return Vector[T]{x: v.y, y: v.y, z: v.z, w: v.x}
+}
+
+// YYZY returns a vector made up of the y, y, z and y components
+func (v Vector[T]) YYZY() Vector[T] {
+	return Vector[T]{x: v.y, y: v.y, z: v.z, w: v.y}
+}
+
+// YYZZ returns a vector made up of the y, y, z and z components
+func (v Vector[T]) YYZZ() Vector[T] {
+	return Vector[T]{x: v.y, y: v.y, z: v.z, w: v.z}
+}
+
+// YYZW returns a vector made up of the y, y, z and w components
+func (v Vector[T]) YYZW() Vector[T] {
+	return Vector[T]{x: v.y, y: v.y, z: v.z, w: v.w}
+}
+
+// YYWX returns a vector made up of the y, y, w and x components
+func (v Vector[T]) YYWX() Vector[T] {
+	return Vector[T]{x: v.y, y: v.y, z: v.w, w: v.x}
+}
+
+// YYWY returns a vector made up of the y, y, w and y components
+func (v Vector[T]) YYWY() Vector[T] {
+	return Vector[T]{x: v.y, y: v.y, z: v.w, w: v.y}
+}
+
+// YYWZ returns a vector made up of the y, y, w and z components
+func (v Vector[T]) YYWZ() Vector[T] {
+	return Vector[T]{x: v.y, y: v.y, z: v.w, w: v.z}
+}
+
+// YYWW returns a vector made up of the y, y, w and w components
+func (v Vector[T]) YYWW() Vector[T] {
+	return Vector[T]{x: v.y, y: v.y, z: v.w, w: v.w}
+}
+
+// YZXX returns a vector made up of the y, z, x and x components
+func (v Vector[T]) YZXX() Vector[T] {
+	return Vector[T]{x: v.y, y: v.z, z: v.x, w: v.x}
+}
+
+// YZXY returns a vector made up of the y, z, x and y components
+func (v Vector[T]) YZXY() Vector[T] {
+	return Vector[T]{x: v.y, y: v.z, z: v.x, w: v.y}
+}
+
+// YZXZ returns a vector made up of the y, z, x and z components
+func (v Vector[T]) YZXZ() Vector[T] {
+	return Vector[T]{x: v.y, y: v.z, z: v.x, w: v.z}
+}
+
+// YZXW returns a vector made up of the y, z, x and w components
+func (v Vector[T]) YZXW() Vector[T] {
+	return Vector[T]{x: v.y, y: v.z, z: v.x, w: v.w}
+}
+
+// YZYX returns a vector made up of the y, z, y and x components
+func (v Vector[T]) YZYX() Vector[T] {
+	return Vector[T]{x: v.y, y: v.z, z: v.y, w: v.x}
+}
+
+// YZYY returns a vector made up of the y, z, y and y components
+func (v Vector[T]) YZYY() Vector[T] {
+	return Vector[T]{x: v.y, y: v.z, z: v.y, w: v.y}
+}
+
+// YZYZ returns a vector made up of the y, z, y and z components
+func (v Vector[T]) YZYZ() Vector[T] {
+	return Vector[T]{x: v.y, y: v.z, z: v.y, w: v.z}
+}
+
+// YZYW returns a vector made up of the y, z, y and w components
+func (v Vector[T]) YZYW() Vector[T] {
+	return Vector[T]{x: v.y, y: v.z, z: v.y, w: v.w}
+}
+
+// YZZX returns a vector made up of the y, z, z and x components
+func (v Vector[T]) YZZX() Vector[T] {
+	return Vector[T]{x: v.y, y: v.z, z: v.z, w: v.x}
+}
+
+// YZZY returns a vector made up of the y, z, z and y components
+func (v Vector[T]) YZZY() Vector[T] {
+	return Vector[T]{x: v.y, y: v.z, z: v.z, w: v.y}
+}
+
+// YZZZ returns a vector made up of the y, z, z and z components
+func (v Vector[T]) YZZZ() Vector[T] {
+	return Vector[T]{x: v.y, y: v.z, z: v.z, w: v.z}
+}
+
+// YZZW returns a vector made up of the y, z, z and w components
+func (v Vector[T]) YZZW() Vector[T] {
+	return Vector[T]{x: v.y, y: v.z, z: v.z, w: v.w}
+}
+
+// YZWX returns a vector made up of the y, z, w and x components
+func (v Vector[T]) YZWX() Vector[T] {
+	return Vector[T]{x: v.y, y: v.z, z: v.w, w: v.x}
+}
+
+// YZWY returns a vector made up of the y, z, w and y components
+func (v Vector[T]) YZWY() Vector[T] {
+	return Vector[T]{x: v.y, y: v.z, z: v.w, w: v.y}
+}
+
+// YZWZ returns a vector made up of the y, z, w and z components
+func (v Vector[T]) YZWZ() Vector[T] {
+	return Vector[T]{x: v.y, y: v.z, z: v.w, w: v.z}
+}
+
+// YZWW returns a vector made up of the y, z, w and w components
+func (v Vector[T]) YZWW() Vector[T] {
+	return Vector[T]{x: v.y, y: v.z, z: v.w, w: v.w}
+}
+
+// YWXX returns a vector made up of the y, w, x and x components
+func (v Vector[T]) YWXX() Vector[T] {
+	return Vector[T]{x: v.y, y: v.w, z: v.x, w: v.x}
+}
+
+// YWXY returns a vector made up of the y, w, x and y components
+func (v Vector[T]) YWXY() Vector[T] {
+	return Vector[T]{x: v.y, y: v.w, z: v.x, w: v.y}
+}
+
+// YWXZ returns a vector made up of the y, w, x and z components
+func (v Vector[T]) YWXZ() Vector[T] {
+	return Vector[T]{x: v.y, y: v.w, z: v.x, w: v.z}
+}
+
+// YWXW returns a vector made up of the y, w, x and w components
+func (v Vector[T]) YWXW() Vector[T] {
+	return Vector[T]{x: v.y, y: v.w, z: v.x, w: v.w}
+}
+
+// YWYX returns a vector made up of the y, w, y and x components
+func (v Vector[T]) YWYX() Vector[T] {
+	return Vector[T]{x: v.y, y: v.w, z: v.y, w: v.x}
+}
+
+// YWYY returns a vector made up of the y, w, y and y components
+func (v Vector[T]) YWYY() Vector[T] {
+	return Vector[T]{x: v.y, y: v.w, z: v.y, w: v.y}
+}
+
+// YWYZ returns a vector made up of the y, w, y and z components
+func (v Vector[T]) YWYZ() Vector[T] {
+	return Vector[T]{x: v.y, y: v.w, z: v.y, w: v.z}
+}
+
+// YWYW returns a vector made up of the y, w, y and w components
+func (v Vector[T]) YWYW() Vector[T] {
+	return Vector[T]{x: v.y, y: v.w, z: v.y, w: v.w}
+}
+
+// YWZX returns a vector made up of the y, w, z and x components
+func (v Vector[T]) YWZX() Vector[T] {
+	return Vector[T]{x: v.y, y: v.w, z: v.z, w: v.x}
+}
+
+// YWZY returns a vector made up of the y, w, z and y components
+func (v Vector[T]) YWZY() Vector[T] {
+	return Vector[T]{x: v.y, y: v.w, z: v.z, w: v.y}
+}
+
+// YWZZ returns a vector made up of the y, w, z and z components
+func (v Vector[T]) YWZZ() Vector[T] {
+	return Vector[T]{x: v.y, y: v.w, z: v.z, w: v.z}
+}
+
+// YWZW returns a vector made up of the y, w, z and w components
+func (v Vector[T]) YWZW() Vector[T] {
+	return Vector[T]{x: v.y, y: v.w, z: v.z, w: v.w}
+}
+
+// YWWX returns a vector made up of the y, w, w and x components
+func (v Vector[T]) YWWX() Vector[T] {
+	return Vector[T]{x: v.y, y: v.w, z: v.w, w: v.x}
+}
+
+// YWWY returns a vector made up of the y, w, w and y components
+func (v Vector[T]) YWWY() Vector[T] {
+	return Vector[T]{x: v.y, y: v.w, z: v.w, w: v.y}
+}
+
+// YWWZ returns a vector made up of the y, w, w and z components
+func (v Vector[T]) YWWZ() Vector[T] {
+	return Vector[T]{x: v.y, y: v.w, z: v.w, w: v.z}
+}
+
+// YWWW returns a vector made up of the y, w, w and w components
+func (v Vector[T]) YWWW() Vector[T] {
+	return Vector[T]{x: v.y, y: v.w, z: v.w, w: v.w}
+}
+
+// ZXXX returns a vector made up of the z, x, x and x components
+func (v Vector[T]) ZXXX() Vector[T] {
+	return Vector[T]{x: v.z, y: v.x, z: v.x, w: v.x}
+}
+
+// ZXXY returns a vector made up of the z, x, x and y components
+func (v Vector[T]) ZXXY() Vector[T] {
+	return Vector[T]{x: v.z, y: v.x, z: v.x, w: v.y}
+}
+
+// ZXXZ returns a vector made up of the z, x, x and z components
+func (v Vector[T]) ZXXZ() Vector[T] {
+	return Vector[T]{x: v.z, y: v.x, z: v.x, w: v.z}
+}
+
+// ZXXW returns a vector made up of the z, x, x and w components
+func (v Vector[T]) ZXXW() Vector[T] {
+	return Vector[T]{x: v.z, y: v.x, z: v.x, w: v.w}
+}
+
+// ZXYX returns a vector made up of the z, x, y and x components
+func (v Vector[T]) ZXYX() Vector[T] {
+	return Vector[T]{x: v.z, y: v.x, z: v.y, w: v.x}
+}
+
+// ZXYY returns a vector made up of the z, x, y and y components
+func (v Vector[T]) ZXYY() Vector[T] {
+	return Vector[T]{x: v.z, y: v.x, z: v.y, w: v.y}
+}
+
+// ZXYZ returns a vector made up of the z, x, y and z components
+func (v Vector[T]) ZXYZ() Vector[T] {
+	return Vector[T]{x: v.z, y: v.x, z: v.y, w: v.z}
+}
+
+// ZXYW returns a vector made up of the z, x, y and w components
+func (v Vector[T]) ZXYW() Vector[T] {
+	return Vector[T]{x: v.z, y: v.x, z: v.y, w: v.w}
+}
+
+// ZXZX returns a vector made up of the z, x, z and x components
+func (v Vector[T]) ZXZX() Vector[T] {
+	return Vector[T]{x: v.z, y: v.x, z: v.z, w: v.x}
+}
+
+// ZXZY returns a vector made up of the z, x, z and y components
+func (v Vector[T]) ZXZY() Vector[T] {
+	return Vector[T]{x: v.z, y: v.x, z: v.z, w: v.y}
+}
+
+// ZXZZ returns a vector made up of the z, x, z and z components
+func (v Vector[T]) ZXZZ() Vector[T] {
+	return Vector[T]{x: v.z, y: v.x, z: v.z, w: v.z}
+}
+
+// ZXZW returns a vector made up of the z, x, z and w components
+func (v Vector[T]) ZXZW() Vector[T] {
+	return Vector[T]{x: v.z, y: v.x, z: v.z, w: v.w}
+}
+
+// ZXWX returns a vector made up of the z, x, w and x components
+func (v Vector[T]) ZXWX() Vector[T] {
+	return Vector[T]{x: v.z, y: v.x, z: v.w, w: v.x}
+}
+
+// ZXWY returns a vector made up of the z, x, w and y components
+func (v Vector[T]) ZXWY() Vector[T] {
+	return Vector[T]{x: v.z, y: v.x, z: v.w, w: v.y}
+}
+
+// ZXWZ returns a vector made up of the z, x, w and z components
+func (v Vector[T]) ZXWZ() Vector[T] {
+	return Vector[T]{x: v.z, y: v.x, z: v.w, w: v.z}
+}
+
+// ZXWW returns a vector made up of the z, x, w and w components
+func (v Vector[T]) ZXWW() Vector[T] {
+	return Vector[T]{x: v.z, y: v.x, z: v.w, w: v.w}
+}
+
+// ZYXX returns a vector made up of the z, y, x and x components
+func (v Vector[T]) ZYXX() Vector[T] {
+	return Vector[T]{x: v.z, y: v.y, z: v.x, w: v.x}
+}
+
+// ZYXY returns a vector made up of the z, y, x and y components
+func (v Vector[T]) ZYXY() Vector[T] {
+	return Vector[T]{x: v.z, y: v.y, z: v.x, w: v.y}
+}
+
+// ZYXZ returns a vector made up of the z, y, x and z components
+func (v Vector[T]) ZYXZ() Vector[T] {
+	return Vector[T]{x: v.z, y: v.y, z: v.x, w: v.z}
+}
+
+// ZYXW returns a vector made up of the z, y, x and w components
+func (v Vector[T]) ZYXW() Vector[T] {
+	return Vector[T]{x: v.z, y: v.y, z: v.x, w: v.w}
+}
+
+// ZYYX returns a vector made up of the z, y, y and x components
+func (v Vector[T]) ZYYX() Vector[T] {
+	return Vector[T]{x: v.z, y: v.y, z: v.y, w: v.x}
+}
+
+// ZYYY returns a vector made up of the z, y, y and y components
+func (v Vector[T]) ZYYY() Vector[T] {
+	return Vector[T]{x: v.z, y: v.y, z: v.y, w: v.y}
+}
+
+// ZYYZ returns a vector made up of the z, y, y and z components
+func (v Vector[T]) ZYYZ() Vector[T] {
+	return Vector[T]{x: v.z, y: v.y, z: v.y, w: v.z}
+}
+
+// ZYYW returns a vector made up of the z, y, y and w components
+func (v Vector[T]) ZYYW() Vector[T] {
+	return Vector[T]{x: v.z, y: v.y, z: v.y, w: v.w}
+}
+
+// ZYZX returns a vector made up of the z, y, z and x components
+func (v Vector[T]) ZYZX() Vector[T] {
+	return Vector[T]{x: v.z, y: v.y, z: v.z, w: v.x}
+}
+
+// ZYZY returns a vector made up of the z, y, z and y components
+func (v Vector[T]) ZYZY() Vector[T] {
+	return Vector[T]{x: v.z, y: v.y, z: v.z, w: v.y}
+}
+
+// ZYZZ returns a vector made up of the z, y, z and z components
+func (v Vector[T]) ZYZZ() Vector[T] {
+	return Vector[T]{x: v.z, y: v.y, z: v.z, w: v.z}
+}
+
+// ZYZW returns a vector made up of the z, y, z and w components
+func (v Vector[T]) ZYZW() Vector[T] {
+	return Vector[T]{x: v.z, y: v.y, z: v.z, w: v.w}
+}
+
+// ZYWX returns a vector made up of the z, y, w and x components
+func (v Vector[T]) ZYWX() Vector[T] {
+	return Vector[T]{x: v.z, y: v.y, z: v.w, w: v.x}
+}
+
+// ZYWY returns a vector made up of the z, y, w and y components
+func (v Vector[T]) ZYWY() Vector[T] {
+	return Vector[T]{x: v.z, y: v.y, z: v.w, w: v.y}
+}
+
+// ZYWZ returns a vector made up of the z, y, w and z components
+func (v Vector[T]) ZYWZ() Vector[T] {
+	return Vector[T]{x: v.z, y: v.y, z: v.w, w: v.z}
+}
+
+// ZYWW returns a vector made up of the z, y, w and w components
+func (v Vector[T]) ZYWW() Vector[T] {
+	return Vector[T]{x: v.z, y: v.y, z: v.w, w: v.w}
+}
+
+// ZZXX returns a vector made up of the z, z, x and x components
+func (v Vector[T]) ZZXX() Vector[T] {
+	return Vector[T]{x: v.z, y: v.z, z: v.x, w: v.x}
+}
+
+// ZZXY returns a vector made up of the z, z, x and y components
+func (v Vector[T]) ZZXY() Vector[T] {
+	return Vector[T]{x: v.z, y: v.z, z: v.x, w: v.y}
+}
+
+// ZZXZ returns a vector made up of the z, z, x and z components
+func (v Vector[T]) ZZXZ() Vector[T] {
+	return Vector[T]{x: v.z, y: v.z, z: v.x, w: v.z}
+}
+
+// ZZXW returns a vector made up of the z, z, x and w components
+func (v Vector[T]) ZZXW() Vector[T] {
+	return Vector[T]{x: v.z, y: v.z, z: v.x, w: v.w}
+}
+
+// ZZYX returns a vector made up of the z, z, y and x components
+func (v Vector[T]) ZZYX() Vector[T] {
+	return Vector[T]{x: v.z, y: v.z, z: v.y, w: v.x}
+}
+
+// ZZYY returns a vector made up of the z, z, y and y components
+func (v Vector[T]) ZZYY() Vector[T] {
+	return Vector[T]{x: v.z, y: v.z, z: v.y, w: v.y}
+}
+
+// ZZYZ returns a vector made up of the z, z, y and z components
+func (v Vector[T]) ZZYZ() Vector[T] {
+	return Vector[T]{x: v.z, y: v.z, z: v.y, w: v.z}
+}
+
+// ZZYW returns a vector made up of the z, z, y and w components
+func (v Vector[T]) ZZYW() Vector[T] {
+	return Vector[T]{x: v.z, y: v.z, z: v.y, w: v.w}
+}
+
+// ZZZX returns a vector made up of the z, z, z and x components
+func (v Vector[T]) ZZZX() Vector[T] {
+	return Vector[T]{x: v.z, y: v.z, z: v.z, w: v.x}
+}
+
+// ZZZY returns a vector made up of the z, z, z and y components
+func (v Vector[T]) ZZZY() Vector[T] {
+	return Vector[T]{x: v.z, y: v.z, z: v.z, w: v.y}
+}
+
+// ZZZZ returns a vector made up of the z, z, z and z components
+func (v Vector[T]) ZZZZ() Vector[T] {
+	return Vector[T]{x: v.z, y: v.z, z: v.z, w: v.z}
+}
+
+// ZZZW returns a vector made up of the z, z, z and w components
+func (v Vector[T]) ZZZW() Vector[T] {
+	return Vector[T]{x: v.z, y: v.z, z: v.z, w: v.w}
+}
+
+// ZZWX returns a vector made up of the z, z, w and x components
+func (v Vector[T]) ZZWX() Vector[T] {
+	return Vector[T]{x: v.z, y: v.z, z: v.w, w: v.x}
+}
+
+// ZZWY returns a vector made up of the z, z, w and y components
+func (v Vector[T]) ZZWY() Vector[T] {
+	return Vector[T]{x: v.z, y: v.z, z: v.w, w: v.y}
+}
+
+// ZZWZ returns a vector made up of the z, z, w and z components
+func (v Vector[T]) ZZWZ() Vector[T] {
+	return Vector[T]{x: v.z, y: v.z, z: v.w, w: v.z}
+}
+
+// ZZWW returns a vector made up of the z, z, w and w components
+func (v Vector[T]) ZZWW() Vector[T] {
+	return Vector[T]{x: v.z, y: v.z, z: v.w, w: v.w}
+}
+
+// ZWXX returns a vector made up of the z, w, x and x components
+func (v Vector[T]) ZWXX() Vector[T] {
+	return Vector[T]{x: v.z, y: v.w, z: v.x, w: v.x}
+}
+
+// ZWXY returns a vector made up of the z, w, x and y components
+func (v Vector[T]) ZWXY() Vector[T] {
+	return Vector[T]{x: v.z, y: v.w, z: v.x, w: v.y}
+}
+
+// ZWXZ returns a vector made up of the z, w, x and z components
+func (v Vector[T]) ZWXZ() Vector[T] {
+	return Vector[T]{x: v.z, y: v.w, z: v.x, w: v.z}
+}
+
+// ZWXW returns a vector made up of the z, w, x and w components
+func (v Vector[T]) ZWXW() Vector[T] {
+	return Vector[T]{x: v.z, y: v.w, z: v.x, w: v.w}
+}
+
+// ZWYX returns a vector made up of the z, w, y and x components
+func (v Vector[T]) ZWYX() Vector[T] {
+	return Vector[T]{x: v.z, y: v.w, z: v.y, w: v.x}
+}
+
+// ZWYY returns a vector made up of the z, w, y and y components
+func (v Vector[T]) ZWYY() Vector[T] {
+	return Vector[T]{x: v.z, y: v.w, z: v.y, w: v.y}
+}
+
+// ZWYZ returns a vector made up of the z, w, y and z components
+func (v Vector[T]) ZWYZ() Vector[T] {
+	return Vector[T]{x: v.z, y: v.w, z: v.y, w: v.z}
+}
+
+// ZWYW returns a vector made up of the z, w, y and w components
+func (v Vector[T]) ZWYW() Vector[T] {
+	return Vector[T]{x: v.z, y: v.w, z: v.y, w: v.w}
+}
+
+// ZWZX returns a vector made up of the z, w, z and x components
+func (v Vector[T]) ZWZX() Vector[T] {
+	return Vector[T]{x: v.z, y: v.w, z: v.z, w: v.x}
+}
+
+// ZWZY returns a vector made up of the z, w, z and y components
+func (v Vector[T]) ZWZY() Vector[T] {
+	return Vector[T]{x: v.z, y: v.w, z: v.z, w: v.y}
+}
+
+// ZWZZ returns a vector made up of the z, w, z and z components
+func (v Vector[T]) ZWZZ() Vector[T] {
+	return Vector[T]{x: v.z, y: v.w, z: v.z, w: v.z}
+}
+
+// ZWZW returns a vector made up of the z, w, z and w components
+func (v Vector[T]) ZWZW() Vector[T] {
+	return Vector[T]{x: v.z, y: v.w, z: v.z, w: v.w}
+}
+
+// ZWWX returns a vector made up of the z, w, w and x components
+func (v Vector[T]) ZWWX() Vector[T] {
+	return Vector[T]{x: v.z, y: v.w, z: v.w, w: v.x}
+}
+
+// ZWWY returns a vector made up of the z, w, w and y components
+func (v Vector[T]) ZWWY() Vector[T] {
+	return Vector[T]{x: v.z, y: v.w, z: v.w, w: v.y}
+}
+
+// ZWWZ returns a vector made up of the z, w, w and z components
+func (v Vector[T]) ZWWZ() Vector[T] {
+	return Vector[T]{x: v.z, y: v.w, z: v.w, w: v.z}
+}
+
+// ZWWW returns a vector made up of the z, w, w and w components
+func (v Vector[T]) ZWWW() Vector[T] {
+	return Vector[T]{x: v.z, y: v.w, z: v.w, w: v.w}
+}
+
+// WXXX returns a vector made up of the w, x, x and x components
+func (v Vector[T]) WXXX() Vector[T] {
+	return Vector[T]{x: v.w, y: v.x, z: v.x, w: v.x}
+}
+
+// WXXY returns a vector made up of the w, x, x and y components
+func (v Vector[T]) WXXY() Vector[T] {
+	return Vector[T]{x: v.w, y: v.x, z: v.x, w: v.y}
+}
+
+// WXXZ returns a vector made up of the w, x, x and z components
+func (v Vector[T]) WXXZ() Vector[T] {
+	return Vector[T]{x: v.w, y: v.x, z: v.x, w: v.z}
+}
+
+// WXXW returns a vector made up of the w, x, x and w components
+func (v Vector[T]) WXXW() Vector[T] {
+	return Vector[T]{x: v.w, y: v.x, z: v.x, w: v.w}
+}
+
+// WXYX returns a vector made up of the w, x, y and x components
+func (v Vector[T]) WXYX() Vector[T] {
+	return Vector[T]{x: v.w, y: v.x, z: v.y, w: v.x}
+}
+
+// WXYY returns a vector made up of the w, x, y and y components
+func (v Vector[T]) WXYY() Vector[T] {
+	return Vector[T]{x: v.w, y: v.x, z: v.y, w: v.y}
+}
+
+// WXYZ returns a vector made up of the w, x, y and z components
+func (v Vector[T]) WXYZ() Vector[T] {
+	return Vector[T]{x: v.w, y: v.x, z: v.y, w: v.z}
+}
+
+// WXYW returns a vector made up of the w, x, y and w components
+func (v Vector[T]) WXYW() Vector[T] {
+	return Vector[T]{x: v.w, y: v.x, z: v.y, w: v.w}
+}
+
+// WXZX returns a vector made up of the w, x, z and x components
+func (v Vector[T]) WXZX() Vector[T] {
+	return Vector[T]{x: v.w, y: v.x, z: v.z, w: v.x}
+}
+
+// WXZY returns a vector made up of the w, x, z and y components
+func (v Vector[T]) WXZY() Vector[T] {
+	return Vector[T]{x: v.w, y: v.x, z: v.z, w: v.y}
+}
+
+// WXZZ returns a vector made up of the w, x, z and z components
+func (v Vector[T]) WXZZ() Vector[T] {
+	return Vector[T]{x: v.w, y: v.x, z: v.z, w: v.z}
+}
+
+// WXZW returns a vector made up of the w, x, z and w components
+func (v Vector[T]) WXZW() Vector[T] {
+	return Vector[T]{x: v.w, y: v.x, z: v.z, w: v.w}
+}
+
+// WXWX returns a vector made up of the w, x, w and x components
+func (v Vector[T]) WXWX() Vector[T] {
+	return Vector[T]{x: v.w, y: v.x, z: v.w, w: v.x}
+}
+
+// WXWY returns a vector made up of the w, x, w and y components
+func (v Vector[T]) WXWY() Vector[T] {
+	return Vector[T]{x: v.w, y: v.x, z: v.w, w: v.y}
+}
+
+// WXWZ returns a vector made up of the w, x, w and z components
+func (v Vector[T]) WXWZ() Vector[T] {
+	return Vector[T]{x: v.w, y: v.x, z: v.w, w: v.z}
+}
+
+// WXWW returns a vector made up of the w, x, w and w components
+func (v Vector[T]) WXWW() Vector[T] {
+	return Vector[T]{x: v.w, y: v.x, z: v.w, w: v.w}
+}
+
+// WYXX returns a vector made up of the w, y, x and x components
+func (v Vector[T]) WYXX() Vector[T] {
+	return Vector[T]{x: v.w, y: v.y, z: v.x, w: v.x}
+}
+
+// WYXY returns a vector made up of the w, y, x and y components
+func (v Vector[T]) WYXY() Vector[T] {
+	return Vector[T]{x: v.w, y: v.y, z: v.x, w: v.y}
+}
+
+// WYXZ returns a vector made up of the w, y, x and z components
+func (v Vector[T]) WYXZ() Vector[T] {
+	return Vector[T]{x: v.w, y: v.y, z: v.x, w: v.z}
+}
+
+// WYXW returns a vector made up of the w, y, x and w components
+func (v Vector[T]) WYXW() Vector[T] {
+	return Vector[T]{x: v.w, y: v.y, z: v.x, w: v.w}
+}
+
+// WYYX returns a vector made up of the w, y, y and x components
+func (v Vector[T]) WYYX() Vector[T] {
+	return Vector[T]{x: v.w, y: v.y, z: v.y, w: v.x}
+}
+
+// WYYY returns a vector made up of the w, y, y and y components
+func (v Vector[T]) WYYY() Vector[T] {
+	return Vector[T]{x: v.w, y: v.y, z: v.y, w: v.y}
+}
+
+// WYYZ returns a vector made up of the w, y, y and z components
+func (v Vector[T]) WYYZ() Vector[T] {
+	return Vector[T]{x: v.w, y: v.y, z: v.y, w: v.z}
+}
+
+// WYYW returns a vector made up of the w, y, y and w components
+func (v Vector[T]) WYYW() Vector[T] {
+	return Vector[T]{x: v.w, y: v.y, z: v.y, w: v.w}
+}
+
+// WYZX returns a vector made up of the w, y, z and x components
+func (v Vector[T]) WYZX() Vector[T] {
+	return Vector[T]{x: v.w, y: v.y, z: v.z, w: v.x}
+}
+
+// WYZY returns a vector made up of the w, y, z and y components
+func (v Vector[T]) WYZY() Vector[T] {
+	return Vector[T]{x: v.w, y: v.y, z: v.z, w: v.y}
+}
+
+// WYZZ returns a vector made up of the w, y, z and z components
+func (v Vector[T]) WYZZ() Vector[T] {
+	return Vector[T]{x: v.w, y: v.y, z: v.z, w: v.z}
+}
+
+// WYZW returns a vector made up of the w, y, z and w components
+func (v Vector[T]) WYZW() Vector[T] {
+	return Vector[T]{x: v.w, y: v.y, z: v.z, w: v.w}
+}
+
+// WYWX returns a vector made up of the w, y, w and x components
+func (v Vector[T]) WYWX() Vector[T] {
+	return Vector[T]{x: v.w, y: v.y, z: v.w, w: v.x}
+}
+
+// WYWY returns a vector made up of the w, y, w and y components
+func (v Vector[T]) WYWY() Vector[T] {
+	return Vector[T]{x: v.w, y: v.y, z: v.w, w: v.y}
+}
+
+// WYWZ returns a vector made up of the w, y, w and z components
+func (v Vector[T]) WYWZ() Vector[T] {
+	return Vector[T]{x: v.w, y: v.y, z: v.w, w: v.z}
+}
+
+// WYWW returns a vector made up of the w, y, w and w components
+func (v Vector[T]) WYWW() Vector[T] {
+	return Vector[T]{x: v.w, y: v.y, z: v.w, w: v.w}
+}
+
+// WZXX returns a vector made up of the w, z, x and x components
+func (v Vector[T]) WZXX() Vector[T] {
+	return Vector[T]{x: v.w, y: v.z, z: v.x, w: v.x}
+}
+
+// WZXY returns a vector made up of the w, z, x and y components
+func (v Vector[T]) WZXY() Vector[T] {
+	return Vector[T]{x: v.w, y: v.z, z: v.x, w: v.y}
+}
+
+// WZXZ returns a vector made up of the w, z, x and z components
+func (v Vector[T]) WZXZ() Vector[T] {
+	return Vector[T]{x: v.w, y: v.z, z: v.x, w: v.z}
+}
+
+// WZXW returns a vector made up of the w, z, x and w components
+func (v Vector[T]) WZXW() Vector[T] {
+	return Vector[T]{x: v.w, y: v.z, z: v.x, w: v.w}
+}
+
+// WZYX returns a vector made up of the w, z, y and x components
+func (v Vector[T]) WZYX() Vector[T] {
+	return Vector[T]{x: v.w, y: v.z, z: v.y, w: v.x}
+}
+
+// WZYY returns a vector made up of the w, z, y and y components
+func (v Vector[T]) WZYY() Vector[T] {
+	return Vector[T]{x: v.w, y: v.z, z: v.y, w: v.y}
+}
+
+// WZYZ returns a vector made up of the w, z, y and z components
+func (v Vector[T]) WZYZ() Vector[T] {
+	return Vector[T]{x: v.w, y: v.z, z: v.y, w: v.z}
+}
+
+// WZYW returns a vector made up of the w, z, y and w components
+func (v Vector[T]) WZYW() Vector[T] {
+	return Vector[T]{x: v.w, y: v.z, z: v.y, w: v.w}
+}
+
+// WZZX returns a vector made up of the w, z, z and x components
+func (v Vector[T]) WZZX() Vector[T] {
+	return Vector[T]{x: v.w, y: v.z, z: v.z, w: v.x}
+}
+
+// WZZY returns a vector made up of the w, z, z and y components
+func (v Vector[T]) WZZY() Vector[T] {
+	return Vector[T]{x: v.w, y: v.z, z: v.z, w: v.y}
+}
+
+// WZZZ returns a vector made up of the w, z, z and z components
+func (v Vector[T]) WZZZ() Vector[T] {
+	return Vector[T]{x: v.w, y: v.z, z: v.z, w: v.z}
+}
+
+// WZZW returns a vector made up of the w, z, z and w components
+func (v Vector[T]) WZZW() Vector[T] {
+	return Vector[T]{x: v.w, y: v.z, z: v.z, w: v.w}
+}
+
+// WZWX returns a vector made up of the w, z, w and x components
+func (v Vector[T]) WZWX() Vector[T] {
+	return Vector[T]{x: v.w, y: v.z, z: v.w, w: v.x}
+}
+
+// WZWY returns a vector made up of the w, z, w and y components
+func (v Vector[T]) WZWY() Vector[T] {
+	return Vector[T]{x: v.w, y: v.z, z: v.w, w: v.y}
+}
+
+// WZWZ returns a vector made up of the w, z, w and z components
+func (v Vector[T]) WZWZ() Vector[T] {
+	return Vector[T]{x: v.w, y: v.z, z: v.w, w: v.z}
+}
+
+// WZWW returns a vector made up of the w, z, w and w components
+func (v Vector[T]) WZWW() Vector[T] {
+	return Vector[T]{x: v.w, y: v.z, z: v.w, w: v.w}
+}
+
+// WWXX returns a vector made up of the w, w, x and x components
+func (v Vector[T]) WWXX() Vector[T] {
+	return Vector[T]{x: v.w, y: v.w, z: v.x, w: v.x}
+}
+
+// WWXY returns a vector made up of the w, w, x and y components
+func (v Vector[T]) WWXY() Vector[T] {
+	return Vector[T]{x: v.w, y: v.w, z: v.x, w: v.y}
+}
+
+// WWXZ returns a vector made up of the w, w, x and z components
+func (v Vector[T]) WWXZ() Vector[T] {
+	return Vector[T]{x: v.w, y: v.w, z: v.x, w: v.z}
+}
+
+// WWXW returns a vector made up of the w, w, x and w components
+func (v Vector[T]) WWXW() Vector[T] {
+	return Vector[T]{x: v.w, y: v.w, z: v.x, w: v.w}
+}
+
+// WWYX returns a vector made up of the w, w, y and x components
+func (v Vector[T]) WWYX() Vector[T] {
+	return Vector[T]{x: v.w, y: v.w, z: v.y, w: v.x}
+}
+
+// WWYY returns a vector made up of the w, w, y and y components
+func (v Vector[T]) WWYY() Vector[T] {
+	return Vector[T]{x: v.w, y: v.w, z: v.y, w: v.y}
+}
+
+// WWYZ returns a vector made up of the w, w, y and z components
+func (v Vector[T]) WWYZ() Vector[T] {
+	return Vector[T]{x: v.w, y: v.w, z: v.y, w: v.z}
+}
+
+// WWYW returns a vector made up of the w, w, y and w components
+func (v Vector[T]) WWYW() Vector[T] {
+	return Vector[T]{x: v.w, y: v.w, z: v.y, w: v.w}
+}
+
+// WWZX returns a vector made up of the w, w, z and x components
+func (v Vector[T]) WWZX() Vector[T] {
+	return Vector[T]{x: v.w, y: v.w, z: v.z, w: v.x}
+}
+
+// WWZY returns a vector made up of the w, w, z and y components
+func (v Vector[T]) WWZY() Vector[T] {
+	return Vector[T]{x: v.w, y: v.w, z: v.z, w: v.y}
+}
+
+// WWZZ returns a vector made up of the w, w, z and z components
+func (v Vector[T]) WWZZ() Vector[T] {
+	return Vector[T]{x: v.w, y: v.w, z: v.z, w: v.z}
+}
+
+// WWZW returns a vector made up of the w, w, z and w components
+func (v Vector[T]) WWZW() Vector[T] {
+	return Vector[T]{x: v.w, y: v.w, z: v.z, w: v.w}
+}
+
+// WWWX returns a vector made up of the w, w, w and x components
+func (v Vector[T]) WWWX() Vector[T] {
+	return Vector[T]{x: v.w, y: v.w, z: v.w, w: v.x}
+}
+
+// WWWY returns a vector made up of the w, w, w and y components
+func (v Vector[T]) WWWY() Vector[T] {
+	return Vector[T]{x: v.w, y: v.w, z: v.w, w: v.y}
+}
+
+// WWWZ returns a vector made up of the w, w, w and z components
+func (v Vector[T]) WWWZ() Vector[T] {
+	return Vector[T]{x: v.w, y: v.w, z: v.w, w: v.z}
+}
+
+// WWWW returns a vector made up of the w, w, w and w components
+func (v Vector[T]) WWWW() Vector[T] {
+	return Vector[T]{x: v.w, y: v.w, z: v.w, w: v.w}
+}
@@ -0,0 +1,20 @@
+package vector4
+
+import "github.com/EliCDavis/vector/internal/codec"
+
+// UnmarshalYAML decodes the vector from a mapping of components, a sequence
+// of components, or a scalar in the form "x,y,z,w". It uses the callback form
+// of the interface, which both gopkg.in/yaml.v2 and gopkg.in/yaml.v3 accept,
+// so this package doesn't depend on either. Vectors are encoded to YAML
+// through MarshalText
+func (v *Vector[T]) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var components [componentCount]T
+	if err := codec.DecodeYAML(unmarshal, componentKeys, components[:]); err != nil {
+		return err
+	}
+	v.x = components[0]
+	v.y = components[1]
+	v.z = components[2]
+	v.w = components[3]
+	return nil
+}
@@ -0,0 +1,70 @@
+package vector4_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector/vector4"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+func TestYAML(t *testing.T) {
+	in := vector4.New(1.5, -2., 0.25, 8.)
+
+	data, err := yaml.Marshal(in)
+	assert.NoError(t, err)
+	assert.Equal(t, "1.5,-2,0.25,8\n", string(data))
+
+	var out vector4.Float64
+	assert.NoError(t, yaml.Unmarshal(data, &out))
+	assert.Equal(t, in, out)
+}
+
+func TestYAMLForms(t *testing.T) {
+	want := vector4.New(1.5, -2., 0.25, 8.)
+
+	tests := map[string]string{
+		"flow mapping":  "{x: 1.5, y: -2, z: 0.25, w: 8}",
+		"block mapping": "x: 1.5\ny: -2\nz: 0.25\nw: 8",
+		"sequence":      "[1.5, -2, 0.25, 8]",
+		"scalar":        `"1.5,-2,0.25,8"`,
+	}
+
+	for name, text := range tests {
+		t.Run(name, func(t *testing.T) {
+			var out vector4.Float64
+			assert.NoError(t, yaml.Unmarshal([]byte(text), &out))
+			assert.Equal(t, want, out)
+		})
+	}
+}
+
+func TestYAMLInField(t *testing.T) {
+	type config struct {
+		Origin vector4.Int64 `yaml:"origin"`
+	}
+
+	in := config{Origin: vector4.Fill[int64](9007199254740993)}
+	data, err := yaml.Marshal(in)
+	assert.NoError(t, err)
+
+	var out config
+	assert.NoError(t, yaml.Unmarshal(data, &out))
+	assert.Equal(t, in, out)
+}
+
+func TestYAMLErrors(t *testing.T) {
+	tests := map[string]string{
+		"short sequence": "[1.5, -2, 0.25]",
+		"bad component":  "{x: abc}",
+		"overflow":       "{x: 300}",
+		"bad scalar":     "nope",
+	}
+
+	for name, text := range tests {
+		t.Run(name, func(t *testing.T) {
+			var out vector4.Int8
+			assert.Error(t, yaml.Unmarshal([]byte(text), &out))
+		})
+	}
+}
@@ -0,0 +1,140 @@
+// Package vectorarrow shares vector slices with Apache Arrow arrays without
+// copying them.
+//
+// Arrow stores a FixedSizeList<T>[N] array as a single contiguous buffer of
+// child values, which is exactly the memory layout of a slice of vectors, so
+// the functions here reinterpret one as the other rather than looping over
+// the components. A Struct array of N numeric fields keeps each field in its
+// own buffer, which matches the SoA types of vector2 and vector3.
+//
+// The package only converts between vectors and those value buffers. It
+// doesn't depend on the Arrow module, so it neither reads arrow.Array values
+// nor drives array builders itself; the caller moves the buffers in and out
+// of Arrow:
+//
+//	values := list.ListValues().(*array.Float64).Float64Values()
+//	points, err := vectorarrow.Vector3FromValues(values)
+//
+//	builder.ValueBuilder().(*array.Float64Builder).AppendValues(vectorarrow.Vector3Values(points), nil)
+//
+//	x, y, z, err := vectorarrow.Vector3ToStruct(soa)
+//	buf := memory.NewBufferBytes(arrow.Float64Traits.CastToBytes(x))
+//
+// The returned slices alias the memory they were created from, so writes
+// through one are visible through the other and Arrow's buffers must be kept
+// alive, and not released, while the vectors are in use. Arrow has no
+// platform sized int, so use the fixed size integer types when sharing
+// integer vectors. Null entries aren't represented and come through as
+// whatever values the child buffer holds for them.
+package vectorarrow
+
+import (
+	"fmt"
+	"unsafe"
+
+	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/EliCDavis/vector/vector4"
+)
+
+// Vector2Values views the vectors as the child values of a
+// FixedSizeList<T>[2] array
+func Vector2Values[T vector.Number](vectors []vector2.Vector[T]) []T {
+	return flatten[T](vectors, 2)
+}
+
+// Vector2FromValues views the child values of a FixedSizeList<T>[2] array as
+// vectors
+func Vector2FromValues[T vector.Number](values []T) ([]vector2.Vector[T], error) {
+	return unflatten[vector2.Vector[T]](values, 2)
+}
+
+// Vector3Values views the vectors as the child values of a
+// FixedSizeList<T>[3] array
+func Vector3Values[T vector.Number](vectors []vector3.Vector[T]) []T {
+	return flatten[T](vectors, 3)
+}
+
+// Vector3FromValues views the child values of a FixedSizeList<T>[3] array as
+// vectors
+func Vector3FromValues[T vector.Number](values []T) ([]vector3.Vector[T], error) {
+	return unflatten[vector3.Vector[T]](values, 3)
+}
+
+// Vector4Values views the vectors as the child values of a
+// FixedSizeList<T>[4] array
+func Vector4Values[T vector.Number](vectors []vector4.Vector[T]) []T {
+	return flatten[T](vectors, 4)
+}
+
+// Vector4FromValues views the child values of a FixedSizeList<T>[4] array as
+// vectors
+func Vector4FromValues[T vector.Number](values []T) ([]vector4.Vector[T], error) {
+	return unflatten[vector4.Vector[T]](values, 4)
+}
+
+// Vector2FromStruct gathers the field values of a Struct array with x and y
+// fields into a SoA, without copying them
+func Vector2FromStruct[T vector.Number](x, y []T) (vector2.SoA[T], error) {
+	if err := checkFields(len(x), len(y)); err != nil {
+		return vector2.SoA[T]{}, err
+	}
+	return vector2.SoA[T]{X: x, Y: y}, nil
+}
+
+// Vector3FromStruct gathers the field values of a Struct array with x, y and
+// z fields into a SoA, without copying them
+func Vector3FromStruct[T vector.Number](x, y, z []T) (vector3.SoA[T], error) {
+	if err := checkFields(len(x), len(y), len(z)); err != nil {
+		return vector3.SoA[T]{}, err
+	}
+	return vector3.SoA[T]{X: x, Y: y, Z: z}, nil
+}
+
+// Vector2ToStruct returns the components of the SoA as the field values of a
+// Struct array with x and y fields, without copying them
+func Vector2ToStruct[T vector.Number](soa vector2.SoA[T]) (x, y []T, err error) {
+	if err := checkFields(len(soa.X), len(soa.Y)); err != nil {
+		return nil, nil, err
+	}
+	return soa.X, soa.Y, nil
+}
+
+// Vector3ToStruct returns the components of the SoA as the field values of a
+// Struct array with x, y and z fields, without copying them
+func Vector3ToStruct[T vector.Number](soa vector3.SoA[T]) (x, y, z []T, err error) {
+	if err := checkFields(len(soa.X), len(soa.Y), len(soa.Z)); err != nil {
+		return nil, nil, nil, err
+	}
+	return soa.X, soa.Y, soa.Z, nil
+}
+
+// flatten reinterprets a slice of vectors as their components. Vectors are
+// made up of components fields of the same type, so there's no padding
+// between or after them
+func flatten[T vector.Number, V any](vectors []V, components int) []T {
+	if len(vectors) == 0 {
+		return nil
+	}
+	return unsafe.Slice((*T)(unsafe.Pointer(&vectors[0])), len(vectors)*components)
+}
+
+func unflatten[V any, T vector.Number](values []T, components int) ([]V, error) {
+	if len(values)%components != 0 {
+		return nil, fmt.Errorf("value count %d is not a multiple of %d", len(values), components)
+	}
+	if len(values) == 0 {
+		return nil, nil
+	}
+	return unsafe.Slice((*V)(unsafe.Pointer(&values[0])), len(values)/components), nil
+}
+
+func checkFields(lengths ...int) error {
+	for _, l := range lengths[1:] {
+		if l != lengths[0] {
+			return fmt.Errorf("struct fields have mismatched lengths %v", lengths)
+		}
+	}
+	return nil
+}
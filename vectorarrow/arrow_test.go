@@ -0,0 +1,71 @@
+package vectorarrow_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/EliCDavis/vector/vector4"
+	"github.com/EliCDavis/vector/vectorarrow"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFixedSizeList(t *testing.T) {
+	values := []float64{1, 2, 3, 4, 5, 6}
+
+	v2, err := vectorarrow.Vector2FromValues(values)
+	require.NoError(t, err)
+	assert.Equal(t, []vector2.Float64{vector2.New(1., 2.), vector2.New(3., 4.), vector2.New(5., 6.)}, v2)
+
+	v3, err := vectorarrow.Vector3FromValues(values)
+	require.NoError(t, err)
+	assert.Equal(t, []vector3.Float64{vector3.New(1., 2., 3.), vector3.New(4., 5., 6.)}, v3)
+
+	// The vectors share memory with the values
+	v3[1] = v3[1].SetY(-5)
+	assert.Equal(t, -5., values[4])
+	assert.Equal(t, values, vectorarrow.Vector3Values(v3))
+
+	_, err = vectorarrow.Vector4FromValues(values)
+	assert.EqualError(t, err, "value count 6 is not a multiple of 4")
+
+	v4 := []vector4.Vector[int32]{vector4.New[int32](1, 2, 3, 4)}
+	assert.Equal(t, []int32{1, 2, 3, 4}, vectorarrow.Vector4Values(v4))
+	assert.Equal(t, []int32{7, 8}, vectorarrow.Vector2Values([]vector2.Vector[int32]{vector2.New[int32](7, 8)}))
+
+	empty, err := vectorarrow.Vector3FromValues([]float32{})
+	require.NoError(t, err)
+	assert.Empty(t, empty)
+	assert.Nil(t, vectorarrow.Vector3Values[float32](nil))
+}
+
+func TestStruct(t *testing.T) {
+	x, y, z := []float32{1, 2}, []float32{3, 4}, []float32{5, 6}
+
+	s3, err := vectorarrow.Vector3FromStruct(x, y, z)
+	require.NoError(t, err)
+	assert.Equal(t, vector3.New[float32](2, 4, 6), s3.At(1))
+
+	s2, err := vectorarrow.Vector2FromStruct(x, y)
+	require.NoError(t, err)
+	assert.Equal(t, vector2.New[float32](1, 3), s2.At(0))
+
+	_, err = vectorarrow.Vector3FromStruct(x, y, z[:1])
+	assert.EqualError(t, err, "struct fields have mismatched lengths [2 2 1]")
+
+	// Converting back hands out the same buffers
+	x3, y3, z3, err := vectorarrow.Vector3ToStruct(s3)
+	require.NoError(t, err)
+	assert.Same(t, &x[0], &x3[0])
+	assert.Same(t, &y[0], &y3[0])
+	assert.Same(t, &z[0], &z3[0])
+
+	x2, y2, err := vectorarrow.Vector2ToStruct(s2)
+	require.NoError(t, err)
+	assert.Equal(t, x, x2)
+	assert.Equal(t, y, y2)
+
+	_, _, err = vectorarrow.Vector2ToStruct(vector2.SoA[float32]{X: x, Y: y[:1]})
+	assert.EqualError(t, err, "struct fields have mismatched lengths [2 1]")
+}
@@ -0,0 +1,74 @@
+// Package vectorebiten converts between vector2 and the float pairs and GeoM
+// transforms used by Ebitengine.
+//
+// Ebitengine's APIs work in terms of plain numbers and its GeoM type, so the
+// helpers here accept small interfaces that *ebiten.GeoM satisfies rather
+// than depending on Ebitengine directly:
+//
+//	op := &ebiten.DrawImageOptions{}
+//	vectorebiten.Translate(&op.GeoM, position)
+//	cursor := vectorebiten.FromInts[float64](ebiten.CursorPosition())
+package vectorebiten
+
+import (
+	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/vector2"
+)
+
+// Translator is satisfied by *ebiten.GeoM
+type Translator interface {
+	Translate(tx, ty float64)
+}
+
+// Scaler is satisfied by *ebiten.GeoM
+type Scaler interface {
+	Scale(x, y float64)
+}
+
+// Applier is satisfied by ebiten.GeoM
+type Applier interface {
+	Apply(x, y float64) (float64, float64)
+}
+
+// Elementer is satisfied by ebiten.GeoM
+type Elementer interface {
+	Element(i, j int) float64
+}
+
+// ToFloats returns the components of the vector as the float64 pair most
+// Ebitengine functions expect
+func ToFloats[T vector.Number](v vector2.Vector[T]) (x, y float64) {
+	return float64(v.X()), float64(v.Y())
+}
+
+// FromFloats creates a vector from a float64 pair, such as the result of
+// ebiten.GeoM.Apply
+func FromFloats[T vector.Number](x, y float64) vector2.Vector[T] {
+	return vector2.New(T(x), T(y))
+}
+
+// FromInts creates a vector from an int pair, such as the result of
+// ebiten.CursorPosition or ebiten.Image.Size
+func FromInts[T vector.Number](x, y int) vector2.Vector[T] {
+	return vector2.New(T(x), T(y))
+}
+
+// Translate translates the transform by the vector
+func Translate[T vector.Number](g Translator, v vector2.Vector[T]) {
+	g.Translate(float64(v.X()), float64(v.Y()))
+}
+
+// Scale scales the transform by the components of the vector
+func Scale[T vector.Number](g Scaler, v vector2.Vector[T]) {
+	g.Scale(float64(v.X()), float64(v.Y()))
+}
+
+// Apply transforms the vector by g
+func Apply[T vector.Number](g Applier, v vector2.Vector[T]) vector2.Vector[T] {
+	return FromFloats[T](g.Apply(float64(v.X()), float64(v.Y())))
+}
+
+// Translation returns the translation component of the transform
+func Translation(g Elementer) vector2.Float64 {
+	return vector2.New(g.Element(0, 2), g.Element(1, 2))
+}
@@ -0,0 +1,64 @@
+package vectorebiten_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vectorebiten"
+	"github.com/stretchr/testify/assert"
+)
+
+// geoM mirrors the method set of ebiten.GeoM for a translate and scale only
+// transform
+type geoM struct {
+	a, d, tx, ty float64
+}
+
+func (g *geoM) Translate(tx, ty float64) {
+	g.tx += tx
+	g.ty += ty
+}
+
+func (g *geoM) Scale(x, y float64) {
+	g.a *= x
+	g.d *= y
+	g.tx *= x
+	g.ty *= y
+}
+
+func (g geoM) Apply(x, y float64) (float64, float64) {
+	return g.a*x + g.tx, g.d*y + g.ty
+}
+
+func (g geoM) Element(i, j int) float64 {
+	switch {
+	case i == 0 && j == 0:
+		return g.a
+	case i == 1 && j == 1:
+		return g.d
+	case i == 0 && j == 2:
+		return g.tx
+	case i == 1 && j == 2:
+		return g.ty
+	}
+	return 0
+}
+
+func TestFloats(t *testing.T) {
+	x, y := vectorebiten.ToFloats(vector2.New(1, 2))
+	assert.Equal(t, 1., x)
+	assert.Equal(t, 2., y)
+
+	assert.Equal(t, vector2.New(1.5, 2.5), vectorebiten.FromFloats[float64](1.5, 2.5))
+	assert.Equal(t, vector2.New(3., 4.), vectorebiten.FromInts[float64](3, 4))
+}
+
+func TestGeoM(t *testing.T) {
+	g := &geoM{a: 1, d: 1}
+
+	vectorebiten.Translate(g, vector2.New(1., 2.))
+	vectorebiten.Scale(g, vector2.New(2., 3.))
+
+	assert.Equal(t, vector2.New(2., 6.), vectorebiten.Translation(g))
+	assert.Equal(t, vector2.New(4., 9.), vectorebiten.Apply(g, vector2.New(1., 1.)))
+}
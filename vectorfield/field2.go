@@ -0,0 +1,160 @@
+// Package vectorfield holds scalar and vector quantities sampled on regular
+// 2D and 3D grids, such as the velocity of a fluid simulation or a wind
+// field, and provides interpolated sampling along with the standard
+// differential operators over them.
+//
+// Samples are stored row major, with x varying fastest. Sampling positions
+// are given in grid coordinates, where sample (i, j) sits at (i, j), and
+// derivatives account for the world space distance between samples.
+// Derivatives are computed with central differences, falling back to one
+// sided differences along the edges of the grid.
+package vectorfield
+
+import (
+	"math"
+
+	"github.com/EliCDavis/vector/vector2"
+)
+
+// Scalar2 is a scalar quantity sampled on a 2D grid
+type Scalar2 struct {
+	Width, Height int
+
+	// Spacing is the world space distance between neighboring samples
+	Spacing float64
+
+	Data []float64
+}
+
+// NewScalar2 creates a zeroed scalar grid
+func NewScalar2(width, height int, spacing float64) *Scalar2 {
+	return &Scalar2{Width: width, Height: height, Spacing: spacing, Data: make([]float64, width*height)}
+}
+
+// At returns the sample at (x, y)
+func (s *Scalar2) At(x, y int) float64 {
+	return s.Data[y*s.Width+x]
+}
+
+// Set stores the sample at (x, y)
+func (s *Scalar2) Set(x, y int, v float64) {
+	s.Data[y*s.Width+x] = v
+}
+
+// Sample bilinearly interpolates the grid at p, given in grid coordinates.
+// Positions outside the grid are clamped to its edges
+func (s *Scalar2) Sample(p vector2.Float64) float64 {
+	x0, x1, tx := cell(p.X(), s.Width)
+	y0, y1, ty := cell(p.Y(), s.Height)
+	top := lerp(s.At(x0, y0), s.At(x1, y0), tx)
+	bottom := lerp(s.At(x0, y1), s.At(x1, y1), tx)
+	return lerp(top, bottom, ty)
+}
+
+// Gradient computes the gradient of the scalar field, the direction and rate
+// of its steepest increase at every sample
+func (s *Scalar2) Gradient() *Field2 {
+	out := NewField2(s.Width, s.Height, s.Spacing)
+	for y := 0; y < s.Height; y++ {
+		for x := 0; x < s.Width; x++ {
+			xl, xh, dx := neighbors(x, s.Width, s.Spacing)
+			yl, yh, dy := neighbors(y, s.Height, s.Spacing)
+			out.Set(x, y, vector2.New(
+				(s.At(xh, y)-s.At(xl, y))/dx,
+				(s.At(x, yh)-s.At(x, yl))/dy,
+			))
+		}
+	}
+	return out
+}
+
+// Field2 is a 2D vector quantity sampled on a 2D grid
+type Field2 struct {
+	Width, Height int
+
+	// Spacing is the world space distance between neighboring samples
+	Spacing float64
+
+	Data []vector2.Float64
+}
+
+// NewField2 creates a zeroed vector grid
+func NewField2(width, height int, spacing float64) *Field2 {
+	return &Field2{Width: width, Height: height, Spacing: spacing, Data: make([]vector2.Float64, width*height)}
+}
+
+// At returns the sample at (x, y)
+func (f *Field2) At(x, y int) vector2.Float64 {
+	return f.Data[y*f.Width+x]
+}
+
+// Set stores the sample at (x, y)
+func (f *Field2) Set(x, y int, v vector2.Float64) {
+	f.Data[y*f.Width+x] = v
+}
+
+// Sample bilinearly interpolates the grid at p, given in grid coordinates.
+// Positions outside the grid are clamped to its edges
+func (f *Field2) Sample(p vector2.Float64) vector2.Float64 {
+	x0, x1, tx := cell(p.X(), f.Width)
+	y0, y1, ty := cell(p.Y(), f.Height)
+	top := vector2.Lerp(f.At(x0, y0), f.At(x1, y0), tx)
+	bottom := vector2.Lerp(f.At(x0, y1), f.At(x1, y1), tx)
+	return vector2.Lerp(top, bottom, ty)
+}
+
+// Divergence computes how much the field flows outwards from every sample
+func (f *Field2) Divergence() *Scalar2 {
+	out := NewScalar2(f.Width, f.Height, f.Spacing)
+	for y := 0; y < f.Height; y++ {
+		for x := 0; x < f.Width; x++ {
+			xl, xh, dx := neighbors(x, f.Width, f.Spacing)
+			yl, yh, dy := neighbors(y, f.Height, f.Spacing)
+			out.Set(x, y, (f.At(xh, y).X()-f.At(xl, y).X())/dx+(f.At(x, yh).Y()-f.At(x, yl).Y())/dy)
+		}
+	}
+	return out
+}
+
+// Curl computes the scalar curl of the field at every sample, the rate at
+// which it rotates counter-clockwise about the axis perpendicular to the
+// grid
+func (f *Field2) Curl() *Scalar2 {
+	out := NewScalar2(f.Width, f.Height, f.Spacing)
+	for y := 0; y < f.Height; y++ {
+		for x := 0; x < f.Width; x++ {
+			xl, xh, dx := neighbors(x, f.Width, f.Spacing)
+			yl, yh, dy := neighbors(y, f.Height, f.Spacing)
+			out.Set(x, y, (f.At(xh, y).Y()-f.At(xl, y).Y())/dx-(f.At(x, yh).X()-f.At(x, yl).X())/dy)
+		}
+	}
+	return out
+}
+
+// cell finds the samples surrounding the grid coordinate v along an axis of
+// the given size, and how far v lies between them
+func cell(v float64, size int) (lo, hi int, t float64) {
+	if size <= 1 || !(v > 0) {
+		return 0, 0, 0
+	}
+	if v >= float64(size-1) {
+		return size - 1, size - 1, 0
+	}
+	lo = int(math.Floor(v))
+	return lo, lo + 1, v - float64(lo)
+}
+
+// neighbors returns the samples to difference when differentiating at i
+// along an axis of the given size, and the distance between them. Sizes of
+// one yield a zero derivative
+func neighbors(i, size int, spacing float64) (lo, hi int, distance float64) {
+	lo, hi = max(i-1, 0), min(i+1, size-1)
+	if lo == hi {
+		return lo, hi, 1
+	}
+	return lo, hi, float64(hi-lo) * spacing
+}
+
+func lerp(a, b, t float64) float64 {
+	return a + (b-a)*t
+}
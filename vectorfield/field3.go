@@ -0,0 +1,151 @@
+package vectorfield
+
+import "github.com/EliCDavis/vector/vector3"
+
+// Scalar3 is a scalar quantity sampled on a 3D grid
+type Scalar3 struct {
+	Width, Height, Depth int
+
+	// Spacing is the world space distance between neighboring samples
+	Spacing float64
+
+	Data []float64
+}
+
+// NewScalar3 creates a zeroed scalar grid
+func NewScalar3(width, height, depth int, spacing float64) *Scalar3 {
+	return &Scalar3{Width: width, Height: height, Depth: depth, Spacing: spacing, Data: make([]float64, width*height*depth)}
+}
+
+// At returns the sample at (x, y, z)
+func (s *Scalar3) At(x, y, z int) float64 {
+	return s.Data[(z*s.Height+y)*s.Width+x]
+}
+
+// Set stores the sample at (x, y, z)
+func (s *Scalar3) Set(x, y, z int, v float64) {
+	s.Data[(z*s.Height+y)*s.Width+x] = v
+}
+
+// Sample trilinearly interpolates the grid at p, given in grid coordinates.
+// Positions outside the grid are clamped to its edges
+func (s *Scalar3) Sample(p vector3.Float64) float64 {
+	x0, x1, tx := cell(p.X(), s.Width)
+	y0, y1, ty := cell(p.Y(), s.Height)
+	z0, z1, tz := cell(p.Z(), s.Depth)
+	plane := func(z int) float64 {
+		return lerp(
+			lerp(s.At(x0, y0, z), s.At(x1, y0, z), tx),
+			lerp(s.At(x0, y1, z), s.At(x1, y1, z), tx),
+			ty,
+		)
+	}
+	return lerp(plane(z0), plane(z1), tz)
+}
+
+// Gradient computes the gradient of the scalar field, the direction and rate
+// of its steepest increase at every sample
+func (s *Scalar3) Gradient() *Field3 {
+	out := NewField3(s.Width, s.Height, s.Depth, s.Spacing)
+	for z := 0; z < s.Depth; z++ {
+		for y := 0; y < s.Height; y++ {
+			for x := 0; x < s.Width; x++ {
+				xl, xh, dx := neighbors(x, s.Width, s.Spacing)
+				yl, yh, dy := neighbors(y, s.Height, s.Spacing)
+				zl, zh, dz := neighbors(z, s.Depth, s.Spacing)
+				out.Set(x, y, z, vector3.New(
+					(s.At(xh, y, z)-s.At(xl, y, z))/dx,
+					(s.At(x, yh, z)-s.At(x, yl, z))/dy,
+					(s.At(x, y, zh)-s.At(x, y, zl))/dz,
+				))
+			}
+		}
+	}
+	return out
+}
+
+// Field3 is a 3D vector quantity sampled on a 3D grid
+type Field3 struct {
+	Width, Height, Depth int
+
+	// Spacing is the world space distance between neighboring samples
+	Spacing float64
+
+	Data []vector3.Float64
+}
+
+// NewField3 creates a zeroed vector grid
+func NewField3(width, height, depth int, spacing float64) *Field3 {
+	return &Field3{Width: width, Height: height, Depth: depth, Spacing: spacing, Data: make([]vector3.Float64, width*height*depth)}
+}
+
+// At returns the sample at (x, y, z)
+func (f *Field3) At(x, y, z int) vector3.Float64 {
+	return f.Data[(z*f.Height+y)*f.Width+x]
+}
+
+// Set stores the sample at (x, y, z)
+func (f *Field3) Set(x, y, z int, v vector3.Float64) {
+	f.Data[(z*f.Height+y)*f.Width+x] = v
+}
+
+// Sample trilinearly interpolates the grid at p, given in grid coordinates.
+// Positions outside the grid are clamped to its edges
+func (f *Field3) Sample(p vector3.Float64) vector3.Float64 {
+	x0, x1, tx := cell(p.X(), f.Width)
+	y0, y1, ty := cell(p.Y(), f.Height)
+	z0, z1, tz := cell(p.Z(), f.Depth)
+	plane := func(z int) vector3.Float64 {
+		return vector3.Lerp(
+			vector3.Lerp(f.At(x0, y0, z), f.At(x1, y0, z), tx),
+			vector3.Lerp(f.At(x0, y1, z), f.At(x1, y1, z), tx),
+			ty,
+		)
+	}
+	return vector3.Lerp(plane(z0), plane(z1), tz)
+}
+
+// partials returns the partial derivatives of the field along each axis at
+// (x, y, z)
+func (f *Field3) partials(x, y, z int) (ddx, ddy, ddz vector3.Float64) {
+	xl, xh, dx := neighbors(x, f.Width, f.Spacing)
+	yl, yh, dy := neighbors(y, f.Height, f.Spacing)
+	zl, zh, dz := neighbors(z, f.Depth, f.Spacing)
+	ddx = f.At(xh, y, z).Sub(f.At(xl, y, z)).DivByConstant(dx)
+	ddy = f.At(x, yh, z).Sub(f.At(x, yl, z)).DivByConstant(dy)
+	ddz = f.At(x, y, zh).Sub(f.At(x, y, zl)).DivByConstant(dz)
+	return
+}
+
+// Divergence computes how much the field flows outwards from every sample
+func (f *Field3) Divergence() *Scalar3 {
+	out := NewScalar3(f.Width, f.Height, f.Depth, f.Spacing)
+	for z := 0; z < f.Depth; z++ {
+		for y := 0; y < f.Height; y++ {
+			for x := 0; x < f.Width; x++ {
+				ddx, ddy, ddz := f.partials(x, y, z)
+				out.Set(x, y, z, ddx.X()+ddy.Y()+ddz.Z())
+			}
+		}
+	}
+	return out
+}
+
+// Curl computes the curl of the field at every sample, the axis and rate of
+// its local rotation following the right hand rule
+func (f *Field3) Curl() *Field3 {
+	out := NewField3(f.Width, f.Height, f.Depth, f.Spacing)
+	for z := 0; z < f.Depth; z++ {
+		for y := 0; y < f.Height; y++ {
+			for x := 0; x < f.Width; x++ {
+				ddx, ddy, ddz := f.partials(x, y, z)
+				out.Set(x, y, z, vector3.New(
+					ddy.Z()-ddz.Y(),
+					ddz.X()-ddx.Z(),
+					ddx.Y()-ddy.X(),
+				))
+			}
+		}
+	}
+	return out
+}
@@ -0,0 +1,84 @@
+package vectorfield_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/EliCDavis/vector/vectorfield"
+	"github.com/EliCDavis/vector/vectortest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScalar2SampleAndGradient(t *testing.T) {
+	s := vectorfield.NewScalar2(4, 3, 0.5)
+	for y := 0; y < 3; y++ {
+		for x := 0; x < 4; x++ {
+			s.Set(x, y, 2*float64(x)+3*float64(y))
+		}
+	}
+
+	assert.InDelta(t, 2*1.5+3*0.25, s.Sample(vector2.New(1.5, 0.25)), 1e-12)
+	assert.InDelta(t, s.At(3, 2), s.Sample(vector2.New(10., 10.)), 1e-12)
+	assert.InDelta(t, s.At(0, 0), s.Sample(vector2.New(-1., -1.)), 1e-12)
+
+	grad := s.Gradient()
+	for _, v := range grad.Data {
+		vectortest.AssertVector2InDelta(t, vector2.New(4., 6.), v, 1e-12)
+	}
+}
+
+func TestField2DivergenceAndCurl(t *testing.T) {
+	expanding := vectorfield.NewField2(5, 5, 1)
+	rotating := vectorfield.NewField2(5, 5, 1)
+	for y := 0; y < 5; y++ {
+		for x := 0; x < 5; x++ {
+			p := vector2.New(float64(x-2), float64(y-2))
+			expanding.Set(x, y, p)
+			rotating.Set(x, y, vector2.New(-p.Y(), p.X()))
+		}
+	}
+
+	for _, d := range expanding.Divergence().Data {
+		assert.InDelta(t, 2., d, 1e-12)
+	}
+	for _, c := range expanding.Curl().Data {
+		assert.InDelta(t, 0., c, 1e-12)
+	}
+	for _, d := range rotating.Divergence().Data {
+		assert.InDelta(t, 0., d, 1e-12)
+	}
+	for _, c := range rotating.Curl().Data {
+		assert.InDelta(t, 2., c, 1e-12)
+	}
+
+	vectortest.AssertVector2InDelta(t, vector2.New(-0.5, -1.25), rotating.Sample(vector2.New(0.75, 2.5)), 1e-12)
+}
+
+func TestField3(t *testing.T) {
+	f := vectorfield.NewField3(4, 4, 4, 0.25)
+	s := vectorfield.NewScalar3(4, 4, 4, 0.25)
+	for z := 0; z < 4; z++ {
+		for y := 0; y < 4; y++ {
+			for x := 0; x < 4; x++ {
+				p := vector3.New(float64(x), float64(y), float64(z)).Scale(0.25)
+				// Rotation about the z axis plus expansion along it
+				f.Set(x, y, z, vector3.New(-p.Y(), p.X(), p.Z()))
+				s.Set(x, y, z, p.X()-2*p.Y()+3*p.Z())
+			}
+		}
+	}
+
+	for _, d := range f.Divergence().Data {
+		assert.InDelta(t, 1., d, 1e-12)
+	}
+	for _, c := range f.Curl().Data {
+		vectortest.AssertVector3InDelta(t, vector3.New(0., 0., 2.), c, 1e-12)
+	}
+	for _, g := range s.Gradient().Data {
+		vectortest.AssertVector3InDelta(t, vector3.New(1., -2., 3.), g, 1e-12)
+	}
+
+	vectortest.AssertVector3InDelta(t, vector3.New(-0.5, 0.25, 0.625), f.Sample(vector3.New(1., 2., 2.5)), 1e-12)
+	assert.InDelta(t, 0.25-1+1.875, s.Sample(vector3.New(1., 2., 2.5)), 1e-12)
+}
@@ -0,0 +1,155 @@
+// Package vectorgonum provides conversions between the vector types and
+// gonum's matrix types, so that statistical and linear algebra workflows can
+// operate on vectors without manual copies.
+package vectorgonum
+
+import (
+	"fmt"
+
+	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/EliCDavis/vector/vector4"
+	"gonum.org/v1/gonum/mat"
+)
+
+// Vector2ToVecDense converts the vector into a gonum column vector of length 2
+func Vector2ToVecDense[T vector.Number](v vector2.Vector[T]) *mat.VecDense {
+	return mat.NewVecDense(2, []float64{float64(v.X()), float64(v.Y())})
+}
+
+// Vector2FromVecDense converts a gonum vector of length 2 into a vector
+func Vector2FromVecDense[T vector.Number](v mat.Vector) (vector2.Vector[T], error) {
+	if v.Len() != 2 {
+		return vector2.Vector[T]{}, fmt.Errorf("expected vector of length 2, got %d", v.Len())
+	}
+	return vector2.New(T(v.AtVec(0)), T(v.AtVec(1))), nil
+}
+
+// Vector2ArrayToDense converts the vectors into an N×2 matrix, one vector
+// per row. An empty slice results in an empty matrix
+func Vector2ArrayToDense[T vector.Number](vectors []vector2.Vector[T]) *mat.Dense {
+	if len(vectors) == 0 {
+		return &mat.Dense{}
+	}
+
+	data := make([]float64, len(vectors)*2)
+	for i, v := range vectors {
+		data[i*2+0] = float64(v.X())
+		data[i*2+1] = float64(v.Y())
+	}
+	return mat.NewDense(len(vectors), 2, data)
+}
+
+// Vector2ArrayFromDense converts an N×2 matrix into vectors, one per row
+func Vector2ArrayFromDense[T vector.Number](m mat.Matrix) ([]vector2.Vector[T], error) {
+	if dense, ok := m.(*mat.Dense); ok && dense.IsEmpty() {
+		return nil, nil
+	}
+
+	rows, cols := m.Dims()
+	if cols != 2 {
+		return nil, fmt.Errorf("expected matrix with 2 columns, got %d", cols)
+	}
+
+	vectors := make([]vector2.Vector[T], rows)
+	for i := range vectors {
+		vectors[i] = vector2.New(T(m.At(i, 0)), T(m.At(i, 1)))
+	}
+	return vectors, nil
+}
+
+// Vector3ToVecDense converts the vector into a gonum column vector of length 3
+func Vector3ToVecDense[T vector.Number](v vector3.Vector[T]) *mat.VecDense {
+	return mat.NewVecDense(3, []float64{float64(v.X()), float64(v.Y()), float64(v.Z())})
+}
+
+// Vector3FromVecDense converts a gonum vector of length 3 into a vector
+func Vector3FromVecDense[T vector.Number](v mat.Vector) (vector3.Vector[T], error) {
+	if v.Len() != 3 {
+		return vector3.Vector[T]{}, fmt.Errorf("expected vector of length 3, got %d", v.Len())
+	}
+	return vector3.New(T(v.AtVec(0)), T(v.AtVec(1)), T(v.AtVec(2))), nil
+}
+
+// Vector3ArrayToDense converts the vectors into an N×3 matrix, one vector
+// per row. An empty slice results in an empty matrix
+func Vector3ArrayToDense[T vector.Number](vectors []vector3.Vector[T]) *mat.Dense {
+	if len(vectors) == 0 {
+		return &mat.Dense{}
+	}
+
+	data := make([]float64, len(vectors)*3)
+	for i, v := range vectors {
+		data[i*3+0] = float64(v.X())
+		data[i*3+1] = float64(v.Y())
+		data[i*3+2] = float64(v.Z())
+	}
+	return mat.NewDense(len(vectors), 3, data)
+}
+
+// Vector3ArrayFromDense converts an N×3 matrix into vectors, one per row
+func Vector3ArrayFromDense[T vector.Number](m mat.Matrix) ([]vector3.Vector[T], error) {
+	if dense, ok := m.(*mat.Dense); ok && dense.IsEmpty() {
+		return nil, nil
+	}
+
+	rows, cols := m.Dims()
+	if cols != 3 {
+		return nil, fmt.Errorf("expected matrix with 3 columns, got %d", cols)
+	}
+
+	vectors := make([]vector3.Vector[T], rows)
+	for i := range vectors {
+		vectors[i] = vector3.New(T(m.At(i, 0)), T(m.At(i, 1)), T(m.At(i, 2)))
+	}
+	return vectors, nil
+}
+
+// Vector4ToVecDense converts the vector into a gonum column vector of length 4
+func Vector4ToVecDense[T vector.Number](v vector4.Vector[T]) *mat.VecDense {
+	return mat.NewVecDense(4, []float64{float64(v.X()), float64(v.Y()), float64(v.Z()), float64(v.W())})
+}
+
+// Vector4FromVecDense converts a gonum vector of length 4 into a vector
+func Vector4FromVecDense[T vector.Number](v mat.Vector) (vector4.Vector[T], error) {
+	if v.Len() != 4 {
+		return vector4.Vector[T]{}, fmt.Errorf("expected vector of length 4, got %d", v.Len())
+	}
+	return vector4.New(T(v.AtVec(0)), T(v.AtVec(1)), T(v.AtVec(2)), T(v.AtVec(3))), nil
+}
+
+// Vector4ArrayToDense converts the vectors into an N×4 matrix, one vector
+// per row. An empty slice results in an empty matrix
+func Vector4ArrayToDense[T vector.Number](vectors []vector4.Vector[T]) *mat.Dense {
+	if len(vectors) == 0 {
+		return &mat.Dense{}
+	}
+
+	data := make([]float64, len(vectors)*4)
+	for i, v := range vectors {
+		data[i*4+0] = float64(v.X())
+		data[i*4+1] = float64(v.Y())
+		data[i*4+2] = float64(v.Z())
+		data[i*4+3] = float64(v.W())
+	}
+	return mat.NewDense(len(vectors), 4, data)
+}
+
+// Vector4ArrayFromDense converts an N×4 matrix into vectors, one per row
+func Vector4ArrayFromDense[T vector.Number](m mat.Matrix) ([]vector4.Vector[T], error) {
+	if dense, ok := m.(*mat.Dense); ok && dense.IsEmpty() {
+		return nil, nil
+	}
+
+	rows, cols := m.Dims()
+	if cols != 4 {
+		return nil, fmt.Errorf("expected matrix with 4 columns, got %d", cols)
+	}
+
+	vectors := make([]vector4.Vector[T], rows)
+	for i := range vectors {
+		vectors[i] = vector4.New(T(m.At(i, 0)), T(m.At(i, 1)), T(m.At(i, 2)), T(m.At(i, 3)))
+	}
+	return vectors, nil
+}
@@ -0,0 +1,97 @@
+package vectorgonum_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/EliCDavis/vector/vector4"
+	"github.com/EliCDavis/vector/vectorgonum"
+	"github.com/stretchr/testify/assert"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat"
+)
+
+func TestVector2VecDense(t *testing.T) {
+	in := vector2.New(1.5, -2.25)
+
+	dense := vectorgonum.Vector2ToVecDense(in)
+	assert.Equal(t, []float64{1.5, -2.25}, dense.RawVector().Data)
+
+	back, err := vectorgonum.Vector2FromVecDense[float64](dense)
+	assert.NoError(t, err)
+	assert.Equal(t, in, back)
+
+	_, err = vectorgonum.Vector2FromVecDense[float64](mat.NewVecDense(3, nil))
+	assert.EqualError(t, err, "expected vector of length 2, got 3")
+}
+
+func TestVector3VecDense(t *testing.T) {
+	in := vector3.New(1.5, -2.25, 3.)
+
+	dense := vectorgonum.Vector3ToVecDense(in)
+	assert.Equal(t, []float64{1.5, -2.25, 3}, dense.RawVector().Data)
+
+	back, err := vectorgonum.Vector3FromVecDense[float64](dense)
+	assert.NoError(t, err)
+	assert.Equal(t, in, back)
+
+	// Any mat.Vector is accepted, such as a column of a matrix
+	m := mat.NewDense(3, 2, []float64{1, 4, 2, 5, 3, 6})
+	col, err := vectorgonum.Vector3FromVecDense[int](m.ColView(1))
+	assert.NoError(t, err)
+	assert.Equal(t, vector3.New(4, 5, 6), col)
+}
+
+func TestVector4VecDense(t *testing.T) {
+	in := vector4.New(1.5, -2.25, 3., 4.)
+
+	back, err := vectorgonum.Vector4FromVecDense[float64](vectorgonum.Vector4ToVecDense(in))
+	assert.NoError(t, err)
+	assert.Equal(t, in, back)
+}
+
+func TestVector3ArrayDense(t *testing.T) {
+	in := []vector3.Float64{
+		vector3.New(1., 2., 3.),
+		vector3.New(4., 5., 6.),
+	}
+
+	dense := vectorgonum.Vector3ArrayToDense(in)
+	rows, cols := dense.Dims()
+	assert.Equal(t, 2, rows)
+	assert.Equal(t, 3, cols)
+	assert.Equal(t, []float64{1, 2, 3, 4, 5, 6}, dense.RawMatrix().Data)
+
+	// Column means match the centroid of the points
+	assert.Equal(t, 2.5, stat.Mean(mat.Col(nil, 0, dense), nil))
+
+	back, err := vectorgonum.Vector3ArrayFromDense[float64](dense)
+	assert.NoError(t, err)
+	assert.Equal(t, in, back)
+
+	back, err = vectorgonum.Vector3ArrayFromDense[float64](dense.T())
+	assert.EqualError(t, err, "expected matrix with 3 columns, got 2")
+	assert.Nil(t, back)
+}
+
+func TestArrayDenseEmpty(t *testing.T) {
+	dense := vectorgonum.Vector3ArrayToDense[float64](nil)
+	assert.True(t, dense.IsEmpty())
+
+	back, err := vectorgonum.Vector3ArrayFromDense[float64](dense)
+	assert.NoError(t, err)
+	assert.Empty(t, back)
+}
+
+func TestVector2And4ArrayDense(t *testing.T) {
+	in2 := []vector2.Float64{vector2.New(1., 2.), vector2.New(3., 4.)}
+	back2, err := vectorgonum.Vector2ArrayFromDense[float64](vectorgonum.Vector2ArrayToDense(in2))
+	assert.NoError(t, err)
+	assert.Equal(t, in2, back2)
+
+	in4 := []vector4.Float64{vector4.New(1., 2., 3., 4.)}
+	back4, err := vectorgonum.Vector4ArrayFromDense[float64](vectorgonum.Vector4ArrayToDense(in4))
+	assert.NoError(t, err)
+	assert.Equal(t, in4, back4)
+}
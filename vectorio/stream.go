@@ -0,0 +1,166 @@
+package vectorio
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+
+	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/internal/codec"
+	"github.com/EliCDavis/vector/vector3"
+)
+
+// Vector3Writer streams vectors to an underlying writer as raw binary
+// components, buffering them into chunks so large datasets can be written
+// without holding an encoded copy of the entire dataset in memory. Flush must
+// be called once all vectors have been written.
+type Vector3Writer[T vector.Number] struct {
+	w     io.Writer
+	order binary.AppendByteOrder
+	buf   []byte
+	err   error
+}
+
+// NewVector3Writer creates a writer that encodes each component of the
+// vectors written to it with the provided byte order
+func NewVector3Writer[T vector.Number](w io.Writer, order binary.AppendByteOrder) *Vector3Writer[T] {
+	return &Vector3Writer[T]{
+		w:     w,
+		order: order,
+		buf:   make([]byte, 0, vertexChunkSize),
+	}
+}
+
+// Write buffers the vectors, handing full chunks to the underlying writer as
+// they fill up. Once an error has occurred, every subsequent call returns it
+func (vw *Vector3Writer[T]) Write(vectors ...vector3.Vector[T]) error {
+	if vw.err != nil {
+		return vw.err
+	}
+
+	size := 3 * vector.ComponentTypeOf[T]().Size()
+	for _, v := range vectors {
+		if len(vw.buf)+size > cap(vw.buf) {
+			if err := vw.Flush(); err != nil {
+				return err
+			}
+		}
+		vw.buf = codec.AppendBinaryOrder(vw.buf, vw.order, v.X(), v.Y(), v.Z())
+	}
+	return nil
+}
+
+// Flush writes any buffered vectors to the underlying writer
+func (vw *Vector3Writer[T]) Flush() error {
+	if vw.err != nil {
+		return vw.err
+	}
+	if len(vw.buf) == 0 {
+		return nil
+	}
+	_, vw.err = vw.w.Write(vw.buf)
+	vw.buf = vw.buf[:0]
+	return vw.err
+}
+
+// Vector3Reader lazily decodes vectors written by a Vector3Writer, reading
+// the underlying stream in chunks so that only a small window of the dataset
+// is ever held in memory.
+//
+// Iterate over the stream in the same manner as a bufio.Scanner:
+//
+//	reader := vectorio.NewVector3Reader[float32](r, binary.LittleEndian)
+//	for reader.Next() {
+//		v := reader.Vector()
+//	}
+//	if err := reader.Err(); err != nil {
+//		...
+//	}
+type Vector3Reader[T vector.Number] struct {
+	r     *bufio.Reader
+	order binary.ByteOrder
+	data  []byte
+	cur   vector3.Vector[T]
+	err   error
+}
+
+// NewVector3Reader creates a reader that decodes each component of the
+// vectors read from r with the provided byte order
+func NewVector3Reader[T vector.Number](r io.Reader, order binary.ByteOrder) *Vector3Reader[T] {
+	return &Vector3Reader[T]{
+		r:     bufio.NewReaderSize(r, vertexChunkSize),
+		order: order,
+		data:  make([]byte, 3*vector.ComponentTypeOf[T]().Size()),
+	}
+}
+
+// Next advances the reader to the next vector, which is then available
+// through Vector. It returns false once the stream is exhausted or an error
+// has occurred
+func (vr *Vector3Reader[T]) Next() bool {
+	if vr.err != nil {
+		return false
+	}
+
+	if _, err := io.ReadFull(vr.r, vr.data); err != nil {
+		vr.err = err
+		return false
+	}
+
+	var components [3]T
+	codec.DecodeBinaryOrder(vr.data, vr.order, components[:])
+	vr.cur = vector3.New(components[0], components[1], components[2])
+	return true
+}
+
+// Vector returns the vector most recently read by Next
+func (vr *Vector3Reader[T]) Vector() vector3.Vector[T] {
+	return vr.cur
+}
+
+// Read decodes up to len(dst) vectors into dst, returning how many were
+// read. io.EOF is returned once the stream is exhausted
+func (vr *Vector3Reader[T]) Read(dst []vector3.Vector[T]) (int, error) {
+	for i := range dst {
+		if !vr.Next() {
+			if i > 0 && vr.err == io.EOF {
+				return i, nil
+			}
+			return i, vr.err
+		}
+		dst[i] = vr.cur
+	}
+	return len(dst), nil
+}
+
+// Err returns the first error encountered while reading, if any. Reaching
+// the end of the stream is not considered an error, while a stream ending
+// partway through a vector results in io.ErrUnexpectedEOF
+func (vr *Vector3Reader[T]) Err() error {
+	if errors.Is(vr.err, io.EOF) {
+		return nil
+	}
+	return vr.err
+}
+
+// WriteVector3 writes all vectors to w as raw binary components in the
+// provided byte order
+func WriteVector3[T vector.Number](w io.Writer, order binary.AppendByteOrder, vectors []vector3.Vector[T]) error {
+	vw := NewVector3Writer[T](w, order)
+	if err := vw.Write(vectors...); err != nil {
+		return err
+	}
+	return vw.Flush()
+}
+
+// ReadVector3 reads every vector from r that was written with WriteVector3
+// or a Vector3Writer
+func ReadVector3[T vector.Number](r io.Reader, order binary.ByteOrder) ([]vector3.Vector[T], error) {
+	vr := NewVector3Reader[T](r, order)
+	var out []vector3.Vector[T]
+	for vr.Next() {
+		out = append(out, vr.Vector())
+	}
+	return out, vr.Err()
+}
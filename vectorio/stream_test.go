@@ -0,0 +1,74 @@
+package vectorio_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"testing"
+
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/EliCDavis/vector/vectorio"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVector3StreamRoundTrip(t *testing.T) {
+	vectors := make([]vector3.Float32, 10000)
+	for i := range vectors {
+		vectors[i] = vector3.New(float32(i), float32(-i), float32(i)/3)
+	}
+
+	for name, order := range map[string]binary.ByteOrder{
+		"little": binary.LittleEndian,
+		"big":    binary.BigEndian,
+	} {
+		t.Run(name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			require.NoError(t, vectorio.WriteVector3(buf, order.(binary.AppendByteOrder), vectors))
+			assert.Equal(t, len(vectors)*12, buf.Len())
+
+			back, err := vectorio.ReadVector3[float32](buf, order)
+			require.NoError(t, err)
+			assert.Equal(t, vectors, back)
+		})
+	}
+}
+
+func TestVector3StreamByteOrder(t *testing.T) {
+	buf := &bytes.Buffer{}
+	require.NoError(t, vectorio.WriteVector3(buf, binary.BigEndian, []vector3.Vector[int16]{vector3.New[int16](1, 2, 3)}))
+	assert.Equal(t, []byte{0, 1, 0, 2, 0, 3}, buf.Bytes())
+}
+
+func TestVector3ReaderChunks(t *testing.T) {
+	buf := &bytes.Buffer{}
+	w := vectorio.NewVector3Writer[float64](buf, binary.LittleEndian)
+	for i := 0; i < 5; i++ {
+		require.NoError(t, w.Write(vector3.Fill(float64(i))))
+	}
+	require.NoError(t, w.Flush())
+
+	r := vectorio.NewVector3Reader[float64](buf, binary.LittleEndian)
+	dst := make([]vector3.Float64, 3)
+
+	n, err := r.Read(dst)
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+	assert.Equal(t, vector3.Fill(2.), dst[2])
+
+	n, err = r.Read(dst)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, vector3.Fill(4.), dst[1])
+
+	n, err = r.Read(dst)
+	assert.Equal(t, 0, n)
+	assert.ErrorIs(t, err, io.EOF)
+}
+
+func TestVector3ReaderTruncated(t *testing.T) {
+	r := vectorio.NewVector3Reader[float32](bytes.NewReader(make([]byte, 14)), binary.LittleEndian)
+	assert.True(t, r.Next())
+	assert.False(t, r.Next())
+	assert.ErrorIs(t, r.Err(), io.ErrUnexpectedEOF)
+}
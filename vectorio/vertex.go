@@ -0,0 +1,209 @@
+// Package vectorio reads and writes vector data to and from streams.
+package vectorio
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/internal/codec"
+	"github.com/EliCDavis/vector/mathex"
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/EliCDavis/vector/vector4"
+)
+
+// Attribute is a single per-vertex attribute of an interleaved vertex stream,
+// such as position or normal, backed by a slice of vectors. Create one with
+// Vector2Attribute, Vector3Attribute, or Vector4Attribute.
+type Attribute interface {
+	// Size is the number of bytes the attribute occupies in each vertex
+	Size() int
+
+	// Len is the number of vertices in the attribute's slice
+	Len() int
+
+	appendVertex(dst []byte, i int) []byte
+	readVertex(src []byte)
+	reset()
+}
+
+type vector2Attribute[T vector.Number] struct {
+	data *[]vector2.Vector[T]
+}
+
+// Vector2Attribute creates an attribute backed by data. When writing, the
+// slice is read from. When reading, the slice is replaced by the vectors read
+func Vector2Attribute[T vector.Number](data *[]vector2.Vector[T]) Attribute {
+	return vector2Attribute[T]{data: data}
+}
+
+func (a vector2Attribute[T]) Size() int {
+	return 2 * vector.ComponentTypeOf[T]().Size()
+}
+
+func (a vector2Attribute[T]) Len() int {
+	return len(*a.data)
+}
+
+func (a vector2Attribute[T]) appendVertex(dst []byte, i int) []byte {
+	v := (*a.data)[i]
+	return codec.AppendBinary(dst, v.X(), v.Y())
+}
+
+func (a vector2Attribute[T]) readVertex(src []byte) {
+	var components [2]T
+	codec.DecodeBinary(src, components[:])
+	*a.data = append(*a.data, vector2.New(components[0], components[1]))
+}
+
+func (a vector2Attribute[T]) reset() {
+	*a.data = (*a.data)[:0]
+}
+
+type vector3Attribute[T vector.Number] struct {
+	data *[]vector3.Vector[T]
+}
+
+// Vector3Attribute creates an attribute backed by data. When writing, the
+// slice is read from. When reading, the slice is replaced by the vectors read
+func Vector3Attribute[T vector.Number](data *[]vector3.Vector[T]) Attribute {
+	return vector3Attribute[T]{data: data}
+}
+
+func (a vector3Attribute[T]) Size() int {
+	return 3 * vector.ComponentTypeOf[T]().Size()
+}
+
+func (a vector3Attribute[T]) Len() int {
+	return len(*a.data)
+}
+
+func (a vector3Attribute[T]) appendVertex(dst []byte, i int) []byte {
+	v := (*a.data)[i]
+	return codec.AppendBinary(dst, v.X(), v.Y(), v.Z())
+}
+
+func (a vector3Attribute[T]) readVertex(src []byte) {
+	var components [3]T
+	codec.DecodeBinary(src, components[:])
+	*a.data = append(*a.data, vector3.New(components[0], components[1], components[2]))
+}
+
+func (a vector3Attribute[T]) reset() {
+	*a.data = (*a.data)[:0]
+}
+
+type vector4Attribute[T vector.Number] struct {
+	data *[]vector4.Vector[T]
+}
+
+// Vector4Attribute creates an attribute backed by data. When writing, the
+// slice is read from. When reading, the slice is replaced by the vectors read
+func Vector4Attribute[T vector.Number](data *[]vector4.Vector[T]) Attribute {
+	return vector4Attribute[T]{data: data}
+}
+
+func (a vector4Attribute[T]) Size() int {
+	return 4 * vector.ComponentTypeOf[T]().Size()
+}
+
+func (a vector4Attribute[T]) Len() int {
+	return len(*a.data)
+}
+
+func (a vector4Attribute[T]) appendVertex(dst []byte, i int) []byte {
+	v := (*a.data)[i]
+	return codec.AppendBinary(dst, v.X(), v.Y(), v.Z(), v.W())
+}
+
+func (a vector4Attribute[T]) readVertex(src []byte) {
+	var components [4]T
+	codec.DecodeBinary(src, components[:])
+	*a.data = append(*a.data, vector4.New(components[0], components[1], components[2], components[3]))
+}
+
+func (a vector4Attribute[T]) reset() {
+	*a.data = (*a.data)[:0]
+}
+
+// Layout returns the number of bytes each vertex occupies and the byte offset
+// of each attribute within a vertex, as needed when describing the buffer to
+// a graphics API
+func Layout(attributes ...Attribute) (stride int, offsets []int) {
+	offsets = make([]int, len(attributes))
+	for i, a := range attributes {
+		offsets[i] = stride
+		stride += a.Size()
+	}
+	return stride, offsets
+}
+
+// vertexChunkSize is roughly how many bytes are buffered before being handed
+// to the underlying writer
+const vertexChunkSize = 32 * 1024
+
+// WriteVertices writes the attributes to w interleaved, one vertex after the
+// other, with each attribute's components in little-endian byte order. All
+// attributes must have the same length
+func WriteVertices(w io.Writer, attributes ...Attribute) error {
+	if len(attributes) == 0 {
+		return nil
+	}
+
+	count := attributes[0].Len()
+	for i, a := range attributes {
+		if a.Len() != count {
+			return fmt.Errorf("attribute %d has %d vertices, expected %d", i, a.Len(), count)
+		}
+	}
+
+	stride, _ := Layout(attributes...)
+	buf := make([]byte, 0, mathex.Max(vertexChunkSize, stride))
+	for i := 0; i < count; i++ {
+		if len(buf)+stride > cap(buf) {
+			if _, err := w.Write(buf); err != nil {
+				return err
+			}
+			buf = buf[:0]
+		}
+		for _, a := range attributes {
+			buf = a.appendVertex(buf, i)
+		}
+	}
+
+	_, err := w.Write(buf)
+	return err
+}
+
+// ReadVertices reads interleaved vertices written by WriteVertices from r
+// until EOF, replacing the contents of each attribute's slice. The attributes
+// must be declared in the same order and with the same component types they
+// were written with.
+func ReadVertices(r io.Reader, attributes ...Attribute) error {
+	if len(attributes) == 0 {
+		return nil
+	}
+
+	for _, a := range attributes {
+		a.reset()
+	}
+
+	stride, offsets := Layout(attributes...)
+	reader := bufio.NewReaderSize(r, vertexChunkSize)
+	vertex := make([]byte, stride)
+	for {
+		if _, err := io.ReadFull(reader, vertex); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+
+		for i, a := range attributes {
+			a.readVertex(vertex[offsets[i]:])
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package vectorio_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"testing"
+
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/EliCDavis/vector/vector4"
+	"github.com/EliCDavis/vector/vectorio"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLayout(t *testing.T) {
+	var positions, normals []vector3.Float32
+	var uvs []vector2.Float32
+	var colors []vector4.Vector[int8]
+
+	stride, offsets := vectorio.Layout(
+		vectorio.Vector3Attribute(&positions),
+		vectorio.Vector3Attribute(&normals),
+		vectorio.Vector2Attribute(&uvs),
+		vectorio.Vector4Attribute(&colors),
+	)
+	assert.Equal(t, 36, stride)
+	assert.Equal(t, []int{0, 12, 24, 32}, offsets)
+}
+
+func TestVertexRoundTrip(t *testing.T) {
+	positions := []vector3.Float32{vector3.New[float32](1, 2, 3), vector3.New[float32](4, 5, 6)}
+	normals := []vector3.Float32{vector3.Up[float32](), vector3.Forward[float32]()}
+	uvs := []vector2.Float32{vector2.New[float32](0, 1), vector2.New[float32](0.5, 0.25)}
+	colors := []vector4.Float64{vector4.One[float64](), vector4.Zero[float64]()}
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, vectorio.WriteVertices(
+		buf,
+		vectorio.Vector3Attribute(&positions),
+		vectorio.Vector3Attribute(&normals),
+		vectorio.Vector2Attribute(&uvs),
+		vectorio.Vector4Attribute(&colors),
+	))
+	assert.Equal(t, 2*(12+12+8+32), buf.Len())
+
+	// The first vertex's normal directly follows its position
+	assert.Equal(t, float32(1), bytesToFloat32(buf.Bytes()[16:]))
+
+	var backPositions, backNormals []vector3.Float32
+	var backUVs []vector2.Float32
+	backColors := []vector4.Float64{vector4.Fill(9.)}
+	require.NoError(t, vectorio.ReadVertices(
+		buf,
+		vectorio.Vector3Attribute(&backPositions),
+		vectorio.Vector3Attribute(&backNormals),
+		vectorio.Vector2Attribute(&backUVs),
+		vectorio.Vector4Attribute(&backColors),
+	))
+	assert.Equal(t, positions, backPositions)
+	assert.Equal(t, normals, backNormals)
+	assert.Equal(t, uvs, backUVs)
+	assert.Equal(t, colors, backColors)
+}
+
+func TestVertexLargeStream(t *testing.T) {
+	positions := make([]vector3.Float64, 10000)
+	for i := range positions {
+		positions[i] = vector3.Fill(float64(i))
+	}
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, vectorio.WriteVertices(buf, vectorio.Vector3Attribute(&positions)))
+
+	var back []vector3.Float64
+	require.NoError(t, vectorio.ReadVertices(buf, vectorio.Vector3Attribute(&back)))
+	assert.Equal(t, positions, back)
+}
+
+func TestWriteVerticesMismatchedLengths(t *testing.T) {
+	positions := []vector3.Float32{vector3.Zero[float32](), vector3.Zero[float32]()}
+	uvs := []vector2.Float32{vector2.Zero[float32]()}
+
+	err := vectorio.WriteVertices(io.Discard, vectorio.Vector3Attribute(&positions), vectorio.Vector2Attribute(&uvs))
+	assert.EqualError(t, err, "attribute 1 has 1 vertices, expected 2")
+}
+
+func TestReadVerticesTruncated(t *testing.T) {
+	var positions []vector3.Float32
+	err := vectorio.ReadVertices(bytes.NewReader(make([]byte, 20)), vectorio.Vector3Attribute(&positions))
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
+
+func bytesToFloat32(b []byte) float32 {
+	var f float32
+	binary.Read(bytes.NewReader(b), binary.LittleEndian, &f)
+	return f
+}
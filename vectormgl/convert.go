@@ -0,0 +1,78 @@
+// Package vectormgl converts between the vector types and the Vec2, Vec3 and
+// Vec4 types of go-gl/mathgl, so OpenGL codebases can adopt this package
+// incrementally.
+//
+// mathgl's vectors are defined as arrays, for example mgl32.Vec3 is a
+// [3]float32, so they are directly assignable to and from the array types used
+// here and this package doesn't need to depend on mathgl itself:
+//
+//	var v mgl32.Vec3 = vectormgl.Vector3ToMgl32(position)
+//	position = vectormgl.Vector3FromMgl32[float64](v)
+package vectormgl
+
+import (
+	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/EliCDavis/vector/vector4"
+)
+
+// Vector2ToMgl32 converts the vector into an mgl32.Vec2
+func Vector2ToMgl32[T vector.Number](v vector2.Vector[T]) [2]float32 {
+	return [2]float32{float32(v.X()), float32(v.Y())}
+}
+
+// Vector2FromMgl32 converts an mgl32.Vec2 into a vector
+func Vector2FromMgl32[T vector.Number](v [2]float32) vector2.Vector[T] {
+	return vector2.New(T(v[0]), T(v[1]))
+}
+
+// Vector2ToMgl64 converts the vector into an mgl64.Vec2
+func Vector2ToMgl64[T vector.Number](v vector2.Vector[T]) [2]float64 {
+	return [2]float64{float64(v.X()), float64(v.Y())}
+}
+
+// Vector2FromMgl64 converts an mgl64.Vec2 into a vector
+func Vector2FromMgl64[T vector.Number](v [2]float64) vector2.Vector[T] {
+	return vector2.New(T(v[0]), T(v[1]))
+}
+
+// Vector3ToMgl32 converts the vector into an mgl32.Vec3
+func Vector3ToMgl32[T vector.Number](v vector3.Vector[T]) [3]float32 {
+	return [3]float32{float32(v.X()), float32(v.Y()), float32(v.Z())}
+}
+
+// Vector3FromMgl32 converts an mgl32.Vec3 into a vector
+func Vector3FromMgl32[T vector.Number](v [3]float32) vector3.Vector[T] {
+	return vector3.New(T(v[0]), T(v[1]), T(v[2]))
+}
+
+// Vector3ToMgl64 converts the vector into an mgl64.Vec3
+func Vector3ToMgl64[T vector.Number](v vector3.Vector[T]) [3]float64 {
+	return [3]float64{float64(v.X()), float64(v.Y()), float64(v.Z())}
+}
+
+// Vector3FromMgl64 converts an mgl64.Vec3 into a vector
+func Vector3FromMgl64[T vector.Number](v [3]float64) vector3.Vector[T] {
+	return vector3.New(T(v[0]), T(v[1]), T(v[2]))
+}
+
+// Vector4ToMgl32 converts the vector into an mgl32.Vec4
+func Vector4ToMgl32[T vector.Number](v vector4.Vector[T]) [4]float32 {
+	return [4]float32{float32(v.X()), float32(v.Y()), float32(v.Z()), float32(v.W())}
+}
+
+// Vector4FromMgl32 converts an mgl32.Vec4 into a vector
+func Vector4FromMgl32[T vector.Number](v [4]float32) vector4.Vector[T] {
+	return vector4.New(T(v[0]), T(v[1]), T(v[2]), T(v[3]))
+}
+
+// Vector4ToMgl64 converts the vector into an mgl64.Vec4
+func Vector4ToMgl64[T vector.Number](v vector4.Vector[T]) [4]float64 {
+	return [4]float64{float64(v.X()), float64(v.Y()), float64(v.Z()), float64(v.W())}
+}
+
+// Vector4FromMgl64 converts an mgl64.Vec4 into a vector
+func Vector4FromMgl64[T vector.Number](v [4]float64) vector4.Vector[T] {
+	return vector4.New(T(v[0]), T(v[1]), T(v[2]), T(v[3]))
+}
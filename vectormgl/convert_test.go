@@ -0,0 +1,49 @@
+package vectormgl_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/EliCDavis/vector/vector4"
+	"github.com/EliCDavis/vector/vectormgl"
+	"github.com/stretchr/testify/assert"
+)
+
+// Mirror mathgl's definitions to verify assignability without depending on it
+type (
+	mgl32Vec2 [2]float32
+	mgl32Vec3 [3]float32
+	mgl64Vec3 [3]float64
+	mgl64Vec4 [4]float64
+)
+
+func TestVector2(t *testing.T) {
+	in := vector2.New(1.5, -2.25)
+
+	var v mgl32Vec2 = vectormgl.Vector2ToMgl32(in)
+	assert.Equal(t, mgl32Vec2{1.5, -2.25}, v)
+	assert.Equal(t, in, vectormgl.Vector2FromMgl32[float64](v))
+	assert.Equal(t, in, vectormgl.Vector2FromMgl64[float64](vectormgl.Vector2ToMgl64(in)))
+}
+
+func TestVector3(t *testing.T) {
+	in := vector3.New(1.5, -2.25, 3.)
+
+	var v32 mgl32Vec3 = vectormgl.Vector3ToMgl32(in)
+	assert.Equal(t, mgl32Vec3{1.5, -2.25, 3}, v32)
+	assert.Equal(t, in.ToFloat32(), vectormgl.Vector3FromMgl32[float32](v32))
+
+	var v64 mgl64Vec3 = vectormgl.Vector3ToMgl64(in)
+	assert.Equal(t, in, vectormgl.Vector3FromMgl64[float64](v64))
+	assert.Equal(t, vector3.New(1, -2, 3), vectormgl.Vector3FromMgl64[int](v64))
+}
+
+func TestVector4(t *testing.T) {
+	in := vector4.New(1.5, -2.25, 3., 4.)
+
+	var v mgl64Vec4 = vectormgl.Vector4ToMgl64(in)
+	assert.Equal(t, mgl64Vec4{1.5, -2.25, 3, 4}, v)
+	assert.Equal(t, in, vectormgl.Vector4FromMgl64[float64](v))
+	assert.Equal(t, in, vectormgl.Vector4FromMgl32[float64](vectormgl.Vector4ToMgl32(in)))
+}
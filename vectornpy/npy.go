@@ -0,0 +1,291 @@
+// Package vectornpy reads and writes NumPy .npy files holding arrays of
+// vectors, so point data can be exchanged with Python pipelines directly.
+//
+// A file of N vectors with C components stores a two dimensional array of
+// shape (N, C). float32 and float64 arrays in either byte order and in C or
+// Fortran order can be read, converting to the requested component type.
+// Files are written in C order as little-endian float32 or float64, matching
+// the component type:
+//
+//	np.save("points.npy", points)       # points.shape == (n, 3)
+//	points, err := vectornpy.ReadVector3[float64](f)
+package vectornpy
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/EliCDavis/vector/vector4"
+)
+
+var magic = []byte("\x93NUMPY")
+
+// WriteVector2 writes the vectors as an array of shape (N, 2)
+func WriteVector2[T vector.Float](w io.Writer, vectors []vector2.Vector[T]) error {
+	values := make([]T, 0, len(vectors)*2)
+	for _, v := range vectors {
+		values = append(values, v.X(), v.Y())
+	}
+	return write(w, values, 2)
+}
+
+// ReadVector2 reads an array of shape (N, 2) into vectors
+func ReadVector2[T vector.Float](r io.Reader) ([]vector2.Vector[T], error) {
+	values, err := read[T](r, 2)
+	if err != nil {
+		return nil, err
+	}
+	vectors := make([]vector2.Vector[T], len(values)/2)
+	for i := range vectors {
+		vectors[i] = vector2.New(values[i*2], values[i*2+1])
+	}
+	return vectors, nil
+}
+
+// WriteVector3 writes the vectors as an array of shape (N, 3)
+func WriteVector3[T vector.Float](w io.Writer, vectors []vector3.Vector[T]) error {
+	values := make([]T, 0, len(vectors)*3)
+	for _, v := range vectors {
+		values = append(values, v.X(), v.Y(), v.Z())
+	}
+	return write(w, values, 3)
+}
+
+// ReadVector3 reads an array of shape (N, 3) into vectors
+func ReadVector3[T vector.Float](r io.Reader) ([]vector3.Vector[T], error) {
+	values, err := read[T](r, 3)
+	if err != nil {
+		return nil, err
+	}
+	vectors := make([]vector3.Vector[T], len(values)/3)
+	for i := range vectors {
+		vectors[i] = vector3.New(values[i*3], values[i*3+1], values[i*3+2])
+	}
+	return vectors, nil
+}
+
+// WriteVector4 writes the vectors as an array of shape (N, 4)
+func WriteVector4[T vector.Float](w io.Writer, vectors []vector4.Vector[T]) error {
+	values := make([]T, 0, len(vectors)*4)
+	for _, v := range vectors {
+		values = append(values, v.X(), v.Y(), v.Z(), v.W())
+	}
+	return write(w, values, 4)
+}
+
+// ReadVector4 reads an array of shape (N, 4) into vectors
+func ReadVector4[T vector.Float](r io.Reader) ([]vector4.Vector[T], error) {
+	values, err := read[T](r, 4)
+	if err != nil {
+		return nil, err
+	}
+	vectors := make([]vector4.Vector[T], len(values)/4)
+	for i := range vectors {
+		vectors[i] = vector4.New(values[i*4], values[i*4+1], values[i*4+2], values[i*4+3])
+	}
+	return vectors, nil
+}
+
+// write writes the row major values as a version 1.0 file
+func write[T vector.Float](w io.Writer, values []T, components int) error {
+	descr, size := "<f8", 8
+	if _, ok := any(T(0)).(float32); ok {
+		descr, size = "<f4", 4
+	}
+
+	header := fmt.Sprintf("{'descr': '%s', 'fortran_order': False, 'shape': (%d, %d), }", descr, len(values)/components, components)
+
+	// The header is padded with spaces and ends in a newline so the data
+	// starts on a 64 byte boundary
+	prefix := len(magic) + 2 + 2
+	header += strings.Repeat(" ", 63-(prefix+len(header))%64) + "\n"
+
+	bw := bufio.NewWriter(w)
+	bw.Write(magic)
+	bw.Write([]byte{1, 0})
+	binary.Write(bw, binary.LittleEndian, uint16(len(header)))
+	bw.WriteString(header)
+
+	buf := make([]byte, size)
+	for _, v := range values {
+		if size == 4 {
+			binary.LittleEndian.PutUint32(buf, math.Float32bits(float32(v)))
+		} else {
+			binary.LittleEndian.PutUint64(buf, math.Float64bits(float64(v)))
+		}
+		if _, err := bw.Write(buf); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+// read reads a file of shape (N, components), returning its values in row
+// major order
+func read[T vector.Float](r io.Reader, components int) ([]T, error) {
+	h, err := readHeader(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(h.shape) != 2 || h.shape[1] != components {
+		return nil, fmt.Errorf("npy: expected shape (N, %d), got %v", components, h.shape)
+	}
+
+	var order binary.ByteOrder
+	switch h.descr[0] {
+	case '<', '=':
+		order = binary.LittleEndian
+	case '>':
+		order = binary.BigEndian
+	default:
+		return nil, fmt.Errorf("npy: unsupported dtype %q", h.descr)
+	}
+
+	size := 0
+	switch h.descr[1:] {
+	case "f4":
+		size = 4
+	case "f8":
+		size = 8
+	default:
+		return nil, fmt.Errorf("npy: unsupported dtype %q, expected float32 or float64", h.descr)
+	}
+
+	if h.shape[0] > math.MaxInt/(components*size) {
+		return nil, fmt.Errorf("npy: shape %v is too large", h.shape)
+	}
+	count := h.shape[0] * components
+
+	// The shape comes from the header, so the buffer grows as data actually
+	// arrives rather than being allocated up front
+	buf := bytes.Buffer{}
+	if n, err := io.CopyN(&buf, r, int64(count*size)); err != nil {
+		if err == io.EOF && n > 0 {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, fmt.Errorf("npy: reading data: %w", err)
+	}
+	data := buf.Bytes()
+
+	values := make([]T, count)
+	for i := range values {
+		// Fortran order stores the array column by column
+		j := i
+		if h.fortranOrder {
+			j = (i%components)*h.shape[0] + i/components
+		}
+		if size == 4 {
+			values[i] = T(math.Float32frombits(order.Uint32(data[j*4:])))
+		} else {
+			values[i] = T(math.Float64frombits(order.Uint64(data[j*8:])))
+		}
+	}
+	return values, nil
+}
+
+type header struct {
+	descr        string
+	fortranOrder bool
+	shape        []int
+}
+
+func readHeader(r io.Reader) (header, error) {
+	prefix := make([]byte, len(magic)+2)
+	if _, err := io.ReadFull(r, prefix); err != nil {
+		return header{}, fmt.Errorf("npy: reading magic: %w", err)
+	}
+	if !bytes.Equal(prefix[:len(magic)], magic) {
+		return header{}, errors.New("npy: not a .npy file")
+	}
+
+	var length int
+	switch major := prefix[len(magic)]; major {
+	case 1:
+		var l uint16
+		if err := binary.Read(r, binary.LittleEndian, &l); err != nil {
+			return header{}, fmt.Errorf("npy: reading header length: %w", err)
+		}
+		length = int(l)
+	case 2, 3:
+		var l uint32
+		if err := binary.Read(r, binary.LittleEndian, &l); err != nil {
+			return header{}, fmt.Errorf("npy: reading header length: %w", err)
+		}
+		length = int(l)
+	default:
+		return header{}, fmt.Errorf("npy: unsupported version %d", major)
+	}
+
+	text := make([]byte, length)
+	if _, err := io.ReadFull(r, text); err != nil {
+		return header{}, fmt.Errorf("npy: reading header: %w", err)
+	}
+	return parseHeader(string(text))
+}
+
+// parseHeader parses the Python dict literal describing the array. Only the
+// fixed set of keys and value forms NumPy writes is supported
+func parseHeader(text string) (header, error) {
+	var h header
+
+	descr, err := headerValue(text, "descr")
+	if err != nil {
+		return h, err
+	}
+	h.descr = strings.Trim(descr, `'"`)
+	if len(h.descr) < 2 {
+		return h, fmt.Errorf("npy: unsupported dtype %q", h.descr)
+	}
+
+	order, err := headerValue(text, "fortran_order")
+	if err != nil {
+		return h, err
+	}
+	h.fortranOrder = order == "True"
+
+	shape, err := headerValue(text, "shape")
+	if err != nil {
+		return h, err
+	}
+	for _, dim := range strings.Split(strings.Trim(shape, "()"), ",") {
+		dim = strings.TrimSpace(dim)
+		if dim == "" {
+			continue
+		}
+		n, err := strconv.Atoi(dim)
+		if err != nil || n < 0 {
+			return h, fmt.Errorf("npy: invalid shape %s", shape)
+		}
+		h.shape = append(h.shape, n)
+	}
+	return h, nil
+}
+
+// headerValue returns the literal value of key in the header dict
+func headerValue(text, key string) (string, error) {
+	i := strings.Index(text, "'"+key+"'")
+	if i < 0 {
+		return "", fmt.Errorf("npy: header is missing %q", key)
+	}
+	rest := strings.TrimSpace(text[i+len(key)+2:])
+	rest = strings.TrimSpace(strings.TrimPrefix(rest, ":"))
+
+	end := strings.IndexAny(rest, ",}")
+	if strings.HasPrefix(rest, "(") {
+		end = strings.Index(rest, ")") + 1
+	}
+	if end <= 0 {
+		return "", fmt.Errorf("npy: malformed header value for %q", key)
+	}
+	return strings.TrimSpace(rest[:end]), nil
+}
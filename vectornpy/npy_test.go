@@ -0,0 +1,132 @@
+package vectornpy_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"testing"
+
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/EliCDavis/vector/vector4"
+	"github.com/EliCDavis/vector/vectornpy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteVector3(t *testing.T) {
+	buf := bytes.Buffer{}
+	require.NoError(t, vectornpy.WriteVector3(&buf, []vector3.Float64{
+		vector3.New(1., 2., 3.),
+		vector3.New(4., 5., 6.),
+	}))
+
+	// Matches the output of np.save
+	data := buf.Bytes()
+	assert.Equal(t, "\x93NUMPY\x01\x00", string(data[:8]))
+	headerLen := int(binary.LittleEndian.Uint16(data[8:]))
+	assert.Equal(t, 0, (10+headerLen)%64)
+	header := string(data[10 : 10+headerLen])
+	assert.Contains(t, header, "{'descr': '<f8', 'fortran_order': False, 'shape': (2, 3), }")
+	assert.Equal(t, byte('\n'), header[len(header)-1])
+	assert.Len(t, data, 10+headerLen+6*8)
+	assert.Equal(t, 6., math.Float64frombits(binary.LittleEndian.Uint64(data[10+headerLen+5*8:])))
+}
+
+func TestRoundTrip(t *testing.T) {
+	v2 := []vector2.Float32{vector2.New[float32](1, -2), vector2.New[float32](0.5, 8)}
+	buf := bytes.Buffer{}
+	require.NoError(t, vectornpy.WriteVector2(&buf, v2))
+	assert.Contains(t, buf.String(), "'<f4'")
+	read2, err := vectornpy.ReadVector2[float32](&buf)
+	require.NoError(t, err)
+	assert.Equal(t, v2, read2)
+
+	v4 := []vector4.Float64{vector4.New(1., 2., 3., 4.)}
+	buf.Reset()
+	require.NoError(t, vectornpy.WriteVector4(&buf, v4))
+	read4, err := vectornpy.ReadVector4[float64](&buf)
+	require.NoError(t, err)
+	assert.Equal(t, v4, read4)
+
+	// Components convert to the requested type
+	buf.Reset()
+	require.NoError(t, vectornpy.WriteVector2(&buf, v2))
+	read64, err := vectornpy.ReadVector2[float64](&buf)
+	require.NoError(t, err)
+	assert.Equal(t, []vector2.Float64{vector2.New(1., -2.), vector2.New(0.5, 8.)}, read64)
+
+	buf.Reset()
+	require.NoError(t, vectornpy.WriteVector3[float64](&buf, nil))
+	empty, err := vectornpy.ReadVector3[float64](&buf)
+	require.NoError(t, err)
+	assert.Empty(t, empty)
+}
+
+// npyFile builds a version 2.0 file by hand
+func npyFile(header string, values ...float32) []byte {
+	buf := bytes.Buffer{}
+	buf.WriteString("\x93NUMPY\x02\x00")
+	binary.Write(&buf, binary.LittleEndian, uint32(len(header)))
+	buf.WriteString(header)
+	binary.Write(&buf, binary.BigEndian, values)
+	return buf.Bytes()
+}
+
+func TestReadFortranBigEndian(t *testing.T) {
+	data := npyFile("{'descr': '>f4', 'fortran_order': True, 'shape': (2, 3), }\n", 1, 4, 2, 5, 3, 6)
+	vectors, err := vectornpy.ReadVector3[float64](bytes.NewReader(data))
+	require.NoError(t, err)
+	assert.Equal(t, []vector3.Float64{vector3.New(1., 2., 3.), vector3.New(4., 5., 6.)}, vectors)
+}
+
+func TestReadErrors(t *testing.T) {
+	tests := map[string]struct {
+		data []byte
+		err  string
+	}{
+		"not npy": {
+			data: []byte("hello world"),
+			err:  "npy: not a .npy file",
+		},
+		"wrong shape": {
+			data: npyFile("{'descr': '>f4', 'fortran_order': False, 'shape': (3, 2), }", 1, 2, 3, 4, 5, 6),
+			err:  "npy: expected shape (N, 3), got [3 2]",
+		},
+		"one dimensional": {
+			data: npyFile("{'descr': '>f4', 'fortran_order': False, 'shape': (3,), }", 1, 2, 3),
+			err:  "npy: expected shape (N, 3), got [3]",
+		},
+		"integers": {
+			data: npyFile("{'descr': '<i4', 'fortran_order': False, 'shape': (1, 3), }", 1, 2, 3),
+			err:  `npy: unsupported dtype "<i4", expected float32 or float64`,
+		},
+		"truncated": {
+			data: npyFile("{'descr': '>f4', 'fortran_order': False, 'shape': (2, 3), }", 1, 2, 3),
+			err:  "npy: reading data: unexpected EOF",
+		},
+		"oversized shape": {
+			data: npyFile("{'descr': '>f8', 'fortran_order': False, 'shape': (999999999999999999, 3), }"),
+			err:  "npy: shape [999999999999999999 3] is too large",
+		},
+		"large shape": {
+			data: npyFile("{'descr': '>f4', 'fortran_order': False, 'shape': (99999999999, 3), }", 1, 2, 3),
+			err:  "npy: reading data: unexpected EOF",
+		},
+		"negative shape": {
+			data: npyFile("{'descr': '>f4', 'fortran_order': False, 'shape': (-1, 3), }"),
+			err:  "npy: invalid shape (-1, 3)",
+		},
+		"missing shape": {
+			data: npyFile("{'descr': '>f4', 'fortran_order': False}"),
+			err:  `npy: header is missing "shape"`,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := vectornpy.ReadVector3[float32](bytes.NewReader(tc.data))
+			assert.EqualError(t, err, tc.err)
+		})
+	}
+}
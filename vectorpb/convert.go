@@ -0,0 +1,103 @@
+// Package vectorpb provides protocol buffer messages for the vector types,
+// along with helpers for converting between the two, so that services
+// exchanging spatial data over gRPC can share the same definitions.
+package vectorpb
+
+//go:generate protoc --go_out=.. --go_opt=paths=source_relative --proto_path=.. vectorpb/vector.proto
+
+import (
+	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/EliCDavis/vector/vector4"
+)
+
+// Vector2ToProto converts the vector into a message with double precision components
+func Vector2ToProto[T vector.Number](v vector2.Vector[T]) *Vector2Double {
+	return &Vector2Double{
+		X: float64(v.X()),
+		Y: float64(v.Y()),
+	}
+}
+
+// Vector2FromProto converts the message into a vector. A nil message results in
+// a zero vector
+func Vector2FromProto[T vector.Number](m *Vector2Double) vector2.Vector[T] {
+	return vector2.New(T(m.GetX()), T(m.GetY()))
+}
+
+// Vector2ToProtoFloat converts the vector into a message with single precision components
+func Vector2ToProtoFloat[T vector.Number](v vector2.Vector[T]) *Vector2Float {
+	return &Vector2Float{
+		X: float32(v.X()),
+		Y: float32(v.Y()),
+	}
+}
+
+// Vector2FromProtoFloat converts the message into a vector. A nil message results in
+// a zero vector
+func Vector2FromProtoFloat[T vector.Number](m *Vector2Float) vector2.Vector[T] {
+	return vector2.New(T(m.GetX()), T(m.GetY()))
+}
+
+// Vector3ToProto converts the vector into a message with double precision components
+func Vector3ToProto[T vector.Number](v vector3.Vector[T]) *Vector3Double {
+	return &Vector3Double{
+		X: float64(v.X()),
+		Y: float64(v.Y()),
+		Z: float64(v.Z()),
+	}
+}
+
+// Vector3FromProto converts the message into a vector. A nil message results in
+// a zero vector
+func Vector3FromProto[T vector.Number](m *Vector3Double) vector3.Vector[T] {
+	return vector3.New(T(m.GetX()), T(m.GetY()), T(m.GetZ()))
+}
+
+// Vector3ToProtoFloat converts the vector into a message with single precision components
+func Vector3ToProtoFloat[T vector.Number](v vector3.Vector[T]) *Vector3Float {
+	return &Vector3Float{
+		X: float32(v.X()),
+		Y: float32(v.Y()),
+		Z: float32(v.Z()),
+	}
+}
+
+// Vector3FromProtoFloat converts the message into a vector. A nil message results in
+// a zero vector
+func Vector3FromProtoFloat[T vector.Number](m *Vector3Float) vector3.Vector[T] {
+	return vector3.New(T(m.GetX()), T(m.GetY()), T(m.GetZ()))
+}
+
+// Vector4ToProto converts the vector into a message with double precision components
+func Vector4ToProto[T vector.Number](v vector4.Vector[T]) *Vector4Double {
+	return &Vector4Double{
+		X: float64(v.X()),
+		Y: float64(v.Y()),
+		Z: float64(v.Z()),
+		W: float64(v.W()),
+	}
+}
+
+// Vector4FromProto converts the message into a vector. A nil message results in
+// a zero vector
+func Vector4FromProto[T vector.Number](m *Vector4Double) vector4.Vector[T] {
+	return vector4.New(T(m.GetX()), T(m.GetY()), T(m.GetZ()), T(m.GetW()))
+}
+
+// Vector4ToProtoFloat converts the vector into a message with single precision components
+func Vector4ToProtoFloat[T vector.Number](v vector4.Vector[T]) *Vector4Float {
+	return &Vector4Float{
+		X: float32(v.X()),
+		Y: float32(v.Y()),
+		Z: float32(v.Z()),
+		W: float32(v.W()),
+	}
+}
+
+// Vector4FromProtoFloat converts the message into a vector. A nil message results in
+// a zero vector
+func Vector4FromProtoFloat[T vector.Number](m *Vector4Float) vector4.Vector[T] {
+	return vector4.New(T(m.GetX()), T(m.GetY()), T(m.GetZ()), T(m.GetW()))
+}
@@ -0,0 +1,54 @@
+package vectorpb_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/EliCDavis/vector/vector4"
+	"github.com/EliCDavis/vector/vectorpb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func TestVector2(t *testing.T) {
+	in := vector2.New(1.5, -2.25)
+
+	assert.Equal(t, in, vectorpb.Vector2FromProto[float64](vectorpb.Vector2ToProto(in)))
+	assert.Equal(t, in.ToFloat32(), vectorpb.Vector2FromProtoFloat[float32](vectorpb.Vector2ToProtoFloat(in)))
+	assert.Equal(t, vector2.New(1, -2), vectorpb.Vector2FromProto[int](vectorpb.Vector2ToProto(in)))
+}
+
+func TestVector3(t *testing.T) {
+	in := vector3.New(1.5, -2.25, 3.)
+
+	assert.Equal(t, in, vectorpb.Vector3FromProto[float64](vectorpb.Vector3ToProto(in)))
+	assert.Equal(t, in.ToFloat32(), vectorpb.Vector3FromProtoFloat[float32](vectorpb.Vector3ToProtoFloat(in)))
+}
+
+func TestVector4(t *testing.T) {
+	in := vector4.New(1.5, -2.25, 3., 4.)
+
+	assert.Equal(t, in, vectorpb.Vector4FromProto[float64](vectorpb.Vector4ToProto(in)))
+	assert.Equal(t, in.ToFloat32(), vectorpb.Vector4FromProtoFloat[float32](vectorpb.Vector4ToProtoFloat(in)))
+}
+
+func TestNilMessage(t *testing.T) {
+	assert.Equal(t, vector3.Zero[float64](), vectorpb.Vector3FromProto[float64](nil))
+}
+
+func TestWireRoundTrip(t *testing.T) {
+	in := vector3.New(1.5, -2.25, 3.)
+
+	data, err := proto.Marshal(vectorpb.Vector3ToProto(in))
+	assert.NoError(t, err)
+
+	msg := &vectorpb.Vector3Double{}
+	assert.NoError(t, proto.Unmarshal(data, msg))
+	assert.Equal(t, in, vectorpb.Vector3FromProto[float64](msg))
+}
+
+func TestProtoPackage(t *testing.T) {
+	assert.Equal(t, protoreflect.FullName("elicdavis.vector.v1.Vector3Double"), (&vectorpb.Vector3Double{}).ProtoReflect().Descriptor().FullName())
+}
@@ -0,0 +1,18 @@
+module github.com/EliCDavis/vector/vectorpb
+
+go 1.21
+
+require (
+	github.com/EliCDavis/vector v0.0.0
+	github.com/stretchr/testify v1.9.0
+	google.golang.org/protobuf v1.36.5
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	golang.org/x/exp v0.0.0-20240409090435-93d18d7e34b8 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+replace github.com/EliCDavis/vector => ../
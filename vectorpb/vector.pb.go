@@ -0,0 +1,474 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        (unknown)
+// source: vectorpb/vector.proto
+
+package vectorpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Vector2Float is a 2 component vector with single precision components
+type Vector2Float struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	X             float32                `protobuf:"fixed32,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y             float32                `protobuf:"fixed32,2,opt,name=y,proto3" json:"y,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Vector2Float) Reset() {
+	*x = Vector2Float{}
+	mi := &file_vectorpb_vector_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Vector2Float) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Vector2Float) ProtoMessage() {}
+
+func (x *Vector2Float) ProtoReflect() protoreflect.Message {
+	mi := &file_vectorpb_vector_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Vector2Float.ProtoReflect.Descriptor instead.
+func (*Vector2Float) Descriptor() ([]byte, []int) {
+	return file_vectorpb_vector_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Vector2Float) GetX() float32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *Vector2Float) GetY() float32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+// Vector2Double is a 2 component vector with double precision components
+type Vector2Double struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	X             float64                `protobuf:"fixed64,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y             float64                `protobuf:"fixed64,2,opt,name=y,proto3" json:"y,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Vector2Double) Reset() {
+	*x = Vector2Double{}
+	mi := &file_vectorpb_vector_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Vector2Double) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Vector2Double) ProtoMessage() {}
+
+func (x *Vector2Double) ProtoReflect() protoreflect.Message {
+	mi := &file_vectorpb_vector_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Vector2Double.ProtoReflect.Descriptor instead.
+func (*Vector2Double) Descriptor() ([]byte, []int) {
+	return file_vectorpb_vector_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Vector2Double) GetX() float64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *Vector2Double) GetY() float64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+// Vector3Float is a 3 component vector with single precision components
+type Vector3Float struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	X             float32                `protobuf:"fixed32,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y             float32                `protobuf:"fixed32,2,opt,name=y,proto3" json:"y,omitempty"`
+	Z             float32                `protobuf:"fixed32,3,opt,name=z,proto3" json:"z,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Vector3Float) Reset() {
+	*x = Vector3Float{}
+	mi := &file_vectorpb_vector_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Vector3Float) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Vector3Float) ProtoMessage() {}
+
+func (x *Vector3Float) ProtoReflect() protoreflect.Message {
+	mi := &file_vectorpb_vector_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Vector3Float.ProtoReflect.Descriptor instead.
+func (*Vector3Float) Descriptor() ([]byte, []int) {
+	return file_vectorpb_vector_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Vector3Float) GetX() float32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *Vector3Float) GetY() float32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *Vector3Float) GetZ() float32 {
+	if x != nil {
+		return x.Z
+	}
+	return 0
+}
+
+// Vector3Double is a 3 component vector with double precision components
+type Vector3Double struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	X             float64                `protobuf:"fixed64,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y             float64                `protobuf:"fixed64,2,opt,name=y,proto3" json:"y,omitempty"`
+	Z             float64                `protobuf:"fixed64,3,opt,name=z,proto3" json:"z,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Vector3Double) Reset() {
+	*x = Vector3Double{}
+	mi := &file_vectorpb_vector_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Vector3Double) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Vector3Double) ProtoMessage() {}
+
+func (x *Vector3Double) ProtoReflect() protoreflect.Message {
+	mi := &file_vectorpb_vector_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Vector3Double.ProtoReflect.Descriptor instead.
+func (*Vector3Double) Descriptor() ([]byte, []int) {
+	return file_vectorpb_vector_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Vector3Double) GetX() float64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *Vector3Double) GetY() float64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *Vector3Double) GetZ() float64 {
+	if x != nil {
+		return x.Z
+	}
+	return 0
+}
+
+// Vector4Float is a 4 component vector with single precision components
+type Vector4Float struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	X             float32                `protobuf:"fixed32,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y             float32                `protobuf:"fixed32,2,opt,name=y,proto3" json:"y,omitempty"`
+	Z             float32                `protobuf:"fixed32,3,opt,name=z,proto3" json:"z,omitempty"`
+	W             float32                `protobuf:"fixed32,4,opt,name=w,proto3" json:"w,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Vector4Float) Reset() {
+	*x = Vector4Float{}
+	mi := &file_vectorpb_vector_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Vector4Float) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Vector4Float) ProtoMessage() {}
+
+func (x *Vector4Float) ProtoReflect() protoreflect.Message {
+	mi := &file_vectorpb_vector_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Vector4Float.ProtoReflect.Descriptor instead.
+func (*Vector4Float) Descriptor() ([]byte, []int) {
+	return file_vectorpb_vector_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Vector4Float) GetX() float32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *Vector4Float) GetY() float32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *Vector4Float) GetZ() float32 {
+	if x != nil {
+		return x.Z
+	}
+	return 0
+}
+
+func (x *Vector4Float) GetW() float32 {
+	if x != nil {
+		return x.W
+	}
+	return 0
+}
+
+// Vector4Double is a 4 component vector with double precision components
+type Vector4Double struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	X             float64                `protobuf:"fixed64,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y             float64                `protobuf:"fixed64,2,opt,name=y,proto3" json:"y,omitempty"`
+	Z             float64                `protobuf:"fixed64,3,opt,name=z,proto3" json:"z,omitempty"`
+	W             float64                `protobuf:"fixed64,4,opt,name=w,proto3" json:"w,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Vector4Double) Reset() {
+	*x = Vector4Double{}
+	mi := &file_vectorpb_vector_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Vector4Double) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Vector4Double) ProtoMessage() {}
+
+func (x *Vector4Double) ProtoReflect() protoreflect.Message {
+	mi := &file_vectorpb_vector_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Vector4Double.ProtoReflect.Descriptor instead.
+func (*Vector4Double) Descriptor() ([]byte, []int) {
+	return file_vectorpb_vector_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Vector4Double) GetX() float64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *Vector4Double) GetY() float64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *Vector4Double) GetZ() float64 {
+	if x != nil {
+		return x.Z
+	}
+	return 0
+}
+
+func (x *Vector4Double) GetW() float64 {
+	if x != nil {
+		return x.W
+	}
+	return 0
+}
+
+var File_vectorpb_vector_proto protoreflect.FileDescriptor
+
+var file_vectorpb_vector_proto_rawDesc = string([]byte{
+	0x0a, 0x15, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2f, 0x76, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x65, 0x6c, 0x69, 0x63, 0x64, 0x61, 0x76,
+	0x69, 0x73, 0x2e, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x22, 0x2a, 0x0a, 0x0c,
+	0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x32, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x12, 0x0c, 0x0a, 0x01,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x79, 0x22, 0x2b, 0x0a, 0x0d, 0x56, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x32, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x01, 0x79, 0x22, 0x38, 0x0a, 0x0c, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x33,
+	0x46, 0x6c, 0x6f, 0x61, 0x74, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01,
+	0x79, 0x12, 0x0c, 0x0a, 0x01, 0x7a, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x7a, 0x22,
+	0x39, 0x0a, 0x0d, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x33, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65,
+	0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x78, 0x12, 0x0c,
+	0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x79, 0x12, 0x0c, 0x0a, 0x01,
+	0x7a, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x7a, 0x22, 0x46, 0x0a, 0x0c, 0x56, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x34, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x01, 0x79, 0x12, 0x0c, 0x0a, 0x01, 0x7a, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x01, 0x7a, 0x12, 0x0c, 0x0a, 0x01, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x01, 0x77, 0x22, 0x47, 0x0a, 0x0d, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x34, 0x44, 0x6f, 0x75,
+	0x62, 0x6c, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01,
+	0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x79, 0x12,
+	0x0c, 0x0a, 0x01, 0x7a, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x7a, 0x12, 0x0c, 0x0a,
+	0x01, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x77, 0x42, 0x26, 0x5a, 0x24, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x45, 0x6c, 0x69, 0x43, 0x44, 0x61,
+	0x76, 0x69, 0x73, 0x2f, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_vectorpb_vector_proto_rawDescOnce sync.Once
+	file_vectorpb_vector_proto_rawDescData []byte
+)
+
+func file_vectorpb_vector_proto_rawDescGZIP() []byte {
+	file_vectorpb_vector_proto_rawDescOnce.Do(func() {
+		file_vectorpb_vector_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_vectorpb_vector_proto_rawDesc), len(file_vectorpb_vector_proto_rawDesc)))
+	})
+	return file_vectorpb_vector_proto_rawDescData
+}
+
+var file_vectorpb_vector_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_vectorpb_vector_proto_goTypes = []any{
+	(*Vector2Float)(nil),  // 0: elicdavis.vector.v1.Vector2Float
+	(*Vector2Double)(nil), // 1: elicdavis.vector.v1.Vector2Double
+	(*Vector3Float)(nil),  // 2: elicdavis.vector.v1.Vector3Float
+	(*Vector3Double)(nil), // 3: elicdavis.vector.v1.Vector3Double
+	(*Vector4Float)(nil),  // 4: elicdavis.vector.v1.Vector4Float
+	(*Vector4Double)(nil), // 5: elicdavis.vector.v1.Vector4Double
+}
+var file_vectorpb_vector_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_vectorpb_vector_proto_init() }
+func file_vectorpb_vector_proto_init() {
+	if File_vectorpb_vector_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_vectorpb_vector_proto_rawDesc), len(file_vectorpb_vector_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_vectorpb_vector_proto_goTypes,
+		DependencyIndexes: file_vectorpb_vector_proto_depIdxs,
+		MessageInfos:      file_vectorpb_vector_proto_msgTypes,
+	}.Build()
+	File_vectorpb_vector_proto = out.File
+	file_vectorpb_vector_proto_goTypes = nil
+	file_vectorpb_vector_proto_depIdxs = nil
+}
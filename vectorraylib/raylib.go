@@ -0,0 +1,50 @@
+// Package vectorraylib converts between the vector types and the Vector2 and
+// Vector3 structs of raylib-go.
+//
+// raylib-go's vectors are plain structs of float32 fields. The Vector2 and
+// Vector3 types here share their exact layout, so Go allows converting
+// between them directly and this package doesn't need to depend on raylib-go
+// or cgo:
+//
+//	rl.DrawCircleV(rl.Vector2(vectorraylib.Vector2ToRaylib(position)), 4, rl.Red)
+//	position = vectorraylib.Vector2FromRaylib[float64](vectorraylib.Vector2(rl.GetMousePosition()))
+package vectorraylib
+
+import (
+	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vector3"
+)
+
+// Vector2 is convertible to and from rl.Vector2
+type Vector2 struct {
+	X float32
+	Y float32
+}
+
+// Vector3 is convertible to and from rl.Vector3
+type Vector3 struct {
+	X float32
+	Y float32
+	Z float32
+}
+
+// Vector2ToRaylib converts the vector into the layout of rl.Vector2
+func Vector2ToRaylib[T vector.Number](v vector2.Vector[T]) Vector2 {
+	return Vector2{X: float32(v.X()), Y: float32(v.Y())}
+}
+
+// Vector2FromRaylib converts the layout of rl.Vector2 into a vector
+func Vector2FromRaylib[T vector.Number](v Vector2) vector2.Vector[T] {
+	return vector2.New(T(v.X), T(v.Y))
+}
+
+// Vector3ToRaylib converts the vector into the layout of rl.Vector3
+func Vector3ToRaylib[T vector.Number](v vector3.Vector[T]) Vector3 {
+	return Vector3{X: float32(v.X()), Y: float32(v.Y()), Z: float32(v.Z())}
+}
+
+// Vector3FromRaylib converts the layout of rl.Vector3 into a vector
+func Vector3FromRaylib[T vector.Number](v Vector3) vector3.Vector[T] {
+	return vector3.New(T(v.X), T(v.Y), T(v.Z))
+}
@@ -0,0 +1,41 @@
+package vectorraylib_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/EliCDavis/vector/vectorraylib"
+	"github.com/stretchr/testify/assert"
+)
+
+// Mirror raylib-go's definitions to verify they're convertible without
+// depending on it
+type (
+	rlVector2 struct {
+		X float32
+		Y float32
+	}
+	rlVector3 struct {
+		X float32
+		Y float32
+		Z float32
+	}
+)
+
+func TestVector2(t *testing.T) {
+	in := vector2.New(1.5, -2.25)
+
+	rl := rlVector2(vectorraylib.Vector2ToRaylib(in))
+	assert.Equal(t, rlVector2{X: 1.5, Y: -2.25}, rl)
+	assert.Equal(t, in, vectorraylib.Vector2FromRaylib[float64](vectorraylib.Vector2(rl)))
+}
+
+func TestVector3(t *testing.T) {
+	in := vector3.New(1.5, -2.25, 3.)
+
+	rl := rlVector3(vectorraylib.Vector3ToRaylib(in))
+	assert.Equal(t, rlVector3{X: 1.5, Y: -2.25, Z: 3}, rl)
+	assert.Equal(t, in, vectorraylib.Vector3FromRaylib[float64](vectorraylib.Vector3(rl)))
+	assert.Equal(t, vector3.New(1, -2, 3), vectorraylib.Vector3FromRaylib[int](vectorraylib.Vector3(rl)))
+}
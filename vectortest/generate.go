@@ -0,0 +1,105 @@
+package vectortest
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/EliCDavis/vector/mathex"
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/EliCDavis/vector/vector4"
+)
+
+// EdgeCases returns the floating point values geometry code most often
+// mishandles: signed zeros, ±1, the extremes of the float64 range, denormals,
+// infinities, and NaN
+func EdgeCases() []float64 {
+	return []float64{
+		0,
+		math.Copysign(0, -1),
+		1,
+		-1,
+		math.MaxFloat64,
+		-math.MaxFloat64,
+		math.SmallestNonzeroFloat64,
+		-math.SmallestNonzeroFloat64,
+		0x1p-1023, // Denormal, half the smallest normal value
+		math.Inf(1),
+		math.Inf(-1),
+		math.NaN(),
+	}
+}
+
+// RandComponent returns a random value suitable for stress testing geometry
+// code. Most values are ordinary, falling within [-1000, 1000], while the
+// rest are evenly split between edge cases, denormals, and very large
+// magnitudes
+func RandComponent(r *rand.Rand) float64 {
+	switch p := r.Float64(); {
+	case p < 0.7:
+		return (r.Float64()*2 - 1) * 1000
+	case p < 0.8:
+		edges := EdgeCases()
+		return edges[r.Intn(len(edges))]
+	case p < 0.9:
+		// Denormals occupy the range below 0x1p-1022
+		return mathex.CopySign(r.Float64()*0x1p-1022, r.Float64()-0.5)
+	default:
+		return mathex.CopySign(math.Ldexp(1+r.Float64(), 900+r.Intn(123)), r.Float64()-0.5)
+	}
+}
+
+// RandVector2 returns a vector with each component produced by RandComponent
+func RandVector2(r *rand.Rand) vector2.Float64 {
+	return vector2.New(RandComponent(r), RandComponent(r))
+}
+
+// RandVector3 returns a vector with each component produced by RandComponent
+func RandVector3(r *rand.Rand) vector3.Float64 {
+	return vector3.New(RandComponent(r), RandComponent(r), RandComponent(r))
+}
+
+// RandVector4 returns a vector with each component produced by RandComponent
+func RandVector4(r *rand.Rand) vector4.Float64 {
+	return vector4.New(RandComponent(r), RandComponent(r), RandComponent(r), RandComponent(r))
+}
+
+// randGaussianDirection fills components with normally distributed values,
+// which once normalized are uniformly distributed over the unit sphere
+func randGaussianDirection(r *rand.Rand, components []float64) {
+	for {
+		lengthSquared := 0.
+		for i := range components {
+			components[i] = mathex.RandNormal(r, 0, 1)
+			lengthSquared += components[i] * components[i]
+		}
+		if lengthSquared > 1e-12 {
+			length := math.Sqrt(lengthSquared)
+			for i := range components {
+				components[i] /= length
+			}
+			return
+		}
+	}
+}
+
+// RandUnit2 returns a vector uniformly distributed over the unit circle
+func RandUnit2(r *rand.Rand) vector2.Float64 {
+	var c [2]float64
+	randGaussianDirection(r, c[:])
+	return vector2.New(c[0], c[1])
+}
+
+// RandUnit3 returns a vector uniformly distributed over the unit sphere
+func RandUnit3(r *rand.Rand) vector3.Float64 {
+	var c [3]float64
+	randGaussianDirection(r, c[:])
+	return vector3.New(c[0], c[1], c[2])
+}
+
+// RandUnit4 returns a vector uniformly distributed over the unit 3-sphere
+func RandUnit4(r *rand.Rand) vector4.Float64 {
+	var c [4]float64
+	randGaussianDirection(r, c[:])
+	return vector4.New(c[0], c[1], c[2], c[3])
+}
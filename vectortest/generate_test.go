@@ -0,0 +1,59 @@
+package vectortest_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+	"testing/quick"
+
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/EliCDavis/vector/vector4"
+	"github.com/EliCDavis/vector/vectortest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuickGenerator(t *testing.T) {
+	assert.NoError(t, quick.Check(func(a, b vector3.Float64) bool {
+		return a.Add(b).Sub(b).Distance(a) < 1e-9
+	}, nil))
+
+	assert.NoError(t, quick.Check(func(v vector2.Vector[int8]) bool {
+		return v.X() >= -100 && v.X() <= 100
+	}, &quick.Config{MaxCountScale: 0.1}))
+
+	assert.NoError(t, quick.Check(func(v vector4.Vector[int]) bool {
+		return v.Add(v) == v.Scale(2)
+	}, nil))
+}
+
+func TestRandUnit(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for i := 0; i < 100; i++ {
+		assert.InDelta(t, 1, vectortest.RandUnit2(r).Length(), 1e-12)
+		assert.InDelta(t, 1, vectortest.RandUnit3(r).Length(), 1e-12)
+		assert.InDelta(t, 1, vectortest.RandUnit4(r).Length(), 1e-12)
+	}
+}
+
+func TestRandComponent(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	var nan, inf, denormal, large int
+	for i := 0; i < 10000; i++ {
+		v := vectortest.RandVector3(r).X()
+		switch {
+		case math.IsNaN(v):
+			nan++
+		case math.IsInf(v, 0):
+			inf++
+		case v != 0 && math.Abs(v) < 0x1p-1022:
+			denormal++
+		case math.Abs(v) > 1e200:
+			large++
+		}
+	}
+	assert.Greater(t, nan, 0)
+	assert.Greater(t, inf, 0)
+	assert.Greater(t, denormal, 500)
+	assert.Greater(t, large, 500)
+}
@@ -0,0 +1,148 @@
+// Package vectortest provides test assertions for comparing vectors and slices
+// of vectors component by component within a tolerance, reporting exactly
+// which component of which element differed on failure.
+package vectortest
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/EliCDavis/vector/vector4"
+	"github.com/stretchr/testify/assert"
+)
+
+type tHelper interface {
+	Helper()
+}
+
+var componentNames = []string{"x", "y", "z", "w"}
+
+// mismatches lists every component of got that isn't within delta of want.
+// NaN components are only considered equal to other NaN components
+func mismatches(want, got []float64, delta float64) []string {
+	var out []string
+	for i := range want {
+		w, g := want[i], got[i]
+		if math.IsNaN(w) && math.IsNaN(g) {
+			continue
+		}
+		if !(math.Abs(w-g) <= delta) && w != g {
+			out = append(out, fmt.Sprintf("%s: expected %v, actual %v (difference %v)", componentNames[i], w, g, g-w))
+		}
+	}
+	return out
+}
+
+func compare(t assert.TestingT, want, got []float64, delta float64, msgAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	diffs := mismatches(want, got, delta)
+	if len(diffs) == 0 {
+		return true
+	}
+	return assert.Fail(t, fmt.Sprintf(
+		"Vectors differ by more than %v\nexpected: %v\nactual:   %v\n%s",
+		delta, want, got, strings.Join(diffs, "\n"),
+	), msgAndArgs...)
+}
+
+func compareSlices[V any](t assert.TestingT, want, got []V, delta float64, components func(V) []float64, msgAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if len(want) != len(got) {
+		return assert.Fail(t, fmt.Sprintf("Expected %d vectors, actual %d", len(want), len(got)), msgAndArgs...)
+	}
+
+	var failures []string
+	failed := 0
+	for i := range want {
+		diffs := mismatches(components(want[i]), components(got[i]), delta)
+		if len(diffs) > 0 {
+			failed++
+		}
+		for _, diff := range diffs {
+			failures = append(failures, fmt.Sprintf("[%d] %s", i, diff))
+		}
+	}
+	if failed == 0 {
+		return true
+	}
+	return assert.Fail(t, fmt.Sprintf(
+		"%d of %d vectors differ by more than %v\n%s",
+		failed, len(want), delta, strings.Join(failures, "\n"),
+	), msgAndArgs...)
+}
+
+func components2[T vector.Number](v vector2.Vector[T]) []float64 {
+	return []float64{float64(v.X()), float64(v.Y())}
+}
+
+func components3[T vector.Number](v vector3.Vector[T]) []float64 {
+	return []float64{float64(v.X()), float64(v.Y()), float64(v.Z())}
+}
+
+func components4[T vector.Number](v vector4.Vector[T]) []float64 {
+	return []float64{float64(v.X()), float64(v.Y()), float64(v.Z()), float64(v.W())}
+}
+
+// AssertVector2InDelta asserts that each component of got is within delta of
+// the corresponding component of want
+func AssertVector2InDelta[T vector.Number](t assert.TestingT, want, got vector2.Vector[T], delta float64, msgAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return compare(t, components2(want), components2(got), delta, msgAndArgs...)
+}
+
+// AssertVector3InDelta asserts that each component of got is within delta of
+// the corresponding component of want
+func AssertVector3InDelta[T vector.Number](t assert.TestingT, want, got vector3.Vector[T], delta float64, msgAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return compare(t, components3(want), components3(got), delta, msgAndArgs...)
+}
+
+// AssertVector4InDelta asserts that each component of got is within delta of
+// the corresponding component of want
+func AssertVector4InDelta[T vector.Number](t assert.TestingT, want, got vector4.Vector[T], delta float64, msgAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return compare(t, components4(want), components4(got), delta, msgAndArgs...)
+}
+
+// AssertVector2sInDelta asserts that both slices have the same length and
+// that every component of every vector in got is within delta of want
+func AssertVector2sInDelta[T vector.Number](t assert.TestingT, want, got []vector2.Vector[T], delta float64, msgAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return compareSlices(t, want, got, delta, components2[T], msgAndArgs...)
+}
+
+// AssertVector3sInDelta asserts that both slices have the same length and
+// that every component of every vector in got is within delta of want
+func AssertVector3sInDelta[T vector.Number](t assert.TestingT, want, got []vector3.Vector[T], delta float64, msgAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return compareSlices(t, want, got, delta, components3[T], msgAndArgs...)
+}
+
+// AssertVector4sInDelta asserts that both slices have the same length and
+// that every component of every vector in got is within delta of want
+func AssertVector4sInDelta[T vector.Number](t assert.TestingT, want, got []vector4.Vector[T], delta float64, msgAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return compareSlices(t, want, got, delta, components4[T], msgAndArgs...)
+}
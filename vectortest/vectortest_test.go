@@ -0,0 +1,49 @@
+package vectortest_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/EliCDavis/vector/vector4"
+	"github.com/EliCDavis/vector/vectortest"
+	"github.com/stretchr/testify/assert"
+)
+
+type recorder struct {
+	messages []string
+}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.messages = append(r.messages, fmt.Sprintf(format, args...))
+}
+
+func TestAssertInDelta(t *testing.T) {
+	assert.True(t, vectortest.AssertVector2InDelta(t, vector2.New(1., 2.), vector2.New(1.001, 1.999), 0.01))
+	assert.True(t, vectortest.AssertVector3InDelta(t, vector3.New(1., 2., 3.), vector3.New(1., 2., 3.0001), 0.001))
+	assert.True(t, vectortest.AssertVector4InDelta(t, vector4.New(1., math.NaN(), 3., 4.), vector4.New(1., math.NaN(), 3., 4.), 0))
+
+	rec := &recorder{}
+	assert.False(t, vectortest.AssertVector3InDelta(rec, vector3.New(1., 2., 3.), vector3.New(1., 2.5, 3.), 0.1))
+	assert.Len(t, rec.messages, 1)
+	assert.Contains(t, rec.messages[0], "y: expected 2, actual 2.5")
+	assert.NotContains(t, rec.messages[0], "x:")
+}
+
+func TestAssertSlicesInDelta(t *testing.T) {
+	want := []vector3.Float64{vector3.New(1., 2., 3.), vector3.New(4., 5., 6.)}
+
+	assert.True(t, vectortest.AssertVector3sInDelta(t, want, []vector3.Float64{vector3.New(1., 2., 3.), vector3.New(4., 5., 6.001)}, 0.01))
+
+	rec := &recorder{}
+	assert.False(t, vectortest.AssertVector3sInDelta(rec, want, want[:1], 0.01))
+	assert.Contains(t, rec.messages[0], "Expected 2 vectors, actual 1")
+
+	rec = &recorder{}
+	assert.False(t, vectortest.AssertVector3sInDelta(rec, want, []vector3.Float64{vector3.New(1., 2., 3.), vector3.New(4., 0., 0.)}, 0.01))
+	assert.Contains(t, rec.messages[0], "1 of 2 vectors differ")
+	assert.Contains(t, rec.messages[0], "[1] y: expected 5, actual 0")
+	assert.Contains(t, rec.messages[0], "[1] z: expected 6, actual 0")
+}